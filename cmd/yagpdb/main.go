@@ -26,6 +26,8 @@ import (
 	"github.com/ThatBathroom/yagpdb/v2/commands"
 	"github.com/ThatBathroom/yagpdb/v2/customcommands"
 	"github.com/ThatBathroom/yagpdb/v2/discordlogger"
+	"github.com/ThatBathroom/yagpdb/v2/incomingwebhooks"
+	"github.com/ThatBathroom/yagpdb/v2/leveling"
 	"github.com/ThatBathroom/yagpdb/v2/logs"
 	"github.com/ThatBathroom/yagpdb/v2/moderation"
 	"github.com/ThatBathroom/yagpdb/v2/notifications"
@@ -73,6 +75,7 @@ func main() {
 	reddit.RegisterPlugin()
 	moderation.RegisterPlugin()
 	reputation.RegisterPlugin()
+	leveling.RegisterPlugin()
 	streaming.RegisterPlugin()
 	automod_legacy.RegisterPlugin()
 	automod.RegisterPlugin()
@@ -98,6 +101,7 @@ func main() {
 	featureflags.RegisterPlugin()
 	trivia.RegisterPlugin()
 	rss.RegisterPlugin()
+	incomingwebhooks.RegisterPlugin()
 	bulkrole.RegisterPlugin()
 	personalizer.RegisterPlugin()
 
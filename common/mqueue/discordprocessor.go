@@ -104,6 +104,12 @@ func trySendNormal(l *logrus.Entry, elem *QueuedElement) (err error) {
 			return err
 		}
 
+		if source, ok := sources[elem.Source]; ok {
+			if handler, ok := source.(PluginWithMessageSentHandler); ok {
+				handler.MessageSent(elem, m)
+			}
+		}
+
 		if elem.PublishAnnouncement {
 			_, err = common.BotSession.ChannelMessageCrosspost(elem.ChannelID, m.ID)
 		}
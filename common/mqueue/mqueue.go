@@ -22,6 +22,12 @@ type PluginWithSourceDisabler interface {
 	DisableFeed(elem *QueuedElement, err error)
 }
 
+// PluginWithMessageSentHandler can be implemented by sources that need to know the message a queued element ended up as,
+// for example to edit it later. Only called for messages not sent through webhooks.
+type PluginWithMessageSentHandler interface {
+	MessageSent(elem *QueuedElement, msg *discordgo.Message)
+}
+
 // PluginWithWebhookAvatar can be implemented by plugins for custom avatars
 type PluginWithWebhookAvatar interface {
 	WebhookAvatar() string
@@ -0,0 +1,376 @@
+package feeds
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/common/mqueue"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/timezonecompanion"
+	"github.com/mediocregopher/radix/v3"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Delivery modes of a feed subscription
+const (
+	DigestModeImmediate = ""
+	DigestModeHourly    = "hourly"
+	DigestModeDaily     = "daily"
+)
+
+const (
+	// Max items kept for a single digest, older ones are dropped
+	DigestMaxItems = 25
+
+	// Valid intervals of hourly digests
+	DigestMinIntervalHours = 1
+	DigestMaxIntervalHours = 24
+
+	// pending items are kept a bit longer than the longest digest period
+	digestItemsTTL = 60 * 60 * 48
+
+	// how long to wait before sending a digest again that failed to be sent
+	digestRetryDelay = time.Minute * 5
+
+	// sorted set of "source:subscription" members with pending digests, scored by when they're due
+	keyDigestsDue = "feed_digests_due"
+)
+
+func keyDigestItems(source string, subscriptionID int64) string {
+	return "feed_digest_items:" + source + ":" + strconv.FormatInt(subscriptionID, 10)
+}
+
+func ValidDigestMode(mode string) bool {
+	return mode == DigestModeImmediate || mode == DigestModeHourly || mode == DigestModeDaily
+}
+
+// DigestSettings is how a subscription collects its items
+type DigestSettings struct {
+	Mode string
+
+	// Hours between hourly digests
+	IntervalHours int
+
+	// When the daily digest is posted, in minutes after midnight in the guild's timezone
+	DailyAt int
+}
+
+// Immediate returns true if items should be posted right away instead of being collected into a digest
+func (s DigestSettings) Immediate() bool {
+	return s.Mode != DigestModeHourly && s.Mode != DigestModeDaily
+}
+
+func (s DigestSettings) describe() string {
+	switch s.Mode {
+	case DigestModeDaily:
+		return "Daily digest"
+	case DigestModeHourly:
+		if s.IntervalHours > 1 {
+			return fmt.Sprintf("Digest of the last %d hours", s.IntervalHours)
+		}
+		return "Hourly digest"
+	}
+
+	return ""
+}
+
+// NextDigestTime returns when the digest of items collected at now is due, daily digests are posted at the configured
+// time in loc (UTC if nil)
+func NextDigestTime(now time.Time, settings DigestSettings, loc *time.Location) time.Time {
+	if settings.Mode == DigestModeDaily {
+		if loc == nil {
+			loc = time.UTC
+		}
+
+		local := now.In(loc)
+		at := time.Date(local.Year(), local.Month(), local.Day(), settings.DailyAt/60, settings.DailyAt%60, 0, 0, loc)
+		if !at.After(local) {
+			at = at.AddDate(0, 0, 1)
+		}
+		return at
+	}
+
+	interval := settings.IntervalHours
+	if interval < DigestMinIntervalHours {
+		interval = DigestMinIntervalHours
+	} else if interval > DigestMaxIntervalHours {
+		interval = DigestMaxIntervalHours
+	}
+
+	return now.Truncate(time.Hour).Add(time.Hour * time.Duration(interval))
+}
+
+// ParseDigestTime parses a "HH:MM" time of day into minutes after midnight
+func ParseDigestTime(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, errors.New("invalid digest time, expected HH:MM")
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// ParseDigestForm validates the delivery mode and daily digest time submitted from the control panel,
+// returning the daily time in minutes after midnight
+func ParseDigestForm(mode, dailyAt string) (int, error) {
+	if !ValidDigestMode(mode) {
+		return 0, errors.New("invalid delivery mode")
+	}
+
+	if mode != DigestModeDaily && strings.TrimSpace(dailyAt) == "" {
+		return 0, nil
+	}
+
+	return ParseDigestTime(dailyAt)
+}
+
+// DigestItem is a single feed item in a digest
+type DigestItem struct {
+	Title  string
+	URL    string
+	Author string `json:",omitempty"`
+
+	// Shown after the author, e.g the flair of a reddit post
+	Extra string `json:",omitempty"`
+
+	// Replaces the default line of the item when set, e.g the output of a custom template
+	Line string `json:",omitempty"`
+}
+
+func (d *DigestItem) line() string {
+	if d.Line != "" {
+		return common.CutStringShort(d.Line, 300)
+	}
+
+	title := common.CutStringShort(strings.TrimSpace(d.Title), 100)
+	if title == "" {
+		title = "(no title)"
+	}
+
+	line := "• [" + title + "](<" + d.URL + ">)"
+	if d.Author != "" {
+		line += " by " + d.Author
+	}
+	if d.Extra != "" {
+		line += " · " + common.CutStringShort(d.Extra, 30)
+	}
+
+	return line
+}
+
+// DigestTarget is where and how the digest of a subscription is posted
+type DigestTarget struct {
+	GuildID   int64
+	ChannelID int64
+	Settings  DigestSettings
+
+	// Name of the feed, e.g "r/golang"
+	Name  string
+	URL   string
+	Color int
+
+	// Posted alongside the embed, used for mentions
+	Content         string
+	AllowedMentions discordgo.AllowedMentions
+
+	UseWebhook      bool
+	WebhookUsername string
+}
+
+// DigestPlugin is implemented by feeds that route items through digests
+type DigestPlugin interface {
+	Plugin
+
+	// DigestTarget returns where the digest of the subscription should be posted,
+	// nil if the subscription was removed, disabled or no longer uses digests
+	DigestTarget(subscriptionID int64) (*DigestTarget, error)
+}
+
+// QueueDigestItem adds the item to the next digest of the subscription, scheduling the digest if there's none pending
+func QueueDigestItem(source string, subscriptionID, guildID, channelID int64, settings DigestSettings, item *DigestItem) error {
+	serialized, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	var loc *time.Location
+	if settings.Mode == DigestModeDaily {
+		loc = timezonecompanion.GetGuildTimezone(guildID, channelID)
+	}
+	due := NextDigestTime(time.Now(), settings, loc).Unix()
+
+	key := keyDigestItems(source, subscriptionID)
+	return common.RedisPool.Do(radix.Pipeline(
+		radix.Cmd(nil, "RPUSH", key, string(serialized)),
+		radix.Cmd(nil, "LTRIM", key, strconv.Itoa(-DigestMaxItems), "-1"),
+		radix.FlatCmd(nil, "EXPIRE", key, digestItemsTTL),
+		radix.FlatCmd(nil, "ZADD", keyDigestsDue, "NX", due, source+":"+strconv.FormatInt(subscriptionID, 10)),
+	))
+}
+
+var stopDigests chan *sync.WaitGroup
+
+func runDigestLoop() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case wg := <-stopDigests:
+			wg.Done()
+			return
+		case <-ticker.C:
+			err := sendDueDigests()
+			if err != nil {
+				logger.WithError(err).Error("failed sending feed digests")
+			}
+		}
+	}
+}
+
+func sendDueDigests() error {
+	var due []string
+	err := common.RedisPool.Do(radix.FlatCmd(&due, "ZRANGEBYSCORE", keyDigestsDue, "-inf", time.Now().Unix()))
+	if err != nil {
+		return err
+	}
+
+	for _, member := range due {
+		// only one feed process should send the digest
+		var removed int
+		err = common.RedisPool.Do(radix.Cmd(&removed, "ZREM", keyDigestsDue, member))
+		if err != nil {
+			return err
+		}
+
+		if removed < 1 {
+			continue
+		}
+
+		err = sendDigest(member)
+		if err != nil {
+			logger.WithError(err).WithField("digest", member).Error("failed sending feed digest")
+		}
+	}
+
+	return nil
+}
+
+func findDigestPlugin(source string) DigestPlugin {
+	for _, v := range common.Plugins {
+		if dp, ok := v.(DigestPlugin); ok && dp.PluginInfo().SysName == source {
+			return dp
+		}
+	}
+
+	return nil
+}
+
+func sendDigest(member string) error {
+	source, rawID, ok := strings.Cut(member, ":")
+	if !ok {
+		return nil
+	}
+
+	subscriptionID, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	key := keyDigestItems(source, subscriptionID)
+
+	var serializedItems []string
+	err = common.RedisPool.Do(radix.Cmd(&serializedItems, "LRANGE", key, "0", "-1"))
+	if err != nil {
+		return err
+	}
+
+	if len(serializedItems) == 0 {
+		return nil
+	}
+
+	plugin := findDigestPlugin(source)
+	if plugin == nil {
+		return fmt.Errorf("unknown digest source %q", source)
+	}
+
+	target, err := plugin.DigestTarget(subscriptionID)
+	if err != nil {
+		// the items are kept, try again in a bit
+		return retryDigest(member, err)
+	}
+
+	if target == nil {
+		// the subscription is gone, so are its items
+		return trimDigestItems(key, len(serializedItems))
+	}
+
+	lines := make([]string, 0, len(serializedItems))
+	for _, v := range serializedItems {
+		var item DigestItem
+		if err := json.Unmarshal([]byte(v), &item); err != nil {
+			continue
+		}
+		lines = append(lines, item.line())
+	}
+
+	if len(lines) == 0 {
+		return trimDigestItems(key, len(serializedItems))
+	}
+
+	err = mqueue.QueueMessage(&mqueue.QueuedElement{
+		Source:       source,
+		SourceItemID: strconv.FormatInt(subscriptionID, 10),
+
+		GuildID:   target.GuildID,
+		ChannelID: target.ChannelID,
+
+		MessageStr: target.Content,
+		MessageEmbed: &discordgo.MessageEmbed{
+			Author: &discordgo.MessageEmbedAuthor{
+				Name: target.Name,
+				URL:  target.URL,
+			},
+			Title:       fmt.Sprintf("%d new item(s)", len(lines)),
+			Description: common.CutStringShort(strings.Join(lines, "\n"), 4096),
+			Color:       target.Color,
+			Footer: &discordgo.MessageEmbedFooter{
+				Text: target.Settings.describe(),
+			},
+			Timestamp: time.Now().Format(time.RFC3339),
+		},
+		AllowedMentions: target.AllowedMentions,
+
+		UseWebhook:      target.UseWebhook,
+		WebhookUsername: target.WebhookUsername,
+
+		Priority: 1,
+	})
+	if err != nil {
+		return retryDigest(member, err)
+	}
+
+	MetricPostedMessages.With(prometheus.Labels{"source": source}).Inc()
+	return trimDigestItems(key, len(serializedItems))
+}
+
+// trimDigestItems removes the first n items of the digest, which were sent, items could have been added in the meantime
+func trimDigestItems(key string, n int) error {
+	return common.RedisPool.Do(radix.Cmd(nil, "LTRIM", key, strconv.Itoa(n), "-1"))
+}
+
+// retryDigest schedules the digest to be sent again after it failed with err, its items are still pending
+func retryDigest(member string, err error) error {
+	retryErr := common.RedisPool.Do(radix.FlatCmd(nil, "ZADD", keyDigestsDue, "NX", time.Now().Add(digestRetryDelay).Unix(), member))
+	if retryErr != nil {
+		logger.WithError(retryErr).WithField("digest", member).Error("failed scheduling digest retry")
+	}
+
+	return err
+}
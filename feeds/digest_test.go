@@ -0,0 +1,45 @@
+package feeds
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextDigestTime(t *testing.T) {
+	berlin := time.FixedZone("CET", 60*60)
+	now := time.Date(2024, 3, 10, 14, 25, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		settings DigestSettings
+		loc      *time.Location
+		want     time.Time
+	}{
+		{"hourly", DigestSettings{Mode: DigestModeHourly}, nil, time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC)},
+		{"every 6 hours", DigestSettings{Mode: DigestModeHourly, IntervalHours: 6}, nil, time.Date(2024, 3, 10, 20, 0, 0, 0, time.UTC)},
+		{"interval clamped", DigestSettings{Mode: DigestModeHourly, IntervalHours: 100}, nil, time.Date(2024, 3, 11, 14, 0, 0, 0, time.UTC)},
+		{"daily later today", DigestSettings{Mode: DigestModeDaily, DailyAt: 18 * 60}, nil, time.Date(2024, 3, 10, 18, 0, 0, 0, time.UTC)},
+		{"daily tomorrow", DigestSettings{Mode: DigestModeDaily, DailyAt: 9*60 + 30}, nil, time.Date(2024, 3, 11, 9, 30, 0, 0, time.UTC)},
+		{"daily in timezone", DigestSettings{Mode: DigestModeDaily, DailyAt: 15*60 + 30}, berlin, time.Date(2024, 3, 10, 14, 30, 0, 0, time.UTC)},
+		{"daily at now", DigestSettings{Mode: DigestModeDaily, DailyAt: 15*60 + 25}, berlin, time.Date(2024, 3, 11, 14, 25, 0, 0, time.UTC)},
+	}
+
+	for _, c := range cases {
+		if got := NextDigestTime(now, c.settings, c.loc); !got.Equal(c.want) {
+			t.Errorf("%s: got %s, want %s", c.name, got.UTC(), c.want)
+		}
+	}
+}
+
+func TestParseDigestTime(t *testing.T) {
+	minutes, err := ParseDigestTime(" 07:45 ")
+	if err != nil || minutes != 7*60+45 {
+		t.Errorf("got %d (%v), want %d", minutes, err, 7*60+45)
+	}
+
+	for _, v := range []string{"", "24:00", "7", "12:60", "noon"} {
+		if _, err := ParseDigestTime(v); err == nil {
+			t.Errorf("%q: expected error", v)
+		}
+	}
+}
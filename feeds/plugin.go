@@ -50,6 +50,9 @@ func Run(which []string) {
 		runningPlugins = append(runningPlugins, fp)
 	}
 
+	stopDigests = make(chan *sync.WaitGroup)
+	go runDigestLoop()
+
 	joined := strings.Join(which, ",")
 	common.ServiceTracker.RegisterService(common.ServiceTypeFeed, "Feeds", joined, nil)
 }
@@ -60,6 +63,11 @@ func Stop(wg *sync.WaitGroup) {
 		wg.Add(1)
 		go plugin.StopFeed(wg)
 	}
+
+	if stopDigests != nil {
+		wg.Add(1)
+		go func() { stopDigests <- wg }()
+	}
 }
 
 var MetricPostedMessages = promauto.NewCounterVec(prometheus.CounterOpts{
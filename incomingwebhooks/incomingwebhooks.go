@@ -0,0 +1,171 @@
+package incomingwebhooks
+
+//go:generate sqlboiler --no-hooks psql
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/common/mqueue"
+	"github.com/ThatBathroom/yagpdb/v2/common/templates"
+	"github.com/ThatBathroom/yagpdb/v2/incomingwebhooks/models"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/premium"
+	"github.com/ThatBathroom/yagpdb/v2/web/discorddata"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+var logger = common.GetPluginLogger(&Plugin{})
+
+func RegisterPlugin() {
+	plugin := &Plugin{}
+
+	common.InitSchemas("incomingwebhooks", DBSchemas...)
+
+	common.RegisterPlugin(plugin)
+	mqueue.RegisterSource("incomingwebhooks", plugin)
+}
+
+type Plugin struct{}
+
+func (p *Plugin) PluginInfo() *common.PluginInfo {
+	return &common.PluginInfo{
+		Name:     "Incoming Webhooks",
+		SysName:  "incomingwebhooks",
+		Category: common.PluginCategoryFeeds,
+	}
+}
+
+const (
+	MaxWebhooks        = 5
+	MaxWebhooksPremium = 25
+
+	// how many deliveries are kept per webhook for the delivery log
+	MaxStoredDeliveries = 25
+
+	MaxPayloadSize = 256 * 1024
+
+	DefaultMessageTemplate = "**{{.Event}}** {{.Repository}} {{.URL}}"
+)
+
+func MaxWebhooksForGuild(guildID int64) int {
+	if isPrem, _ := premium.IsGuildPremium(guildID); isPrem {
+		return MaxWebhooksPremium
+	}
+
+	return MaxWebhooks
+}
+
+// DisableFeed implements mqueue.PluginWithSourceDisabler, webhooks are disabled when the bot can't post in their channel
+func (p *Plugin) DisableFeed(elem *mqueue.QueuedElement, err error) {
+	logger.WithError(err).WithField("source_id", elem.SourceItemID).Warn("Disabling incoming webhook")
+
+	id, convErr := strconv.Atoi(elem.SourceItemID)
+	if convErr != nil {
+		logger.WithError(convErr).WithField("source_id", elem.SourceItemID).Error("Invalid SourceItemID for incoming webhook")
+		return
+	}
+
+	_, err = models.IncomingWebhooks(models.IncomingWebhookWhere.ID.EQ(id)).UpdateAllG(context.Background(), models.M{"enabled": false})
+	if err != nil {
+		logger.WithError(err).WithField("webhook_id", id).Error("Failed disabling incoming webhook")
+	}
+}
+
+// Post renders the message template of the webhook against the payload and queues the result in the webhook's channel
+func Post(hook *models.IncomingWebhook, payload *Payload) error {
+	gs, err := discorddata.GetFullGuild(hook.GuildID)
+	if err != nil {
+		return errors.WithMessage(err, "getguild")
+	}
+
+	if gs == nil {
+		return errors.New("server not found")
+	}
+
+	cs := gs.GetChannel(hook.ChannelID)
+	if cs == nil {
+		return errors.New("channel not found")
+	}
+
+	ctx := templates.NewContext(gs, cs, nil)
+	ctx.Data["Webhook"] = hook.Name
+	ctx.Data["Event"] = payload.Event
+	ctx.Data["Repository"] = payload.Repository
+	ctx.Data["Sender"] = payload.Sender
+	ctx.Data["Action"] = payload.Action
+	ctx.Data["Branch"] = payload.Branch
+	ctx.Data["URL"] = payload.URL
+	ctx.Data["Payload"] = payload.Data
+
+	content, err := ctx.Execute(hook.MessageTemplate)
+	if err != nil {
+		return errors.WithMessage(err, "template")
+	}
+
+	if strings.TrimSpace(content) == "" {
+		// nothing to post, lets templates skip events they don't care about
+		return nil
+	}
+
+	mqueue.QueueMessage(&mqueue.QueuedElement{
+		GuildID:             hook.GuildID,
+		ChannelID:           hook.ChannelID,
+		Source:              "incomingwebhooks",
+		SourceItemID:        strconv.Itoa(hook.ID),
+		MessageStr:          content,
+		Priority:            2,
+		AllowedMentions:     templateMentions(ctx),
+		PublishAnnouncement: ctx.CurrentFrame.PublishResponse,
+	})
+
+	return nil
+}
+
+// templateMentions only allows the mentions the template added with mentionRoleID, mentionEveryone and the like,
+// mentions in the content itself could come from the payload and are never parsed
+func templateMentions(ctx *templates.Context) discordgo.AllowedMentions {
+	allowed := discordgo.AllowedMentions{
+		Roles: ctx.CurrentFrame.MentionRoles,
+	}
+
+	if ctx.CurrentFrame.MentionEveryone || ctx.CurrentFrame.MentionHere {
+		allowed.Parse = []discordgo.AllowedMentionType{discordgo.AllowedMentionTypeEveryone}
+	}
+
+	return allowed
+}
+
+// LogDelivery stores a delivery in the delivery log of the webhook, keeping only the latest MaxStoredDeliveries
+func LogDelivery(ctx context.Context, hook *models.IncomingWebhook, event string, statusCode int, deliveryErr error) {
+	errMsg := ""
+	if deliveryErr != nil {
+		errMsg = common.CutStringShort(deliveryErr.Error(), 500)
+	}
+
+	delivery := &models.IncomingWebhookDelivery{
+		CreatedAt:  time.Now(),
+		WebhookID:  hook.ID,
+		GuildID:    hook.GuildID,
+		Event:      common.CutStringShort(event, 100),
+		StatusCode: statusCode,
+		Error:      errMsg,
+	}
+
+	err := delivery.InsertG(ctx, boil.Infer())
+	if err != nil {
+		logger.WithError(err).WithField("webhook_id", hook.ID).Error("Failed logging incoming webhook delivery")
+		return
+	}
+
+	_, err = common.PQ.ExecContext(ctx, `DELETE FROM incoming_webhook_deliveries WHERE webhook_id = $1 AND id NOT IN (
+	SELECT id FROM incoming_webhook_deliveries WHERE webhook_id = $1 ORDER BY id DESC LIMIT $2
+)`, hook.ID, MaxStoredDeliveries)
+	if err != nil {
+		logger.WithError(err).WithField("webhook_id", hook.ID).Error("Failed cleaning up old incoming webhook deliveries")
+	}
+}
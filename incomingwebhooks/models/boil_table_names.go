@@ -0,0 +1,12 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+var TableNames = struct {
+	IncomingWebhookDeliveries string
+	IncomingWebhooks          string
+}{
+	IncomingWebhookDeliveries: "incoming_webhook_deliveries",
+	IncomingWebhooks:          "incoming_webhooks",
+}
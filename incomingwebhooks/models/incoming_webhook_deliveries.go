@@ -0,0 +1,1097 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// IncomingWebhookDelivery is an object representing the database table.
+type IncomingWebhookDelivery struct {
+	ID         int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	WebhookID  int       `boil:"webhook_id" json:"webhook_id" toml:"webhook_id" yaml:"webhook_id"`
+	GuildID    int64     `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	Event      string    `boil:"event" json:"event" toml:"event" yaml:"event"`
+	StatusCode int       `boil:"status_code" json:"status_code" toml:"status_code" yaml:"status_code"`
+	Error      string    `boil:"error" json:"error" toml:"error" yaml:"error"`
+
+	R *incomingWebhookDeliveryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L incomingWebhookDeliveryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var IncomingWebhookDeliveryColumns = struct {
+	ID         string
+	CreatedAt  string
+	WebhookID  string
+	GuildID    string
+	Event      string
+	StatusCode string
+	Error      string
+}{
+	ID:         "id",
+	CreatedAt:  "created_at",
+	WebhookID:  "webhook_id",
+	GuildID:    "guild_id",
+	Event:      "event",
+	StatusCode: "status_code",
+	Error:      "error",
+}
+
+var IncomingWebhookDeliveryTableColumns = struct {
+	ID         string
+	CreatedAt  string
+	WebhookID  string
+	GuildID    string
+	Event      string
+	StatusCode string
+	Error      string
+}{
+	ID:         "incoming_webhook_deliveries.id",
+	CreatedAt:  "incoming_webhook_deliveries.created_at",
+	WebhookID:  "incoming_webhook_deliveries.webhook_id",
+	GuildID:    "incoming_webhook_deliveries.guild_id",
+	Event:      "incoming_webhook_deliveries.event",
+	StatusCode: "incoming_webhook_deliveries.status_code",
+	Error:      "incoming_webhook_deliveries.error",
+}
+
+// Generated where
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
+func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var IncomingWebhookDeliveryWhere = struct {
+	ID         whereHelperint64
+	CreatedAt  whereHelpertime_Time
+	WebhookID  whereHelperint
+	GuildID    whereHelperint64
+	Event      whereHelperstring
+	StatusCode whereHelperint
+	Error      whereHelperstring
+}{
+	ID:         whereHelperint64{field: "\"incoming_webhook_deliveries\".\"id\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"incoming_webhook_deliveries\".\"created_at\""},
+	WebhookID:  whereHelperint{field: "\"incoming_webhook_deliveries\".\"webhook_id\""},
+	GuildID:    whereHelperint64{field: "\"incoming_webhook_deliveries\".\"guild_id\""},
+	Event:      whereHelperstring{field: "\"incoming_webhook_deliveries\".\"event\""},
+	StatusCode: whereHelperint{field: "\"incoming_webhook_deliveries\".\"status_code\""},
+	Error:      whereHelperstring{field: "\"incoming_webhook_deliveries\".\"error\""},
+}
+
+// IncomingWebhookDeliveryRels is where relationship names are stored.
+var IncomingWebhookDeliveryRels = struct {
+	Webhook string
+}{
+	Webhook: "Webhook",
+}
+
+// incomingWebhookDeliveryR is where relationships are stored.
+type incomingWebhookDeliveryR struct {
+	Webhook *IncomingWebhook `boil:"Webhook" json:"Webhook" toml:"Webhook" yaml:"Webhook"`
+}
+
+// NewStruct creates a new relationship struct
+func (*incomingWebhookDeliveryR) NewStruct() *incomingWebhookDeliveryR {
+	return &incomingWebhookDeliveryR{}
+}
+
+func (r *incomingWebhookDeliveryR) GetWebhook() *IncomingWebhook {
+	if r == nil {
+		return nil
+	}
+	return r.Webhook
+}
+
+// incomingWebhookDeliveryL is where Load methods for each relationship are stored.
+type incomingWebhookDeliveryL struct{}
+
+var (
+	incomingWebhookDeliveryAllColumns            = []string{"id", "created_at", "webhook_id", "guild_id", "event", "status_code", "error"}
+	incomingWebhookDeliveryColumnsWithoutDefault = []string{"created_at", "webhook_id", "guild_id", "event", "status_code"}
+	incomingWebhookDeliveryColumnsWithDefault    = []string{"id", "error"}
+	incomingWebhookDeliveryPrimaryKeyColumns     = []string{"id"}
+	incomingWebhookDeliveryGeneratedColumns      = []string{}
+)
+
+type (
+	// IncomingWebhookDeliverySlice is an alias for a slice of pointers to IncomingWebhookDelivery.
+	// This should almost always be used instead of []IncomingWebhookDelivery.
+	IncomingWebhookDeliverySlice []*IncomingWebhookDelivery
+
+	incomingWebhookDeliveryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	incomingWebhookDeliveryType                 = reflect.TypeOf(&IncomingWebhookDelivery{})
+	incomingWebhookDeliveryMapping              = queries.MakeStructMapping(incomingWebhookDeliveryType)
+	incomingWebhookDeliveryPrimaryKeyMapping, _ = queries.BindMapping(incomingWebhookDeliveryType, incomingWebhookDeliveryMapping, incomingWebhookDeliveryPrimaryKeyColumns)
+	incomingWebhookDeliveryInsertCacheMut       sync.RWMutex
+	incomingWebhookDeliveryInsertCache          = make(map[string]insertCache)
+	incomingWebhookDeliveryUpdateCacheMut       sync.RWMutex
+	incomingWebhookDeliveryUpdateCache          = make(map[string]updateCache)
+	incomingWebhookDeliveryUpsertCacheMut       sync.RWMutex
+	incomingWebhookDeliveryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single incomingWebhookDelivery record from the query using the global executor.
+func (q incomingWebhookDeliveryQuery) OneG(ctx context.Context) (*IncomingWebhookDelivery, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single incomingWebhookDelivery record from the query.
+func (q incomingWebhookDeliveryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*IncomingWebhookDelivery, error) {
+	o := &IncomingWebhookDelivery{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for incoming_webhook_deliveries")
+	}
+
+	return o, nil
+}
+
+// AllG returns all IncomingWebhookDelivery records from the query using the global executor.
+func (q incomingWebhookDeliveryQuery) AllG(ctx context.Context) (IncomingWebhookDeliverySlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all IncomingWebhookDelivery records from the query.
+func (q incomingWebhookDeliveryQuery) All(ctx context.Context, exec boil.ContextExecutor) (IncomingWebhookDeliverySlice, error) {
+	var o []*IncomingWebhookDelivery
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to IncomingWebhookDelivery slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all IncomingWebhookDelivery records in the query using the global executor
+func (q incomingWebhookDeliveryQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all IncomingWebhookDelivery records in the query.
+func (q incomingWebhookDeliveryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count incoming_webhook_deliveries rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q incomingWebhookDeliveryQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q incomingWebhookDeliveryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if incoming_webhook_deliveries exists")
+	}
+
+	return count > 0, nil
+}
+
+// Webhook pointed to by the foreign key.
+func (o *IncomingWebhookDelivery) Webhook(mods ...qm.QueryMod) incomingWebhookQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WebhookID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return IncomingWebhooks(queryMods...)
+}
+
+// LoadWebhook allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (incomingWebhookDeliveryL) LoadWebhook(ctx context.Context, e boil.ContextExecutor, singular bool, maybeIncomingWebhookDelivery interface{}, mods queries.Applicator) error {
+	var slice []*IncomingWebhookDelivery
+	var object *IncomingWebhookDelivery
+
+	if singular {
+		var ok bool
+		object, ok = maybeIncomingWebhookDelivery.(*IncomingWebhookDelivery)
+		if !ok {
+			object = new(IncomingWebhookDelivery)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeIncomingWebhookDelivery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeIncomingWebhookDelivery))
+			}
+		}
+	} else {
+		s, ok := maybeIncomingWebhookDelivery.(*[]*IncomingWebhookDelivery)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeIncomingWebhookDelivery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeIncomingWebhookDelivery))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &incomingWebhookDeliveryR{}
+		}
+		args[object.WebhookID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &incomingWebhookDeliveryR{}
+			}
+
+			args[obj.WebhookID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`incoming_webhooks`),
+		qm.WhereIn(`incoming_webhooks.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load IncomingWebhook")
+	}
+
+	var resultSlice []*IncomingWebhook
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice IncomingWebhook")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for incoming_webhooks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for incoming_webhooks")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Webhook = foreign
+		if foreign.R == nil {
+			foreign.R = &incomingWebhookR{}
+		}
+		foreign.R.WebhookIncomingWebhookDeliveries = append(foreign.R.WebhookIncomingWebhookDeliveries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WebhookID == foreign.ID {
+				local.R.Webhook = foreign
+				if foreign.R == nil {
+					foreign.R = &incomingWebhookR{}
+				}
+				foreign.R.WebhookIncomingWebhookDeliveries = append(foreign.R.WebhookIncomingWebhookDeliveries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWebhookG of the incomingWebhookDelivery to the related item.
+// Sets o.R.Webhook to related.
+// Adds o to related.R.WebhookIncomingWebhookDeliveries.
+// Uses the global database handle.
+func (o *IncomingWebhookDelivery) SetWebhookG(ctx context.Context, insert bool, related *IncomingWebhook) error {
+	return o.SetWebhook(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetWebhook of the incomingWebhookDelivery to the related item.
+// Sets o.R.Webhook to related.
+// Adds o to related.R.WebhookIncomingWebhookDeliveries.
+func (o *IncomingWebhookDelivery) SetWebhook(ctx context.Context, exec boil.ContextExecutor, insert bool, related *IncomingWebhook) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"incoming_webhook_deliveries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"webhook_id"}),
+		strmangle.WhereClause("\"", "\"", 2, incomingWebhookDeliveryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WebhookID = related.ID
+	if o.R == nil {
+		o.R = &incomingWebhookDeliveryR{
+			Webhook: related,
+		}
+	} else {
+		o.R.Webhook = related
+	}
+
+	if related.R == nil {
+		related.R = &incomingWebhookR{
+			WebhookIncomingWebhookDeliveries: IncomingWebhookDeliverySlice{o},
+		}
+	} else {
+		related.R.WebhookIncomingWebhookDeliveries = append(related.R.WebhookIncomingWebhookDeliveries, o)
+	}
+
+	return nil
+}
+
+// IncomingWebhookDeliveries retrieves all the records using an executor.
+func IncomingWebhookDeliveries(mods ...qm.QueryMod) incomingWebhookDeliveryQuery {
+	mods = append(mods, qm.From("\"incoming_webhook_deliveries\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"incoming_webhook_deliveries\".*"})
+	}
+
+	return incomingWebhookDeliveryQuery{q}
+}
+
+// FindIncomingWebhookDeliveryG retrieves a single record by ID.
+func FindIncomingWebhookDeliveryG(ctx context.Context, iD int64, selectCols ...string) (*IncomingWebhookDelivery, error) {
+	return FindIncomingWebhookDelivery(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindIncomingWebhookDelivery retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindIncomingWebhookDelivery(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*IncomingWebhookDelivery, error) {
+	incomingWebhookDeliveryObj := &IncomingWebhookDelivery{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"incoming_webhook_deliveries\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, incomingWebhookDeliveryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from incoming_webhook_deliveries")
+	}
+
+	return incomingWebhookDeliveryObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *IncomingWebhookDelivery) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *IncomingWebhookDelivery) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no incoming_webhook_deliveries provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(incomingWebhookDeliveryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	incomingWebhookDeliveryInsertCacheMut.RLock()
+	cache, cached := incomingWebhookDeliveryInsertCache[key]
+	incomingWebhookDeliveryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			incomingWebhookDeliveryAllColumns,
+			incomingWebhookDeliveryColumnsWithDefault,
+			incomingWebhookDeliveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(incomingWebhookDeliveryType, incomingWebhookDeliveryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(incomingWebhookDeliveryType, incomingWebhookDeliveryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"incoming_webhook_deliveries\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"incoming_webhook_deliveries\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into incoming_webhook_deliveries")
+	}
+
+	if !cached {
+		incomingWebhookDeliveryInsertCacheMut.Lock()
+		incomingWebhookDeliveryInsertCache[key] = cache
+		incomingWebhookDeliveryInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single IncomingWebhookDelivery record using the global executor.
+// See Update for more documentation.
+func (o *IncomingWebhookDelivery) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the IncomingWebhookDelivery.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *IncomingWebhookDelivery) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	incomingWebhookDeliveryUpdateCacheMut.RLock()
+	cache, cached := incomingWebhookDeliveryUpdateCache[key]
+	incomingWebhookDeliveryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			incomingWebhookDeliveryAllColumns,
+			incomingWebhookDeliveryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update incoming_webhook_deliveries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"incoming_webhook_deliveries\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, incomingWebhookDeliveryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(incomingWebhookDeliveryType, incomingWebhookDeliveryMapping, append(wl, incomingWebhookDeliveryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update incoming_webhook_deliveries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for incoming_webhook_deliveries")
+	}
+
+	if !cached {
+		incomingWebhookDeliveryUpdateCacheMut.Lock()
+		incomingWebhookDeliveryUpdateCache[key] = cache
+		incomingWebhookDeliveryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q incomingWebhookDeliveryQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q incomingWebhookDeliveryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for incoming_webhook_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for incoming_webhook_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o IncomingWebhookDeliverySlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o IncomingWebhookDeliverySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), incomingWebhookDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"incoming_webhook_deliveries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, incomingWebhookDeliveryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in incomingWebhookDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all incomingWebhookDelivery")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *IncomingWebhookDelivery) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *IncomingWebhookDelivery) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no incoming_webhook_deliveries provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(incomingWebhookDeliveryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	incomingWebhookDeliveryUpsertCacheMut.RLock()
+	cache, cached := incomingWebhookDeliveryUpsertCache[key]
+	incomingWebhookDeliveryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			incomingWebhookDeliveryAllColumns,
+			incomingWebhookDeliveryColumnsWithDefault,
+			incomingWebhookDeliveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			incomingWebhookDeliveryAllColumns,
+			incomingWebhookDeliveryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert incoming_webhook_deliveries, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(incomingWebhookDeliveryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(incomingWebhookDeliveryPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert incoming_webhook_deliveries, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(incomingWebhookDeliveryPrimaryKeyColumns))
+			copy(conflict, incomingWebhookDeliveryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"incoming_webhook_deliveries\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(incomingWebhookDeliveryType, incomingWebhookDeliveryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(incomingWebhookDeliveryType, incomingWebhookDeliveryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert incoming_webhook_deliveries")
+	}
+
+	if !cached {
+		incomingWebhookDeliveryUpsertCacheMut.Lock()
+		incomingWebhookDeliveryUpsertCache[key] = cache
+		incomingWebhookDeliveryUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single IncomingWebhookDelivery record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *IncomingWebhookDelivery) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single IncomingWebhookDelivery record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *IncomingWebhookDelivery) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no IncomingWebhookDelivery provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), incomingWebhookDeliveryPrimaryKeyMapping)
+	sql := "DELETE FROM \"incoming_webhook_deliveries\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from incoming_webhook_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for incoming_webhook_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+func (q incomingWebhookDeliveryQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q incomingWebhookDeliveryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no incomingWebhookDeliveryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from incoming_webhook_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for incoming_webhook_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o IncomingWebhookDeliverySlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o IncomingWebhookDeliverySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), incomingWebhookDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"incoming_webhook_deliveries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, incomingWebhookDeliveryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from incomingWebhookDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for incoming_webhook_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *IncomingWebhookDelivery) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no IncomingWebhookDelivery provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *IncomingWebhookDelivery) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindIncomingWebhookDelivery(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *IncomingWebhookDeliverySlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty IncomingWebhookDeliverySlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *IncomingWebhookDeliverySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := IncomingWebhookDeliverySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), incomingWebhookDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"incoming_webhook_deliveries\".* FROM \"incoming_webhook_deliveries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, incomingWebhookDeliveryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in IncomingWebhookDeliverySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// IncomingWebhookDeliveryExistsG checks if the IncomingWebhookDelivery row exists.
+func IncomingWebhookDeliveryExistsG(ctx context.Context, iD int64) (bool, error) {
+	return IncomingWebhookDeliveryExists(ctx, boil.GetContextDB(), iD)
+}
+
+// IncomingWebhookDeliveryExists checks if the IncomingWebhookDelivery row exists.
+func IncomingWebhookDeliveryExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"incoming_webhook_deliveries\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if incoming_webhook_deliveries exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the IncomingWebhookDelivery row exists.
+func (o *IncomingWebhookDelivery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return IncomingWebhookDeliveryExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1054 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// IncomingWebhook is an object representing the database table.
+type IncomingWebhook struct {
+	ID              int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt       time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	GuildID         int64     `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	ChannelID       int64     `boil:"channel_id" json:"channel_id" toml:"channel_id" yaml:"channel_id"`
+	Name            string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Token           string    `boil:"token" json:"token" toml:"token" yaml:"token"`
+	SigningSecret   string    `boil:"signing_secret" json:"signing_secret" toml:"signing_secret" yaml:"signing_secret"`
+	Format          string    `boil:"format" json:"format" toml:"format" yaml:"format"`
+	MessageTemplate string    `boil:"message_template" json:"message_template" toml:"message_template" yaml:"message_template"`
+	Enabled         bool      `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+
+	R *incomingWebhookR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L incomingWebhookL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var IncomingWebhookColumns = struct {
+	ID              string
+	CreatedAt       string
+	UpdatedAt       string
+	GuildID         string
+	ChannelID       string
+	Name            string
+	Token           string
+	SigningSecret   string
+	Format          string
+	MessageTemplate string
+	Enabled         string
+}{
+	ID:              "id",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+	GuildID:         "guild_id",
+	ChannelID:       "channel_id",
+	Name:            "name",
+	Token:           "token",
+	SigningSecret:   "signing_secret",
+	Format:          "format",
+	MessageTemplate: "message_template",
+	Enabled:         "enabled",
+}
+
+var IncomingWebhookTableColumns = struct {
+	ID              string
+	CreatedAt       string
+	UpdatedAt       string
+	GuildID         string
+	ChannelID       string
+	Name            string
+	Token           string
+	SigningSecret   string
+	Format          string
+	MessageTemplate string
+	Enabled         string
+}{
+	ID:              "incoming_webhooks.id",
+	CreatedAt:       "incoming_webhooks.created_at",
+	UpdatedAt:       "incoming_webhooks.updated_at",
+	GuildID:         "incoming_webhooks.guild_id",
+	ChannelID:       "incoming_webhooks.channel_id",
+	Name:            "incoming_webhooks.name",
+	Token:           "incoming_webhooks.token",
+	SigningSecret:   "incoming_webhooks.signing_secret",
+	Format:          "incoming_webhooks.format",
+	MessageTemplate: "incoming_webhooks.message_template",
+	Enabled:         "incoming_webhooks.enabled",
+}
+
+// Generated where
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var IncomingWebhookWhere = struct {
+	ID              whereHelperint
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+	GuildID         whereHelperint64
+	ChannelID       whereHelperint64
+	Name            whereHelperstring
+	Token           whereHelperstring
+	SigningSecret   whereHelperstring
+	Format          whereHelperstring
+	MessageTemplate whereHelperstring
+	Enabled         whereHelperbool
+}{
+	ID:              whereHelperint{field: "\"incoming_webhooks\".\"id\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"incoming_webhooks\".\"created_at\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"incoming_webhooks\".\"updated_at\""},
+	GuildID:         whereHelperint64{field: "\"incoming_webhooks\".\"guild_id\""},
+	ChannelID:       whereHelperint64{field: "\"incoming_webhooks\".\"channel_id\""},
+	Name:            whereHelperstring{field: "\"incoming_webhooks\".\"name\""},
+	Token:           whereHelperstring{field: "\"incoming_webhooks\".\"token\""},
+	SigningSecret:   whereHelperstring{field: "\"incoming_webhooks\".\"signing_secret\""},
+	Format:          whereHelperstring{field: "\"incoming_webhooks\".\"format\""},
+	MessageTemplate: whereHelperstring{field: "\"incoming_webhooks\".\"message_template\""},
+	Enabled:         whereHelperbool{field: "\"incoming_webhooks\".\"enabled\""},
+}
+
+// IncomingWebhookRels is where relationship names are stored.
+var IncomingWebhookRels = struct {
+	WebhookIncomingWebhookDeliveries string
+}{
+	WebhookIncomingWebhookDeliveries: "WebhookIncomingWebhookDeliveries",
+}
+
+// incomingWebhookR is where relationships are stored.
+type incomingWebhookR struct {
+	WebhookIncomingWebhookDeliveries IncomingWebhookDeliverySlice `boil:"WebhookIncomingWebhookDeliveries" json:"WebhookIncomingWebhookDeliveries" toml:"WebhookIncomingWebhookDeliveries" yaml:"WebhookIncomingWebhookDeliveries"`
+}
+
+// NewStruct creates a new relationship struct
+func (*incomingWebhookR) NewStruct() *incomingWebhookR {
+	return &incomingWebhookR{}
+}
+
+func (r *incomingWebhookR) GetWebhookIncomingWebhookDeliveries() IncomingWebhookDeliverySlice {
+	if r == nil {
+		return nil
+	}
+	return r.WebhookIncomingWebhookDeliveries
+}
+
+// incomingWebhookL is where Load methods for each relationship are stored.
+type incomingWebhookL struct{}
+
+var (
+	incomingWebhookAllColumns            = []string{"id", "created_at", "updated_at", "guild_id", "channel_id", "name", "token", "signing_secret", "format", "message_template", "enabled"}
+	incomingWebhookColumnsWithoutDefault = []string{"created_at", "updated_at", "guild_id", "channel_id", "name", "token", "format", "message_template"}
+	incomingWebhookColumnsWithDefault    = []string{"id", "signing_secret", "enabled"}
+	incomingWebhookPrimaryKeyColumns     = []string{"id"}
+	incomingWebhookGeneratedColumns      = []string{}
+)
+
+type (
+	// IncomingWebhookSlice is an alias for a slice of pointers to IncomingWebhook.
+	// This should almost always be used instead of []IncomingWebhook.
+	IncomingWebhookSlice []*IncomingWebhook
+
+	incomingWebhookQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	incomingWebhookType                 = reflect.TypeOf(&IncomingWebhook{})
+	incomingWebhookMapping              = queries.MakeStructMapping(incomingWebhookType)
+	incomingWebhookPrimaryKeyMapping, _ = queries.BindMapping(incomingWebhookType, incomingWebhookMapping, incomingWebhookPrimaryKeyColumns)
+	incomingWebhookInsertCacheMut       sync.RWMutex
+	incomingWebhookInsertCache          = make(map[string]insertCache)
+	incomingWebhookUpdateCacheMut       sync.RWMutex
+	incomingWebhookUpdateCache          = make(map[string]updateCache)
+	incomingWebhookUpsertCacheMut       sync.RWMutex
+	incomingWebhookUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single incomingWebhook record from the query using the global executor.
+func (q incomingWebhookQuery) OneG(ctx context.Context) (*IncomingWebhook, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single incomingWebhook record from the query.
+func (q incomingWebhookQuery) One(ctx context.Context, exec boil.ContextExecutor) (*IncomingWebhook, error) {
+	o := &IncomingWebhook{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for incoming_webhooks")
+	}
+
+	return o, nil
+}
+
+// AllG returns all IncomingWebhook records from the query using the global executor.
+func (q incomingWebhookQuery) AllG(ctx context.Context) (IncomingWebhookSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all IncomingWebhook records from the query.
+func (q incomingWebhookQuery) All(ctx context.Context, exec boil.ContextExecutor) (IncomingWebhookSlice, error) {
+	var o []*IncomingWebhook
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to IncomingWebhook slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all IncomingWebhook records in the query using the global executor
+func (q incomingWebhookQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all IncomingWebhook records in the query.
+func (q incomingWebhookQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count incoming_webhooks rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q incomingWebhookQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q incomingWebhookQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if incoming_webhooks exists")
+	}
+
+	return count > 0, nil
+}
+
+// WebhookIncomingWebhookDeliveries retrieves all the incoming_webhook_delivery's IncomingWebhookDeliveries with an executor via webhook_id column.
+func (o *IncomingWebhook) WebhookIncomingWebhookDeliveries(mods ...qm.QueryMod) incomingWebhookDeliveryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"incoming_webhook_deliveries\".\"webhook_id\"=?", o.ID),
+	)
+
+	return IncomingWebhookDeliveries(queryMods...)
+}
+
+// LoadWebhookIncomingWebhookDeliveries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (incomingWebhookL) LoadWebhookIncomingWebhookDeliveries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeIncomingWebhook interface{}, mods queries.Applicator) error {
+	var slice []*IncomingWebhook
+	var object *IncomingWebhook
+
+	if singular {
+		var ok bool
+		object, ok = maybeIncomingWebhook.(*IncomingWebhook)
+		if !ok {
+			object = new(IncomingWebhook)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeIncomingWebhook)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeIncomingWebhook))
+			}
+		}
+	} else {
+		s, ok := maybeIncomingWebhook.(*[]*IncomingWebhook)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeIncomingWebhook)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeIncomingWebhook))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &incomingWebhookR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &incomingWebhookR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`incoming_webhook_deliveries`),
+		qm.WhereIn(`incoming_webhook_deliveries.webhook_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load incoming_webhook_deliveries")
+	}
+
+	var resultSlice []*IncomingWebhookDelivery
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice incoming_webhook_deliveries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on incoming_webhook_deliveries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for incoming_webhook_deliveries")
+	}
+
+	if singular {
+		object.R.WebhookIncomingWebhookDeliveries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &incomingWebhookDeliveryR{}
+			}
+			foreign.R.Webhook = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WebhookID {
+				local.R.WebhookIncomingWebhookDeliveries = append(local.R.WebhookIncomingWebhookDeliveries, foreign)
+				if foreign.R == nil {
+					foreign.R = &incomingWebhookDeliveryR{}
+				}
+				foreign.R.Webhook = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddWebhookIncomingWebhookDeliveriesG adds the given related objects to the existing relationships
+// of the incoming_webhook, optionally inserting them as new records.
+// Appends related to o.R.WebhookIncomingWebhookDeliveries.
+// Sets related.R.Webhook appropriately.
+// Uses the global database handle.
+func (o *IncomingWebhook) AddWebhookIncomingWebhookDeliveriesG(ctx context.Context, insert bool, related ...*IncomingWebhookDelivery) error {
+	return o.AddWebhookIncomingWebhookDeliveries(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddWebhookIncomingWebhookDeliveries adds the given related objects to the existing relationships
+// of the incoming_webhook, optionally inserting them as new records.
+// Appends related to o.R.WebhookIncomingWebhookDeliveries.
+// Sets related.R.Webhook appropriately.
+func (o *IncomingWebhook) AddWebhookIncomingWebhookDeliveries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*IncomingWebhookDelivery) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WebhookID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"incoming_webhook_deliveries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"webhook_id"}),
+				strmangle.WhereClause("\"", "\"", 2, incomingWebhookDeliveryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WebhookID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &incomingWebhookR{
+			WebhookIncomingWebhookDeliveries: related,
+		}
+	} else {
+		o.R.WebhookIncomingWebhookDeliveries = append(o.R.WebhookIncomingWebhookDeliveries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &incomingWebhookDeliveryR{
+				Webhook: o,
+			}
+		} else {
+			rel.R.Webhook = o
+		}
+	}
+	return nil
+}
+
+// IncomingWebhooks retrieves all the records using an executor.
+func IncomingWebhooks(mods ...qm.QueryMod) incomingWebhookQuery {
+	mods = append(mods, qm.From("\"incoming_webhooks\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"incoming_webhooks\".*"})
+	}
+
+	return incomingWebhookQuery{q}
+}
+
+// FindIncomingWebhookG retrieves a single record by ID.
+func FindIncomingWebhookG(ctx context.Context, iD int, selectCols ...string) (*IncomingWebhook, error) {
+	return FindIncomingWebhook(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindIncomingWebhook retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindIncomingWebhook(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*IncomingWebhook, error) {
+	incomingWebhookObj := &IncomingWebhook{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"incoming_webhooks\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, incomingWebhookObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from incoming_webhooks")
+	}
+
+	return incomingWebhookObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *IncomingWebhook) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *IncomingWebhook) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no incoming_webhooks provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(incomingWebhookColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	incomingWebhookInsertCacheMut.RLock()
+	cache, cached := incomingWebhookInsertCache[key]
+	incomingWebhookInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			incomingWebhookAllColumns,
+			incomingWebhookColumnsWithDefault,
+			incomingWebhookColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(incomingWebhookType, incomingWebhookMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(incomingWebhookType, incomingWebhookMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"incoming_webhooks\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"incoming_webhooks\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into incoming_webhooks")
+	}
+
+	if !cached {
+		incomingWebhookInsertCacheMut.Lock()
+		incomingWebhookInsertCache[key] = cache
+		incomingWebhookInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single IncomingWebhook record using the global executor.
+// See Update for more documentation.
+func (o *IncomingWebhook) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the IncomingWebhook.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *IncomingWebhook) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	incomingWebhookUpdateCacheMut.RLock()
+	cache, cached := incomingWebhookUpdateCache[key]
+	incomingWebhookUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			incomingWebhookAllColumns,
+			incomingWebhookPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update incoming_webhooks, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"incoming_webhooks\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, incomingWebhookPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(incomingWebhookType, incomingWebhookMapping, append(wl, incomingWebhookPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update incoming_webhooks row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for incoming_webhooks")
+	}
+
+	if !cached {
+		incomingWebhookUpdateCacheMut.Lock()
+		incomingWebhookUpdateCache[key] = cache
+		incomingWebhookUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q incomingWebhookQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q incomingWebhookQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for incoming_webhooks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for incoming_webhooks")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o IncomingWebhookSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o IncomingWebhookSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), incomingWebhookPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"incoming_webhooks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, incomingWebhookPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in incomingWebhook slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all incomingWebhook")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *IncomingWebhook) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *IncomingWebhook) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no incoming_webhooks provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(incomingWebhookColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	incomingWebhookUpsertCacheMut.RLock()
+	cache, cached := incomingWebhookUpsertCache[key]
+	incomingWebhookUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			incomingWebhookAllColumns,
+			incomingWebhookColumnsWithDefault,
+			incomingWebhookColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			incomingWebhookAllColumns,
+			incomingWebhookPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert incoming_webhooks, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(incomingWebhookAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(incomingWebhookPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert incoming_webhooks, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(incomingWebhookPrimaryKeyColumns))
+			copy(conflict, incomingWebhookPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"incoming_webhooks\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(incomingWebhookType, incomingWebhookMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(incomingWebhookType, incomingWebhookMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert incoming_webhooks")
+	}
+
+	if !cached {
+		incomingWebhookUpsertCacheMut.Lock()
+		incomingWebhookUpsertCache[key] = cache
+		incomingWebhookUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single IncomingWebhook record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *IncomingWebhook) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single IncomingWebhook record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *IncomingWebhook) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no IncomingWebhook provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), incomingWebhookPrimaryKeyMapping)
+	sql := "DELETE FROM \"incoming_webhooks\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from incoming_webhooks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for incoming_webhooks")
+	}
+
+	return rowsAff, nil
+}
+
+func (q incomingWebhookQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q incomingWebhookQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no incomingWebhookQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from incoming_webhooks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for incoming_webhooks")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o IncomingWebhookSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o IncomingWebhookSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), incomingWebhookPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"incoming_webhooks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, incomingWebhookPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from incomingWebhook slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for incoming_webhooks")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *IncomingWebhook) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no IncomingWebhook provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *IncomingWebhook) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindIncomingWebhook(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *IncomingWebhookSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty IncomingWebhookSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *IncomingWebhookSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := IncomingWebhookSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), incomingWebhookPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"incoming_webhooks\".* FROM \"incoming_webhooks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, incomingWebhookPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in IncomingWebhookSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// IncomingWebhookExistsG checks if the IncomingWebhook row exists.
+func IncomingWebhookExistsG(ctx context.Context, iD int) (bool, error) {
+	return IncomingWebhookExists(ctx, boil.GetContextDB(), iD)
+}
+
+// IncomingWebhookExists checks if the IncomingWebhook row exists.
+func IncomingWebhookExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"incoming_webhooks\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if incoming_webhooks exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the IncomingWebhook row exists.
+func (o *IncomingWebhook) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return IncomingWebhookExists(ctx, exec, o.ID)
+}
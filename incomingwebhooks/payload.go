@@ -0,0 +1,156 @@
+package incomingwebhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"emperror.dev/errors"
+)
+
+const (
+	FormatGeneric = "generic"
+	FormatGitHub  = "github"
+	FormatGitLab  = "gitlab"
+)
+
+var Formats = []string{FormatGeneric, FormatGitHub, FormatGitLab}
+
+var (
+	ErrMissingSignature = errors.New("missing signature")
+	ErrBadSignature     = errors.New("bad signature")
+)
+
+// VerifySignature checks the request against the signing secret of the webhook, github and generic webhooks
+// are signed with a hex encoded HMAC-SHA256 of the body, while gitlab just sends the secret as is
+func VerifySignature(format, secret string, header http.Header, body []byte) error {
+	if secret == "" {
+		return nil
+	}
+
+	if format == FormatGitLab {
+		token := header.Get("X-Gitlab-Token")
+		if token == "" {
+			return ErrMissingSignature
+		}
+
+		if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+			return ErrBadSignature
+		}
+
+		return nil
+	}
+
+	sig := header.Get("X-Hub-Signature-256")
+	if sig == "" {
+		sig = header.Get("X-Signature-256")
+	}
+	if sig == "" {
+		return ErrMissingSignature
+	}
+
+	decoded, err := hex.DecodeString(strings.TrimPrefix(sig, "sha256="))
+	if err != nil {
+		return ErrBadSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(decoded, mac.Sum(nil)) {
+		return ErrBadSignature
+	}
+
+	return nil
+}
+
+// Payload is what the message template is executed against
+type Payload struct {
+	// the event type, from the headers of github and gitlab requests or the "event" field of generic ones
+	Event string
+
+	// these are filled in by the github and gitlab adapters
+	Repository string
+	Sender     string
+	Action     string
+	Branch     string
+	URL        string
+
+	// the full decoded json body
+	Data map[string]interface{}
+}
+
+// ParsePayload decodes the body and pulls the commonly used fields out of github and gitlab payloads
+func ParsePayload(format string, header http.Header, body []byte) (*Payload, error) {
+	var data map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&data); err != nil {
+		return nil, errors.WithMessage(err, "invalid json")
+	}
+
+	p := &Payload{Data: data}
+
+	switch format {
+	case FormatGitHub:
+		p.Event = header.Get("X-GitHub-Event")
+		p.Repository = lookupString(data, "repository", "full_name")
+		p.Sender = lookupString(data, "sender", "login")
+		p.Action = lookupString(data, "action")
+		p.Branch = branchFromRef(lookupString(data, "ref"))
+		p.URL = firstNonEmpty(
+			lookupString(data, "compare"),
+			lookupString(data, "pull_request", "html_url"),
+			lookupString(data, "issue", "html_url"),
+			lookupString(data, "release", "html_url"),
+			lookupString(data, "repository", "html_url"),
+		)
+	case FormatGitLab:
+		p.Event = firstNonEmpty(header.Get("X-Gitlab-Event"), lookupString(data, "object_kind"))
+		p.Repository = lookupString(data, "project", "path_with_namespace")
+		p.Sender = firstNonEmpty(lookupString(data, "user_username"), lookupString(data, "user", "username"))
+		p.Action = lookupString(data, "object_attributes", "action")
+		p.Branch = branchFromRef(lookupString(data, "ref"))
+		p.URL = firstNonEmpty(
+			lookupString(data, "object_attributes", "url"),
+			lookupString(data, "project", "web_url"),
+		)
+	default:
+		p.Event = lookupString(data, "event")
+	}
+
+	return p, nil
+}
+
+// lookupString returns the string at the path in the decoded json, or an empty string if there's none
+func lookupString(data map[string]interface{}, path ...string) string {
+	var cur interface{} = data
+	for _, k := range path {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		cur = m[k]
+	}
+
+	s, _ := cur.(string)
+	return s
+}
+
+func branchFromRef(ref string) string {
+	return strings.TrimPrefix(ref, "refs/heads/")
+}
+
+func firstNonEmpty(strs ...string) string {
+	for _, s := range strs {
+		if s != "" {
+			return s
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,75 @@
+package incomingwebhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+)
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(`{"event":"deploy"}`)
+
+	cases := []struct {
+		name    string
+		format  string
+		secret  string
+		headers map[string]string
+		want    error
+	}{
+		{"no secret", FormatGeneric, "", nil, nil},
+		{"missing", FormatGeneric, "s3cret", nil, ErrMissingSignature},
+		{"generic valid", FormatGeneric, "s3cret", map[string]string{"X-Signature-256": sign("s3cret", body)}, nil},
+		{"github valid", FormatGitHub, "s3cret", map[string]string{"X-Hub-Signature-256": sign("s3cret", body)}, nil},
+		{"wrong secret", FormatGitHub, "s3cret", map[string]string{"X-Hub-Signature-256": sign("other", body)}, ErrBadSignature},
+		{"not hex", FormatGitHub, "s3cret", map[string]string{"X-Hub-Signature-256": "sha256=zz"}, ErrBadSignature},
+		{"gitlab valid", FormatGitLab, "s3cret", map[string]string{"X-Gitlab-Token": "s3cret"}, nil},
+		{"gitlab wrong", FormatGitLab, "s3cret", map[string]string{"X-Gitlab-Token": "nope"}, ErrBadSignature},
+	}
+
+	for _, c := range cases {
+		header := http.Header{}
+		for k, v := range c.headers {
+			header.Set(k, v)
+		}
+
+		if got := VerifySignature(c.format, c.secret, header, body); got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestParsePayload(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-GitHub-Event", "push")
+
+	body := []byte(`{"ref":"refs/heads/main","compare":"https://github.com/a/b/compare/1...2","repository":{"full_name":"a/b"},"sender":{"login":"someone"}}`)
+	p, err := ParsePayload(FormatGitHub, header, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Event != "push" || p.Repository != "a/b" || p.Sender != "someone" || p.Branch != "main" || p.URL != "https://github.com/a/b/compare/1...2" {
+		t.Errorf("unexpected github payload: %+v", p)
+	}
+
+	p, err = ParsePayload(FormatGeneric, http.Header{}, []byte(`{"event":"alert","level":3}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Event != "alert" || p.Data["level"] == nil {
+		t.Errorf("unexpected generic payload: %+v", p)
+	}
+
+	if _, err = ParsePayload(FormatGeneric, http.Header{}, []byte(`not json`)); err == nil {
+		t.Error("expected error for invalid json")
+	}
+}
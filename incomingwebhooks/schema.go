@@ -0,0 +1,40 @@
+package incomingwebhooks
+
+var DBSchemas = []string{`
+CREATE TABLE IF NOT EXISTS incoming_webhooks (
+	id SERIAL PRIMARY KEY,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+	guild_id BIGINT NOT NULL,
+	channel_id BIGINT NOT NULL,
+	name TEXT NOT NULL,
+
+	-- part of the url, anyone with it can post to the webhook unless a signing secret is set
+	token TEXT NOT NULL,
+	-- empty to not require signed requests
+	signing_secret TEXT NOT NULL DEFAULT '',
+
+	-- generic, github or gitlab
+	format TEXT NOT NULL,
+	message_template TEXT NOT NULL,
+
+	enabled BOOLEAN NOT NULL DEFAULT TRUE
+);
+`, `
+CREATE INDEX IF NOT EXISTS incoming_webhooks_guild_idx ON incoming_webhooks(guild_id);
+`, `
+CREATE TABLE IF NOT EXISTS incoming_webhook_deliveries (
+	id BIGSERIAL PRIMARY KEY,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+	webhook_id INT NOT NULL REFERENCES incoming_webhooks(id) ON DELETE CASCADE,
+	guild_id BIGINT NOT NULL,
+
+	event TEXT NOT NULL,
+	status_code INT NOT NULL,
+	error TEXT NOT NULL DEFAULT ''
+);
+`, `
+CREATE INDEX IF NOT EXISTS incoming_webhook_deliveries_webhook_idx ON incoming_webhook_deliveries(webhook_id, created_at);
+`}
@@ -0,0 +1,346 @@
+package incomingwebhooks
+
+import (
+	"context"
+	"crypto/subtle"
+	_ "embed"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/common/cplogs"
+	"github.com/ThatBathroom/yagpdb/v2/common/multiratelimit"
+	"github.com/ThatBathroom/yagpdb/v2/incomingwebhooks/models"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/web"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"goji.io"
+	"goji.io/pat"
+)
+
+//go:embed assets/incomingwebhooks.html
+var PageHTML string
+
+var (
+	panelLogKeyAddedWebhook   = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "incomingwebhooks_added", FormatString: "Added incoming webhook: %s"})
+	panelLogKeyUpdatedWebhook = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "incomingwebhooks_updated", FormatString: "Updated incoming webhook: %s"})
+	panelLogKeyRemovedWebhook = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "incomingwebhooks_removed", FormatString: "Removed incoming webhook: %s"})
+	panelLogKeyResetToken     = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "incomingwebhooks_reset_token", FormatString: "Reset the url of incoming webhook: %s"})
+)
+
+// 30 requests per minute per webhook, with some room for bursts
+var incomingRatelimiter = multiratelimit.NewMultiRatelimiter(0.5, 10)
+
+type WebhookForm struct {
+	Name            string `valid:",1,100,trimspace"`
+	Channel         int64  `valid:"channel,false"`
+	Format          string
+	SigningSecret   string `valid:",200"`
+	MessageTemplate string `valid:"template,2000"`
+	Enabled         bool
+
+	// the signing secret is never sent back to the browser, an empty one keeps the current secret unless this is set
+	RemoveSigningSecret bool
+}
+
+var _ web.CustomValidator = (*WebhookForm)(nil)
+
+func (f *WebhookForm) Validate(tmpl web.TemplateData, _ int64) bool {
+	if !common.ContainsStringSlice(Formats, f.Format) {
+		tmpl.AddAlerts(web.ErrorAlert("Unknown payload format"))
+		return false
+	}
+
+	return true
+}
+
+func (p *Plugin) InitWeb() {
+	web.AddHTMLTemplate("incomingwebhooks/assets/incomingwebhooks.html", PageHTML)
+	web.AddSidebarItem(web.SidebarCategoryFeeds, &web.SidebarItem{
+		Name: "Incoming Webhooks",
+		URL:  "incomingwebhooks",
+		Icon: "fas fa-plug",
+	})
+
+	subMux := goji.SubMux()
+	web.CPMux.Handle(pat.New("/incomingwebhooks"), subMux)
+	web.CPMux.Handle(pat.New("/incomingwebhooks/*"), subMux)
+
+	subMux.Use(web.RequireBotMemberMW)
+
+	mainGetHandler := web.ControllerHandler(HandleGetWebhooks, "cp_incomingwebhooks")
+	subMux.Handle(pat.Get(""), mainGetHandler)
+	subMux.Handle(pat.Get("/"), mainGetHandler)
+
+	subMux.Handle(pat.Post("/new"), web.ControllerPostHandler(HandleNewWebhook, mainGetHandler, WebhookForm{}))
+	subMux.Handle(pat.Post("/:id/update"), web.ControllerPostHandler(BaseEditHandler(HandleUpdateWebhook), mainGetHandler, WebhookForm{}))
+	subMux.Handle(pat.Post("/:id/delete"), web.ControllerPostHandler(BaseEditHandler(HandleDeleteWebhook), mainGetHandler, nil))
+	subMux.Handle(pat.Post("/:id/reset_token"), web.ControllerPostHandler(BaseEditHandler(HandleResetToken), mainGetHandler, nil))
+
+	// the endpoint the external services post to
+	web.RootMux.Handle(pat.Post("/webhooks/incoming/:id/:token"), http.HandlerFunc(HandleIncoming))
+}
+
+func WebhookURL(hook *models.IncomingWebhook) string {
+	return fmt.Sprintf("%s/webhooks/incoming/%d/%s", web.BaseURL(), hook.ID, hook.Token)
+}
+
+func HandleGetWebhooks(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+	ctx := r.Context()
+	activeGuild, templateData := web.GetBaseCPContextData(ctx)
+
+	hooks, err := models.IncomingWebhooks(
+		models.IncomingWebhookWhere.GuildID.EQ(activeGuild.ID),
+		qm.OrderBy("id ASC"),
+	).AllG(ctx)
+	if err != nil {
+		return templateData, err
+	}
+
+	deliveries, err := models.IncomingWebhookDeliveries(
+		models.IncomingWebhookDeliveryWhere.GuildID.EQ(activeGuild.ID),
+		qm.OrderBy("id DESC"),
+	).AllG(ctx)
+	if err != nil {
+		return templateData, err
+	}
+
+	deliveriesByHook := make(map[int][]*models.IncomingWebhookDelivery)
+	for _, v := range deliveries {
+		deliveriesByHook[v.WebhookID] = append(deliveriesByHook[v.WebhookID], v)
+	}
+
+	urls := make(map[int]string)
+	for _, v := range hooks {
+		urls[v.ID] = WebhookURL(v)
+	}
+
+	templateData["Webhooks"] = hooks
+	templateData["WebhookURLs"] = urls
+	templateData["Deliveries"] = deliveriesByHook
+	templateData["Formats"] = Formats
+	templateData["DefaultMessageTemplate"] = DefaultMessageTemplate
+	templateData["MaxWebhooks"] = MaxWebhooksForGuild(activeGuild.ID)
+	templateData["VisibleURL"] = "/manage/" + discordgo.StrID(activeGuild.ID) + "/incomingwebhooks"
+
+	return templateData, nil
+}
+
+func HandleNewWebhook(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+	ctx := r.Context()
+	activeGuild, templateData := web.GetBaseCPContextData(ctx)
+	form := ctx.Value(common.ContextKeyParsedForm).(*WebhookForm)
+
+	count, err := models.IncomingWebhooks(models.IncomingWebhookWhere.GuildID.EQ(activeGuild.ID)).CountG(ctx)
+	if err != nil {
+		return templateData, err
+	}
+
+	if limit := MaxWebhooksForGuild(activeGuild.ID); count >= int64(limit) {
+		return templateData.AddAlerts(web.ErrorAlert(fmt.Sprintf("Max %d incoming webhooks allowed (%d for premium servers)", limit, MaxWebhooksPremium))), nil
+	}
+
+	hook := &models.IncomingWebhook{
+		GuildID:         activeGuild.ID,
+		ChannelID:       form.Channel,
+		Name:            form.Name,
+		Token:           web.RandBase64(24),
+		SigningSecret:   form.SigningSecret,
+		Format:          form.Format,
+		MessageTemplate: form.MessageTemplate,
+		Enabled:         true,
+	}
+
+	err = hook.InsertG(ctx, boil.Infer())
+	if err != nil {
+		return templateData, err
+	}
+
+	go cplogs.RetryAddEntry(web.NewLogEntryFromContext(ctx, panelLogKeyAddedWebhook, &cplogs.Param{Type: cplogs.ParamTypeString, Value: hook.Name}))
+	return templateData, nil
+}
+
+type ContextKey int
+
+const (
+	ContextKeyWebhook ContextKey = iota
+)
+
+func BaseEditHandler(inner web.ControllerHandlerFunc) web.ControllerHandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+		ctx := r.Context()
+		activeGuild, templateData := web.GetBaseCPContextData(ctx)
+
+		id, err := strconv.Atoi(pat.Param(r, "id"))
+		if err != nil {
+			return templateData.AddAlerts(web.ErrorAlert("Invalid webhook ID")), nil
+		}
+
+		hook, err := models.IncomingWebhooks(
+			models.IncomingWebhookWhere.ID.EQ(id),
+			models.IncomingWebhookWhere.GuildID.EQ(activeGuild.ID),
+		).OneG(ctx)
+		if err != nil {
+			return templateData.AddAlerts(web.ErrorAlert("Failed retrieving that webhook")), err
+		}
+
+		ctx = context.WithValue(ctx, ContextKeyWebhook, hook)
+		return inner(w, r.WithContext(ctx))
+	}
+}
+
+func HandleUpdateWebhook(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+	ctx := r.Context()
+	_, templateData := web.GetBaseCPContextData(ctx)
+	hook := ctx.Value(ContextKeyWebhook).(*models.IncomingWebhook)
+	form := ctx.Value(common.ContextKeyParsedForm).(*WebhookForm)
+
+	hook.Name = form.Name
+	hook.ChannelID = form.Channel
+	hook.Format = form.Format
+	if form.RemoveSigningSecret {
+		hook.SigningSecret = ""
+	} else if form.SigningSecret != "" {
+		hook.SigningSecret = form.SigningSecret
+	}
+	hook.MessageTemplate = form.MessageTemplate
+	hook.Enabled = form.Enabled
+
+	_, err := hook.UpdateG(ctx, boil.Whitelist("updated_at", "name", "channel_id", "format", "signing_secret", "message_template", "enabled"))
+	if err != nil {
+		return templateData, err
+	}
+
+	go cplogs.RetryAddEntry(web.NewLogEntryFromContext(ctx, panelLogKeyUpdatedWebhook, &cplogs.Param{Type: cplogs.ParamTypeString, Value: hook.Name}))
+	return templateData, nil
+}
+
+func HandleDeleteWebhook(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+	ctx := r.Context()
+	_, templateData := web.GetBaseCPContextData(ctx)
+	hook := ctx.Value(ContextKeyWebhook).(*models.IncomingWebhook)
+
+	_, err := hook.DeleteG(ctx)
+	if err != nil {
+		return templateData, err
+	}
+
+	go cplogs.RetryAddEntry(web.NewLogEntryFromContext(ctx, panelLogKeyRemovedWebhook, &cplogs.Param{Type: cplogs.ParamTypeString, Value: hook.Name}))
+	return templateData, nil
+}
+
+func HandleResetToken(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+	ctx := r.Context()
+	_, templateData := web.GetBaseCPContextData(ctx)
+	hook := ctx.Value(ContextKeyWebhook).(*models.IncomingWebhook)
+
+	hook.Token = web.RandBase64(24)
+	_, err := hook.UpdateG(ctx, boil.Whitelist("updated_at", "token"))
+	if err != nil {
+		return templateData, err
+	}
+
+	go cplogs.RetryAddEntry(web.NewLogEntryFromContext(ctx, panelLogKeyResetToken, &cplogs.Param{Type: cplogs.ParamTypeString, Value: hook.Name}))
+	return templateData.AddAlerts(web.SucessAlert("The webhook url was changed, remember to update it in the services using it")), nil
+}
+
+var _ web.PluginWithServerHomeWidget = (*Plugin)(nil)
+
+func (p *Plugin) LoadServerHomeWidget(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+	ag, templateData := web.GetBaseCPContextData(r.Context())
+
+	templateData["WidgetTitle"] = "Incoming webhooks"
+	templateData["SettingsPath"] = "/incomingwebhooks"
+
+	count, err := models.IncomingWebhooks(models.IncomingWebhookWhere.GuildID.EQ(ag.ID), models.IncomingWebhookWhere.Enabled.EQ(true)).CountG(r.Context())
+	if err != nil {
+		return templateData, err
+	}
+
+	if count > 0 {
+		templateData["WidgetEnabled"] = true
+	} else {
+		templateData["WidgetDisabled"] = true
+	}
+
+	const format = `<p>Active incoming webhooks: <code>%d</code></p>`
+	templateData["WidgetBody"] = template.HTML(fmt.Sprintf(format, count))
+
+	return templateData, nil
+}
+
+// HandleIncoming handles the requests from the external services
+func HandleIncoming(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), time.Second*10)
+	defer cancel()
+
+	id, err := strconv.Atoi(pat.Param(r, "id"))
+	if err != nil {
+		http.Error(w, "Unknown webhook", http.StatusNotFound)
+		return
+	}
+
+	hook, err := models.FindIncomingWebhookG(ctx, id)
+	if err != nil || subtle.ConstantTimeCompare([]byte(hook.Token), []byte(pat.Param(r, "token"))) != 1 {
+		http.Error(w, "Unknown webhook", http.StatusNotFound)
+		return
+	}
+
+	if !hook.Enabled {
+		http.Error(w, "Webhook disabled", http.StatusForbidden)
+		return
+	}
+
+	if !incomingRatelimiter.AllowN(hook.ID, time.Now(), 1) {
+		http.Error(w, "Ratelimited", http.StatusTooManyRequests)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxPayloadSize))
+	if err != nil {
+		respondDelivery(ctx, w, hook, "", http.StatusRequestEntityTooLarge, err)
+		return
+	}
+
+	err = VerifySignature(hook.Format, hook.SigningSecret, r.Header, body)
+	if err != nil {
+		respondDelivery(ctx, w, hook, "", http.StatusUnauthorized, err)
+		return
+	}
+
+	payload, err := ParsePayload(hook.Format, r.Header, body)
+	if err != nil {
+		respondDelivery(ctx, w, hook, "", http.StatusBadRequest, err)
+		return
+	}
+
+	if hook.Format == FormatGitHub && payload.Event == "ping" {
+		// sent by github when the webhook is set up
+		respondDelivery(ctx, w, hook, payload.Event, http.StatusOK, nil)
+		return
+	}
+
+	err = Post(hook, payload)
+	if err != nil {
+		respondDelivery(ctx, w, hook, payload.Event, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	respondDelivery(ctx, w, hook, payload.Event, http.StatusOK, nil)
+}
+
+func respondDelivery(ctx context.Context, w http.ResponseWriter, hook *models.IncomingWebhook, event string, statusCode int, err error) {
+	LogDelivery(ctx, hook, event, statusCode, err)
+
+	if err != nil {
+		http.Error(w, err.Error(), statusCode)
+		return
+	}
+
+	w.WriteHeader(statusCode)
+}
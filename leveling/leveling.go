@@ -0,0 +1,507 @@
+package leveling
+
+//go:generate sqlboiler --no-hooks psql
+
+import (
+	"context"
+	"database/sql"
+	"math/rand"
+	"slices"
+	"strconv"
+
+	"emperror.dev/errors"
+	"github.com/ThatBathroom/yagpdb/v2/bot"
+	"github.com/ThatBathroom/yagpdb/v2/bot/botrest"
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/common/featureflags"
+	"github.com/ThatBathroom/yagpdb/v2/leveling/models"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/lib/dstate"
+	"github.com/ThatBathroom/yagpdb/v2/premium"
+	"github.com/mediocregopher/radix/v3"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var logger = common.GetPluginLogger(&Plugin{})
+
+func RegisterPlugin() {
+	plugin := &Plugin{}
+
+	common.InitSchemas("leveling", DBSchemas...)
+
+	common.RegisterPlugin(plugin)
+}
+
+type Plugin struct{}
+
+func (p *Plugin) PluginInfo() *common.PluginInfo {
+	return &common.PluginInfo{
+		Name:     "Leveling",
+		SysName:  "leveling",
+		Category: common.PluginCategoryMisc,
+	}
+}
+
+const (
+	DefaultLevelUpMessage = "GG {{.User.Mention}}, you reached level **{{.Level}}**!"
+
+	MaxLevelRoles        = 10
+	MaxLevelRolesPremium = 50
+
+	MaxMultipliers = 25
+)
+
+func DefaultConfig(guildID int64) *models.LevelingConfig {
+	return &models.LevelingConfig{
+		GuildID:        guildID,
+		Enabled:        false,
+		XPMin:          15,
+		XPMax:          25,
+		Cooldown:       60,
+		StackRoles:     true,
+		LevelUpEnabled: true,
+		LevelUpMessage: DefaultLevelUpMessage,
+	}
+}
+
+func GetConfig(ctx context.Context, guildID int64) (*models.LevelingConfig, error) {
+	conf, err := models.FindLevelingConfigG(ctx, guildID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return DefaultConfig(guildID), nil
+		}
+		return nil, errors.WrapIf(err, "Leveling.GetConfig")
+	}
+
+	return conf, nil
+}
+
+func GuildMaxLevelRoles(guildID int64) int {
+	if isPrem, _ := premium.IsGuildPremium(guildID); isPrem {
+		return MaxLevelRolesPremium
+	}
+	return MaxLevelRoles
+}
+
+// XPToNextLevel returns how much xp is needed to go from level to level+1
+func XPToNextLevel(level int) int64 {
+	l := int64(level)
+	return 5*l*l + 50*l + 100
+}
+
+// TotalXPForLevel returns the total amount of xp needed to reach level
+func TotalXPForLevel(level int) int64 {
+	total := int64(0)
+	for i := 0; i < level; i++ {
+		total += XPToNextLevel(i)
+	}
+
+	return total
+}
+
+// LevelForXP returns the level someone with xp total xp is at
+func LevelForXP(xp int64) int {
+	level := 0
+	for xp >= XPToNextLevel(level) {
+		xp -= XPToNextLevel(level)
+		level++
+	}
+
+	return level
+}
+
+// multiplier returns the multiplier of the first key found in the parallel arrays, or 1
+func multiplier(keys, values []int64, key int64) int64 {
+	for i, v := range keys {
+		if v == key && i < len(values) && values[i] > 0 {
+			return values[i]
+		}
+	}
+
+	return 1
+}
+
+// ChannelMultiplier returns what xp earned in the channel is multiplied by, thread channels should be resolved to their parent first
+func ChannelMultiplier(conf *models.LevelingConfig, channelID int64) int64 {
+	return multiplier(conf.MultiplierChannels, conf.MultiplierChannelValues, channelID)
+}
+
+// RoleMultiplier returns the highest multiplier of the given roles
+func RoleMultiplier(conf *models.LevelingConfig, roles []int64) int64 {
+	highest := int64(1)
+	for _, r := range roles {
+		if m := multiplier(conf.MultiplierRoles, conf.MultiplierRoleValues, r); m > highest {
+			highest = m
+		}
+	}
+
+	return highest
+}
+
+type MultiplierEntry struct {
+	ID         int64
+	Multiplier int64
+}
+
+// Multipliers returns parallel multiplier arrays in a form that's easier to display
+func Multipliers(keys, values []int64) []*MultiplierEntry {
+	result := make([]*MultiplierEntry, 0, len(keys))
+	for i, v := range keys {
+		if i < len(values) {
+			result = append(result, &MultiplierEntry{ID: v, Multiplier: values[i]})
+		}
+	}
+
+	return result
+}
+
+// SetMultiplier adds or updates the multiplier of key in the parallel arrays
+func SetMultiplier(keys, values []int64, key, multiplier int64) ([]int64, []int64) {
+	for i, v := range keys {
+		if v == key && i < len(values) {
+			values[i] = multiplier
+			return keys, values
+		}
+	}
+
+	return append(keys, key), append(values, multiplier)
+}
+
+// RemoveMultiplier removes the multiplier of key from the parallel arrays
+func RemoveMultiplier(keys, values []int64, key int64) ([]int64, []int64) {
+	newKeys := make([]int64, 0, len(keys))
+	newValues := make([]int64, 0, len(values))
+	for i, v := range keys {
+		if v == key || i >= len(values) {
+			continue
+		}
+
+		newKeys = append(newKeys, v)
+		newValues = append(newValues, values[i])
+	}
+
+	return newKeys, newValues
+}
+
+// RandomXP returns a random amount of xp between the configured min and max
+func RandomXP(conf *models.LevelingConfig) int64 {
+	if conf.XPMax <= conf.XPMin {
+		return int64(conf.XPMin)
+	}
+
+	return int64(conf.XPMin + rand.Intn(conf.XPMax-conf.XPMin+1))
+}
+
+func KeyCooldown(guildID, userID int64) string {
+	return "leveling_cooldown:" + discordgo.StrID(guildID) + ":" + discordgo.StrID(userID)
+}
+
+// CheckSetCooldown checks and updates the xp cooldown of a user,
+// it returns true if the user was not on cooldown
+func CheckSetCooldown(conf *models.LevelingConfig, userID int64) (bool, error) {
+	if conf.Cooldown < 1 {
+		return true, nil
+	}
+
+	var resp string
+	err := common.RedisPool.Do(radix.FlatCmd(&resp, "SET", KeyCooldown(conf.GuildID, userID), true, "EX", conf.Cooldown, "NX"))
+	if resp != "OK" {
+		return false, err
+	}
+
+	return true, err
+}
+
+// AddXP adds xp to the user (or removes it if negative) and returns their new xp,
+// if the level changed because of it newLevel will be different from oldLevel
+func AddXP(ctx context.Context, guildID, userID, xp int64, messages int) (newXP int64, oldLevel, newLevel int, err error) {
+	const query = `
+INSERT INTO leveling_users (created_at, updated_at, guild_id, user_id, xp, messages)
+VALUES (now(), now(), $1, $2, GREATEST($3, 0), $4)
+ON CONFLICT (guild_id, user_id)
+DO UPDATE SET xp = GREATEST(leveling_users.xp + $3, 0), messages = leveling_users.messages + $4, updated_at = now()
+RETURNING xp, level;
+`
+	row := common.PQ.QueryRowContext(ctx, query, guildID, userID, xp, messages)
+	err = row.Scan(&newXP, &oldLevel)
+	if err != nil {
+		return
+	}
+
+	newLevel = LevelForXP(newXP)
+	if newLevel == oldLevel {
+		return
+	}
+
+	// only one of several concurrent updates gets to announce the new level
+	res, err := common.PQ.ExecContext(ctx, "UPDATE leveling_users SET level = $3 WHERE guild_id = $1 AND user_id = $2 AND level = $4", guildID, userID, newLevel, oldLevel)
+	if err != nil {
+		return
+	}
+
+	if affected, _ := res.RowsAffected(); affected < 1 {
+		newLevel = oldLevel
+	}
+
+	return
+}
+
+var (
+	ErrUserNotFound = errors.New("User not found")
+)
+
+type UserStats struct {
+	UserID   int64 `json:"user_id"`
+	XP       int64 `json:"xp"`
+	Level    int   `json:"level"`
+	Messages int64 `json:"messages"`
+	Rank     int   `json:"rank"`
+
+	// XP needed for the current and the next level
+	LevelXP     int64 `json:"level_xp"`
+	NextLevelXP int64 `json:"next_level_xp"`
+}
+
+func GetUserStats(ctx context.Context, guildID, userID int64) (*UserStats, error) {
+	const query = `SELECT xp, level, messages, position FROM
+(
+	SELECT user_id, xp, level, messages,
+	RANK() OVER(ORDER BY xp DESC) AS position
+	FROM leveling_users WHERE guild_id = $1
+) AS w
+WHERE user_id = $2`
+
+	stats := &UserStats{UserID: userID}
+	err := common.PQ.QueryRowContext(ctx, query, guildID, userID).Scan(&stats.XP, &stats.Level, &stats.Messages, &stats.Rank)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	stats.LevelXP = TotalXPForLevel(stats.Level)
+	stats.NextLevelXP = TotalXPForLevel(stats.Level + 1)
+	return stats, nil
+}
+
+type RankEntry struct {
+	Rank   int   `json:"rank"`
+	UserID int64 `json:"user_id"`
+	XP     int64 `json:"xp"`
+	Level  int   `json:"level"`
+}
+
+func TopUsers(guildID int64, offset, limit int) ([]*RankEntry, error) {
+	const query = `SELECT xp, level, position, user_id FROM
+(
+	SELECT user_id, xp, level,
+	RANK() OVER(ORDER BY xp DESC) AS position
+	FROM leveling_users WHERE guild_id = $1
+) AS w
+ORDER BY xp DESC, user_id ASC
+LIMIT $2 OFFSET $3`
+
+	rows, err := common.PQ.Query(query, guildID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*RankEntry, 0, limit)
+	for rows.Next() {
+		entry := &RankEntry{}
+		err = rows.Scan(&entry.XP, &entry.Level, &entry.Rank, &entry.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, entry)
+	}
+
+	return result, rows.Err()
+}
+
+type LeaderboardEntry struct {
+	*RankEntry
+	Username string `json:"username"`
+	Bot      bool   `json:"bot"`
+	Avatar   string `json:"avatar"`
+}
+
+func DetailedLeaderboardEntries(guildID int64, ranks []*RankEntry) ([]*LeaderboardEntry, error) {
+	if len(ranks) < 1 {
+		return []*LeaderboardEntry{}, nil
+	}
+
+	userIDs := make([]int64, len(ranks))
+	for i, v := range ranks {
+		userIDs[i] = v.UserID
+	}
+
+	var members []*discordgo.Member
+	var err error
+	if bot.Running {
+		var tmp []*dstate.MemberState
+		tmp, err = bot.GetMembers(guildID, userIDs...)
+		for _, v := range tmp {
+			members = append(members, v.DgoMember())
+		}
+	} else {
+		members, err = botrest.GetMembers(guildID, userIDs...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*LeaderboardEntry, len(ranks))
+	for i, v := range ranks {
+		entry := &LeaderboardEntry{
+			RankEntry: v,
+			Username:  strconv.FormatInt(v.UserID, 10),
+		}
+
+		for _, m := range members {
+			if m.User.ID == v.UserID {
+				entry.Username = m.User.String()
+				entry.Avatar = m.User.AvatarURL("256")
+				entry.Bot = m.User.Bot
+				break
+			}
+		}
+
+		result[i] = entry
+	}
+
+	return result, nil
+}
+
+// UpdateLevelRoles gives the member the level roles they should have at level and takes away the ones they shouldn't
+func UpdateLevelRoles(gs *dstate.GuildSet, ms *dstate.MemberState, conf *models.LevelingConfig, level int) error {
+	levelRoles, err := models.LevelingRoles(models.LevelingRoleWhere.GuildID.EQ(gs.ID)).AllG(context.Background())
+	if err != nil {
+		return err
+	}
+	if len(levelRoles) == 0 {
+		return nil // nothing to do
+	}
+
+	botMember, err := bot.GetMember(gs.ID, common.BotUser.ID)
+	if err != nil {
+		return err
+	}
+	botHighestRole := bot.MemberHighestRole(gs, botMember)
+
+	add, remove := computeRoleChanges(ms.Member.Roles, level, levelRoles, conf.StackRoles)
+	add, remove = filterActionable(add, gs, botHighestRole), filterActionable(remove, gs, botHighestRole)
+	if len(add) == 0 && len(remove) == 0 {
+		return nil // nothing to do
+	}
+
+	// Can we edit the member's roles in one go?
+	if common.IsRoleAbove(botHighestRole, bot.MemberHighestRole(gs, ms)) {
+		return bulkEditRoles(ms, add, remove)
+	}
+
+	// Otherwise add and remove them one by one to avoid a permissions error
+	for _, r := range add {
+		if err = common.BotSession.GuildMemberRoleAdd(gs.ID, ms.User.ID, r); err != nil {
+			return err
+		}
+	}
+	for _, r := range remove {
+		if err = common.BotSession.GuildMemberRoleRemove(gs.ID, ms.User.ID, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func bulkEditRoles(ms *dstate.MemberState, add []int64, remove []int64) error {
+	newRoles := make([]string, 0, max(len(ms.Member.Roles)+len(add)-len(remove), 0))
+	for _, r := range ms.Member.Roles {
+		if !slices.Contains(remove, r) {
+			newRoles = append(newRoles, discordgo.StrID(r))
+		}
+	}
+	for _, r := range add {
+		newRoles = append(newRoles, discordgo.StrID(r))
+	}
+
+	return common.BotSession.GuildMemberEdit(ms.GuildID, ms.User.ID, newRoles)
+}
+
+func filterActionable(roles []int64, gs *dstate.GuildSet, botHighest *discordgo.Role) []int64 {
+	return slices.DeleteFunc(roles, func(r int64) bool {
+		rr := gs.GetRole(r)
+		return rr == nil || !common.IsRoleAbove(botHighest, rr)
+	})
+}
+
+// computeRoleChanges works out which level roles to give and take away, if stack is false only the role
+// of the highest level reached is kept
+func computeRoleChanges(memberRoles []int64, level int, levelRoles models.LevelingRoleSlice, stack bool) (add []int64, remove []int64) {
+	highest := -1
+	for _, lr := range levelRoles {
+		if lr.Level <= level && lr.Level > highest {
+			highest = lr.Level
+		}
+	}
+
+	// a role can be used for several levels, it's kept if any of them is
+	var keep []int64
+	for _, lr := range levelRoles {
+		if lr.Level <= level && (stack || lr.Level == highest) {
+			keep = append(keep, lr.Role)
+		}
+	}
+
+	for _, lr := range levelRoles {
+		has := slices.Contains(memberRoles, lr.Role)
+		kept := slices.Contains(keep, lr.Role)
+		switch {
+		case kept && !has:
+			add = append(add, lr.Role)
+		case !kept && has:
+			remove = append(remove, lr.Role)
+		}
+	}
+
+	// deduplicate
+	slices.Sort(add)
+	slices.Sort(remove)
+	return slices.Compact(add), slices.Compact(remove)
+}
+
+// ResetGuild removes everyone's xp and levels
+func ResetGuild(ctx context.Context, guildID int64) error {
+	_, err := models.LevelingUsers(qm.Where("guild_id = ?", guildID)).DeleteAll(ctx, common.PQ)
+	return err
+}
+
+var _ featureflags.PluginWithFeatureFlags = (*Plugin)(nil)
+
+const (
+	featureFlagEnabled = "leveling_enabled"
+)
+
+func (p *Plugin) UpdateFeatureFlags(guildID int64) ([]string, error) {
+	config, err := GetConfig(context.Background(), guildID)
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	var flags []string
+	if config.Enabled {
+		flags = append(flags, featureFlagEnabled)
+	}
+
+	return flags, nil
+}
+
+func (p *Plugin) AllFeatureFlags() []string {
+	return []string{
+		featureFlagEnabled, // set if leveling is enabled on this server
+	}
+}
@@ -0,0 +1,57 @@
+package leveling
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ThatBathroom/yagpdb/v2/leveling/models"
+)
+
+func TestLevelForXP(t *testing.T) {
+	cases := []struct {
+		xp    int64
+		level int
+	}{
+		{0, 0},
+		{99, 0},
+		{100, 1},
+		{254, 1},
+		{255, 2},
+		{TotalXPForLevel(10) - 1, 9},
+		{TotalXPForLevel(10), 10},
+	}
+
+	for _, c := range cases {
+		if got := LevelForXP(c.xp); got != c.level {
+			t.Errorf("LevelForXP(%d) = %d, want %d", c.xp, got, c.level)
+		}
+	}
+}
+
+func TestComputeRoleChanges(t *testing.T) {
+	levelRoles := models.LevelingRoleSlice{
+		{Level: 5, Role: 1},
+		{Level: 10, Role: 2},
+		{Level: 20, Role: 3},
+	}
+
+	cases := []struct {
+		name        string
+		memberRoles []int64
+		level       int
+		stack       bool
+		add, remove []int64
+	}{
+		{"below all", nil, 1, true, nil, nil},
+		{"stacked", []int64{1}, 12, true, []int64{2}, nil},
+		{"not stacked", []int64{1}, 12, false, []int64{2}, []int64{1}},
+		{"lost levels", []int64{1, 2, 3}, 6, true, nil, []int64{2, 3}},
+	}
+
+	for _, c := range cases {
+		add, remove := computeRoleChanges(c.memberRoles, c.level, levelRoles, c.stack)
+		if !slices.Equal(add, c.add) || !slices.Equal(remove, c.remove) {
+			t.Errorf("%s: got add %v remove %v, want add %v remove %v", c.name, add, remove, c.add, c.remove)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+var TableNames = struct {
+	LevelingConfigs string
+	LevelingRoles   string
+	LevelingUsers   string
+}{
+	LevelingConfigs: "leveling_configs",
+	LevelingRoles:   "leveling_roles",
+	LevelingUsers:   "leveling_users",
+}
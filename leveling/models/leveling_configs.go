@@ -0,0 +1,963 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// LevelingConfig is an object representing the database table.
+type LevelingConfig struct {
+	GuildID                 int64            `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	Enabled                 bool             `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	XPMin                   int              `boil:"xp_min" json:"xp_min" toml:"xp_min" yaml:"xp_min"`
+	XPMax                   int              `boil:"xp_max" json:"xp_max" toml:"xp_max" yaml:"xp_max"`
+	Cooldown                int              `boil:"cooldown" json:"cooldown" toml:"cooldown" yaml:"cooldown"`
+	NoXPChannels            types.Int64Array `boil:"no_xp_channels" json:"no_xp_channels,omitempty" toml:"no_xp_channels" yaml:"no_xp_channels,omitempty"`
+	NoXPRoles               types.Int64Array `boil:"no_xp_roles" json:"no_xp_roles,omitempty" toml:"no_xp_roles" yaml:"no_xp_roles,omitempty"`
+	MultiplierChannels      types.Int64Array `boil:"multiplier_channels" json:"multiplier_channels,omitempty" toml:"multiplier_channels" yaml:"multiplier_channels,omitempty"`
+	MultiplierChannelValues types.Int64Array `boil:"multiplier_channel_values" json:"multiplier_channel_values,omitempty" toml:"multiplier_channel_values" yaml:"multiplier_channel_values,omitempty"`
+	MultiplierRoles         types.Int64Array `boil:"multiplier_roles" json:"multiplier_roles,omitempty" toml:"multiplier_roles" yaml:"multiplier_roles,omitempty"`
+	MultiplierRoleValues    types.Int64Array `boil:"multiplier_role_values" json:"multiplier_role_values,omitempty" toml:"multiplier_role_values" yaml:"multiplier_role_values,omitempty"`
+	StackRoles              bool             `boil:"stack_roles" json:"stack_roles" toml:"stack_roles" yaml:"stack_roles"`
+	LevelUpEnabled          bool             `boil:"level_up_enabled" json:"level_up_enabled" toml:"level_up_enabled" yaml:"level_up_enabled"`
+	LevelUpChannel          int64            `boil:"level_up_channel" json:"level_up_channel" toml:"level_up_channel" yaml:"level_up_channel"`
+	LevelUpMessage          string           `boil:"level_up_message" json:"level_up_message" toml:"level_up_message" yaml:"level_up_message"`
+
+	R *levelingConfigR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L levelingConfigL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LevelingConfigColumns = struct {
+	GuildID                 string
+	Enabled                 string
+	XPMin                   string
+	XPMax                   string
+	Cooldown                string
+	NoXPChannels            string
+	NoXPRoles               string
+	MultiplierChannels      string
+	MultiplierChannelValues string
+	MultiplierRoles         string
+	MultiplierRoleValues    string
+	StackRoles              string
+	LevelUpEnabled          string
+	LevelUpChannel          string
+	LevelUpMessage          string
+}{
+	GuildID:                 "guild_id",
+	Enabled:                 "enabled",
+	XPMin:                   "xp_min",
+	XPMax:                   "xp_max",
+	Cooldown:                "cooldown",
+	NoXPChannels:            "no_xp_channels",
+	NoXPRoles:               "no_xp_roles",
+	MultiplierChannels:      "multiplier_channels",
+	MultiplierChannelValues: "multiplier_channel_values",
+	MultiplierRoles:         "multiplier_roles",
+	MultiplierRoleValues:    "multiplier_role_values",
+	StackRoles:              "stack_roles",
+	LevelUpEnabled:          "level_up_enabled",
+	LevelUpChannel:          "level_up_channel",
+	LevelUpMessage:          "level_up_message",
+}
+
+var LevelingConfigTableColumns = struct {
+	GuildID                 string
+	Enabled                 string
+	XPMin                   string
+	XPMax                   string
+	Cooldown                string
+	NoXPChannels            string
+	NoXPRoles               string
+	MultiplierChannels      string
+	MultiplierChannelValues string
+	MultiplierRoles         string
+	MultiplierRoleValues    string
+	StackRoles              string
+	LevelUpEnabled          string
+	LevelUpChannel          string
+	LevelUpMessage          string
+}{
+	GuildID:                 "leveling_configs.guild_id",
+	Enabled:                 "leveling_configs.enabled",
+	XPMin:                   "leveling_configs.xp_min",
+	XPMax:                   "leveling_configs.xp_max",
+	Cooldown:                "leveling_configs.cooldown",
+	NoXPChannels:            "leveling_configs.no_xp_channels",
+	NoXPRoles:               "leveling_configs.no_xp_roles",
+	MultiplierChannels:      "leveling_configs.multiplier_channels",
+	MultiplierChannelValues: "leveling_configs.multiplier_channel_values",
+	MultiplierRoles:         "leveling_configs.multiplier_roles",
+	MultiplierRoleValues:    "leveling_configs.multiplier_role_values",
+	StackRoles:              "leveling_configs.stack_roles",
+	LevelUpEnabled:          "leveling_configs.level_up_enabled",
+	LevelUpChannel:          "leveling_configs.level_up_channel",
+	LevelUpMessage:          "leveling_configs.level_up_message",
+}
+
+// Generated where
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertypes_Int64Array struct{ field string }
+
+func (w whereHelpertypes_Int64Array) EQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpertypes_Int64Array) NEQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpertypes_Int64Array) LT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_Int64Array) LTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_Int64Array) GT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_Int64Array) GTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpertypes_Int64Array) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpertypes_Int64Array) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
+func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var LevelingConfigWhere = struct {
+	GuildID                 whereHelperint64
+	Enabled                 whereHelperbool
+	XPMin                   whereHelperint
+	XPMax                   whereHelperint
+	Cooldown                whereHelperint
+	NoXPChannels            whereHelpertypes_Int64Array
+	NoXPRoles               whereHelpertypes_Int64Array
+	MultiplierChannels      whereHelpertypes_Int64Array
+	MultiplierChannelValues whereHelpertypes_Int64Array
+	MultiplierRoles         whereHelpertypes_Int64Array
+	MultiplierRoleValues    whereHelpertypes_Int64Array
+	StackRoles              whereHelperbool
+	LevelUpEnabled          whereHelperbool
+	LevelUpChannel          whereHelperint64
+	LevelUpMessage          whereHelperstring
+}{
+	GuildID:                 whereHelperint64{field: "\"leveling_configs\".\"guild_id\""},
+	Enabled:                 whereHelperbool{field: "\"leveling_configs\".\"enabled\""},
+	XPMin:                   whereHelperint{field: "\"leveling_configs\".\"xp_min\""},
+	XPMax:                   whereHelperint{field: "\"leveling_configs\".\"xp_max\""},
+	Cooldown:                whereHelperint{field: "\"leveling_configs\".\"cooldown\""},
+	NoXPChannels:            whereHelpertypes_Int64Array{field: "\"leveling_configs\".\"no_xp_channels\""},
+	NoXPRoles:               whereHelpertypes_Int64Array{field: "\"leveling_configs\".\"no_xp_roles\""},
+	MultiplierChannels:      whereHelpertypes_Int64Array{field: "\"leveling_configs\".\"multiplier_channels\""},
+	MultiplierChannelValues: whereHelpertypes_Int64Array{field: "\"leveling_configs\".\"multiplier_channel_values\""},
+	MultiplierRoles:         whereHelpertypes_Int64Array{field: "\"leveling_configs\".\"multiplier_roles\""},
+	MultiplierRoleValues:    whereHelpertypes_Int64Array{field: "\"leveling_configs\".\"multiplier_role_values\""},
+	StackRoles:              whereHelperbool{field: "\"leveling_configs\".\"stack_roles\""},
+	LevelUpEnabled:          whereHelperbool{field: "\"leveling_configs\".\"level_up_enabled\""},
+	LevelUpChannel:          whereHelperint64{field: "\"leveling_configs\".\"level_up_channel\""},
+	LevelUpMessage:          whereHelperstring{field: "\"leveling_configs\".\"level_up_message\""},
+}
+
+// LevelingConfigRels is where relationship names are stored.
+var LevelingConfigRels = struct {
+}{}
+
+// levelingConfigR is where relationships are stored.
+type levelingConfigR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*levelingConfigR) NewStruct() *levelingConfigR {
+	return &levelingConfigR{}
+}
+
+// levelingConfigL is where Load methods for each relationship are stored.
+type levelingConfigL struct{}
+
+var (
+	levelingConfigAllColumns            = []string{"guild_id", "enabled", "xp_min", "xp_max", "cooldown", "no_xp_channels", "no_xp_roles", "multiplier_channels", "multiplier_channel_values", "multiplier_roles", "multiplier_role_values", "stack_roles", "level_up_enabled", "level_up_channel", "level_up_message"}
+	levelingConfigColumnsWithoutDefault = []string{"guild_id"}
+	levelingConfigColumnsWithDefault    = []string{"enabled", "xp_min", "xp_max", "cooldown", "no_xp_channels", "no_xp_roles", "multiplier_channels", "multiplier_channel_values", "multiplier_roles", "multiplier_role_values", "stack_roles", "level_up_enabled", "level_up_channel", "level_up_message"}
+	levelingConfigPrimaryKeyColumns     = []string{"guild_id"}
+	levelingConfigGeneratedColumns      = []string{}
+)
+
+type (
+	// LevelingConfigSlice is an alias for a slice of pointers to LevelingConfig.
+	// This should almost always be used instead of []LevelingConfig.
+	LevelingConfigSlice []*LevelingConfig
+
+	levelingConfigQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	levelingConfigType                 = reflect.TypeOf(&LevelingConfig{})
+	levelingConfigMapping              = queries.MakeStructMapping(levelingConfigType)
+	levelingConfigPrimaryKeyMapping, _ = queries.BindMapping(levelingConfigType, levelingConfigMapping, levelingConfigPrimaryKeyColumns)
+	levelingConfigInsertCacheMut       sync.RWMutex
+	levelingConfigInsertCache          = make(map[string]insertCache)
+	levelingConfigUpdateCacheMut       sync.RWMutex
+	levelingConfigUpdateCache          = make(map[string]updateCache)
+	levelingConfigUpsertCacheMut       sync.RWMutex
+	levelingConfigUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single levelingConfig record from the query using the global executor.
+func (q levelingConfigQuery) OneG(ctx context.Context) (*LevelingConfig, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single levelingConfig record from the query.
+func (q levelingConfigQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LevelingConfig, error) {
+	o := &LevelingConfig{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for leveling_configs")
+	}
+
+	return o, nil
+}
+
+// AllG returns all LevelingConfig records from the query using the global executor.
+func (q levelingConfigQuery) AllG(ctx context.Context) (LevelingConfigSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all LevelingConfig records from the query.
+func (q levelingConfigQuery) All(ctx context.Context, exec boil.ContextExecutor) (LevelingConfigSlice, error) {
+	var o []*LevelingConfig
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to LevelingConfig slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all LevelingConfig records in the query using the global executor
+func (q levelingConfigQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all LevelingConfig records in the query.
+func (q levelingConfigQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count leveling_configs rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q levelingConfigQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q levelingConfigQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if leveling_configs exists")
+	}
+
+	return count > 0, nil
+}
+
+// LevelingConfigs retrieves all the records using an executor.
+func LevelingConfigs(mods ...qm.QueryMod) levelingConfigQuery {
+	mods = append(mods, qm.From("\"leveling_configs\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"leveling_configs\".*"})
+	}
+
+	return levelingConfigQuery{q}
+}
+
+// FindLevelingConfigG retrieves a single record by ID.
+func FindLevelingConfigG(ctx context.Context, guildID int64, selectCols ...string) (*LevelingConfig, error) {
+	return FindLevelingConfig(ctx, boil.GetContextDB(), guildID, selectCols...)
+}
+
+// FindLevelingConfig retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLevelingConfig(ctx context.Context, exec boil.ContextExecutor, guildID int64, selectCols ...string) (*LevelingConfig, error) {
+	levelingConfigObj := &LevelingConfig{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"leveling_configs\" where \"guild_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, guildID)
+
+	err := q.Bind(ctx, exec, levelingConfigObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from leveling_configs")
+	}
+
+	return levelingConfigObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *LevelingConfig) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LevelingConfig) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no leveling_configs provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(levelingConfigColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	levelingConfigInsertCacheMut.RLock()
+	cache, cached := levelingConfigInsertCache[key]
+	levelingConfigInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			levelingConfigAllColumns,
+			levelingConfigColumnsWithDefault,
+			levelingConfigColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(levelingConfigType, levelingConfigMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(levelingConfigType, levelingConfigMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"leveling_configs\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"leveling_configs\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into leveling_configs")
+	}
+
+	if !cached {
+		levelingConfigInsertCacheMut.Lock()
+		levelingConfigInsertCache[key] = cache
+		levelingConfigInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single LevelingConfig record using the global executor.
+// See Update for more documentation.
+func (o *LevelingConfig) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the LevelingConfig.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LevelingConfig) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	levelingConfigUpdateCacheMut.RLock()
+	cache, cached := levelingConfigUpdateCache[key]
+	levelingConfigUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			levelingConfigAllColumns,
+			levelingConfigPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update leveling_configs, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"leveling_configs\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, levelingConfigPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(levelingConfigType, levelingConfigMapping, append(wl, levelingConfigPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update leveling_configs row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for leveling_configs")
+	}
+
+	if !cached {
+		levelingConfigUpdateCacheMut.Lock()
+		levelingConfigUpdateCache[key] = cache
+		levelingConfigUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q levelingConfigQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q levelingConfigQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for leveling_configs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for leveling_configs")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o LevelingConfigSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LevelingConfigSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), levelingConfigPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"leveling_configs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, levelingConfigPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in levelingConfig slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all levelingConfig")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *LevelingConfig) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LevelingConfig) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no leveling_configs provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(levelingConfigColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	levelingConfigUpsertCacheMut.RLock()
+	cache, cached := levelingConfigUpsertCache[key]
+	levelingConfigUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			levelingConfigAllColumns,
+			levelingConfigColumnsWithDefault,
+			levelingConfigColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			levelingConfigAllColumns,
+			levelingConfigPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert leveling_configs, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(levelingConfigAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(levelingConfigPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert leveling_configs, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(levelingConfigPrimaryKeyColumns))
+			copy(conflict, levelingConfigPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"leveling_configs\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(levelingConfigType, levelingConfigMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(levelingConfigType, levelingConfigMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert leveling_configs")
+	}
+
+	if !cached {
+		levelingConfigUpsertCacheMut.Lock()
+		levelingConfigUpsertCache[key] = cache
+		levelingConfigUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single LevelingConfig record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *LevelingConfig) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single LevelingConfig record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LevelingConfig) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no LevelingConfig provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), levelingConfigPrimaryKeyMapping)
+	sql := "DELETE FROM \"leveling_configs\" WHERE \"guild_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from leveling_configs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for leveling_configs")
+	}
+
+	return rowsAff, nil
+}
+
+func (q levelingConfigQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q levelingConfigQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no levelingConfigQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from leveling_configs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for leveling_configs")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o LevelingConfigSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LevelingConfigSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), levelingConfigPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"leveling_configs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, levelingConfigPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from levelingConfig slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for leveling_configs")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *LevelingConfig) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no LevelingConfig provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LevelingConfig) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLevelingConfig(ctx, exec, o.GuildID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LevelingConfigSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty LevelingConfigSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LevelingConfigSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LevelingConfigSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), levelingConfigPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"leveling_configs\".* FROM \"leveling_configs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, levelingConfigPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in LevelingConfigSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LevelingConfigExistsG checks if the LevelingConfig row exists.
+func LevelingConfigExistsG(ctx context.Context, guildID int64) (bool, error) {
+	return LevelingConfigExists(ctx, boil.GetContextDB(), guildID)
+}
+
+// LevelingConfigExists checks if the LevelingConfig row exists.
+func LevelingConfigExists(ctx context.Context, exec boil.ContextExecutor, guildID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"leveling_configs\" where \"guild_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, guildID)
+	}
+	row := exec.QueryRowContext(ctx, sql, guildID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if leveling_configs exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the LevelingConfig row exists.
+func (o *LevelingConfig) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LevelingConfigExists(ctx, exec, o.GuildID)
+}
@@ -0,0 +1,779 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// LevelingRole is an object representing the database table.
+type LevelingRole struct {
+	ID      int64 `boil:"id" json:"id" toml:"id" yaml:"id"`
+	GuildID int64 `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	Level   int   `boil:"level" json:"level" toml:"level" yaml:"level"`
+	Role    int64 `boil:"role" json:"role" toml:"role" yaml:"role"`
+
+	R *levelingRoleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L levelingRoleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LevelingRoleColumns = struct {
+	ID      string
+	GuildID string
+	Level   string
+	Role    string
+}{
+	ID:      "id",
+	GuildID: "guild_id",
+	Level:   "level",
+	Role:    "role",
+}
+
+var LevelingRoleTableColumns = struct {
+	ID      string
+	GuildID string
+	Level   string
+	Role    string
+}{
+	ID:      "leveling_roles.id",
+	GuildID: "leveling_roles.guild_id",
+	Level:   "leveling_roles.level",
+	Role:    "leveling_roles.role",
+}
+
+// Generated where
+
+var LevelingRoleWhere = struct {
+	ID      whereHelperint64
+	GuildID whereHelperint64
+	Level   whereHelperint
+	Role    whereHelperint64
+}{
+	ID:      whereHelperint64{field: "\"leveling_roles\".\"id\""},
+	GuildID: whereHelperint64{field: "\"leveling_roles\".\"guild_id\""},
+	Level:   whereHelperint{field: "\"leveling_roles\".\"level\""},
+	Role:    whereHelperint64{field: "\"leveling_roles\".\"role\""},
+}
+
+// LevelingRoleRels is where relationship names are stored.
+var LevelingRoleRels = struct {
+}{}
+
+// levelingRoleR is where relationships are stored.
+type levelingRoleR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*levelingRoleR) NewStruct() *levelingRoleR {
+	return &levelingRoleR{}
+}
+
+// levelingRoleL is where Load methods for each relationship are stored.
+type levelingRoleL struct{}
+
+var (
+	levelingRoleAllColumns            = []string{"id", "guild_id", "level", "role"}
+	levelingRoleColumnsWithoutDefault = []string{"guild_id", "level", "role"}
+	levelingRoleColumnsWithDefault    = []string{"id"}
+	levelingRolePrimaryKeyColumns     = []string{"id"}
+	levelingRoleGeneratedColumns      = []string{}
+)
+
+type (
+	// LevelingRoleSlice is an alias for a slice of pointers to LevelingRole.
+	// This should almost always be used instead of []LevelingRole.
+	LevelingRoleSlice []*LevelingRole
+
+	levelingRoleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	levelingRoleType                 = reflect.TypeOf(&LevelingRole{})
+	levelingRoleMapping              = queries.MakeStructMapping(levelingRoleType)
+	levelingRolePrimaryKeyMapping, _ = queries.BindMapping(levelingRoleType, levelingRoleMapping, levelingRolePrimaryKeyColumns)
+	levelingRoleInsertCacheMut       sync.RWMutex
+	levelingRoleInsertCache          = make(map[string]insertCache)
+	levelingRoleUpdateCacheMut       sync.RWMutex
+	levelingRoleUpdateCache          = make(map[string]updateCache)
+	levelingRoleUpsertCacheMut       sync.RWMutex
+	levelingRoleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single levelingRole record from the query using the global executor.
+func (q levelingRoleQuery) OneG(ctx context.Context) (*LevelingRole, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single levelingRole record from the query.
+func (q levelingRoleQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LevelingRole, error) {
+	o := &LevelingRole{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for leveling_roles")
+	}
+
+	return o, nil
+}
+
+// AllG returns all LevelingRole records from the query using the global executor.
+func (q levelingRoleQuery) AllG(ctx context.Context) (LevelingRoleSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all LevelingRole records from the query.
+func (q levelingRoleQuery) All(ctx context.Context, exec boil.ContextExecutor) (LevelingRoleSlice, error) {
+	var o []*LevelingRole
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to LevelingRole slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all LevelingRole records in the query using the global executor
+func (q levelingRoleQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all LevelingRole records in the query.
+func (q levelingRoleQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count leveling_roles rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q levelingRoleQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q levelingRoleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if leveling_roles exists")
+	}
+
+	return count > 0, nil
+}
+
+// LevelingRoles retrieves all the records using an executor.
+func LevelingRoles(mods ...qm.QueryMod) levelingRoleQuery {
+	mods = append(mods, qm.From("\"leveling_roles\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"leveling_roles\".*"})
+	}
+
+	return levelingRoleQuery{q}
+}
+
+// FindLevelingRoleG retrieves a single record by ID.
+func FindLevelingRoleG(ctx context.Context, iD int64, selectCols ...string) (*LevelingRole, error) {
+	return FindLevelingRole(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindLevelingRole retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLevelingRole(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*LevelingRole, error) {
+	levelingRoleObj := &LevelingRole{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"leveling_roles\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, levelingRoleObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from leveling_roles")
+	}
+
+	return levelingRoleObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *LevelingRole) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LevelingRole) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no leveling_roles provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(levelingRoleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	levelingRoleInsertCacheMut.RLock()
+	cache, cached := levelingRoleInsertCache[key]
+	levelingRoleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			levelingRoleAllColumns,
+			levelingRoleColumnsWithDefault,
+			levelingRoleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(levelingRoleType, levelingRoleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(levelingRoleType, levelingRoleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"leveling_roles\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"leveling_roles\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into leveling_roles")
+	}
+
+	if !cached {
+		levelingRoleInsertCacheMut.Lock()
+		levelingRoleInsertCache[key] = cache
+		levelingRoleInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single LevelingRole record using the global executor.
+// See Update for more documentation.
+func (o *LevelingRole) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the LevelingRole.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LevelingRole) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	levelingRoleUpdateCacheMut.RLock()
+	cache, cached := levelingRoleUpdateCache[key]
+	levelingRoleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			levelingRoleAllColumns,
+			levelingRolePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update leveling_roles, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"leveling_roles\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, levelingRolePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(levelingRoleType, levelingRoleMapping, append(wl, levelingRolePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update leveling_roles row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for leveling_roles")
+	}
+
+	if !cached {
+		levelingRoleUpdateCacheMut.Lock()
+		levelingRoleUpdateCache[key] = cache
+		levelingRoleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q levelingRoleQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q levelingRoleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for leveling_roles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for leveling_roles")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o LevelingRoleSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LevelingRoleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), levelingRolePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"leveling_roles\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, levelingRolePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in levelingRole slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all levelingRole")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *LevelingRole) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LevelingRole) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no leveling_roles provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(levelingRoleColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	levelingRoleUpsertCacheMut.RLock()
+	cache, cached := levelingRoleUpsertCache[key]
+	levelingRoleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			levelingRoleAllColumns,
+			levelingRoleColumnsWithDefault,
+			levelingRoleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			levelingRoleAllColumns,
+			levelingRolePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert leveling_roles, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(levelingRoleAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(levelingRolePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert leveling_roles, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(levelingRolePrimaryKeyColumns))
+			copy(conflict, levelingRolePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"leveling_roles\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(levelingRoleType, levelingRoleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(levelingRoleType, levelingRoleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert leveling_roles")
+	}
+
+	if !cached {
+		levelingRoleUpsertCacheMut.Lock()
+		levelingRoleUpsertCache[key] = cache
+		levelingRoleUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single LevelingRole record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *LevelingRole) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single LevelingRole record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LevelingRole) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no LevelingRole provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), levelingRolePrimaryKeyMapping)
+	sql := "DELETE FROM \"leveling_roles\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from leveling_roles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for leveling_roles")
+	}
+
+	return rowsAff, nil
+}
+
+func (q levelingRoleQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q levelingRoleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no levelingRoleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from leveling_roles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for leveling_roles")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o LevelingRoleSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LevelingRoleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), levelingRolePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"leveling_roles\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, levelingRolePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from levelingRole slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for leveling_roles")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *LevelingRole) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no LevelingRole provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LevelingRole) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLevelingRole(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LevelingRoleSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty LevelingRoleSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LevelingRoleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LevelingRoleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), levelingRolePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"leveling_roles\".* FROM \"leveling_roles\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, levelingRolePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in LevelingRoleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LevelingRoleExistsG checks if the LevelingRole row exists.
+func LevelingRoleExistsG(ctx context.Context, iD int64) (bool, error) {
+	return LevelingRoleExists(ctx, boil.GetContextDB(), iD)
+}
+
+// LevelingRoleExists checks if the LevelingRole row exists.
+func LevelingRoleExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"leveling_roles\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if leveling_roles exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the LevelingRole row exists.
+func (o *LevelingRole) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LevelingRoleExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,845 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// LevelingUser is an object representing the database table.
+type LevelingUser struct {
+	GuildID   int64     `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	UserID    int64     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	XP        int64     `boil:"xp" json:"xp" toml:"xp" yaml:"xp"`
+	Level     int       `boil:"level" json:"level" toml:"level" yaml:"level"`
+	Messages  int64     `boil:"messages" json:"messages" toml:"messages" yaml:"messages"`
+
+	R *levelingUserR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L levelingUserL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LevelingUserColumns = struct {
+	GuildID   string
+	UserID    string
+	CreatedAt string
+	UpdatedAt string
+	XP        string
+	Level     string
+	Messages  string
+}{
+	GuildID:   "guild_id",
+	UserID:    "user_id",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+	XP:        "xp",
+	Level:     "level",
+	Messages:  "messages",
+}
+
+var LevelingUserTableColumns = struct {
+	GuildID   string
+	UserID    string
+	CreatedAt string
+	UpdatedAt string
+	XP        string
+	Level     string
+	Messages  string
+}{
+	GuildID:   "leveling_users.guild_id",
+	UserID:    "leveling_users.user_id",
+	CreatedAt: "leveling_users.created_at",
+	UpdatedAt: "leveling_users.updated_at",
+	XP:        "leveling_users.xp",
+	Level:     "leveling_users.level",
+	Messages:  "leveling_users.messages",
+}
+
+// Generated where
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var LevelingUserWhere = struct {
+	GuildID   whereHelperint64
+	UserID    whereHelperint64
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+	XP        whereHelperint64
+	Level     whereHelperint
+	Messages  whereHelperint64
+}{
+	GuildID:   whereHelperint64{field: "\"leveling_users\".\"guild_id\""},
+	UserID:    whereHelperint64{field: "\"leveling_users\".\"user_id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"leveling_users\".\"created_at\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"leveling_users\".\"updated_at\""},
+	XP:        whereHelperint64{field: "\"leveling_users\".\"xp\""},
+	Level:     whereHelperint{field: "\"leveling_users\".\"level\""},
+	Messages:  whereHelperint64{field: "\"leveling_users\".\"messages\""},
+}
+
+// LevelingUserRels is where relationship names are stored.
+var LevelingUserRels = struct {
+}{}
+
+// levelingUserR is where relationships are stored.
+type levelingUserR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*levelingUserR) NewStruct() *levelingUserR {
+	return &levelingUserR{}
+}
+
+// levelingUserL is where Load methods for each relationship are stored.
+type levelingUserL struct{}
+
+var (
+	levelingUserAllColumns            = []string{"guild_id", "user_id", "created_at", "updated_at", "xp", "level", "messages"}
+	levelingUserColumnsWithoutDefault = []string{"guild_id", "user_id", "created_at", "updated_at", "xp"}
+	levelingUserColumnsWithDefault    = []string{"level", "messages"}
+	levelingUserPrimaryKeyColumns     = []string{"guild_id", "user_id"}
+	levelingUserGeneratedColumns      = []string{}
+)
+
+type (
+	// LevelingUserSlice is an alias for a slice of pointers to LevelingUser.
+	// This should almost always be used instead of []LevelingUser.
+	LevelingUserSlice []*LevelingUser
+
+	levelingUserQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	levelingUserType                 = reflect.TypeOf(&LevelingUser{})
+	levelingUserMapping              = queries.MakeStructMapping(levelingUserType)
+	levelingUserPrimaryKeyMapping, _ = queries.BindMapping(levelingUserType, levelingUserMapping, levelingUserPrimaryKeyColumns)
+	levelingUserInsertCacheMut       sync.RWMutex
+	levelingUserInsertCache          = make(map[string]insertCache)
+	levelingUserUpdateCacheMut       sync.RWMutex
+	levelingUserUpdateCache          = make(map[string]updateCache)
+	levelingUserUpsertCacheMut       sync.RWMutex
+	levelingUserUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single levelingUser record from the query using the global executor.
+func (q levelingUserQuery) OneG(ctx context.Context) (*LevelingUser, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single levelingUser record from the query.
+func (q levelingUserQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LevelingUser, error) {
+	o := &LevelingUser{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for leveling_users")
+	}
+
+	return o, nil
+}
+
+// AllG returns all LevelingUser records from the query using the global executor.
+func (q levelingUserQuery) AllG(ctx context.Context) (LevelingUserSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all LevelingUser records from the query.
+func (q levelingUserQuery) All(ctx context.Context, exec boil.ContextExecutor) (LevelingUserSlice, error) {
+	var o []*LevelingUser
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to LevelingUser slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all LevelingUser records in the query using the global executor
+func (q levelingUserQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all LevelingUser records in the query.
+func (q levelingUserQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count leveling_users rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q levelingUserQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q levelingUserQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if leveling_users exists")
+	}
+
+	return count > 0, nil
+}
+
+// LevelingUsers retrieves all the records using an executor.
+func LevelingUsers(mods ...qm.QueryMod) levelingUserQuery {
+	mods = append(mods, qm.From("\"leveling_users\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"leveling_users\".*"})
+	}
+
+	return levelingUserQuery{q}
+}
+
+// FindLevelingUserG retrieves a single record by ID.
+func FindLevelingUserG(ctx context.Context, guildID int64, userID int64, selectCols ...string) (*LevelingUser, error) {
+	return FindLevelingUser(ctx, boil.GetContextDB(), guildID, userID, selectCols...)
+}
+
+// FindLevelingUser retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLevelingUser(ctx context.Context, exec boil.ContextExecutor, guildID int64, userID int64, selectCols ...string) (*LevelingUser, error) {
+	levelingUserObj := &LevelingUser{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"leveling_users\" where \"guild_id\"=$1 AND \"user_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, guildID, userID)
+
+	err := q.Bind(ctx, exec, levelingUserObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from leveling_users")
+	}
+
+	return levelingUserObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *LevelingUser) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LevelingUser) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no leveling_users provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(levelingUserColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	levelingUserInsertCacheMut.RLock()
+	cache, cached := levelingUserInsertCache[key]
+	levelingUserInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			levelingUserAllColumns,
+			levelingUserColumnsWithDefault,
+			levelingUserColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(levelingUserType, levelingUserMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(levelingUserType, levelingUserMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"leveling_users\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"leveling_users\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into leveling_users")
+	}
+
+	if !cached {
+		levelingUserInsertCacheMut.Lock()
+		levelingUserInsertCache[key] = cache
+		levelingUserInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single LevelingUser record using the global executor.
+// See Update for more documentation.
+func (o *LevelingUser) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the LevelingUser.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LevelingUser) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	levelingUserUpdateCacheMut.RLock()
+	cache, cached := levelingUserUpdateCache[key]
+	levelingUserUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			levelingUserAllColumns,
+			levelingUserPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update leveling_users, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"leveling_users\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, levelingUserPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(levelingUserType, levelingUserMapping, append(wl, levelingUserPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update leveling_users row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for leveling_users")
+	}
+
+	if !cached {
+		levelingUserUpdateCacheMut.Lock()
+		levelingUserUpdateCache[key] = cache
+		levelingUserUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q levelingUserQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q levelingUserQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for leveling_users")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for leveling_users")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o LevelingUserSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LevelingUserSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), levelingUserPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"leveling_users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, levelingUserPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in levelingUser slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all levelingUser")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *LevelingUser) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LevelingUser) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no leveling_users provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(levelingUserColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	levelingUserUpsertCacheMut.RLock()
+	cache, cached := levelingUserUpsertCache[key]
+	levelingUserUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			levelingUserAllColumns,
+			levelingUserColumnsWithDefault,
+			levelingUserColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			levelingUserAllColumns,
+			levelingUserPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert leveling_users, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(levelingUserAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(levelingUserPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert leveling_users, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(levelingUserPrimaryKeyColumns))
+			copy(conflict, levelingUserPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"leveling_users\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(levelingUserType, levelingUserMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(levelingUserType, levelingUserMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert leveling_users")
+	}
+
+	if !cached {
+		levelingUserUpsertCacheMut.Lock()
+		levelingUserUpsertCache[key] = cache
+		levelingUserUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single LevelingUser record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *LevelingUser) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single LevelingUser record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LevelingUser) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no LevelingUser provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), levelingUserPrimaryKeyMapping)
+	sql := "DELETE FROM \"leveling_users\" WHERE \"guild_id\"=$1 AND \"user_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from leveling_users")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for leveling_users")
+	}
+
+	return rowsAff, nil
+}
+
+func (q levelingUserQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q levelingUserQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no levelingUserQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from leveling_users")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for leveling_users")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o LevelingUserSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LevelingUserSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), levelingUserPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"leveling_users\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, levelingUserPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from levelingUser slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for leveling_users")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *LevelingUser) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no LevelingUser provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LevelingUser) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLevelingUser(ctx, exec, o.GuildID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LevelingUserSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty LevelingUserSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LevelingUserSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LevelingUserSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), levelingUserPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"leveling_users\".* FROM \"leveling_users\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, levelingUserPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in LevelingUserSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LevelingUserExistsG checks if the LevelingUser row exists.
+func LevelingUserExistsG(ctx context.Context, guildID int64, userID int64) (bool, error) {
+	return LevelingUserExists(ctx, boil.GetContextDB(), guildID, userID)
+}
+
+// LevelingUserExists checks if the LevelingUser row exists.
+func LevelingUserExists(ctx context.Context, exec boil.ContextExecutor, guildID int64, userID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"leveling_users\" where \"guild_id\"=$1 AND \"user_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, guildID, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, guildID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if leveling_users exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the LevelingUser row exists.
+func (o *LevelingUser) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LevelingUserExists(ctx, exec, o.GuildID, o.UserID)
+}
@@ -0,0 +1,291 @@
+package leveling
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ThatBathroom/yagpdb/v2/analytics"
+	"github.com/ThatBathroom/yagpdb/v2/bot"
+	"github.com/ThatBathroom/yagpdb/v2/bot/eventsystem"
+	"github.com/ThatBathroom/yagpdb/v2/bot/paginatedmessages"
+	"github.com/ThatBathroom/yagpdb/v2/commands"
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/common/pubsub"
+	"github.com/ThatBathroom/yagpdb/v2/common/templates"
+	"github.com/ThatBathroom/yagpdb/v2/leveling/models"
+	"github.com/ThatBathroom/yagpdb/v2/lib/dcmd"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/lib/dstate"
+	"github.com/ThatBathroom/yagpdb/v2/web"
+)
+
+var _ bot.BotInitHandler = (*Plugin)(nil)
+var _ commands.CommandProvider = (*Plugin)(nil)
+
+func (p *Plugin) AddCommands() {
+	commands.AddRootCommands(p, cmds...)
+}
+
+func (p *Plugin) BotInit() {
+	eventsystem.AddHandlerAsyncLastLegacy(p, handleMessageCreate, eventsystem.EventMessageCreate)
+
+	pubsub.AddHandler("leveling_config_changed", handleConfigChanged, nil)
+}
+
+var cachedConfig = common.CacheSet.RegisterSlot("leveling_configs", nil, int64(0))
+
+func BotCachedGetConfig(guildID int64) (*models.LevelingConfig, error) {
+	v, err := cachedConfig.GetCustomFetch(guildID, func(key interface{}) (interface{}, error) {
+		return GetConfig(context.Background(), guildID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*models.LevelingConfig), nil
+}
+
+func handleConfigChanged(evt *pubsub.Event) {
+	cachedConfig.Delete(evt.TargetGuildInt)
+}
+
+func handleMessageCreate(evt *eventsystem.EventData) {
+	msg := evt.MessageCreate()
+
+	if msg.GuildID == 0 || msg.Author == nil || msg.Author.Bot || msg.Member == nil {
+		return
+	}
+
+	if !bot.IsUserMessage(msg.Message) {
+		return
+	}
+
+	if !evt.HasFeatureFlag(featureFlagEnabled) {
+		return
+	}
+
+	conf, err := BotCachedGetConfig(msg.GuildID)
+	if err != nil {
+		logger.WithError(err).WithField("guild", msg.GuildID).Error("failed retrieving leveling config")
+		return
+	}
+
+	if !conf.Enabled {
+		return
+	}
+
+	cs := evt.CSOrThread()
+	if cs == nil {
+		return
+	}
+
+	// threads use the settings of their parent channel
+	channelID := cs.ID
+	if cs.Type.IsThread() {
+		channelID = cs.ParentID
+	}
+
+	if common.ContainsInt64Slice(conf.NoXPChannels, channelID) || common.ContainsInt64SliceOneOf(msg.Member.Roles, conf.NoXPRoles) {
+		return
+	}
+
+	ok, err := CheckSetCooldown(conf, msg.Author.ID)
+	if err != nil || !ok {
+		if err != nil {
+			logger.WithError(err).WithField("guild", msg.GuildID).Error("failed checking xp cooldown")
+		}
+		return
+	}
+
+	xp := RandomXP(conf) * ChannelMultiplier(conf, channelID) * RoleMultiplier(conf, msg.Member.Roles)
+
+	_, oldLevel, newLevel, err := AddXP(evt.Context(), msg.GuildID, msg.Author.ID, xp, 1)
+	if err != nil {
+		logger.WithError(err).WithField("guild", msg.GuildID).Error("failed adding xp")
+		return
+	}
+
+	if newLevel == oldLevel {
+		return
+	}
+
+	ms := dstate.MemberStateFromMember(msg.Member)
+	ms.GuildID = msg.GuildID
+	onLevelChanged(evt.GS, cs, ms, conf, oldLevel, newLevel)
+}
+
+// onLevelChanged updates the level roles of the member and announces it if they went up a level,
+// cs is the channel the xp was earned in and may be nil
+func onLevelChanged(gs *dstate.GuildSet, cs *dstate.ChannelState, ms *dstate.MemberState, conf *models.LevelingConfig, oldLevel, newLevel int) {
+	if err := UpdateLevelRoles(gs, ms, conf, newLevel); err != nil {
+		logger.WithError(err).WithField("guild", gs.ID).Error("failed updating level roles")
+	}
+
+	if newLevel < oldLevel || !conf.LevelUpEnabled || conf.LevelUpMessage == "" {
+		return
+	}
+
+	if conf.LevelUpChannel != 0 {
+		cs = gs.GetChannel(conf.LevelUpChannel)
+	}
+	if cs == nil {
+		return
+	}
+
+	go analytics.RecordActiveUnit(gs.ID, &Plugin{}, "posted_level_up_msg")
+
+	tmplCtx := templates.NewContext(gs, cs, ms)
+	tmplCtx.Name = "level up message"
+	tmplCtx.Data["Level"] = newLevel
+	tmplCtx.Data["OldLevel"] = oldLevel
+	tmplCtx.Data[levelUpMessageDataKey] = true
+
+	err := tmplCtx.ExecuteAndSendWithErrors(conf.LevelUpMessage, cs.ID)
+	if err != nil {
+		logger.WithError(err).WithField("guild", gs.ID).Error("failed sending level up message")
+	}
+}
+
+func createLevelingDisabledError(g *dcmd.GuildContextData) string {
+	return fmt.Sprintf("**The leveling system is disabled for this server.** Enable it at: <%s/leveling>.", web.ManageServerURL(g.GS.ID))
+}
+
+var cmds = []*commands.YAGCommand{
+	{
+		CmdCategory: commands.CategoryFun,
+		Name:        "Rank",
+		Aliases:     []string{"level", "xp"},
+		Description: "Shows yours or the specified users level, xp and rank",
+		Arguments: []*dcmd.ArgDef{
+			{Name: "User", Type: dcmd.User},
+		},
+		SlashCommandEnabled: true,
+		DefaultEnabled:      false,
+		RunFunc: func(parsed *dcmd.Data) (interface{}, error) {
+			conf, err := GetConfig(parsed.Context(), parsed.GuildData.GS.ID)
+			if err != nil {
+				return "An error occurred finding the server config", err
+			}
+
+			if !conf.Enabled {
+				return createLevelingDisabledError(parsed.GuildData), nil
+			}
+
+			target := parsed.Author
+			if parsed.Args[0].Value != nil {
+				target = parsed.Args[0].Value.(*discordgo.User)
+			}
+
+			stats, err := GetUserStats(parsed.Context(), parsed.GuildData.GS.ID, target.ID)
+			if err != nil {
+				if err == ErrUserNotFound {
+					return fmt.Sprintf("**%s** hasn't earned any xp yet", target.Username), nil
+				}
+				return nil, err
+			}
+
+			progress := stats.XP - stats.LevelXP
+			needed := stats.NextLevelXP - stats.LevelXP
+
+			embed := &discordgo.MessageEmbed{
+				Title: target.Username,
+				Thumbnail: &discordgo.MessageEmbedThumbnail{
+					URL: target.AvatarURL("256"),
+				},
+				Fields: []*discordgo.MessageEmbedField{
+					{Name: "Rank", Value: "#" + strconv.Itoa(stats.Rank), Inline: true},
+					{Name: "Level", Value: strconv.Itoa(stats.Level), Inline: true},
+					{Name: "XP", Value: fmt.Sprintf("%d / %d", progress, needed), Inline: true},
+					{Name: "Progress", Value: progressBar(progress, needed, 20)},
+				},
+				Footer: &discordgo.MessageEmbedFooter{
+					Text: fmt.Sprintf("%d total xp from %d messages", stats.XP, stats.Messages),
+				},
+			}
+
+			return embed, nil
+		},
+	},
+	{
+		CmdCategory: commands.CategoryFun,
+		Name:        "Leaderboard",
+		Aliases:     []string{"lb", "levels", "toplevels"},
+		Description: "Shows the level leaderboard on the server",
+		Arguments: []*dcmd.ArgDef{
+			{Name: "Page", Type: dcmd.Int, Default: 0},
+		},
+		SlashCommandEnabled: true,
+		DefaultEnabled:      false,
+		RunFunc: func(parsed *dcmd.Data) (interface{}, error) {
+			conf, err := GetConfig(parsed.Context(), parsed.GuildData.GS.ID)
+			if err != nil {
+				return "An error occurred finding the server config", err
+			}
+
+			if !conf.Enabled {
+				return createLevelingDisabledError(parsed.GuildData), nil
+			}
+
+			page := parsed.Args[0].Int()
+			if page < 1 {
+				page = 1
+			}
+
+			if parsed.Context().Value(paginatedmessages.CtxKeyNoPagination) != nil {
+				return leaderboardPager(parsed.GuildData.GS.ID, nil, page)
+			}
+
+			return paginatedmessages.NewPaginatedResponse(parsed.GuildData.GS.ID, parsed.ChannelID, page, 0, func(p *paginatedmessages.PaginatedMessage, page int) (*discordgo.MessageEmbed, error) {
+				return leaderboardPager(parsed.GuildData.GS.ID, p, page)
+			}), nil
+		},
+	},
+}
+
+func leaderboardPager(guildID int64, p *paginatedmessages.PaginatedMessage, page int) (*discordgo.MessageEmbed, error) {
+	offset := (page - 1) * 15
+	entries, err := TopUsers(guildID, offset, 15)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) < 1 && p != nil && p.LastResponse != nil { // Dont send No Results error on first execution
+		return nil, paginatedmessages.ErrNoResults
+	}
+
+	detailed, err := DetailedLeaderboardEntries(guildID, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	leaderboardURL := web.BaseURL() + "/public/" + discordgo.StrID(guildID) + "/leveling/leaderboard"
+	out := "```\n# -- Level --     XP -- User\n"
+	for _, v := range detailed {
+		out += fmt.Sprintf("#%02d: %5d - %6d - %s\n", v.Rank, v.Level, v.XP, v.Username)
+	}
+	out += "```\n" + "Full leaderboard: <" + leaderboardURL + ">"
+
+	return &discordgo.MessageEmbed{
+		Title:       "Level leaderboard",
+		Description: out,
+	}, nil
+}
+
+func progressBar(current, total int64, width int) string {
+	filled := 0
+	if total > 0 {
+		filled = int(current * int64(width) / total)
+	}
+
+	bar := ""
+	for i := 0; i < width; i++ {
+		if i < filled {
+			bar += "█"
+		} else {
+			bar += "░"
+		}
+	}
+
+	return "`" + bar + "`"
+}
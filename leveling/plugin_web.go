@@ -0,0 +1,419 @@
+package leveling
+
+import (
+	_ "embed"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/common/cplogs"
+	"github.com/ThatBathroom/yagpdb/v2/common/featureflags"
+	"github.com/ThatBathroom/yagpdb/v2/common/pubsub"
+	"github.com/ThatBathroom/yagpdb/v2/leveling/models"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/web"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"goji.io"
+	"goji.io/pat"
+)
+
+//go:embed assets/leveling_settings.html
+var PageHTMLSettings string
+
+//go:embed assets/leveling_leaderboard.html
+var PageHTMLLeaderboard string
+
+type PostConfigForm struct {
+	Enabled        bool
+	XPMin          int     `valid:"1,1000"`
+	XPMax          int     `valid:"1,1000"`
+	Cooldown       int     `valid:"0,86400"`
+	NoXPChannels   []int64 `valid:"channel,true"`
+	NoXPRoles      []int64 `valid:"role,true"`
+	StackRoles     bool
+	LevelUpEnabled bool
+	LevelUpChannel int64  `valid:"channel,true"`
+	LevelUpMessage string `valid:"template,2000"`
+}
+
+var _ web.CustomValidator = (*PostConfigForm)(nil)
+
+func (p *PostConfigForm) Validate(tmpl web.TemplateData, _ int64) bool {
+	if p.XPMin > p.XPMax {
+		tmpl.AddAlerts(web.ErrorAlert("The minimum xp per message can't be higher than the maximum"))
+		return false
+	}
+
+	return true
+}
+
+func (p *PostConfigForm) LevelingConfig() *models.LevelingConfig {
+	return &models.LevelingConfig{
+		Enabled:        p.Enabled,
+		XPMin:          p.XPMin,
+		XPMax:          p.XPMax,
+		Cooldown:       p.Cooldown,
+		NoXPChannels:   p.NoXPChannels,
+		NoXPRoles:      p.NoXPRoles,
+		StackRoles:     p.StackRoles,
+		LevelUpEnabled: p.LevelUpEnabled,
+		LevelUpChannel: p.LevelUpChannel,
+		LevelUpMessage: p.LevelUpMessage,
+	}
+}
+
+type NewRoleForm struct {
+	Level int   `valid:"1,10000"`
+	Role  int64 `valid:"role,false"`
+}
+
+type PostRoleForm struct {
+	Role int64 `valid:"role,false"`
+}
+
+type SetChannelMultiplierForm struct {
+	Channel    int64 `valid:"channel,false"`
+	Multiplier int64 `valid:"2,10"`
+}
+
+type SetRoleMultiplierForm struct {
+	Role       int64 `valid:"role,false"`
+	Multiplier int64 `valid:"2,10"`
+}
+
+var (
+	panelLogKeyUpdatedSettings = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "leveling_settings_updated", FormatString: "Updated leveling settings"})
+	panelLogKeyReset           = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "leveling_reset", FormatString: "Reset all levels"})
+	panelLogKeyNewLevelRole    = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "leveling_role_added", FormatString: "Level role created"})
+	panelLogKeyUpdateLevelRole = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "leveling_role_updated", FormatString: "Level role updated"})
+	panelLogKeyDeleteLevelRole = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "leveling_role_deleted", FormatString: "Level role deleted"})
+	panelLogKeySetMultiplier   = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "leveling_multiplier_set", FormatString: "Set xp multiplier"})
+	panelLogKeyDelMultiplier   = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "leveling_multiplier_deleted", FormatString: "Removed xp multiplier"})
+)
+
+func (p *Plugin) InitWeb() {
+	web.AddHTMLTemplate("leveling/assets/leveling_settings.html", PageHTMLSettings)
+	web.AddHTMLTemplate("leveling/assets/leveling_leaderboard.html", PageHTMLLeaderboard)
+	web.AddSidebarItem(web.SidebarCategoryFun, &web.SidebarItem{
+		Name: "Leveling",
+		URL:  "leveling",
+		Icon: "fas fa-level-up-alt",
+	})
+
+	subMux := goji.SubMux()
+
+	web.CPMux.Handle(pat.New("/leveling"), subMux)
+	web.CPMux.Handle(pat.New("/leveling/*"), subMux)
+
+	subMux.Use(web.RequireBotMemberMW)
+
+	mainGetHandler := web.RenderHandler(HandleGetLeveling, "cp_leveling_settings")
+
+	subMux.Handle(pat.Get(""), mainGetHandler)
+	subMux.Handle(pat.Get("/"), mainGetHandler)
+	subMux.Handle(pat.Post(""), web.ControllerPostHandler(HandlePostLeveling, mainGetHandler, PostConfigForm{}))
+	subMux.Handle(pat.Post("/"), web.ControllerPostHandler(HandlePostLeveling, mainGetHandler, PostConfigForm{}))
+	subMux.Handle(pat.Post("/reset"), web.ControllerPostHandler(HandleReset, mainGetHandler, nil))
+
+	subMux.Handle(pat.Post("/new_role"), web.ControllerPostHandler(HandleNewLevelRole, mainGetHandler, NewRoleForm{}))
+	subMux.Handle(pat.Post("/roles/:id/update"), web.ControllerPostHandler(HandleUpdateLevelRole, mainGetHandler, PostRoleForm{}))
+	subMux.Handle(pat.Post("/roles/:id/delete"), web.ControllerPostHandler(HandleDeleteLevelRole, mainGetHandler, nil))
+
+	subMux.Handle(pat.Post("/multipliers/channels/set"), web.ControllerPostHandler(HandleSetChannelMultiplier, mainGetHandler, SetChannelMultiplierForm{}))
+	subMux.Handle(pat.Post("/multipliers/channels/:id/delete"), web.ControllerPostHandler(HandleDeleteChannelMultiplier, mainGetHandler, nil))
+	subMux.Handle(pat.Post("/multipliers/roles/set"), web.ControllerPostHandler(HandleSetRoleMultiplier, mainGetHandler, SetRoleMultiplierForm{}))
+	subMux.Handle(pat.Post("/multipliers/roles/:id/delete"), web.ControllerPostHandler(HandleDeleteRoleMultiplier, mainGetHandler, nil))
+
+	web.ServerPublicMux.Handle(pat.Get("/leveling/leaderboard"), web.RenderHandler(HandleGetLeveling, "cp_leveling_leaderboard"))
+	web.ServerPublicAPIMux.Handle(pat.Get("/leveling/leaderboard"), web.APIHandler(HandleLeaderboardJson))
+}
+
+func HandleGetLeveling(w http.ResponseWriter, r *http.Request) interface{} {
+	activeGuild, templateData := web.GetBaseCPContextData(r.Context())
+
+	if _, ok := templateData["LevelingSettings"]; !ok {
+		settings, err := GetConfig(r.Context(), activeGuild.ID)
+		if !web.CheckErr(templateData, err, "Failed retrieving settings", web.CtxLogger(r.Context()).Error) {
+			templateData["LevelingSettings"] = settings
+		}
+	}
+
+	if settings, ok := templateData["LevelingSettings"].(*models.LevelingConfig); ok {
+		templateData["ChannelMultipliers"] = Multipliers(settings.MultiplierChannels, settings.MultiplierChannelValues)
+		templateData["RoleMultipliers"] = Multipliers(settings.MultiplierRoles, settings.MultiplierRoleValues)
+	}
+
+	levelRoles, err := models.LevelingRoles(
+		models.LevelingRoleWhere.GuildID.EQ(activeGuild.ID),
+		qm.OrderBy("level ASC"),
+	).AllG(r.Context())
+	if !web.CheckErr(templateData, err, "Failed retrieving level roles", web.CtxLogger(r.Context()).Error) {
+		templateData["LevelRoles"] = levelRoles
+	}
+
+	return templateData
+}
+
+func HandlePostLeveling(w http.ResponseWriter, r *http.Request) (templateData web.TemplateData, err error) {
+	activeGuild, templateData := web.GetBaseCPContextData(r.Context())
+	templateData["VisibleURL"] = "/manage/" + discordgo.StrID(activeGuild.ID) + "/leveling"
+
+	form := r.Context().Value(common.ContextKeyParsedForm).(*PostConfigForm)
+	conf := form.LevelingConfig()
+	conf.GuildID = activeGuild.ID
+
+	err = conf.UpsertG(r.Context(), true, []string{"guild_id"}, boil.Whitelist(
+		"enabled",
+		"xp_min",
+		"xp_max",
+		"cooldown",
+		"no_xp_channels",
+		"no_xp_roles",
+		"stack_roles",
+		"level_up_enabled",
+		"level_up_channel",
+		"level_up_message",
+	), boil.Infer())
+	if err != nil {
+		return templateData, err
+	}
+
+	featureflags.MarkGuildDirty(activeGuild.ID)
+	go pubsub.Publish("leveling_config_changed", activeGuild.ID, nil)
+	go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeyUpdatedSettings))
+	return templateData, nil
+}
+
+func HandleReset(w http.ResponseWriter, r *http.Request) (templateData web.TemplateData, err error) {
+	activeGuild, templateData := web.GetBaseCPContextData(r.Context())
+	templateData["VisibleURL"] = "/manage/" + discordgo.StrID(activeGuild.ID) + "/leveling"
+
+	err = ResetGuild(r.Context(), activeGuild.ID)
+	if err == nil {
+		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeyReset))
+	}
+	return templateData, err
+}
+
+func HandleNewLevelRole(w http.ResponseWriter, r *http.Request) (templateData web.TemplateData, err error) {
+	activeGuild, templateData := web.GetBaseCPContextData(r.Context())
+	templateData["VisibleURL"] = "/manage/" + discordgo.StrID(activeGuild.ID) + "/leveling"
+
+	form := r.Context().Value(common.ContextKeyParsedForm).(*NewRoleForm)
+
+	existing, err := models.LevelingRoles(models.LevelingRoleWhere.GuildID.EQ(activeGuild.ID)).AllG(r.Context())
+	if err != nil {
+		return templateData, err
+	}
+
+	if lim := GuildMaxLevelRoles(activeGuild.ID); len(existing) >= lim {
+		return templateData.AddAlerts(web.ErrorAlert("Too many level roles (max ", lim, ")")), nil
+	}
+	for _, v := range existing {
+		if v.Level == form.Level {
+			return templateData.AddAlerts(web.ErrorAlert("There's already a level role for that level")), nil
+		}
+	}
+
+	levelRole := &models.LevelingRole{
+		GuildID: activeGuild.ID,
+		Level:   form.Level,
+		Role:    form.Role,
+	}
+	err = levelRole.InsertG(r.Context(), boil.Infer())
+	if err == nil {
+		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeyNewLevelRole))
+	}
+	return templateData, err
+}
+
+func HandleUpdateLevelRole(w http.ResponseWriter, r *http.Request) (templateData web.TemplateData, err error) {
+	activeGuild, templateData := web.GetBaseCPContextData(r.Context())
+	templateData["VisibleURL"] = "/manage/" + discordgo.StrID(activeGuild.ID) + "/leveling"
+
+	form := r.Context().Value(common.ContextKeyParsedForm).(*PostRoleForm)
+
+	id, err := strconv.ParseInt(pat.Param(r, "id"), 10, 64)
+	if err != nil {
+		return templateData.AddAlerts(web.ErrorAlert("Invalid level role")), nil
+	}
+
+	rowsAff, err := models.LevelingRoles(
+		models.LevelingRoleWhere.GuildID.EQ(activeGuild.ID),
+		models.LevelingRoleWhere.ID.EQ(id),
+	).UpdateAllG(r.Context(), models.M{"role": form.Role})
+	if err != nil {
+		return templateData, err
+	}
+
+	if rowsAff > 0 {
+		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeyUpdateLevelRole))
+	}
+	return templateData, nil
+}
+
+func HandleDeleteLevelRole(w http.ResponseWriter, r *http.Request) (templateData web.TemplateData, err error) {
+	activeGuild, templateData := web.GetBaseCPContextData(r.Context())
+	templateData["VisibleURL"] = "/manage/" + discordgo.StrID(activeGuild.ID) + "/leveling"
+
+	id, err := strconv.ParseInt(pat.Param(r, "id"), 10, 64)
+	if err != nil {
+		return templateData.AddAlerts(web.ErrorAlert("Invalid level role")), nil
+	}
+
+	rowsAff, err := models.LevelingRoles(
+		models.LevelingRoleWhere.GuildID.EQ(activeGuild.ID),
+		models.LevelingRoleWhere.ID.EQ(id),
+	).DeleteAllG(r.Context())
+	if err != nil {
+		return templateData, err
+	}
+
+	if rowsAff > 0 {
+		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeyDeleteLevelRole))
+	}
+	return templateData, nil
+}
+
+func HandleSetChannelMultiplier(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+	form := r.Context().Value(common.ContextKeyParsedForm).(*SetChannelMultiplierForm)
+	return updateMultipliers(r, func(conf *models.LevelingConfig) string {
+		if !common.ContainsInt64Slice(conf.MultiplierChannels, form.Channel) && len(conf.MultiplierChannels) >= MaxMultipliers {
+			return fmt.Sprintf("Too many channel multipliers (max %d)", MaxMultipliers)
+		}
+
+		conf.MultiplierChannels, conf.MultiplierChannelValues = SetMultiplier(conf.MultiplierChannels, conf.MultiplierChannelValues, form.Channel, form.Multiplier)
+		return ""
+	}, panelLogKeySetMultiplier)
+}
+
+func HandleDeleteChannelMultiplier(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+	id, _ := strconv.ParseInt(pat.Param(r, "id"), 10, 64)
+	return updateMultipliers(r, func(conf *models.LevelingConfig) string {
+		conf.MultiplierChannels, conf.MultiplierChannelValues = RemoveMultiplier(conf.MultiplierChannels, conf.MultiplierChannelValues, id)
+		return ""
+	}, panelLogKeyDelMultiplier)
+}
+
+func HandleSetRoleMultiplier(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+	form := r.Context().Value(common.ContextKeyParsedForm).(*SetRoleMultiplierForm)
+	return updateMultipliers(r, func(conf *models.LevelingConfig) string {
+		if !common.ContainsInt64Slice(conf.MultiplierRoles, form.Role) && len(conf.MultiplierRoles) >= MaxMultipliers {
+			return fmt.Sprintf("Too many role multipliers (max %d)", MaxMultipliers)
+		}
+
+		conf.MultiplierRoles, conf.MultiplierRoleValues = SetMultiplier(conf.MultiplierRoles, conf.MultiplierRoleValues, form.Role, form.Multiplier)
+		return ""
+	}, panelLogKeySetMultiplier)
+}
+
+func HandleDeleteRoleMultiplier(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+	id, _ := strconv.ParseInt(pat.Param(r, "id"), 10, 64)
+	return updateMultipliers(r, func(conf *models.LevelingConfig) string {
+		conf.MultiplierRoles, conf.MultiplierRoleValues = RemoveMultiplier(conf.MultiplierRoles, conf.MultiplierRoleValues, id)
+		return ""
+	}, panelLogKeyDelMultiplier)
+}
+
+// updateMultipliers applies modify to the config and saves only the multiplier columns,
+// modify returns an error message to show the user if the change is not allowed
+func updateMultipliers(r *http.Request, modify func(conf *models.LevelingConfig) string, logKey string) (web.TemplateData, error) {
+	activeGuild, templateData := web.GetBaseCPContextData(r.Context())
+	templateData["VisibleURL"] = "/manage/" + discordgo.StrID(activeGuild.ID) + "/leveling"
+
+	conf, err := GetConfig(r.Context(), activeGuild.ID)
+	if err != nil {
+		return templateData, err
+	}
+
+	if msg := modify(conf); msg != "" {
+		return templateData.AddAlerts(web.ErrorAlert(msg)), nil
+	}
+
+	err = conf.UpsertG(r.Context(), true, []string{"guild_id"}, boil.Whitelist(
+		"multiplier_channels",
+		"multiplier_channel_values",
+		"multiplier_roles",
+		"multiplier_role_values",
+	), boil.Infer())
+	if err != nil {
+		return templateData, err
+	}
+
+	go pubsub.Publish("leveling_config_changed", activeGuild.ID, nil)
+	go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), logKey))
+	return templateData, nil
+}
+
+func HandleLeaderboardJson(w http.ResponseWriter, r *http.Request) interface{} {
+	activeGuild, _ := web.GetBaseCPContextData(r.Context())
+
+	conf, err := GetConfig(r.Context(), activeGuild.ID)
+	if err != nil {
+		return err
+	}
+
+	if !conf.Enabled {
+		return web.NewPublicError("Leveling not enabled")
+	}
+
+	query := r.URL.Query()
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	if offset < 0 {
+		offset = 0
+	}
+	if limit > 100 || limit < 1 {
+		limit = 10
+	}
+
+	top, err := TopUsers(activeGuild.ID, offset, limit)
+	if err != nil {
+		return err
+	}
+
+	entries, err := DetailedLeaderboardEntries(activeGuild.ID, top)
+	if err != nil {
+		return err
+	}
+
+	return entries
+}
+
+var _ web.PluginWithServerHomeWidget = (*Plugin)(nil)
+
+func (p *Plugin) LoadServerHomeWidget(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+	ag, templateData := web.GetBaseCPContextData(r.Context())
+
+	templateData["WidgetTitle"] = "Leveling"
+	templateData["SettingsPath"] = "/leveling"
+
+	settings, err := GetConfig(r.Context(), ag.ID)
+	if err != nil {
+		return templateData, err
+	}
+
+	numRoles, err := models.LevelingRoles(models.LevelingRoleWhere.GuildID.EQ(ag.ID)).CountG(r.Context())
+	if err != nil {
+		return templateData, err
+	}
+
+	const format = `<ul>
+	<li>Leveling is: %s</li>
+	<li>XP per message: <code>%d - %d</code></li>
+	<li>Level roles: <code>%d</code></li>
+</ul>`
+
+	if settings.Enabled {
+		templateData["WidgetEnabled"] = true
+	} else {
+		templateData["WidgetDisabled"] = true
+	}
+
+	templateData["WidgetBody"] = template.HTML(fmt.Sprintf(format, web.EnabledDisabledSpanStatus(settings.Enabled), settings.XPMin, settings.XPMax, numRoles))
+
+	return templateData, nil
+}
@@ -0,0 +1,53 @@
+package leveling
+
+var DBSchemas = []string{`
+CREATE TABLE IF NOT EXISTS leveling_configs (
+	guild_id BIGINT PRIMARY KEY,
+	enabled BOOLEAN NOT NULL DEFAULT false,
+
+	xp_min INT NOT NULL DEFAULT 15,
+	xp_max INT NOT NULL DEFAULT 25,
+	cooldown INT NOT NULL DEFAULT 60,
+
+	no_xp_channels BIGINT[],
+	no_xp_roles BIGINT[],
+
+	-- parallel arrays, multiplier_channel_values[i] is the multiplier of multiplier_channels[i]
+	multiplier_channels BIGINT[],
+	multiplier_channel_values BIGINT[],
+	multiplier_roles BIGINT[],
+	multiplier_role_values BIGINT[],
+
+	stack_roles BOOLEAN NOT NULL DEFAULT true,
+
+	level_up_enabled BOOLEAN NOT NULL DEFAULT true,
+	-- 0 for the channel the message was sent in
+	level_up_channel BIGINT NOT NULL DEFAULT 0,
+	level_up_message TEXT NOT NULL DEFAULT 'GG {{.User.Mention}}, you reached level **{{.Level}}**!'
+);
+`, `
+CREATE TABLE IF NOT EXISTS leveling_users (
+	guild_id BIGINT NOT NULL,
+	user_id BIGINT NOT NULL,
+
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+	xp BIGINT NOT NULL,
+	level INT NOT NULL DEFAULT 0,
+	messages BIGINT NOT NULL DEFAULT 0,
+
+	PRIMARY KEY(guild_id, user_id)
+);
+`, `
+CREATE INDEX IF NOT EXISTS leveling_users_guild_xp_idx ON leveling_users(guild_id, xp DESC);
+`, `
+CREATE TABLE IF NOT EXISTS leveling_roles (
+	id BIGSERIAL PRIMARY KEY,
+	guild_id BIGINT NOT NULL,
+	level INT NOT NULL,
+	role BIGINT NOT NULL
+);
+`, `
+CREATE INDEX IF NOT EXISTS leveling_roles_guild_idx ON leveling_roles(guild_id);
+`}
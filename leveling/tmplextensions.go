@@ -0,0 +1,105 @@
+package leveling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ThatBathroom/yagpdb/v2/bot"
+	"github.com/ThatBathroom/yagpdb/v2/common/templates"
+)
+
+func init() {
+	templates.RegisterSetupFunc(func(ctx *templates.Context) {
+		ctx.ContextFuncs["getLevel"] = tmplGetLevel(ctx)
+		ctx.ContextFuncs["giveXP"] = tmplGiveXP(ctx)
+		ctx.ContextFuncs["xpForLevel"] = tmplXPForLevel
+	})
+}
+
+const (
+	maxTemplateXPChange = 1000000
+	maxTemplateLevel    = 10000
+
+	// set in the data of level up messages, they run in a context of their own with fresh call counters so giveXP
+	// there could level someone up again and again
+	levelUpMessageDataKey = "__leveling_level_up"
+)
+
+// getLevel returns the level stats of the target user, users that never earned xp have everything at 0
+func tmplGetLevel(ctx *templates.Context) interface{} {
+	return func(target interface{}) (*UserStats, error) {
+		if ctx.IncreaseCheckCallCounterPremium("leveling", 5, 10) {
+			return nil, templates.ErrTooManyCalls
+		}
+
+		targetID := templates.TargetUserID(target)
+		if targetID == 0 {
+			return nil, fmt.Errorf("could not convert %T to a user ID", target)
+		}
+
+		stats, err := GetUserStats(context.Background(), ctx.GS.ID, targetID)
+		if err == ErrUserNotFound {
+			return &UserStats{UserID: targetID, NextLevelXP: TotalXPForLevel(1)}, nil
+		}
+
+		return stats, err
+	}
+}
+
+// giveXP gives the target user xp (or takes it away if negative) and returns their new total,
+// level roles are updated and level ups announced like they would be for messages
+func tmplGiveXP(ctx *templates.Context) interface{} {
+	return func(target interface{}, amountArg interface{}) (int64, error) {
+		if isLevelUp, _ := ctx.Data[levelUpMessageDataKey].(bool); isLevelUp {
+			return 0, errors.New("giveXP can't be used in level up messages")
+		}
+
+		amount := templates.ToInt64(amountArg)
+		if ctx.IncreaseCheckCallCounterPremium("leveling", 5, 10) {
+			return 0, templates.ErrTooManyCalls
+		}
+
+		if amount > maxTemplateXPChange || amount < -maxTemplateXPChange {
+			return 0, fmt.Errorf("amount has to be between -%d and %d", maxTemplateXPChange, maxTemplateXPChange)
+		}
+
+		targetID := templates.TargetUserID(target)
+		if targetID == 0 {
+			return 0, fmt.Errorf("could not convert %T to a user ID", target)
+		}
+
+		conf, err := BotCachedGetConfig(ctx.GS.ID)
+		if err != nil {
+			return 0, err
+		}
+
+		if !conf.Enabled {
+			return 0, errors.New("leveling is disabled on this server")
+		}
+
+		newXP, oldLevel, newLevel, err := AddXP(context.Background(), ctx.GS.ID, targetID, amount, 0)
+		if err != nil {
+			return 0, err
+		}
+
+		if oldLevel != newLevel {
+			ms, err := bot.GetMember(ctx.GS.ID, targetID)
+			if err == nil && ms.Member != nil {
+				onLevelChanged(ctx.GS, ctx.CurrentFrame.CS, ms, conf, oldLevel, newLevel)
+			}
+		}
+
+		return newXP, nil
+	}
+}
+
+// xpForLevel returns the total xp needed to reach the level
+func tmplXPForLevel(levelArg interface{}) (int64, error) {
+	level := int(templates.ToInt64(levelArg))
+	if level < 0 || level > maxTemplateLevel {
+		return 0, fmt.Errorf("level has to be between 0 and %d", maxTemplateLevel)
+	}
+
+	return TotalXPForLevel(level), nil
+}
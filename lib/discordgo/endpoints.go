@@ -95,25 +95,28 @@ var (
 	EndpointGuildIntegrationSync      = func(gID, iID int64) string {
 		return ""
 	}
-	EndpointGuildRoles          = func(gID int64) string { return "" }
-	EndpointGuildRole           = func(gID, rID int64) string { return "" }
-	EndpointGuildInvites        = func(gID int64) string { return "" }
-	EndpointGuildEmbed          = func(gID int64) string { return "" }
-	EndpointGuildPrune          = func(gID int64) string { return "" }
-	EndpointGuildIcon           = func(gID int64, hash string) string { return "" }
-	EndpointGuildIconAnimated   = func(gID int64, hash string) string { return "" }
-	EndpointGuildSplash         = func(gID int64, hash string) string { return "" }
-	EndpointGuildWebhooks       = func(gID int64) string { return "" }
-	EndpointGuildAuditLogs      = func(gID int64) string { return "" }
-	EndpointGuildEmojis         = func(gID int64) string { return "" }
-	EndpointGuildEmoji          = func(gID, eID int64) string { return "" }
-	EndpointGuildBanner         = func(gID int64, hash string) string { return "" }
-	EndpointGuildBannerAnimated = func(gID int64, hash string) string { return "" }
-	EndpointGuildThreads        = func(gID int64) string { return "" }
-	EndpointGuildActiveThreads  = func(gID int64) string { return "" }
-	EndpointGuildStickers       = func(gID int64) string { return "" }
-	EndpointGuildSticker        = func(gID, sID int64) string { return "" }
-	EndpointGuildTagBadge       = func(gID int64, bID string) string { return "" }
+	EndpointGuildRoles               = func(gID int64) string { return "" }
+	EndpointGuildRole                = func(gID, rID int64) string { return "" }
+	EndpointGuildInvites             = func(gID int64) string { return "" }
+	EndpointGuildEmbed               = func(gID int64) string { return "" }
+	EndpointGuildPrune               = func(gID int64) string { return "" }
+	EndpointGuildIcon                = func(gID int64, hash string) string { return "" }
+	EndpointGuildIconAnimated        = func(gID int64, hash string) string { return "" }
+	EndpointGuildSplash              = func(gID int64, hash string) string { return "" }
+	EndpointGuildWebhooks            = func(gID int64) string { return "" }
+	EndpointGuildAuditLogs           = func(gID int64) string { return "" }
+	EndpointGuildEmojis              = func(gID int64) string { return "" }
+	EndpointGuildEmoji               = func(gID, eID int64) string { return "" }
+	EndpointGuildBanner              = func(gID int64, hash string) string { return "" }
+	EndpointGuildBannerAnimated      = func(gID int64, hash string) string { return "" }
+	EndpointGuildThreads             = func(gID int64) string { return "" }
+	EndpointGuildActiveThreads       = func(gID int64) string { return "" }
+	EndpointGuildStickers            = func(gID int64) string { return "" }
+	EndpointGuildSticker             = func(gID, sID int64) string { return "" }
+	EndpointGuildTagBadge            = func(gID int64, bID string) string { return "" }
+	EndpointGuildScheduledEvents     = func(gID int64) string { return "" }
+	EndpointGuildScheduledEvent      = func(gID, eID int64) string { return "" }
+	EndpointGuildScheduledEventUsers = func(gID, eID int64) string { return "" }
 
 	EndpointChannel                             = func(cID int64) string { return "" }
 	EndpointChannelThreads                      = func(cID int64) string { return "" }
@@ -335,6 +338,9 @@ func CreateEndpoints(base string) {
 	EndpointGuildStickers = func(gID int64) string { return EndpointGuilds + StrID(gID) + "/stickers" }
 	EndpointGuildSticker = func(gID, sID int64) string { return EndpointGuilds + StrID(gID) + "/stickers/" + StrID(sID) }
 	EndpointGuildTagBadge = func(gID int64, bID string) string { return EndpointCDNGuildTagBadge + StrID(gID) + "/" + bID + ".png" }
+	EndpointGuildScheduledEvents = func(gID int64) string { return EndpointGuilds + StrID(gID) + "/scheduled-events" }
+	EndpointGuildScheduledEvent = func(gID, eID int64) string { return EndpointGuildScheduledEvents(gID) + "/" + StrID(eID) }
+	EndpointGuildScheduledEventUsers = func(gID, eID int64) string { return EndpointGuildScheduledEvent(gID, eID) + "/users" }
 
 	EndpointChannel = func(cID int64) string { return EndpointChannels + StrID(cID) }
 	EndpointChannelThreads = func(cID int64) string { return EndpointChannel(cID) + "/threads" }
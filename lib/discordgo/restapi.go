@@ -1513,6 +1513,81 @@ func (s *Session) GuildEmojiDelete(guildID, emojiID int64) (err error) {
 	return
 }
 
+// GuildScheduledEvents returns the scheduled events in a guild
+// guildID : The ID of a Guild.
+func (s *Session) GuildScheduledEvents(guildID int64) (st []*GuildScheduledEvent, err error) {
+	body, err := s.RequestWithBucketID("GET", EndpointGuildScheduledEvents(guildID), nil, nil, EndpointGuildScheduledEvents(guildID))
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// GuildScheduledEventCreate creates a scheduled event in a guild
+// guildID : The ID of a Guild.
+// params  : The event to create, external events require a location and an end time.
+func (s *Session) GuildScheduledEventCreate(guildID int64, params *GuildScheduledEventParams) (st *GuildScheduledEvent, err error) {
+	body, err := s.RequestWithBucketID("POST", EndpointGuildScheduledEvents(guildID), params, nil, EndpointGuildScheduledEvents(guildID))
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// GuildScheduledEventEdit modifies a scheduled event
+// guildID : The ID of a Guild.
+// eventID : The ID of the scheduled event.
+// params  : The fields to change.
+func (s *Session) GuildScheduledEventEdit(guildID, eventID int64, params *GuildScheduledEventParams) (st *GuildScheduledEvent, err error) {
+	body, err := s.RequestWithBucketID("PATCH", EndpointGuildScheduledEvent(guildID, eventID), params, nil, EndpointGuildScheduledEvents(guildID))
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
+// GuildScheduledEventDelete deletes a scheduled event
+// guildID : The ID of a Guild.
+// eventID : The ID of the scheduled event.
+func (s *Session) GuildScheduledEventDelete(guildID, eventID int64) (err error) {
+	_, err = s.RequestWithBucketID("DELETE", EndpointGuildScheduledEvent(guildID, eventID), nil, nil, EndpointGuildScheduledEvents(guildID))
+	return
+}
+
+// GuildScheduledEventUsers returns the users interested in a scheduled event
+// guildID : The ID of a Guild.
+// eventID : The ID of the scheduled event.
+// limit   : Max number of users to return, max 100.
+// afterID : If provided, only users with an ID higher than this are returned.
+func (s *Session) GuildScheduledEventUsers(guildID, eventID int64, limit int, afterID int64) (st []*GuildScheduledEventUser, err error) {
+	uri := EndpointGuildScheduledEventUsers(guildID, eventID)
+
+	v := url.Values{}
+	if limit > 0 {
+		v.Set("limit", strconv.Itoa(limit))
+	}
+	if afterID != 0 {
+		v.Set("after", StrID(afterID))
+	}
+	if len(v) > 0 {
+		uri = fmt.Sprintf("%s?%s", uri, v.Encode())
+	}
+
+	body, err := s.RequestWithBucketID("GET", uri, nil, nil, EndpointGuildScheduledEventUsers(guildID, 0))
+	if err != nil {
+		return
+	}
+
+	err = unmarshal(body, &st)
+	return
+}
+
 // ------------------------------------------------------------------------------------------------
 // Functions specific to Discord Channels
 // ------------------------------------------------------------------------------------------------
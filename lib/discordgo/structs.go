@@ -500,6 +500,76 @@ type StickerPack struct {
 	BannerAssetID  int64     `json:"banner_asset_id,string"`
 }
 
+// GuildScheduledEventPrivacyLevel is the privacy level of a scheduled event
+type GuildScheduledEventPrivacyLevel int
+
+const (
+	// GuildScheduledEventPrivacyLevelGuildOnly makes the scheduled event only accessible to guild members
+	GuildScheduledEventPrivacyLevelGuildOnly GuildScheduledEventPrivacyLevel = 2
+)
+
+// GuildScheduledEventStatus is the status of a scheduled event
+type GuildScheduledEventStatus int
+
+const (
+	GuildScheduledEventStatusScheduled GuildScheduledEventStatus = 1
+	GuildScheduledEventStatusActive    GuildScheduledEventStatus = 2
+	GuildScheduledEventStatusCompleted GuildScheduledEventStatus = 3
+	GuildScheduledEventStatusCanceled  GuildScheduledEventStatus = 4
+)
+
+// GuildScheduledEventEntityType is the type of the entity a scheduled event is hosted in
+type GuildScheduledEventEntityType int
+
+const (
+	GuildScheduledEventEntityTypeStageInstance GuildScheduledEventEntityType = 1
+	GuildScheduledEventEntityTypeVoice         GuildScheduledEventEntityType = 2
+	GuildScheduledEventEntityTypeExternal      GuildScheduledEventEntityType = 3
+)
+
+// GuildScheduledEventEntityMetadata holds additional data for scheduled events, location is required for external events
+type GuildScheduledEventEntityMetadata struct {
+	Location string `json:"location,omitempty"`
+}
+
+// GuildScheduledEvent represents a native scheduled event in a guild
+type GuildScheduledEvent struct {
+	ID                 int64                             `json:"id,string"`
+	GuildID            int64                             `json:"guild_id,string"`
+	ChannelID          int64                             `json:"channel_id,string"`
+	CreatorID          int64                             `json:"creator_id,string"`
+	Name               string                            `json:"name"`
+	Description        string                            `json:"description"`
+	ScheduledStartTime time.Time                         `json:"scheduled_start_time"`
+	ScheduledEndTime   *time.Time                        `json:"scheduled_end_time"`
+	PrivacyLevel       GuildScheduledEventPrivacyLevel   `json:"privacy_level"`
+	Status             GuildScheduledEventStatus         `json:"status"`
+	EntityType         GuildScheduledEventEntityType     `json:"entity_type"`
+	EntityMetadata     GuildScheduledEventEntityMetadata `json:"entity_metadata"`
+	Creator            *User                             `json:"creator"`
+	UserCount          int                               `json:"user_count"`
+}
+
+// GuildScheduledEventParams are the fields used to create or edit a scheduled event, only non-nil fields are sent when editing
+type GuildScheduledEventParams struct {
+	ChannelID          int64                              `json:"channel_id,string,omitempty"`
+	Name               string                             `json:"name,omitempty"`
+	Description        string                             `json:"description,omitempty"`
+	ScheduledStartTime *time.Time                         `json:"scheduled_start_time,omitempty"`
+	ScheduledEndTime   *time.Time                         `json:"scheduled_end_time,omitempty"`
+	PrivacyLevel       GuildScheduledEventPrivacyLevel    `json:"privacy_level,omitempty"`
+	Status             GuildScheduledEventStatus          `json:"status,omitempty"`
+	EntityType         GuildScheduledEventEntityType      `json:"entity_type,omitempty"`
+	EntityMetadata     *GuildScheduledEventEntityMetadata `json:"entity_metadata,omitempty"`
+}
+
+// GuildScheduledEventUser is a user that's interested in a scheduled event
+type GuildScheduledEventUser struct {
+	GuildScheduledEventID int64   `json:"guild_scheduled_event_id,string"`
+	User                  *User   `json:"user"`
+	Member                *Member `json:"member"`
+}
+
 // VerificationLevel type definition
 type VerificationLevel int
 
@@ -1521,6 +1591,8 @@ const (
 	ErrCodeUnknownEmoji       = 10014
 	ErrCodeUnknownWebhook     = 10015
 
+	ErrCodeUnknownGuildScheduledEvent = 10070
+
 	ErrCodeBotsCannotUseEndpoint  = 20001
 	ErrCodeOnlyBotsCanUseEndpoint = 20002
 
@@ -0,0 +1,384 @@
+package notifications
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"emperror.dev/errors"
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// CardLayout controls where the avatar and text are placed on welcome/leave cards
+type CardLayout int16
+
+const (
+	CardLayoutAvatarLeft   CardLayout = 0
+	CardLayoutAvatarCenter CardLayout = 1
+)
+
+const (
+	DefaultJoinCardTitle     = "Welcome {username}!"
+	DefaultJoinCardSubtitle  = "You are member #{count} of {server}"
+	DefaultLeaveCardTitle    = "Goodbye {username}"
+	DefaultLeaveCardSubtitle = "{server} now has {count} members"
+
+	DefaultCardBackgroundColor = "#23272a"
+	DefaultCardTextColor       = "#ffffff"
+
+	CardWidth  = 1000
+	CardHeight = 360
+
+	// Max size of a downloaded background or avatar image
+	maxCardImageBytes = 8 << 20
+	// Max dimensions of a downloaded image, checked before decoding it
+	maxCardImageSide   = 4096
+	maxCardImagePixels = 4096 * 4096
+)
+
+// CardOptions holds everything needed to render a card
+type CardOptions struct {
+	Layout CardLayout
+
+	Title    string
+	Subtitle string
+
+	BackgroundColor color.Color
+	TextColor       color.Color
+
+	// Optional, drawn over the background color scaled to fill the card
+	Background image.Image
+	// Optional, drawn as a circle
+	Avatar image.Image
+}
+
+// FormatCardText replaces the placeholders available in card titles and subtitles
+func FormatCardText(text, username, server string, memberCount int64) string {
+	r := strings.NewReplacer(
+		"{username}", username,
+		"{server}", server,
+		"{count}", strconv.FormatInt(memberCount, 10),
+	)
+
+	return r.Replace(text)
+}
+
+// parseHexColor parses a color in the #rrggbb format
+func parseHexColor(s string) (color.RGBA, bool) {
+	if len(s) != 7 || s[0] != '#' {
+		return color.RGBA{}, false
+	}
+
+	v, err := strconv.ParseUint(s[1:], 16, 32)
+	if err != nil {
+		return color.RGBA{}, false
+	}
+
+	return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xff}, true
+}
+
+var (
+	cardFontsOnce sync.Once
+	cardFontBold  *opentype.Font
+	cardFontReg   *opentype.Font
+	cardFontsErr  error
+)
+
+func loadCardFonts() error {
+	cardFontsOnce.Do(func() {
+		cardFontBold, cardFontsErr = opentype.Parse(gobold.TTF)
+		if cardFontsErr != nil {
+			return
+		}
+
+		cardFontReg, cardFontsErr = opentype.Parse(goregular.TTF)
+	})
+
+	return cardFontsErr
+}
+
+// RenderCard renders a card and returns it PNG encoded
+func RenderCard(opts *CardOptions) ([]byte, error) {
+	err := loadCardFonts()
+	if err != nil {
+		return nil, errors.WithMessage(err, "loadCardFonts")
+	}
+
+	canvas := image.NewRGBA(image.Rect(0, 0, CardWidth, CardHeight))
+	draw.Draw(canvas, canvas.Bounds(), image.NewUniform(opts.BackgroundColor), image.Point{}, draw.Src)
+
+	if opts.Background != nil {
+		drawCover(canvas, opts.Background)
+
+		// darken the image a bit so the text stays readable
+		draw.Draw(canvas, canvas.Bounds(), image.NewUniform(color.RGBA{A: 0x64}), image.Point{}, draw.Over)
+	}
+
+	var avatarRect image.Rectangle
+	var textX, titleY, subtitleY, textWidth int
+	centered := opts.Layout == CardLayoutAvatarCenter
+
+	if centered {
+		const size = 170
+		avatarRect = image.Rect((CardWidth-size)/2, 30, (CardWidth+size)/2, 30+size)
+		textX, textWidth = 40, CardWidth-80
+		titleY, subtitleY = 265, 320
+	} else {
+		const size = 220
+		avatarRect = image.Rect(60, (CardHeight-size)/2, 60+size, (CardHeight+size)/2)
+		textX = avatarRect.Max.X + 50
+		textWidth = CardWidth - textX - 40
+		titleY, subtitleY = CardHeight/2-5, CardHeight/2+55
+	}
+
+	if opts.Avatar != nil {
+		// border around the avatar, filled so transparent avatars don't show the border color through
+		drawCircle(canvas, avatarRect.Inset(-6), image.NewUniform(opts.TextColor))
+		drawCircle(canvas, avatarRect, image.NewUniform(opts.BackgroundColor))
+		drawCircle(canvas, avatarRect, scaleImage(opts.Avatar, avatarRect.Dx(), avatarRect.Dy()))
+	}
+
+	err = drawCardText(canvas, cardFontBold, opts.Title, 56, textX, titleY, textWidth, centered, opts.TextColor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = drawCardText(canvas, cardFontReg, opts.Subtitle, 36, textX, subtitleY, textWidth, centered, opts.TextColor)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = png.Encode(&buf, canvas)
+	return buf.Bytes(), err
+}
+
+// drawCardText draws a single line of text with the baseline at y, shrinking the font until the text fits in maxWidth
+func drawCardText(dst draw.Image, f *opentype.Font, text string, size float64, x, y, maxWidth int, centered bool, c color.Color) error {
+	if text == "" {
+		return nil
+	}
+
+	const minSize = 20
+
+	var face font.Face
+	var width fixed.Int26_6
+	for {
+		var err error
+		face, err = opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+		if err != nil {
+			return errors.WithMessage(err, "opentype.NewFace")
+		}
+
+		width = font.MeasureString(face, text)
+		if width.Ceil() <= maxWidth || size <= minSize {
+			break
+		}
+
+		face.Close()
+		size -= 4
+	}
+	defer face.Close()
+
+	// still too long at the smallest size, cut it off
+	if width.Ceil() > maxWidth {
+		runes := []rune(text)
+		for len(runes) > 0 && font.MeasureString(face, string(runes)+"...").Ceil() > maxWidth {
+			runes = runes[:len(runes)-1]
+		}
+		text = string(runes) + "..."
+		width = font.MeasureString(face, text)
+	}
+
+	if centered {
+		x += (maxWidth - width.Ceil()) / 2
+	}
+
+	d := &font.Drawer{
+		Dst:  dst,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(x, y),
+	}
+	d.DrawString(text)
+	return nil
+}
+
+// drawCover scales src to fill all of dst while keeping the aspect ratio, cropping what doesn't fit
+func drawCover(dst draw.Image, src image.Image) {
+	db, sb := dst.Bounds(), src.Bounds()
+	if sb.Empty() {
+		return
+	}
+
+	// crop the source to the aspect ratio of the destination
+	crop := sb
+	if sb.Dx()*db.Dy() > sb.Dy()*db.Dx() {
+		w := sb.Dy() * db.Dx() / db.Dy()
+		crop.Min.X += (sb.Dx() - w) / 2
+		crop.Max.X = crop.Min.X + w
+	} else {
+		h := sb.Dx() * db.Dy() / db.Dx()
+		crop.Min.Y += (sb.Dy() - h) / 2
+		crop.Max.Y = crop.Min.Y + h
+	}
+
+	xdraw.CatmullRom.Scale(dst, db, src, crop, xdraw.Over, nil)
+}
+
+func scaleImage(src image.Image, w, h int) image.Image {
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	drawCover(dst, src)
+	return dst
+}
+
+// drawCircle draws src clipped to the largest circle that fits in r
+func drawCircle(dst draw.Image, r image.Rectangle, src image.Image) {
+	draw.DrawMask(dst, r, src, image.Point{}, &circleMask{r: r}, r.Min, draw.Over)
+}
+
+type circleMask struct {
+	r image.Rectangle
+}
+
+func (c *circleMask) ColorModel() color.Model {
+	return color.AlphaModel
+}
+
+func (c *circleMask) Bounds() image.Rectangle {
+	return c.r
+}
+
+func (c *circleMask) At(x, y int) color.Color {
+	radius := float64(c.r.Dx()) / 2
+	dx := float64(x-c.r.Min.X) + 0.5 - radius
+	dy := float64(y-c.r.Min.Y) + 0.5 - float64(c.r.Dy())/2
+
+	// one pixel of anti aliasing at the edge
+	dist := radius - math.Sqrt(dx*dx+dy*dy)
+	switch {
+	case dist >= 1:
+		return color.Alpha{A: 0xff}
+	case dist <= 0:
+		return color.Alpha{}
+	default:
+		return color.Alpha{A: uint8(dist * 0xff)}
+	}
+}
+
+var cardHTTPClient = &http.Client{
+	Timeout: 10 * time.Second,
+	Transport: &http.Transport{
+		// no proxy from the environment, the dialer has to see the real address
+		Proxy: nil,
+		DialContext: (&net.Dialer{
+			Timeout: 5 * time.Second,
+			Control: cardDialControl,
+		}).DialContext,
+		TLSHandshakeTimeout:   5 * time.Second,
+		ResponseHeaderTimeout: 5 * time.Second,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       30 * time.Second,
+	},
+}
+
+// cardDialControl stops the background image download from reaching internal services, it's checked on the
+// resolved address so it also covers DNS names and redirects pointing at them
+func cardDialControl(network, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || isBlockedCardIP(ip) {
+		return errors.Errorf("connecting to %s is not allowed", host)
+	}
+
+	return nil
+}
+
+// carrier grade nat range, not covered by net.IP.IsPrivate
+var cgnatNet = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// isBlockedCardIP returns true for addresses that aren't on the public internet
+func isBlockedCardIP(ip net.IP) bool {
+	return ip.IsPrivate() || ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() || cgnatNet.Contains(ip)
+}
+
+// validCardImageURL returns true if the url is a http(s) link that doesn't point at an internal address, names are
+// only resolved when downloading it
+func validCardImageURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return false
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || strings.HasSuffix(host, ".internal") {
+		return false
+	}
+
+	if ip := net.ParseIP(host); ip != nil && isBlockedCardIP(ip) {
+		return false
+	}
+
+	return true
+}
+
+// fetchCardImage downloads and decodes a png, jpeg or gif image
+func fetchCardImage(imageURL string) (image.Image, error) {
+	resp, err := cardHTTPClient.Get(imageURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return decodeCardImage(io.LimitReader(resp.Body, maxCardImageBytes))
+}
+
+// decodeCardImage decodes the image, refusing ones with too big dimensions
+func decodeCardImage(r io.Reader) (image.Image, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// a small compressed file can still decode into a huge image, so check the dimensions before decoding it
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width > maxCardImageSide || cfg.Height > maxCardImageSide ||
+		cfg.Width*cfg.Height > maxCardImagePixels {
+		return nil, errors.Errorf("image too big (%dx%d)", cfg.Width, cfg.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}
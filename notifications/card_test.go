@@ -0,0 +1,135 @@
+package notifications
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFormatCardText(t *testing.T) {
+	cases := []struct {
+		in, want string
+	}{
+		{DefaultJoinCardTitle, "Welcome bob!"},
+		{DefaultJoinCardSubtitle, "You are member #42 of Test Server"},
+		{"{username} {username}", "bob bob"},
+		{"no placeholders", "no placeholders"},
+		{"{unknown}", "{unknown}"},
+	}
+
+	for _, c := range cases {
+		if got := FormatCardText(c.in, "bob", "Test Server", 42); got != c.want {
+			t.Errorf("FormatCardText(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestParseHexColor(t *testing.T) {
+	valid := map[string]color.RGBA{
+		"#000000": {A: 0xff},
+		"#ffffff": {R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+		"#23272A": {R: 0x23, G: 0x27, B: 0x2a, A: 0xff},
+	}
+
+	for in, want := range valid {
+		got, ok := parseHexColor(in)
+		if !ok || got != want {
+			t.Errorf("parseHexColor(%q) = %v, %t, want %v", in, got, ok, want)
+		}
+	}
+
+	for _, in := range []string{"", "#fff", "23272a", "#23272az", "#gggggg", "#23272a0"} {
+		if _, ok := parseHexColor(in); ok {
+			t.Errorf("parseHexColor(%q): expected it to be invalid", in)
+		}
+	}
+}
+
+func TestRenderCard(t *testing.T) {
+	bg, _ := parseHexColor(DefaultCardBackgroundColor)
+	fg, _ := parseHexColor(DefaultCardTextColor)
+	background := image.NewRGBA(image.Rect(0, 0, 200, 100))
+
+	for _, layout := range []CardLayout{CardLayoutAvatarLeft, CardLayoutAvatarCenter} {
+		out, err := RenderCard(&CardOptions{
+			Layout:          layout,
+			Title:           "Welcome someone with a really long name that has to be shrunk to fit!",
+			Subtitle:        "You are member #1",
+			BackgroundColor: bg,
+			TextColor:       fg,
+			Avatar:          image.NewRGBA(image.Rect(0, 0, 64, 64)),
+			Background:      background,
+		})
+		if err != nil {
+			t.Fatalf("layout %d: %v", layout, err)
+		}
+
+		img, err := png.Decode(bytes.NewReader(out))
+		if err != nil {
+			t.Fatalf("layout %d: output isn't a png: %v", layout, err)
+		}
+
+		if b := img.Bounds(); b.Dx() != CardWidth || b.Dy() != CardHeight {
+			t.Errorf("layout %d: got size %dx%d", layout, b.Dx(), b.Dy())
+		}
+	}
+}
+
+func TestDecodeCardImage(t *testing.T) {
+	encode := func(w, h int) *bytes.Reader {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, w, h))); err != nil {
+			t.Fatal(err)
+		}
+		return bytes.NewReader(buf.Bytes())
+	}
+
+	if _, err := decodeCardImage(encode(100, 50)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if _, err := decodeCardImage(encode(maxCardImageSide+1, 1)); err == nil {
+		t.Error("expected an image over the max dimensions to be refused")
+	}
+}
+
+func TestValidCardImageURL(t *testing.T) {
+	valid := []string{"https://cdn.discordapp.com/a.png", "http://example.com/b.jpg", "https://1.1.1.1/c.png"}
+	for _, v := range valid {
+		if !validCardImageURL(v) {
+			t.Errorf("validCardImageURL(%q): expected it to be valid", v)
+		}
+	}
+
+	invalid := []string{
+		"ftp://example.com/a.png", "example.com/a.png", "https://",
+		"http://localhost/a.png", "http://127.0.0.1:8080/a.png", "http://10.0.0.5/a.png",
+		"http://192.168.1.1/a.png", "http://169.254.169.254/latest/meta-data", "http://[::1]/a.png",
+		"http://100.64.0.1/a.png", "http://0.0.0.0/a.png",
+	}
+	for _, v := range invalid {
+		if validCardImageURL(v) {
+			t.Errorf("validCardImageURL(%q): expected it to be invalid", v)
+		}
+	}
+}
+
+func TestFetchCardImageBlocksInternal(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		png.Encode(w, image.NewGray(image.Rect(0, 0, 1, 1)))
+	}))
+	defer srv.Close()
+
+	if _, err := fetchCardImage(srv.URL); err == nil {
+		t.Error("expected downloading from a loopback address to fail")
+	}
+
+	if !isBlockedCardIP(net.ParseIP("fe80::1")) || isBlockedCardIP(net.ParseIP("8.8.8.8")) {
+		t.Error("isBlockedCardIP classified an address wrong")
+	}
+}
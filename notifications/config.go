@@ -34,6 +34,20 @@ func FetchConfig(guildID int64) (*Config, error) {
 		return &Config{
 			JoinServerMsgs: []string{"<@{{.User.ID}}> Joined!"},
 			LeaveMsgs:      []string{"**{{.User.Username}}** Left... :'("},
+
+			JoinCardTitle:     DefaultJoinCardTitle,
+			JoinCardSubtitle:  DefaultJoinCardSubtitle,
+			LeaveCardTitle:    DefaultLeaveCardTitle,
+			LeaveCardSubtitle: DefaultLeaveCardSubtitle,
+
+			CardBackgroundColor: DefaultCardBackgroundColor,
+			CardTextColor:       DefaultCardTextColor,
+
+			BoostMsg:          "{{.User.Mention}} just boosted the server!",
+			BoostEndMsg:       "**{{.User.Username}}** is no longer boosting the server.",
+			MilestoneMsg:      "We just reached **{{.MemberCount}}** members!",
+			MilestoneInterval: 100,
+			AnniversaryMsg:    "{{.User.Mention}} joined the server {{.Years}} year(s) ago today!",
 		}, nil
 	}
 
@@ -66,6 +80,36 @@ type Config struct {
 	TopicChannel int64 `json:"topic_channel" schema:"topic_channel" valid:"channel,true"`
 
 	CensorInvites bool `schema:"censor_invites"`
+
+	JoinCardEnabled  bool   `json:"join_card_enabled" schema:"join_card_enabled"`
+	JoinCardTitle    string `json:"join_card_title" schema:"join_card_title" valid:",100"`
+	JoinCardSubtitle string `json:"join_card_subtitle" schema:"join_card_subtitle" valid:",100"`
+
+	LeaveCardEnabled  bool   `json:"leave_card_enabled" schema:"leave_card_enabled"`
+	LeaveCardTitle    string `json:"leave_card_title" schema:"leave_card_title" valid:",100"`
+	LeaveCardSubtitle string `json:"leave_card_subtitle" schema:"leave_card_subtitle" valid:",100"`
+
+	CardBackgroundURL   string     `json:"card_background_url" schema:"card_background_url" valid:",500"`
+	CardBackgroundColor string     `json:"card_background_color" schema:"card_background_color"`
+	CardTextColor       string     `json:"card_text_color" schema:"card_text_color"`
+	CardLayout          CardLayout `json:"card_layout" schema:"card_layout"`
+
+	BoostEnabled bool   `json:"boost_enabled" schema:"boost_enabled"`
+	BoostChannel int64  `json:"boost_channel" schema:"boost_channel" valid:"channel,true"`
+	BoostMsg     string `json:"boost_msg" schema:"boost_msg" valid:"template,5000"`
+
+	BoostEndEnabled bool   `json:"boost_end_enabled" schema:"boost_end_enabled"`
+	BoostEndChannel int64  `json:"boost_end_channel" schema:"boost_end_channel" valid:"channel,true"`
+	BoostEndMsg     string `json:"boost_end_msg" schema:"boost_end_msg" valid:"template,5000"`
+
+	MilestoneEnabled  bool   `json:"milestone_enabled" schema:"milestone_enabled"`
+	MilestoneChannel  int64  `json:"milestone_channel" schema:"milestone_channel" valid:"channel,true"`
+	MilestoneMsg      string `json:"milestone_msg" schema:"milestone_msg" valid:"template,5000"`
+	MilestoneInterval int    `json:"milestone_interval" schema:"milestone_interval" valid:"10,10000000"`
+
+	AnniversaryEnabled bool   `json:"anniversary_enabled" schema:"anniversary_enabled"`
+	AnniversaryChannel int64  `json:"anniversary_channel" schema:"anniversary_channel" valid:"channel,true"`
+	AnniversaryMsg     string `json:"anniversary_msg" schema:"anniversary_msg" valid:"template,5000"`
 }
 
 var _ web.CustomValidator = (*Config)(nil)
@@ -81,6 +125,23 @@ func (c *Config) Validate(tmpl web.TemplateData, _ int64) bool {
 		tmpl.AddAlerts(web.ErrorAlert(fmt.Sprintf("Too many leave server messages, max %d", MaxResponses)))
 		return false
 	}
+
+	if _, ok := parseHexColor(c.CardBackgroundColor); !ok {
+		tmpl.AddAlerts(web.ErrorAlert("Invalid card background color"))
+		return false
+	}
+	if _, ok := parseHexColor(c.CardTextColor); !ok {
+		tmpl.AddAlerts(web.ErrorAlert("Invalid card text color"))
+		return false
+	}
+	if c.CardLayout != CardLayoutAvatarLeft && c.CardLayout != CardLayoutAvatarCenter {
+		tmpl.AddAlerts(web.ErrorAlert("Invalid card layout"))
+		return false
+	}
+	if c.CardBackgroundURL != "" && !validCardImageURL(c.CardBackgroundURL) {
+		tmpl.AddAlerts(web.ErrorAlert("Card background image has to be a public http(s) link"))
+		return false
+	}
 	return true
 }
 
@@ -118,6 +179,36 @@ func (c *Config) ToModel() *models.GeneralNotificationConfig {
 		TopicChannel: null.StringFrom(discordgo.StrID(c.TopicChannel)),
 
 		CensorInvites: null.BoolFrom(c.CensorInvites),
+
+		JoinCardEnabled:  c.JoinCardEnabled,
+		JoinCardTitle:    c.JoinCardTitle,
+		JoinCardSubtitle: c.JoinCardSubtitle,
+
+		LeaveCardEnabled:  c.LeaveCardEnabled,
+		LeaveCardTitle:    c.LeaveCardTitle,
+		LeaveCardSubtitle: c.LeaveCardSubtitle,
+
+		CardBackgroundURL:   c.CardBackgroundURL,
+		CardBackgroundColor: c.CardBackgroundColor,
+		CardTextColor:       c.CardTextColor,
+		CardLayout:          int16(c.CardLayout),
+
+		BoostEnabled: c.BoostEnabled,
+		BoostChannel: c.BoostChannel,
+		BoostMsg:     c.BoostMsg,
+
+		BoostEndEnabled: c.BoostEndEnabled,
+		BoostEndChannel: c.BoostEndChannel,
+		BoostEndMsg:     c.BoostEndMsg,
+
+		MilestoneEnabled:  c.MilestoneEnabled,
+		MilestoneChannel:  c.MilestoneChannel,
+		MilestoneMsg:      c.MilestoneMsg,
+		MilestoneInterval: c.MilestoneInterval,
+
+		AnniversaryEnabled: c.AnniversaryEnabled,
+		AnniversaryChannel: c.AnniversaryChannel,
+		AnniversaryMsg:     c.AnniversaryMsg,
 	}
 }
 
@@ -145,5 +236,35 @@ func configFromModel(model *models.GeneralNotificationConfig) *Config {
 		TopicChannel: topicChannel,
 
 		CensorInvites: model.CensorInvites.Bool,
+
+		JoinCardEnabled:  model.JoinCardEnabled,
+		JoinCardTitle:    model.JoinCardTitle,
+		JoinCardSubtitle: model.JoinCardSubtitle,
+
+		LeaveCardEnabled:  model.LeaveCardEnabled,
+		LeaveCardTitle:    model.LeaveCardTitle,
+		LeaveCardSubtitle: model.LeaveCardSubtitle,
+
+		CardBackgroundURL:   model.CardBackgroundURL,
+		CardBackgroundColor: model.CardBackgroundColor,
+		CardTextColor:       model.CardTextColor,
+		CardLayout:          CardLayout(model.CardLayout),
+
+		BoostEnabled: model.BoostEnabled,
+		BoostChannel: model.BoostChannel,
+		BoostMsg:     model.BoostMsg,
+
+		BoostEndEnabled: model.BoostEndEnabled,
+		BoostEndChannel: model.BoostEndChannel,
+		BoostEndMsg:     model.BoostEndMsg,
+
+		MilestoneEnabled:  model.MilestoneEnabled,
+		MilestoneChannel:  model.MilestoneChannel,
+		MilestoneMsg:      model.MilestoneMsg,
+		MilestoneInterval: model.MilestoneInterval,
+
+		AnniversaryEnabled: model.AnniversaryEnabled,
+		AnniversaryChannel: model.AnniversaryChannel,
+		AnniversaryMsg:     model.AnniversaryMsg,
 	}
 }
@@ -0,0 +1,327 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/ThatBathroom/yagpdb/v2/analytics"
+	"github.com/ThatBathroom/yagpdb/v2/bot"
+	"github.com/ThatBathroom/yagpdb/v2/bot/eventsystem"
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/common/pubsub"
+	"github.com/ThatBathroom/yagpdb/v2/common/scheduledevents2"
+	seventsmodels "github.com/ThatBathroom/yagpdb/v2/common/scheduledevents2/models"
+	"github.com/ThatBathroom/yagpdb/v2/common/templates"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/lib/dstate"
+	"github.com/mediocregopher/radix/v3"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	// Boosts that started longer ago than this when we first see the member are not announced,
+	// they were already boosting before we started tracking them
+	boostStartWindow = 15 * time.Minute
+
+	// ran once a day for every guild with anniversaries enabled
+	anniversarySweepEventName = "notifications_anniversary_sweep"
+
+	// at most this many anniversary messages are posted a day, the rest are summed up in a single message
+	maxAnniversaryMessages = 10
+)
+
+// set of members we know are boosting, used to tell when a boost starts or ends
+func boostersKey(guildID int64) string {
+	return "notifications_boosters:" + strconv.FormatInt(guildID, 10)
+}
+
+func milestoneKey(guildID int64, count int64) string {
+	return "notifications_milestone:" + strconv.FormatInt(guildID, 10) + ":" + strconv.FormatInt(count, 10)
+}
+
+func HandleGuildMemberUpdateBoost(evtData *eventsystem.EventData) (retry bool, err error) {
+	evt := evtData.GuildMemberUpdate()
+
+	config, err := BotCachedGetConfig(evt.GuildID)
+	if err != nil {
+		return true, errors.WithStackIf(err)
+	}
+
+	if !config.BoostEnabled && !config.BoostEndEnabled {
+		return false, nil
+	}
+
+	gs := evtData.GS
+	ms := dstate.MemberStateFromMember(evt.Member)
+	ms.GuildID = evt.GuildID
+
+	if evt.PremiumSince != nil {
+		var added int
+		err = common.RedisPool.Do(radix.FlatCmd(&added, "SADD", boostersKey(evt.GuildID), evt.User.ID))
+		if err != nil {
+			return true, errors.WithStackIf(err)
+		}
+
+		if added == 0 || !config.BoostEnabled || time.Since(*evt.PremiumSince) > boostStartWindow {
+			return false, nil
+		}
+
+		channel := gs.GetChannel(config.BoostChannel)
+		if channel == nil {
+			return false, nil
+		}
+
+		go analytics.RecordActiveUnit(gs.ID, &Plugin{}, "posted_boost_msg")
+
+		if sendTemplate(gs, channel, config.BoostMsg, ms, "boost", config.CensorInvites, templates.ExecutedFromStandard, nil, nil) {
+			return true, nil
+		}
+
+		return false, nil
+	}
+
+	var removed int
+	err = common.RedisPool.Do(radix.FlatCmd(&removed, "SREM", boostersKey(evt.GuildID), evt.User.ID))
+	if err != nil {
+		return true, errors.WithStackIf(err)
+	}
+
+	if removed == 0 || !config.BoostEndEnabled {
+		return false, nil
+	}
+
+	channel := gs.GetChannel(config.BoostEndChannel)
+	if channel == nil {
+		return false, nil
+	}
+
+	go analytics.RecordActiveUnit(gs.ID, &Plugin{}, "posted_boost_end_msg")
+
+	if sendTemplate(gs, channel, config.BoostEndMsg, ms, "boost end", config.CensorInvites, templates.ExecutedFromStandard, nil, nil) {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// HandleGuildMemberAddMilestones posts member count milestones
+func HandleGuildMemberAddMilestones(evtData *eventsystem.EventData) (retry bool, err error) {
+	evt := evtData.GuildMemberAdd()
+
+	config, err := BotCachedGetConfig(evt.GuildID)
+	if err != nil {
+		return true, errors.WithStackIf(err)
+	}
+
+	if !config.MilestoneEnabled || config.MilestoneInterval < 1 {
+		return false, nil
+	}
+
+	gs := evtData.GS
+	count := gs.MemberCount
+	if count < 1 || count%int64(config.MilestoneInterval) != 0 {
+		return false, nil
+	}
+
+	channel := gs.GetChannel(config.MilestoneChannel)
+	if channel == nil {
+		return false, nil
+	}
+
+	// members leaving and joining around the milestone would otherwise post it again
+	var set string
+	err = common.RedisPool.Do(radix.FlatCmd(&set, "SET", milestoneKey(gs.ID, count), true, "EX", int((time.Hour * 24 * 365).Seconds()), "NX"))
+	if err != nil {
+		logger.WithError(err).WithField("guild", gs.ID).Error("failed marking milestone as posted")
+		return false, nil
+	}
+	if set != "OK" {
+		return false, nil
+	}
+
+	ms := dstate.MemberStateFromMember(evt.Member)
+	ms.GuildID = evt.GuildID
+
+	go analytics.RecordActiveUnit(gs.ID, &Plugin{}, "posted_milestone_msg")
+
+	data := map[string]interface{}{"MemberCount": count}
+	sendTemplate(gs, channel, config.MilestoneMsg, ms, "milestone", config.CensorInvites, templates.ExecutedFromStandard, data, nil)
+	return false, nil
+}
+
+// anniversaryYears returns how many years ago the member joined if day is their join anniversary, members that joined
+// on the 29th of February celebrate on the 28th in common years
+func anniversaryYears(joinedAt, day time.Time) (years int, ok bool) {
+	joinedAt = joinedAt.UTC()
+	day = day.UTC()
+
+	years = day.Year() - joinedAt.Year()
+	if years < 1 {
+		return 0, false
+	}
+
+	if joinedAt.Month() == day.Month() && joinedAt.Day() == day.Day() {
+		return years, true
+	}
+
+	if joinedAt.Month() == time.February && joinedAt.Day() == 29 && day.Month() == time.February && day.Day() == 28 && !isLeapYear(day.Year()) {
+		return years, true
+	}
+
+	return 0, false
+}
+
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// nextAnniversarySweep returns the start of the next day in UTC
+func nextAnniversarySweep(now time.Time) time.Time {
+	y, m, d := now.UTC().Date()
+	return time.Date(y, m, d+1, 0, 0, 0, 0, time.UTC)
+}
+
+// handleAnniversarySweep posts the anniversaries of the day and schedules the sweep of the next day, so there's only
+// a single scheduled event per guild no matter how many members it has
+func handleAnniversarySweep(evt *seventsmodels.ScheduledEvent, data interface{}) (retry bool, err error) {
+	config, err := BotCachedGetConfig(evt.GuildID)
+	if err != nil {
+		return true, errors.WithStackIf(err)
+	}
+
+	// it's scheduled again if it's turned back on
+	if !config.AnniversaryEnabled {
+		return false, nil
+	}
+
+	gs := bot.State.GetGuild(evt.GuildID)
+	if gs == nil {
+		return false, nil
+	}
+
+	next := nextAnniversarySweep(evt.TriggersAt)
+	if next.Before(time.Now()) {
+		next = nextAnniversarySweep(time.Now())
+	}
+
+	err = scheduledevents2.ScheduleEvent(anniversarySweepEventName, evt.GuildID, next, nil)
+	if err != nil {
+		return true, errors.WithStackIf(err)
+	}
+
+	// the bot was down for a while, too late to congratulate anyone
+	if time.Since(evt.TriggersAt) > time.Hour*24 {
+		return false, nil
+	}
+
+	channel := gs.GetChannel(config.AnniversaryChannel)
+	if channel == nil {
+		return false, nil
+	}
+
+	// use the day it was scheduled for, so a sweep that runs late doesn't skip a day
+	day := evt.TriggersAt
+
+	// the state only keeps the members that are online or were active recently, so the members are fetched from discord
+	members, err := fetchAllMembers(gs.ID)
+	if err != nil {
+		// not retried as the next sweep is already scheduled
+		logger.WithError(err).WithField("guild", gs.ID).Error("failed fetching members for anniversaries")
+		return false, nil
+	}
+
+	celebrating, more := pickAnniversaries(members, day, maxAnniversaryMessages)
+	for _, v := range celebrating {
+		ms := dstate.MemberStateFromMember(v.member)
+		ms.GuildID = gs.ID
+
+		go analytics.RecordActiveUnit(gs.ID, &Plugin{}, "posted_anniversary_msg")
+
+		tmplData := map[string]interface{}{"Years": v.years}
+		sendTemplate(gs, channel, config.AnniversaryMsg, ms, "anniversary", config.CensorInvites, templates.ExecutedFromStandard, tmplData, nil)
+	}
+
+	if more > 0 {
+		bot.QueueMergedMessage(channel.ID, fmt.Sprintf("...and %d more member(s) celebrating their server anniversary today!", more), discordgo.AllowedMentions{})
+	}
+
+	return false, nil
+}
+
+// fetchAllMembers pages through all the members of the guild
+func fetchAllMembers(guildID int64) ([]*discordgo.Member, error) {
+	var result []*discordgo.Member
+	after := int64(0)
+	for {
+		members, err := common.BotSession.GuildMembers(guildID, after, 1000)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, members...)
+		if len(members) < 1000 {
+			return result, nil
+		}
+
+		after = members[len(members)-1].User.ID
+	}
+}
+
+type memberAnniversary struct {
+	member *discordgo.Member
+	years  int
+}
+
+// pickAnniversaries returns up to limit of the members celebrating on the day, the longest members first, and how many
+// more are celebrating
+func pickAnniversaries(members []*discordgo.Member, day time.Time, limit int) (picked []memberAnniversary, more int) {
+	for _, m := range members {
+		if m.User == nil || m.User.Bot {
+			continue
+		}
+
+		joinedAt, err := m.JoinedAt.Parse()
+		if err != nil {
+			continue
+		}
+
+		if years, ok := anniversaryYears(joinedAt, day); ok {
+			picked = append(picked, memberAnniversary{member: m, years: years})
+		}
+	}
+
+	sort.SliceStable(picked, func(i, j int) bool {
+		return picked[i].years > picked[j].years
+	})
+
+	if len(picked) > limit {
+		return picked[:limit], len(picked) - limit
+	}
+
+	return picked, 0
+}
+
+// handleScheduleAnniversaries starts the daily anniversary sweep after the anniversary messages were enabled
+func handleScheduleAnniversaries(evt *pubsub.Event) {
+	l := logger.WithField("guild", evt.TargetGuildInt)
+
+	// clear the old one in case it was turned off and on again
+	_, err := seventsmodels.ScheduledEvents(
+		qm.Where("event_name = ?", anniversarySweepEventName),
+		qm.Where("guild_id = ?", evt.TargetGuildInt),
+		qm.Where("processed = false"),
+	).DeleteAll(context.Background(), common.PQ)
+	if err != nil {
+		l.WithError(err).Error("failed clearing old anniversary sweep")
+		return
+	}
+
+	err = scheduledevents2.ScheduleEvent(anniversarySweepEventName, evt.TargetGuildInt, nextAnniversarySweep(time.Now()), nil)
+	if err != nil {
+		l.WithError(err).Error("failed scheduling anniversary sweep")
+	}
+}
@@ -0,0 +1,108 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+)
+
+func TestAnniversaryYears(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 13, 37, 0, 0, time.UTC)
+	}
+
+	cases := []struct {
+		joinedAt, day time.Time
+		years         int
+		ok            bool
+	}{
+		{date(2020, time.May, 3), date(2021, time.May, 3), 1, true},
+		{date(2020, time.May, 3), date(2025, time.May, 3), 5, true},
+		{date(2020, time.May, 3), date(2020, time.May, 3), 0, false},
+		{date(2020, time.May, 3), date(2021, time.May, 4), 0, false},
+		{date(2020, time.May, 3), date(2021, time.June, 3), 0, false},
+		{date(2020, time.May, 3), date(2019, time.May, 3), 0, false},
+
+		// leap day joins
+		{date(2020, time.February, 29), date(2021, time.February, 28), 1, true},
+		{date(2020, time.February, 29), date(2021, time.March, 1), 0, false},
+		{date(2020, time.February, 29), date(2024, time.February, 29), 4, true},
+		{date(2020, time.February, 29), date(2024, time.February, 28), 0, false},
+		{date(2000, time.February, 29), date(2100, time.February, 28), 100, true},
+		{date(2019, time.February, 28), date(2020, time.February, 28), 1, true},
+		{date(2019, time.February, 28), date(2020, time.February, 29), 0, false},
+	}
+
+	for _, c := range cases {
+		years, ok := anniversaryYears(c.joinedAt, c.day)
+		if years != c.years || ok != c.ok {
+			t.Errorf("anniversaryYears(%s, %s) = %d, %t, want %d, %t",
+				c.joinedAt.Format("2006-01-02"), c.day.Format("2006-01-02"), years, ok, c.years, c.ok)
+		}
+	}
+}
+
+func TestAnniversaryYearsTimezone(t *testing.T) {
+	// 23:30 on the 2nd in UTC-5 is the 3rd in UTC
+	joinedAt := time.Date(2020, time.May, 2, 23, 30, 0, 0, time.FixedZone("", -5*60*60))
+	if _, ok := anniversaryYears(joinedAt, time.Date(2021, time.May, 3, 0, 0, 0, 0, time.UTC)); !ok {
+		t.Error("expected anniversaries to be compared in UTC")
+	}
+}
+
+func TestNextAnniversarySweep(t *testing.T) {
+	cases := []struct {
+		now, want time.Time
+	}{
+		{time.Date(2024, time.May, 3, 13, 0, 0, 0, time.UTC), time.Date(2024, time.May, 4, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, time.May, 3, 0, 0, 0, 0, time.UTC), time.Date(2024, time.May, 4, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, time.February, 28, 23, 59, 0, 0, time.UTC), time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, time.December, 31, 12, 0, 0, 0, time.UTC), time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, c := range cases {
+		if got := nextAnniversarySweep(c.now); !got.Equal(c.want) {
+			t.Errorf("nextAnniversarySweep(%s) = %s, want %s", c.now, got, c.want)
+		}
+	}
+}
+
+func TestPickAnniversaries(t *testing.T) {
+	day := time.Date(2024, time.May, 3, 0, 0, 0, 0, time.UTC)
+	member := func(id int64, joined time.Time, isBot bool) *discordgo.Member {
+		return &discordgo.Member{
+			User:     &discordgo.User{ID: id, Bot: isBot},
+			JoinedAt: discordgo.Timestamp(joined.Format(time.RFC3339)),
+		}
+	}
+
+	members := []*discordgo.Member{
+		member(1, time.Date(2023, time.May, 3, 10, 0, 0, 0, time.UTC), false),
+		member(2, time.Date(2020, time.May, 3, 10, 0, 0, 0, time.UTC), false),
+		member(3, time.Date(2020, time.May, 4, 10, 0, 0, 0, time.UTC), false),
+		member(4, time.Date(2019, time.May, 3, 10, 0, 0, 0, time.UTC), true),
+		member(5, time.Date(2022, time.May, 3, 10, 0, 0, 0, time.UTC), false),
+	}
+
+	picked, more := pickAnniversaries(members, day, 10)
+	if more != 0 || len(picked) != 3 {
+		t.Fatalf("got %d picked and %d more, want 3 and 0", len(picked), more)
+	}
+
+	wantIDs := []int64{2, 5, 1}
+	for i, v := range picked {
+		if v.member.User.ID != wantIDs[i] {
+			t.Errorf("picked[%d] is member %d, want %d", i, v.member.User.ID, wantIDs[i])
+		}
+	}
+
+	if picked[0].years != 4 {
+		t.Errorf("got %d years, want 4", picked[0].years)
+	}
+
+	picked, more = pickAnniversaries(members, day, 2)
+	if len(picked) != 2 || more != 1 {
+		t.Errorf("got %d picked and %d more, want 2 and 1", len(picked), more)
+	}
+}
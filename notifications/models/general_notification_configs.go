@@ -24,87 +24,202 @@ import (
 
 // GeneralNotificationConfig is an object representing the database table.
 type GeneralNotificationConfig struct {
-	GuildID           int64       `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
-	CreatedAt         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt         time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	JoinServerEnabled null.Bool   `boil:"join_server_enabled" json:"join_server_enabled,omitempty" toml:"join_server_enabled" yaml:"join_server_enabled,omitempty"`
-	JoinServerChannel null.String `boil:"join_server_channel" json:"join_server_channel,omitempty" toml:"join_server_channel" yaml:"join_server_channel,omitempty"`
-	JoinServerMsgs    null.String `boil:"join_server_msgs" json:"join_server_msgs,omitempty" toml:"join_server_msgs" yaml:"join_server_msgs,omitempty"`
-	JoinDMEnabled     null.Bool   `boil:"join_dm_enabled" json:"join_dm_enabled,omitempty" toml:"join_dm_enabled" yaml:"join_dm_enabled,omitempty"`
-	JoinDMMsg         null.String `boil:"join_dm_msg" json:"join_dm_msg,omitempty" toml:"join_dm_msg" yaml:"join_dm_msg,omitempty"`
-	LeaveEnabled      null.Bool   `boil:"leave_enabled" json:"leave_enabled,omitempty" toml:"leave_enabled" yaml:"leave_enabled,omitempty"`
-	LeaveChannel      null.String `boil:"leave_channel" json:"leave_channel,omitempty" toml:"leave_channel" yaml:"leave_channel,omitempty"`
-	LeaveMsgs         null.String `boil:"leave_msgs" json:"leave_msgs,omitempty" toml:"leave_msgs" yaml:"leave_msgs,omitempty"`
-	TopicEnabled      null.Bool   `boil:"topic_enabled" json:"topic_enabled,omitempty" toml:"topic_enabled" yaml:"topic_enabled,omitempty"`
-	TopicChannel      null.String `boil:"topic_channel" json:"topic_channel,omitempty" toml:"topic_channel" yaml:"topic_channel,omitempty"`
-	CensorInvites     null.Bool   `boil:"censor_invites" json:"censor_invites,omitempty" toml:"censor_invites" yaml:"censor_invites,omitempty"`
+	GuildID             int64       `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	CreatedAt           time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt           time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	JoinServerEnabled   null.Bool   `boil:"join_server_enabled" json:"join_server_enabled,omitempty" toml:"join_server_enabled" yaml:"join_server_enabled,omitempty"`
+	JoinServerChannel   null.String `boil:"join_server_channel" json:"join_server_channel,omitempty" toml:"join_server_channel" yaml:"join_server_channel,omitempty"`
+	JoinServerMsgs      null.String `boil:"join_server_msgs" json:"join_server_msgs,omitempty" toml:"join_server_msgs" yaml:"join_server_msgs,omitempty"`
+	JoinDMEnabled       null.Bool   `boil:"join_dm_enabled" json:"join_dm_enabled,omitempty" toml:"join_dm_enabled" yaml:"join_dm_enabled,omitempty"`
+	JoinDMMsg           null.String `boil:"join_dm_msg" json:"join_dm_msg,omitempty" toml:"join_dm_msg" yaml:"join_dm_msg,omitempty"`
+	LeaveEnabled        null.Bool   `boil:"leave_enabled" json:"leave_enabled,omitempty" toml:"leave_enabled" yaml:"leave_enabled,omitempty"`
+	LeaveChannel        null.String `boil:"leave_channel" json:"leave_channel,omitempty" toml:"leave_channel" yaml:"leave_channel,omitempty"`
+	LeaveMsgs           null.String `boil:"leave_msgs" json:"leave_msgs,omitempty" toml:"leave_msgs" yaml:"leave_msgs,omitempty"`
+	TopicEnabled        null.Bool   `boil:"topic_enabled" json:"topic_enabled,omitempty" toml:"topic_enabled" yaml:"topic_enabled,omitempty"`
+	TopicChannel        null.String `boil:"topic_channel" json:"topic_channel,omitempty" toml:"topic_channel" yaml:"topic_channel,omitempty"`
+	CensorInvites       null.Bool   `boil:"censor_invites" json:"censor_invites,omitempty" toml:"censor_invites" yaml:"censor_invites,omitempty"`
+	JoinCardEnabled     bool        `boil:"join_card_enabled" json:"join_card_enabled" toml:"join_card_enabled" yaml:"join_card_enabled"`
+	LeaveCardEnabled    bool        `boil:"leave_card_enabled" json:"leave_card_enabled" toml:"leave_card_enabled" yaml:"leave_card_enabled"`
+	CardBackgroundURL   string      `boil:"card_background_url" json:"card_background_url" toml:"card_background_url" yaml:"card_background_url"`
+	CardBackgroundColor string      `boil:"card_background_color" json:"card_background_color" toml:"card_background_color" yaml:"card_background_color"`
+	CardTextColor       string      `boil:"card_text_color" json:"card_text_color" toml:"card_text_color" yaml:"card_text_color"`
+	CardLayout          int16       `boil:"card_layout" json:"card_layout" toml:"card_layout" yaml:"card_layout"`
+	JoinCardTitle       string      `boil:"join_card_title" json:"join_card_title" toml:"join_card_title" yaml:"join_card_title"`
+	JoinCardSubtitle    string      `boil:"join_card_subtitle" json:"join_card_subtitle" toml:"join_card_subtitle" yaml:"join_card_subtitle"`
+	LeaveCardTitle      string      `boil:"leave_card_title" json:"leave_card_title" toml:"leave_card_title" yaml:"leave_card_title"`
+	LeaveCardSubtitle   string      `boil:"leave_card_subtitle" json:"leave_card_subtitle" toml:"leave_card_subtitle" yaml:"leave_card_subtitle"`
+	BoostEnabled        bool        `boil:"boost_enabled" json:"boost_enabled" toml:"boost_enabled" yaml:"boost_enabled"`
+	BoostChannel        int64       `boil:"boost_channel" json:"boost_channel" toml:"boost_channel" yaml:"boost_channel"`
+	BoostMsg            string      `boil:"boost_msg" json:"boost_msg" toml:"boost_msg" yaml:"boost_msg"`
+	BoostEndEnabled     bool        `boil:"boost_end_enabled" json:"boost_end_enabled" toml:"boost_end_enabled" yaml:"boost_end_enabled"`
+	BoostEndChannel     int64       `boil:"boost_end_channel" json:"boost_end_channel" toml:"boost_end_channel" yaml:"boost_end_channel"`
+	BoostEndMsg         string      `boil:"boost_end_msg" json:"boost_end_msg" toml:"boost_end_msg" yaml:"boost_end_msg"`
+	MilestoneEnabled    bool        `boil:"milestone_enabled" json:"milestone_enabled" toml:"milestone_enabled" yaml:"milestone_enabled"`
+	MilestoneChannel    int64       `boil:"milestone_channel" json:"milestone_channel" toml:"milestone_channel" yaml:"milestone_channel"`
+	MilestoneMsg        string      `boil:"milestone_msg" json:"milestone_msg" toml:"milestone_msg" yaml:"milestone_msg"`
+	MilestoneInterval   int         `boil:"milestone_interval" json:"milestone_interval" toml:"milestone_interval" yaml:"milestone_interval"`
+	AnniversaryEnabled  bool        `boil:"anniversary_enabled" json:"anniversary_enabled" toml:"anniversary_enabled" yaml:"anniversary_enabled"`
+	AnniversaryChannel  int64       `boil:"anniversary_channel" json:"anniversary_channel" toml:"anniversary_channel" yaml:"anniversary_channel"`
+	AnniversaryMsg      string      `boil:"anniversary_msg" json:"anniversary_msg" toml:"anniversary_msg" yaml:"anniversary_msg"`
 
 	R *generalNotificationConfigR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L generalNotificationConfigL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var GeneralNotificationConfigColumns = struct {
-	GuildID           string
-	CreatedAt         string
-	UpdatedAt         string
-	JoinServerEnabled string
-	JoinServerChannel string
-	JoinServerMsgs    string
-	JoinDMEnabled     string
-	JoinDMMsg         string
-	LeaveEnabled      string
-	LeaveChannel      string
-	LeaveMsgs         string
-	TopicEnabled      string
-	TopicChannel      string
-	CensorInvites     string
+	GuildID             string
+	CreatedAt           string
+	UpdatedAt           string
+	JoinServerEnabled   string
+	JoinServerChannel   string
+	JoinServerMsgs      string
+	JoinDMEnabled       string
+	JoinDMMsg           string
+	LeaveEnabled        string
+	LeaveChannel        string
+	LeaveMsgs           string
+	TopicEnabled        string
+	TopicChannel        string
+	CensorInvites       string
+	JoinCardEnabled     string
+	LeaveCardEnabled    string
+	CardBackgroundURL   string
+	CardBackgroundColor string
+	CardTextColor       string
+	CardLayout          string
+	JoinCardTitle       string
+	JoinCardSubtitle    string
+	LeaveCardTitle      string
+	LeaveCardSubtitle   string
+	BoostEnabled        string
+	BoostChannel        string
+	BoostMsg            string
+	BoostEndEnabled     string
+	BoostEndChannel     string
+	BoostEndMsg         string
+	MilestoneEnabled    string
+	MilestoneChannel    string
+	MilestoneMsg        string
+	MilestoneInterval   string
+	AnniversaryEnabled  string
+	AnniversaryChannel  string
+	AnniversaryMsg      string
 }{
-	GuildID:           "guild_id",
-	CreatedAt:         "created_at",
-	UpdatedAt:         "updated_at",
-	JoinServerEnabled: "join_server_enabled",
-	JoinServerChannel: "join_server_channel",
-	JoinServerMsgs:    "join_server_msgs",
-	JoinDMEnabled:     "join_dm_enabled",
-	JoinDMMsg:         "join_dm_msg",
-	LeaveEnabled:      "leave_enabled",
-	LeaveChannel:      "leave_channel",
-	LeaveMsgs:         "leave_msgs",
-	TopicEnabled:      "topic_enabled",
-	TopicChannel:      "topic_channel",
-	CensorInvites:     "censor_invites",
+	GuildID:             "guild_id",
+	CreatedAt:           "created_at",
+	UpdatedAt:           "updated_at",
+	JoinServerEnabled:   "join_server_enabled",
+	JoinServerChannel:   "join_server_channel",
+	JoinServerMsgs:      "join_server_msgs",
+	JoinDMEnabled:       "join_dm_enabled",
+	JoinDMMsg:           "join_dm_msg",
+	LeaveEnabled:        "leave_enabled",
+	LeaveChannel:        "leave_channel",
+	LeaveMsgs:           "leave_msgs",
+	TopicEnabled:        "topic_enabled",
+	TopicChannel:        "topic_channel",
+	CensorInvites:       "censor_invites",
+	JoinCardEnabled:     "join_card_enabled",
+	LeaveCardEnabled:    "leave_card_enabled",
+	CardBackgroundURL:   "card_background_url",
+	CardBackgroundColor: "card_background_color",
+	CardTextColor:       "card_text_color",
+	CardLayout:          "card_layout",
+	JoinCardTitle:       "join_card_title",
+	JoinCardSubtitle:    "join_card_subtitle",
+	LeaveCardTitle:      "leave_card_title",
+	LeaveCardSubtitle:   "leave_card_subtitle",
+	BoostEnabled:        "boost_enabled",
+	BoostChannel:        "boost_channel",
+	BoostMsg:            "boost_msg",
+	BoostEndEnabled:     "boost_end_enabled",
+	BoostEndChannel:     "boost_end_channel",
+	BoostEndMsg:         "boost_end_msg",
+	MilestoneEnabled:    "milestone_enabled",
+	MilestoneChannel:    "milestone_channel",
+	MilestoneMsg:        "milestone_msg",
+	MilestoneInterval:   "milestone_interval",
+	AnniversaryEnabled:  "anniversary_enabled",
+	AnniversaryChannel:  "anniversary_channel",
+	AnniversaryMsg:      "anniversary_msg",
 }
 
 var GeneralNotificationConfigTableColumns = struct {
-	GuildID           string
-	CreatedAt         string
-	UpdatedAt         string
-	JoinServerEnabled string
-	JoinServerChannel string
-	JoinServerMsgs    string
-	JoinDMEnabled     string
-	JoinDMMsg         string
-	LeaveEnabled      string
-	LeaveChannel      string
-	LeaveMsgs         string
-	TopicEnabled      string
-	TopicChannel      string
-	CensorInvites     string
+	GuildID             string
+	CreatedAt           string
+	UpdatedAt           string
+	JoinServerEnabled   string
+	JoinServerChannel   string
+	JoinServerMsgs      string
+	JoinDMEnabled       string
+	JoinDMMsg           string
+	LeaveEnabled        string
+	LeaveChannel        string
+	LeaveMsgs           string
+	TopicEnabled        string
+	TopicChannel        string
+	CensorInvites       string
+	JoinCardEnabled     string
+	LeaveCardEnabled    string
+	CardBackgroundURL   string
+	CardBackgroundColor string
+	CardTextColor       string
+	CardLayout          string
+	JoinCardTitle       string
+	JoinCardSubtitle    string
+	LeaveCardTitle      string
+	LeaveCardSubtitle   string
+	BoostEnabled        string
+	BoostChannel        string
+	BoostMsg            string
+	BoostEndEnabled     string
+	BoostEndChannel     string
+	BoostEndMsg         string
+	MilestoneEnabled    string
+	MilestoneChannel    string
+	MilestoneMsg        string
+	MilestoneInterval   string
+	AnniversaryEnabled  string
+	AnniversaryChannel  string
+	AnniversaryMsg      string
 }{
-	GuildID:           "general_notification_configs.guild_id",
-	CreatedAt:         "general_notification_configs.created_at",
-	UpdatedAt:         "general_notification_configs.updated_at",
-	JoinServerEnabled: "general_notification_configs.join_server_enabled",
-	JoinServerChannel: "general_notification_configs.join_server_channel",
-	JoinServerMsgs:    "general_notification_configs.join_server_msgs",
-	JoinDMEnabled:     "general_notification_configs.join_dm_enabled",
-	JoinDMMsg:         "general_notification_configs.join_dm_msg",
-	LeaveEnabled:      "general_notification_configs.leave_enabled",
-	LeaveChannel:      "general_notification_configs.leave_channel",
-	LeaveMsgs:         "general_notification_configs.leave_msgs",
-	TopicEnabled:      "general_notification_configs.topic_enabled",
-	TopicChannel:      "general_notification_configs.topic_channel",
-	CensorInvites:     "general_notification_configs.censor_invites",
+	GuildID:             "general_notification_configs.guild_id",
+	CreatedAt:           "general_notification_configs.created_at",
+	UpdatedAt:           "general_notification_configs.updated_at",
+	JoinServerEnabled:   "general_notification_configs.join_server_enabled",
+	JoinServerChannel:   "general_notification_configs.join_server_channel",
+	JoinServerMsgs:      "general_notification_configs.join_server_msgs",
+	JoinDMEnabled:       "general_notification_configs.join_dm_enabled",
+	JoinDMMsg:           "general_notification_configs.join_dm_msg",
+	LeaveEnabled:        "general_notification_configs.leave_enabled",
+	LeaveChannel:        "general_notification_configs.leave_channel",
+	LeaveMsgs:           "general_notification_configs.leave_msgs",
+	TopicEnabled:        "general_notification_configs.topic_enabled",
+	TopicChannel:        "general_notification_configs.topic_channel",
+	CensorInvites:       "general_notification_configs.censor_invites",
+	JoinCardEnabled:     "general_notification_configs.join_card_enabled",
+	LeaveCardEnabled:    "general_notification_configs.leave_card_enabled",
+	CardBackgroundURL:   "general_notification_configs.card_background_url",
+	CardBackgroundColor: "general_notification_configs.card_background_color",
+	CardTextColor:       "general_notification_configs.card_text_color",
+	CardLayout:          "general_notification_configs.card_layout",
+	JoinCardTitle:       "general_notification_configs.join_card_title",
+	JoinCardSubtitle:    "general_notification_configs.join_card_subtitle",
+	LeaveCardTitle:      "general_notification_configs.leave_card_title",
+	LeaveCardSubtitle:   "general_notification_configs.leave_card_subtitle",
+	BoostEnabled:        "general_notification_configs.boost_enabled",
+	BoostChannel:        "general_notification_configs.boost_channel",
+	BoostMsg:            "general_notification_configs.boost_msg",
+	BoostEndEnabled:     "general_notification_configs.boost_end_enabled",
+	BoostEndChannel:     "general_notification_configs.boost_end_channel",
+	BoostEndMsg:         "general_notification_configs.boost_end_msg",
+	MilestoneEnabled:    "general_notification_configs.milestone_enabled",
+	MilestoneChannel:    "general_notification_configs.milestone_channel",
+	MilestoneMsg:        "general_notification_configs.milestone_msg",
+	MilestoneInterval:   "general_notification_configs.milestone_interval",
+	AnniversaryEnabled:  "general_notification_configs.anniversary_enabled",
+	AnniversaryChannel:  "general_notification_configs.anniversary_channel",
+	AnniversaryMsg:      "general_notification_configs.anniversary_msg",
 }
 
 // Generated where
@@ -227,36 +342,164 @@ func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
 func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
+func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperint16 struct{ field string }
+
+func (w whereHelperint16) EQ(x int16) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint16) NEQ(x int16) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint16) LT(x int16) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint16) LTE(x int16) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint16) GT(x int16) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint16) GTE(x int16) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint16) IN(slice []int16) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint16) NIN(slice []int16) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var GeneralNotificationConfigWhere = struct {
-	GuildID           whereHelperint64
-	CreatedAt         whereHelpertime_Time
-	UpdatedAt         whereHelpertime_Time
-	JoinServerEnabled whereHelpernull_Bool
-	JoinServerChannel whereHelpernull_String
-	JoinServerMsgs    whereHelpernull_String
-	JoinDMEnabled     whereHelpernull_Bool
-	JoinDMMsg         whereHelpernull_String
-	LeaveEnabled      whereHelpernull_Bool
-	LeaveChannel      whereHelpernull_String
-	LeaveMsgs         whereHelpernull_String
-	TopicEnabled      whereHelpernull_Bool
-	TopicChannel      whereHelpernull_String
-	CensorInvites     whereHelpernull_Bool
+	GuildID             whereHelperint64
+	CreatedAt           whereHelpertime_Time
+	UpdatedAt           whereHelpertime_Time
+	JoinServerEnabled   whereHelpernull_Bool
+	JoinServerChannel   whereHelpernull_String
+	JoinServerMsgs      whereHelpernull_String
+	JoinDMEnabled       whereHelpernull_Bool
+	JoinDMMsg           whereHelpernull_String
+	LeaveEnabled        whereHelpernull_Bool
+	LeaveChannel        whereHelpernull_String
+	LeaveMsgs           whereHelpernull_String
+	TopicEnabled        whereHelpernull_Bool
+	TopicChannel        whereHelpernull_String
+	CensorInvites       whereHelpernull_Bool
+	JoinCardEnabled     whereHelperbool
+	LeaveCardEnabled    whereHelperbool
+	CardBackgroundURL   whereHelperstring
+	CardBackgroundColor whereHelperstring
+	CardTextColor       whereHelperstring
+	CardLayout          whereHelperint16
+	JoinCardTitle       whereHelperstring
+	JoinCardSubtitle    whereHelperstring
+	LeaveCardTitle      whereHelperstring
+	LeaveCardSubtitle   whereHelperstring
+	BoostEnabled        whereHelperbool
+	BoostChannel        whereHelperint64
+	BoostMsg            whereHelperstring
+	BoostEndEnabled     whereHelperbool
+	BoostEndChannel     whereHelperint64
+	BoostEndMsg         whereHelperstring
+	MilestoneEnabled    whereHelperbool
+	MilestoneChannel    whereHelperint64
+	MilestoneMsg        whereHelperstring
+	MilestoneInterval   whereHelperint
+	AnniversaryEnabled  whereHelperbool
+	AnniversaryChannel  whereHelperint64
+	AnniversaryMsg      whereHelperstring
 }{
-	GuildID:           whereHelperint64{field: "\"general_notification_configs\".\"guild_id\""},
-	CreatedAt:         whereHelpertime_Time{field: "\"general_notification_configs\".\"created_at\""},
-	UpdatedAt:         whereHelpertime_Time{field: "\"general_notification_configs\".\"updated_at\""},
-	JoinServerEnabled: whereHelpernull_Bool{field: "\"general_notification_configs\".\"join_server_enabled\""},
-	JoinServerChannel: whereHelpernull_String{field: "\"general_notification_configs\".\"join_server_channel\""},
-	JoinServerMsgs:    whereHelpernull_String{field: "\"general_notification_configs\".\"join_server_msgs\""},
-	JoinDMEnabled:     whereHelpernull_Bool{field: "\"general_notification_configs\".\"join_dm_enabled\""},
-	JoinDMMsg:         whereHelpernull_String{field: "\"general_notification_configs\".\"join_dm_msg\""},
-	LeaveEnabled:      whereHelpernull_Bool{field: "\"general_notification_configs\".\"leave_enabled\""},
-	LeaveChannel:      whereHelpernull_String{field: "\"general_notification_configs\".\"leave_channel\""},
-	LeaveMsgs:         whereHelpernull_String{field: "\"general_notification_configs\".\"leave_msgs\""},
-	TopicEnabled:      whereHelpernull_Bool{field: "\"general_notification_configs\".\"topic_enabled\""},
-	TopicChannel:      whereHelpernull_String{field: "\"general_notification_configs\".\"topic_channel\""},
-	CensorInvites:     whereHelpernull_Bool{field: "\"general_notification_configs\".\"censor_invites\""},
+	GuildID:             whereHelperint64{field: "\"general_notification_configs\".\"guild_id\""},
+	CreatedAt:           whereHelpertime_Time{field: "\"general_notification_configs\".\"created_at\""},
+	UpdatedAt:           whereHelpertime_Time{field: "\"general_notification_configs\".\"updated_at\""},
+	JoinServerEnabled:   whereHelpernull_Bool{field: "\"general_notification_configs\".\"join_server_enabled\""},
+	JoinServerChannel:   whereHelpernull_String{field: "\"general_notification_configs\".\"join_server_channel\""},
+	JoinServerMsgs:      whereHelpernull_String{field: "\"general_notification_configs\".\"join_server_msgs\""},
+	JoinDMEnabled:       whereHelpernull_Bool{field: "\"general_notification_configs\".\"join_dm_enabled\""},
+	JoinDMMsg:           whereHelpernull_String{field: "\"general_notification_configs\".\"join_dm_msg\""},
+	LeaveEnabled:        whereHelpernull_Bool{field: "\"general_notification_configs\".\"leave_enabled\""},
+	LeaveChannel:        whereHelpernull_String{field: "\"general_notification_configs\".\"leave_channel\""},
+	LeaveMsgs:           whereHelpernull_String{field: "\"general_notification_configs\".\"leave_msgs\""},
+	TopicEnabled:        whereHelpernull_Bool{field: "\"general_notification_configs\".\"topic_enabled\""},
+	TopicChannel:        whereHelpernull_String{field: "\"general_notification_configs\".\"topic_channel\""},
+	CensorInvites:       whereHelpernull_Bool{field: "\"general_notification_configs\".\"censor_invites\""},
+	JoinCardEnabled:     whereHelperbool{field: "\"general_notification_configs\".\"join_card_enabled\""},
+	LeaveCardEnabled:    whereHelperbool{field: "\"general_notification_configs\".\"leave_card_enabled\""},
+	CardBackgroundURL:   whereHelperstring{field: "\"general_notification_configs\".\"card_background_url\""},
+	CardBackgroundColor: whereHelperstring{field: "\"general_notification_configs\".\"card_background_color\""},
+	CardTextColor:       whereHelperstring{field: "\"general_notification_configs\".\"card_text_color\""},
+	CardLayout:          whereHelperint16{field: "\"general_notification_configs\".\"card_layout\""},
+	JoinCardTitle:       whereHelperstring{field: "\"general_notification_configs\".\"join_card_title\""},
+	JoinCardSubtitle:    whereHelperstring{field: "\"general_notification_configs\".\"join_card_subtitle\""},
+	LeaveCardTitle:      whereHelperstring{field: "\"general_notification_configs\".\"leave_card_title\""},
+	LeaveCardSubtitle:   whereHelperstring{field: "\"general_notification_configs\".\"leave_card_subtitle\""},
+	BoostEnabled:        whereHelperbool{field: "\"general_notification_configs\".\"boost_enabled\""},
+	BoostChannel:        whereHelperint64{field: "\"general_notification_configs\".\"boost_channel\""},
+	BoostMsg:            whereHelperstring{field: "\"general_notification_configs\".\"boost_msg\""},
+	BoostEndEnabled:     whereHelperbool{field: "\"general_notification_configs\".\"boost_end_enabled\""},
+	BoostEndChannel:     whereHelperint64{field: "\"general_notification_configs\".\"boost_end_channel\""},
+	BoostEndMsg:         whereHelperstring{field: "\"general_notification_configs\".\"boost_end_msg\""},
+	MilestoneEnabled:    whereHelperbool{field: "\"general_notification_configs\".\"milestone_enabled\""},
+	MilestoneChannel:    whereHelperint64{field: "\"general_notification_configs\".\"milestone_channel\""},
+	MilestoneMsg:        whereHelperstring{field: "\"general_notification_configs\".\"milestone_msg\""},
+	MilestoneInterval:   whereHelperint{field: "\"general_notification_configs\".\"milestone_interval\""},
+	AnniversaryEnabled:  whereHelperbool{field: "\"general_notification_configs\".\"anniversary_enabled\""},
+	AnniversaryChannel:  whereHelperint64{field: "\"general_notification_configs\".\"anniversary_channel\""},
+	AnniversaryMsg:      whereHelperstring{field: "\"general_notification_configs\".\"anniversary_msg\""},
 }
 
 // GeneralNotificationConfigRels is where relationship names are stored.
@@ -276,9 +519,9 @@ func (*generalNotificationConfigR) NewStruct() *generalNotificationConfigR {
 type generalNotificationConfigL struct{}
 
 var (
-	generalNotificationConfigAllColumns            = []string{"guild_id", "created_at", "updated_at", "join_server_enabled", "join_server_channel", "join_server_msgs", "join_dm_enabled", "join_dm_msg", "leave_enabled", "leave_channel", "leave_msgs", "topic_enabled", "topic_channel", "censor_invites"}
+	generalNotificationConfigAllColumns            = []string{"guild_id", "created_at", "updated_at", "join_server_enabled", "join_server_channel", "join_server_msgs", "join_dm_enabled", "join_dm_msg", "leave_enabled", "leave_channel", "leave_msgs", "topic_enabled", "topic_channel", "censor_invites", "join_card_enabled", "leave_card_enabled", "card_background_url", "card_background_color", "card_text_color", "card_layout", "join_card_title", "join_card_subtitle", "leave_card_title", "leave_card_subtitle", "boost_enabled", "boost_channel", "boost_msg", "boost_end_enabled", "boost_end_channel", "boost_end_msg", "milestone_enabled", "milestone_channel", "milestone_msg", "milestone_interval", "anniversary_enabled", "anniversary_channel", "anniversary_msg"}
 	generalNotificationConfigColumnsWithoutDefault = []string{"guild_id", "created_at", "updated_at"}
-	generalNotificationConfigColumnsWithDefault    = []string{"join_server_enabled", "join_server_channel", "join_server_msgs", "join_dm_enabled", "join_dm_msg", "leave_enabled", "leave_channel", "leave_msgs", "topic_enabled", "topic_channel", "censor_invites"}
+	generalNotificationConfigColumnsWithDefault    = []string{"join_server_enabled", "join_server_channel", "join_server_msgs", "join_dm_enabled", "join_dm_msg", "leave_enabled", "leave_channel", "leave_msgs", "topic_enabled", "topic_channel", "censor_invites", "join_card_enabled", "leave_card_enabled", "card_background_url", "card_background_color", "card_text_color", "card_layout", "join_card_title", "join_card_subtitle", "leave_card_title", "leave_card_subtitle", "boost_enabled", "boost_channel", "boost_msg", "boost_end_enabled", "boost_end_channel", "boost_end_msg", "milestone_enabled", "milestone_channel", "milestone_msg", "milestone_interval", "anniversary_enabled", "anniversary_channel", "anniversary_msg"}
 	generalNotificationConfigPrimaryKeyColumns     = []string{"guild_id"}
 	generalNotificationConfigGeneratedColumns      = []string{}
 )
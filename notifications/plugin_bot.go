@@ -1,7 +1,9 @@
 package notifications
 
 import (
+	"bytes"
 	"fmt"
+	"image"
 	"math/rand"
 	"strings"
 	"time"
@@ -12,6 +14,7 @@ import (
 	"github.com/ThatBathroom/yagpdb/v2/bot/eventsystem"
 	"github.com/ThatBathroom/yagpdb/v2/common"
 	"github.com/ThatBathroom/yagpdb/v2/common/pubsub"
+	"github.com/ThatBathroom/yagpdb/v2/common/scheduledevents2"
 	"github.com/ThatBathroom/yagpdb/v2/common/templates"
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
 	"github.com/ThatBathroom/yagpdb/v2/lib/dstate"
@@ -22,10 +25,15 @@ var _ bot.BotInitHandler = (*Plugin)(nil)
 
 func (p *Plugin) BotInit() {
 	eventsystem.AddHandlerAsyncLast(p, HandleGuildMemberAdd, eventsystem.EventGuildMemberAdd)
+	eventsystem.AddHandlerAsyncLast(p, HandleGuildMemberAddMilestones, eventsystem.EventGuildMemberAdd)
 	eventsystem.AddHandlerAsyncLast(p, HandleGuildMemberRemove, eventsystem.EventGuildMemberRemove)
+	eventsystem.AddHandlerAsyncLast(p, HandleGuildMemberUpdateBoost, eventsystem.EventGuildMemberUpdate)
 	eventsystem.AddHandlerFirst(p, HandleChannelUpdate, eventsystem.EventChannelUpdate)
 
 	pubsub.AddHandler("invalidate_notifications_config_cache", handleInvalidateConfigCache, nil)
+	pubsub.AddHandler("notifications_schedule_anniversaries", handleScheduleAnniversaries, nil)
+
+	scheduledevents2.RegisterHandler(anniversarySweepEventName, nil, handleAnniversarySweep)
 }
 
 var configCache = ccache.New(ccache.Configure().MaxSize(15000))
@@ -89,7 +97,7 @@ func HandleGuildMemberAdd(evtData *eventsystem.EventData) (retry bool, err error
 
 			go analytics.RecordActiveUnit(gs.ID, &Plugin{}, "posted_join_server_msg")
 
-			if sendTemplate(gs, thinCState, config.JoinDMMsg, ms, "join dm", false, templates.ExecutedFromJoin) {
+			if sendTemplate(gs, thinCState, config.JoinDMMsg, ms, "join dm", false, templates.ExecutedFromJoin, nil, nil) {
 				return true, nil
 			}
 		}
@@ -103,8 +111,13 @@ func HandleGuildMemberAdd(evtData *eventsystem.EventData) (retry bool, err error
 
 		go analytics.RecordActiveUnit(gs.ID, &Plugin{}, "posted_join_server_dm")
 
+		var card *discordgo.File
+		if config.JoinCardEnabled {
+			card = generateCard(gs, config, evt.User, config.JoinCardTitle, config.JoinCardSubtitle)
+		}
+
 		chanMsg := config.JoinServerMsgs[rand.Intn(len(config.JoinServerMsgs))]
-		if sendTemplate(gs, channel, chanMsg, ms, "join server msg", config.CensorInvites, templates.ExecutedFromJoin) {
+		if sendTemplate(gs, channel, chanMsg, ms, "join server msg", config.CensorInvites, templates.ExecutedFromJoin, nil, card) {
 			return true, nil
 		}
 	}
@@ -140,7 +153,12 @@ func HandleGuildMemberRemove(evt *eventsystem.EventData) (retry bool, err error)
 
 	go analytics.RecordActiveUnit(gs.ID, &Plugin{}, "posted_leave_server_msg")
 
-	if sendTemplate(gs, channel, chanMsg, ms, "leave", config.CensorInvites, templates.ExecutedFromLeave) {
+	var card *discordgo.File
+	if config.LeaveCardEnabled {
+		card = generateCard(gs, config, memberRemove.User, config.LeaveCardTitle, config.LeaveCardSubtitle)
+	}
+
+	if sendTemplate(gs, channel, chanMsg, ms, "leave", config.CensorInvites, templates.ExecutedFromLeave, nil, card) {
 		return true, nil
 	}
 
@@ -148,10 +166,14 @@ func HandleGuildMemberRemove(evt *eventsystem.EventData) (retry bool, err error)
 }
 
 // sendTemplate parses and executes the provided template, returns wether an error occured that we can retry from (temporary network failures and the like)
-func sendTemplate(gs *dstate.GuildSet, cs *dstate.ChannelState, tmpl string, ms *dstate.MemberState, name string, censorInvites bool, executedFrom templates.ExecutedFromType) bool {
+// data is optional extra template data, card is an optional image attached to the message, if set the message is sent even when the template output is empty
+func sendTemplate(gs *dstate.GuildSet, cs *dstate.ChannelState, tmpl string, ms *dstate.MemberState, name string, censorInvites bool, executedFrom templates.ExecutedFromType, data map[string]interface{}, card *discordgo.File) bool {
 	ctx := templates.NewContext(gs, cs, ms)
 	ctx.CurrentFrame.SendResponseInDM = cs.Type == discordgo.ChannelTypeDM
 	ctx.ExecutedFrom = executedFrom
+	for k, v := range data {
+		ctx.Data[k] = v
+	}
 
 	// since were changing the fields, we need a copy
 	msCop := *ms
@@ -180,7 +202,7 @@ func sendTemplate(gs *dstate.GuildSet, cs *dstate.ChannelState, tmpl string, ms
 	}
 
 	msg = strings.TrimSpace(msg)
-	if msg == "" {
+	if msg == "" && card == nil {
 		return false
 	}
 
@@ -191,8 +213,12 @@ func sendTemplate(gs *dstate.GuildSet, cs *dstate.ChannelState, tmpl string, ms
 		msgSend.Components = bot.GenerateServerInfoButton(gs.ID)
 		m, err = common.BotSession.ChannelMessageSendComplex(cs.ID, msgSend)
 	} else {
-		if len(ctx.CurrentFrame.AddResponseReactionNames) > 0 || ctx.CurrentFrame.DelResponse || ctx.CurrentFrame.PublishResponse {
-			m, err = common.BotSession.ChannelMessageSendComplex(cs.ID, ctx.MessageSend(msg))
+		if card != nil || len(ctx.CurrentFrame.AddResponseReactionNames) > 0 || ctx.CurrentFrame.DelResponse || ctx.CurrentFrame.PublishResponse {
+			msgSend := ctx.MessageSend(msg)
+			if card != nil {
+				msgSend.Files = []*discordgo.File{card}
+			}
+			m, err = common.BotSession.ChannelMessageSendComplex(cs.ID, msgSend)
 			if err == nil && ctx.CurrentFrame.DelResponse {
 				templates.MaybeScheduledDeleteMessage(gs.ID, cs.ID, m.ID, ctx.CurrentFrame.DelResponseDelay, "")
 			}
@@ -228,6 +254,59 @@ func sendTemplate(gs *dstate.GuildSet, cs *dstate.ChannelState, tmpl string, ms
 	return bot.CheckDiscordErrRetry(err)
 }
 
+var cardBackgroundCache = ccache.New(ccache.Configure().MaxSize(1000))
+
+// generateCard renders the welcome/leave card for the user, returns nil if it failed
+func generateCard(gs *dstate.GuildSet, config *Config, user *discordgo.User, title, subtitle string) *discordgo.File {
+	bgColor, _ := parseHexColor(config.CardBackgroundColor)
+	textColor, _ := parseHexColor(config.CardTextColor)
+
+	username := user.Username
+	if config.CensorInvites {
+		username = common.ReplaceServerInvites(username, gs.ID, "[removed-server-invite]")
+	}
+
+	opts := &CardOptions{
+		Layout:          config.CardLayout,
+		Title:           FormatCardText(title, username, gs.Name, gs.MemberCount),
+		Subtitle:        FormatCardText(subtitle, username, gs.Name, gs.MemberCount),
+		BackgroundColor: bgColor,
+		TextColor:       textColor,
+	}
+
+	l := logger.WithField("guild", gs.ID)
+
+	avatar, err := fetchCardImage(user.AvatarURL("256"))
+	if err != nil {
+		l.WithError(err).Warn("Failed fetching avatar for card")
+	} else {
+		opts.Avatar = avatar
+	}
+
+	if config.CardBackgroundURL != "" {
+		item, err := cardBackgroundCache.Fetch(config.CardBackgroundURL, 10*time.Minute, func() (interface{}, error) {
+			return fetchCardImage(config.CardBackgroundURL)
+		})
+		if err != nil {
+			l.WithError(err).Warn("Failed fetching card background")
+		} else {
+			opts.Background = item.Value().(image.Image)
+		}
+	}
+
+	img, err := RenderCard(opts)
+	if err != nil {
+		l.WithError(err).Error("Failed rendering card")
+		return nil
+	}
+
+	return &discordgo.File{
+		Name:        "card.png",
+		ContentType: "image/png",
+		Reader:      bytes.NewReader(img),
+	}
+}
+
 func HandleChannelUpdate(evt *eventsystem.EventData) (retry bool, err error) {
 	cu := evt.ChannelUpdate()
 
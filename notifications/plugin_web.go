@@ -8,6 +8,7 @@ import (
 
 	"github.com/ThatBathroom/yagpdb/v2/common"
 	"github.com/ThatBathroom/yagpdb/v2/common/cplogs"
+	"github.com/ThatBathroom/yagpdb/v2/common/pubsub"
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
 	"github.com/ThatBathroom/yagpdb/v2/web"
 	"goji.io/pat"
@@ -63,11 +64,22 @@ func HandleNotificationsPost(w http.ResponseWriter, r *http.Request) (web.Templa
 	newConfig := ctx.Value(common.ContextKeyParsedForm).(*Config)
 
 	newConfig.GuildID = activeGuild.ID
-	err := SaveConfig(newConfig)
+
+	oldConfig, err := FetchConfig(activeGuild.ID)
+	if err != nil {
+		return templateData, err
+	}
+
+	err = SaveConfig(newConfig)
 	if err != nil {
 		return templateData, nil
 	}
 
+	// start the daily anniversary sweep
+	if newConfig.AnniversaryEnabled && !oldConfig.AnniversaryEnabled {
+		go pubsub.Publish("notifications_schedule_anniversaries", activeGuild.ID, nil)
+	}
+
 	go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKey))
 
 	return templateData, nil
@@ -91,9 +103,13 @@ func (p *Plugin) LoadServerHomeWidget(w http.ResponseWriter, r *http.Request) (w
 	<li>Join DM message: %s</li>
 	<li>Leave message: %s</li>
 	<li>Topic change message: %s</li>
+	<li>Boost messages: %s</li>
+	<li>Milestone message: %s</li>
+	<li>Anniversary message: %s</li>
 </ul>`
 
-	if config.JoinServerEnabled || config.JoinDMEnabled || config.LeaveEnabled || config.TopicEnabled {
+	if config.JoinServerEnabled || config.JoinDMEnabled || config.LeaveEnabled || config.TopicEnabled ||
+		config.BoostEnabled || config.BoostEndEnabled || config.MilestoneEnabled || config.AnniversaryEnabled {
 		templateData["WidgetEnabled"] = true
 	} else {
 		templateData["WidgetDisabled"] = true
@@ -101,7 +117,9 @@ func (p *Plugin) LoadServerHomeWidget(w http.ResponseWriter, r *http.Request) (w
 
 	templateData["WidgetBody"] = template.HTML(fmt.Sprintf(format,
 		web.EnabledDisabledSpanStatus(config.JoinServerEnabled), web.EnabledDisabledSpanStatus(config.JoinDMEnabled),
-		web.EnabledDisabledSpanStatus(config.LeaveEnabled), web.EnabledDisabledSpanStatus(config.TopicEnabled)))
+		web.EnabledDisabledSpanStatus(config.LeaveEnabled), web.EnabledDisabledSpanStatus(config.TopicEnabled),
+		web.EnabledDisabledSpanStatus(config.BoostEnabled || config.BoostEndEnabled), web.EnabledDisabledSpanStatus(config.MilestoneEnabled),
+		web.EnabledDisabledSpanStatus(config.AnniversaryEnabled)))
 
 	return templateData, nil
 }
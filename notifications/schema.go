@@ -78,4 +78,50 @@ AND EXISTS(SELECT 1 FROM information_schema.columns WHERE table_name='general_no
 	ALTER TABLE general_notification_configs RENAME COLUMN leave_msgs_ to leave_msgs;
 END IF;
 END $$;
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS join_card_enabled BOOLEAN NOT NULL DEFAULT false;
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS leave_card_enabled BOOLEAN NOT NULL DEFAULT false;
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS card_background_url TEXT NOT NULL DEFAULT '';
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS card_background_color TEXT NOT NULL DEFAULT '#23272a';
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS card_text_color TEXT NOT NULL DEFAULT '#ffffff';
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS card_layout SMALLINT NOT NULL DEFAULT 0;
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS join_card_title TEXT NOT NULL DEFAULT 'Welcome {username}!';
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS join_card_subtitle TEXT NOT NULL DEFAULT 'You are member #{count} of {server}';
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS leave_card_title TEXT NOT NULL DEFAULT 'Goodbye {username}';
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS leave_card_subtitle TEXT NOT NULL DEFAULT '{server} now has {count} members';
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS boost_enabled BOOLEAN NOT NULL DEFAULT false;
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS boost_channel BIGINT NOT NULL DEFAULT 0;
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS boost_msg TEXT NOT NULL DEFAULT '{{.User.Mention}} just boosted the server!';
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS boost_end_enabled BOOLEAN NOT NULL DEFAULT false;
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS boost_end_channel BIGINT NOT NULL DEFAULT 0;
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS boost_end_msg TEXT NOT NULL DEFAULT '**{{.User.Username}}** is no longer boosting the server.';
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS milestone_enabled BOOLEAN NOT NULL DEFAULT false;
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS milestone_channel BIGINT NOT NULL DEFAULT 0;
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS milestone_msg TEXT NOT NULL DEFAULT 'We just reached **{{.MemberCount}}** members!';
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS milestone_interval INT NOT NULL DEFAULT 100;
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS anniversary_enabled BOOLEAN NOT NULL DEFAULT false;
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS anniversary_channel BIGINT NOT NULL DEFAULT 0;
+`, `
+ALTER TABLE general_notification_configs ADD COLUMN IF NOT EXISTS anniversary_msg TEXT NOT NULL DEFAULT '{{.User.Mention}} joined the server {{.Years}} year(s) ago today!';
 `}
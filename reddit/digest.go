@@ -0,0 +1,70 @@
+package reddit
+
+import (
+	"context"
+	"database/sql"
+	"html"
+
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/feeds"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/lib/go-reddit"
+	"github.com/ThatBathroom/yagpdb/v2/reddit/models"
+)
+
+var _ feeds.DigestPlugin = (*Plugin)(nil)
+
+func digestSettings(feed *models.RedditFeed) feeds.DigestSettings {
+	return feeds.DigestSettings{
+		Mode:          feed.DigestMode,
+		IntervalHours: feed.DigestIntervalHours,
+		DailyAt:       feed.DigestDailyAt,
+	}
+}
+
+// queueDigestPost adds the post to the next digest of the feed, custom is the output of the feed's template which
+// replaces the default line of the post
+func queueDigestPost(feed *models.RedditFeed, post *reddit.Link, custom string) error {
+	title := html.UnescapeString(post.Title)
+	if post.Spoiler && feed.SpoilersEnabled {
+		title = "||" + common.CutStringShort(title, 96) + "||"
+	}
+
+	return feeds.QueueDigestItem("reddit", feed.ID, feed.GuildID, feed.ChannelID, digestSettings(feed), &feeds.DigestItem{
+		Title:  title,
+		URL:    "https://redd.it/" + post.ID,
+		Author: post.Author,
+		Extra:  post.LinkFlairText,
+		Line:   custom,
+	})
+}
+
+// DigestTarget implements feeds.DigestPlugin
+func (p *Plugin) DigestTarget(subscriptionID int64) (*feeds.DigestTarget, error) {
+	feed, err := models.FindRedditFeedG(context.Background(), subscriptionID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	settings := digestSettings(feed)
+	if feed.Disabled || settings.Immediate() {
+		return nil, nil
+	}
+
+	return &feeds.DigestTarget{
+		GuildID:   feed.GuildID,
+		ChannelID: feed.ChannelID,
+		Settings:  settings,
+		Name:      "r/" + feed.Subreddit,
+		URL:       "https://reddit.com/r/" + feed.Subreddit,
+		Color:     0xff4500,
+		AllowedMentions: discordgo.AllowedMentions{
+			Parse: []discordgo.AllowedMentionType{},
+		},
+		UseWebhook:      true,
+		WebhookUsername: "Reddit • YAGPDB",
+	}, nil
+}
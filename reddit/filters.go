@@ -0,0 +1,94 @@
+package reddit
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ThatBathroom/yagpdb/v2/lib/go-reddit"
+	"github.com/ThatBathroom/yagpdb/v2/reddit/models"
+	"github.com/karlseguin/ccache"
+)
+
+// compiled title regexes, keyed by the pattern, bounded as patterns change whenever users edit their feeds
+var titleRegexCache = ccache.New(ccache.Configure().MaxSize(5000))
+
+func compiledTitleRegex(pattern string) (*regexp.Regexp, error) {
+	item, err := titleRegexCache.Fetch(pattern, time.Hour, func() (interface{}, error) {
+		return regexp.Compile("(?i)" + pattern)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return item.Value().(*regexp.Regexp), nil
+}
+
+// PostMatchesFilters returns true if the post passes the flair, author and title filters of the feed
+func PostMatchesFilters(feed *models.RedditFeed, post *reddit.Link) bool {
+	if len(feed.IncludeFlairs) > 0 && !containsFold(feed.IncludeFlairs, post.LinkFlairText) {
+		return false
+	}
+
+	if containsFold(feed.ExcludeFlairs, post.LinkFlairText) {
+		return false
+	}
+
+	if len(feed.IncludeAuthors) > 0 && !containsFold(feed.IncludeAuthors, post.Author) {
+		return false
+	}
+
+	if containsFold(feed.ExcludeAuthors, post.Author) {
+		return false
+	}
+
+	title := html.UnescapeString(post.Title)
+	if feed.TitleRegex != "" {
+		re, err := compiledTitleRegex(feed.TitleRegex)
+		if err != nil || !re.MatchString(title) {
+			return false
+		}
+	}
+
+	if feed.TitleExcludeRegex != "" {
+		re, err := compiledTitleRegex(feed.TitleExcludeRegex)
+		if err != nil || re.MatchString(title) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsFold(list []string, s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseFilterList parses the newline separated list of flairs or authors from the control panel
+func ParseFilterList(s string, maxEntries int) []string {
+	result := make([]string, 0)
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || containsFold(result, line) {
+			continue
+		}
+
+		result = append(result, line)
+		if len(result) >= maxEntries {
+			break
+		}
+	}
+
+	return result
+}
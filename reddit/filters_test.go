@@ -0,0 +1,42 @@
+package reddit
+
+import (
+	"testing"
+
+	"github.com/ThatBathroom/yagpdb/v2/lib/go-reddit"
+	"github.com/ThatBathroom/yagpdb/v2/reddit/models"
+)
+
+func TestPostMatchesFilters(t *testing.T) {
+	post := &reddit.Link{Title: "Patch notes &amp; fixes", Author: "SomeDev", LinkFlairText: "News"}
+
+	cases := []struct {
+		name string
+		feed *models.RedditFeed
+		want bool
+	}{
+		{"no filters", &models.RedditFeed{}, true},
+		{"include flair", &models.RedditFeed{IncludeFlairs: []string{"news"}}, true},
+		{"include flair miss", &models.RedditFeed{IncludeFlairs: []string{"meme"}}, false},
+		{"exclude flair", &models.RedditFeed{ExcludeFlairs: []string{"NEWS"}}, false},
+		{"include author", &models.RedditFeed{IncludeAuthors: []string{"somedev"}}, true},
+		{"exclude author", &models.RedditFeed{ExcludeAuthors: []string{"somedev"}}, false},
+		{"title regex", &models.RedditFeed{TitleRegex: `patch notes & fixes`}, true},
+		{"title regex miss", &models.RedditFeed{TitleRegex: `^release`}, false},
+		{"title exclude regex", &models.RedditFeed{TitleExcludeRegex: `fix(es)?`}, false},
+		{"invalid regex", &models.RedditFeed{TitleRegex: `(`}, false},
+	}
+
+	for _, c := range cases {
+		if got := PostMatchesFilters(c.feed, post); got != c.want {
+			t.Errorf("%s: got %t, want %t", c.name, got, c.want)
+		}
+	}
+}
+
+func TestParseFilterList(t *testing.T) {
+	got := ParseFilterList("news\n  News \n\nmeta\r\nother", 2)
+	if len(got) != 2 || got[0] != "news" || got[1] != "meta" {
+		t.Errorf("unexpected result: %v", got)
+	}
+}
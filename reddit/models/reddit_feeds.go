@@ -18,72 +18,123 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
 	"github.com/volatiletech/strmangle"
 )
 
 // RedditFeed is an object representing the database table.
 type RedditFeed struct {
-	ID              int64  `boil:"id" json:"id" toml:"id" yaml:"id"`
-	GuildID         int64  `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
-	ChannelID       int64  `boil:"channel_id" json:"channel_id" toml:"channel_id" yaml:"channel_id"`
-	Subreddit       string `boil:"subreddit" json:"subreddit" toml:"subreddit" yaml:"subreddit"`
-	FilterNSFW      int    `boil:"filter_nsfw" json:"filter_nsfw" toml:"filter_nsfw" yaml:"filter_nsfw"`
-	MinUpvotes      int    `boil:"min_upvotes" json:"min_upvotes" toml:"min_upvotes" yaml:"min_upvotes"`
-	UseEmbeds       bool   `boil:"use_embeds" json:"use_embeds" toml:"use_embeds" yaml:"use_embeds"`
-	Slow            bool   `boil:"slow" json:"slow" toml:"slow" yaml:"slow"`
-	Disabled        bool   `boil:"disabled" json:"disabled" toml:"disabled" yaml:"disabled"`
-	SpoilersEnabled bool   `boil:"spoilers_enabled" json:"spoilers_enabled" toml:"spoilers_enabled" yaml:"spoilers_enabled"`
+	ID                  int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	GuildID             int64             `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	ChannelID           int64             `boil:"channel_id" json:"channel_id" toml:"channel_id" yaml:"channel_id"`
+	Subreddit           string            `boil:"subreddit" json:"subreddit" toml:"subreddit" yaml:"subreddit"`
+	FilterNSFW          int               `boil:"filter_nsfw" json:"filter_nsfw" toml:"filter_nsfw" yaml:"filter_nsfw"`
+	MinUpvotes          int               `boil:"min_upvotes" json:"min_upvotes" toml:"min_upvotes" yaml:"min_upvotes"`
+	UseEmbeds           bool              `boil:"use_embeds" json:"use_embeds" toml:"use_embeds" yaml:"use_embeds"`
+	Slow                bool              `boil:"slow" json:"slow" toml:"slow" yaml:"slow"`
+	Disabled            bool              `boil:"disabled" json:"disabled" toml:"disabled" yaml:"disabled"`
+	SpoilersEnabled     bool              `boil:"spoilers_enabled" json:"spoilers_enabled" toml:"spoilers_enabled" yaml:"spoilers_enabled"`
+	MessageTemplate     string            `boil:"message_template" json:"message_template" toml:"message_template" yaml:"message_template"`
+	IncludeFlairs       types.StringArray `boil:"include_flairs" json:"include_flairs" toml:"include_flairs" yaml:"include_flairs"`
+	ExcludeFlairs       types.StringArray `boil:"exclude_flairs" json:"exclude_flairs" toml:"exclude_flairs" yaml:"exclude_flairs"`
+	IncludeAuthors      types.StringArray `boil:"include_authors" json:"include_authors" toml:"include_authors" yaml:"include_authors"`
+	ExcludeAuthors      types.StringArray `boil:"exclude_authors" json:"exclude_authors" toml:"exclude_authors" yaml:"exclude_authors"`
+	TitleRegex          string            `boil:"title_regex" json:"title_regex" toml:"title_regex" yaml:"title_regex"`
+	TitleExcludeRegex   string            `boil:"title_exclude_regex" json:"title_exclude_regex" toml:"title_exclude_regex" yaml:"title_exclude_regex"`
+	DigestIntervalHours int               `boil:"digest_interval_hours" json:"digest_interval_hours" toml:"digest_interval_hours" yaml:"digest_interval_hours"`
+	DigestMode          string            `boil:"digest_mode" json:"digest_mode" toml:"digest_mode" yaml:"digest_mode"`
+	DigestDailyAt       int               `boil:"digest_daily_at" json:"digest_daily_at" toml:"digest_daily_at" yaml:"digest_daily_at"`
 
 	R *redditFeedR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L redditFeedL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var RedditFeedColumns = struct {
-	ID              string
-	GuildID         string
-	ChannelID       string
-	Subreddit       string
-	FilterNSFW      string
-	MinUpvotes      string
-	UseEmbeds       string
-	Slow            string
-	Disabled        string
-	SpoilersEnabled string
+	ID                  string
+	GuildID             string
+	ChannelID           string
+	Subreddit           string
+	FilterNSFW          string
+	MinUpvotes          string
+	UseEmbeds           string
+	Slow                string
+	Disabled            string
+	SpoilersEnabled     string
+	MessageTemplate     string
+	IncludeFlairs       string
+	ExcludeFlairs       string
+	IncludeAuthors      string
+	ExcludeAuthors      string
+	TitleRegex          string
+	TitleExcludeRegex   string
+	DigestIntervalHours string
+	DigestMode          string
+	DigestDailyAt       string
 }{
-	ID:              "id",
-	GuildID:         "guild_id",
-	ChannelID:       "channel_id",
-	Subreddit:       "subreddit",
-	FilterNSFW:      "filter_nsfw",
-	MinUpvotes:      "min_upvotes",
-	UseEmbeds:       "use_embeds",
-	Slow:            "slow",
-	Disabled:        "disabled",
-	SpoilersEnabled: "spoilers_enabled",
+	ID:                  "id",
+	GuildID:             "guild_id",
+	ChannelID:           "channel_id",
+	Subreddit:           "subreddit",
+	FilterNSFW:          "filter_nsfw",
+	MinUpvotes:          "min_upvotes",
+	UseEmbeds:           "use_embeds",
+	Slow:                "slow",
+	Disabled:            "disabled",
+	SpoilersEnabled:     "spoilers_enabled",
+	MessageTemplate:     "message_template",
+	IncludeFlairs:       "include_flairs",
+	ExcludeFlairs:       "exclude_flairs",
+	IncludeAuthors:      "include_authors",
+	ExcludeAuthors:      "exclude_authors",
+	TitleRegex:          "title_regex",
+	TitleExcludeRegex:   "title_exclude_regex",
+	DigestIntervalHours: "digest_interval_hours",
+	DigestMode:          "digest_mode",
+	DigestDailyAt:       "digest_daily_at",
 }
 
 var RedditFeedTableColumns = struct {
-	ID              string
-	GuildID         string
-	ChannelID       string
-	Subreddit       string
-	FilterNSFW      string
-	MinUpvotes      string
-	UseEmbeds       string
-	Slow            string
-	Disabled        string
-	SpoilersEnabled string
+	ID                  string
+	GuildID             string
+	ChannelID           string
+	Subreddit           string
+	FilterNSFW          string
+	MinUpvotes          string
+	UseEmbeds           string
+	Slow                string
+	Disabled            string
+	SpoilersEnabled     string
+	MessageTemplate     string
+	IncludeFlairs       string
+	ExcludeFlairs       string
+	IncludeAuthors      string
+	ExcludeAuthors      string
+	TitleRegex          string
+	TitleExcludeRegex   string
+	DigestIntervalHours string
+	DigestMode          string
+	DigestDailyAt       string
 }{
-	ID:              "reddit_feeds.id",
-	GuildID:         "reddit_feeds.guild_id",
-	ChannelID:       "reddit_feeds.channel_id",
-	Subreddit:       "reddit_feeds.subreddit",
-	FilterNSFW:      "reddit_feeds.filter_nsfw",
-	MinUpvotes:      "reddit_feeds.min_upvotes",
-	UseEmbeds:       "reddit_feeds.use_embeds",
-	Slow:            "reddit_feeds.slow",
-	Disabled:        "reddit_feeds.disabled",
-	SpoilersEnabled: "reddit_feeds.spoilers_enabled",
+	ID:                  "reddit_feeds.id",
+	GuildID:             "reddit_feeds.guild_id",
+	ChannelID:           "reddit_feeds.channel_id",
+	Subreddit:           "reddit_feeds.subreddit",
+	FilterNSFW:          "reddit_feeds.filter_nsfw",
+	MinUpvotes:          "reddit_feeds.min_upvotes",
+	UseEmbeds:           "reddit_feeds.use_embeds",
+	Slow:                "reddit_feeds.slow",
+	Disabled:            "reddit_feeds.disabled",
+	SpoilersEnabled:     "reddit_feeds.spoilers_enabled",
+	MessageTemplate:     "reddit_feeds.message_template",
+	IncludeFlairs:       "reddit_feeds.include_flairs",
+	ExcludeFlairs:       "reddit_feeds.exclude_flairs",
+	IncludeAuthors:      "reddit_feeds.include_authors",
+	ExcludeAuthors:      "reddit_feeds.exclude_authors",
+	TitleRegex:          "reddit_feeds.title_regex",
+	TitleExcludeRegex:   "reddit_feeds.title_exclude_regex",
+	DigestIntervalHours: "reddit_feeds.digest_interval_hours",
+	DigestMode:          "reddit_feeds.digest_mode",
+	DigestDailyAt:       "reddit_feeds.digest_daily_at",
 }
 
 // Generated where
@@ -170,28 +221,69 @@ func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field
 func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
 func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
+type whereHelpertypes_StringArray struct{ field string }
+
+func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
 var RedditFeedWhere = struct {
-	ID              whereHelperint64
-	GuildID         whereHelperint64
-	ChannelID       whereHelperint64
-	Subreddit       whereHelperstring
-	FilterNSFW      whereHelperint
-	MinUpvotes      whereHelperint
-	UseEmbeds       whereHelperbool
-	Slow            whereHelperbool
-	Disabled        whereHelperbool
-	SpoilersEnabled whereHelperbool
+	ID                  whereHelperint64
+	GuildID             whereHelperint64
+	ChannelID           whereHelperint64
+	Subreddit           whereHelperstring
+	FilterNSFW          whereHelperint
+	MinUpvotes          whereHelperint
+	UseEmbeds           whereHelperbool
+	Slow                whereHelperbool
+	Disabled            whereHelperbool
+	SpoilersEnabled     whereHelperbool
+	MessageTemplate     whereHelperstring
+	IncludeFlairs       whereHelpertypes_StringArray
+	ExcludeFlairs       whereHelpertypes_StringArray
+	IncludeAuthors      whereHelpertypes_StringArray
+	ExcludeAuthors      whereHelpertypes_StringArray
+	TitleRegex          whereHelperstring
+	TitleExcludeRegex   whereHelperstring
+	DigestIntervalHours whereHelperint
+	DigestMode          whereHelperstring
+	DigestDailyAt       whereHelperint
 }{
-	ID:              whereHelperint64{field: "\"reddit_feeds\".\"id\""},
-	GuildID:         whereHelperint64{field: "\"reddit_feeds\".\"guild_id\""},
-	ChannelID:       whereHelperint64{field: "\"reddit_feeds\".\"channel_id\""},
-	Subreddit:       whereHelperstring{field: "\"reddit_feeds\".\"subreddit\""},
-	FilterNSFW:      whereHelperint{field: "\"reddit_feeds\".\"filter_nsfw\""},
-	MinUpvotes:      whereHelperint{field: "\"reddit_feeds\".\"min_upvotes\""},
-	UseEmbeds:       whereHelperbool{field: "\"reddit_feeds\".\"use_embeds\""},
-	Slow:            whereHelperbool{field: "\"reddit_feeds\".\"slow\""},
-	Disabled:        whereHelperbool{field: "\"reddit_feeds\".\"disabled\""},
-	SpoilersEnabled: whereHelperbool{field: "\"reddit_feeds\".\"spoilers_enabled\""},
+	ID:                  whereHelperint64{field: "\"reddit_feeds\".\"id\""},
+	GuildID:             whereHelperint64{field: "\"reddit_feeds\".\"guild_id\""},
+	ChannelID:           whereHelperint64{field: "\"reddit_feeds\".\"channel_id\""},
+	Subreddit:           whereHelperstring{field: "\"reddit_feeds\".\"subreddit\""},
+	FilterNSFW:          whereHelperint{field: "\"reddit_feeds\".\"filter_nsfw\""},
+	MinUpvotes:          whereHelperint{field: "\"reddit_feeds\".\"min_upvotes\""},
+	UseEmbeds:           whereHelperbool{field: "\"reddit_feeds\".\"use_embeds\""},
+	Slow:                whereHelperbool{field: "\"reddit_feeds\".\"slow\""},
+	Disabled:            whereHelperbool{field: "\"reddit_feeds\".\"disabled\""},
+	SpoilersEnabled:     whereHelperbool{field: "\"reddit_feeds\".\"spoilers_enabled\""},
+	MessageTemplate:     whereHelperstring{field: "\"reddit_feeds\".\"message_template\""},
+	IncludeFlairs:       whereHelpertypes_StringArray{field: "\"reddit_feeds\".\"include_flairs\""},
+	ExcludeFlairs:       whereHelpertypes_StringArray{field: "\"reddit_feeds\".\"exclude_flairs\""},
+	IncludeAuthors:      whereHelpertypes_StringArray{field: "\"reddit_feeds\".\"include_authors\""},
+	ExcludeAuthors:      whereHelpertypes_StringArray{field: "\"reddit_feeds\".\"exclude_authors\""},
+	TitleRegex:          whereHelperstring{field: "\"reddit_feeds\".\"title_regex\""},
+	TitleExcludeRegex:   whereHelperstring{field: "\"reddit_feeds\".\"title_exclude_regex\""},
+	DigestIntervalHours: whereHelperint{field: "\"reddit_feeds\".\"digest_interval_hours\""},
+	DigestMode:          whereHelperstring{field: "\"reddit_feeds\".\"digest_mode\""},
+	DigestDailyAt:       whereHelperint{field: "\"reddit_feeds\".\"digest_daily_at\""},
 }
 
 // RedditFeedRels is where relationship names are stored.
@@ -211,9 +303,9 @@ func (*redditFeedR) NewStruct() *redditFeedR {
 type redditFeedL struct{}
 
 var (
-	redditFeedAllColumns            = []string{"id", "guild_id", "channel_id", "subreddit", "filter_nsfw", "min_upvotes", "use_embeds", "slow", "disabled", "spoilers_enabled"}
+	redditFeedAllColumns            = []string{"id", "guild_id", "channel_id", "subreddit", "filter_nsfw", "min_upvotes", "use_embeds", "slow", "disabled", "spoilers_enabled", "message_template", "include_flairs", "exclude_flairs", "include_authors", "exclude_authors", "title_regex", "title_exclude_regex", "digest_interval_hours", "digest_mode", "digest_daily_at"}
 	redditFeedColumnsWithoutDefault = []string{"guild_id", "channel_id", "subreddit", "filter_nsfw", "min_upvotes", "use_embeds", "slow"}
-	redditFeedColumnsWithDefault    = []string{"id", "disabled", "spoilers_enabled"}
+	redditFeedColumnsWithDefault    = []string{"id", "disabled", "spoilers_enabled", "message_template", "include_flairs", "exclude_flairs", "include_authors", "exclude_authors", "title_regex", "title_exclude_regex", "digest_interval_hours", "digest_mode", "digest_daily_at"}
 	redditFeedPrimaryKeyColumns     = []string{"id"}
 	redditFeedGeneratedColumns      = []string{}
 )
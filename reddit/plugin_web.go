@@ -13,6 +13,7 @@ import (
 	"github.com/ThatBathroom/yagpdb/v2/common"
 	"github.com/ThatBathroom/yagpdb/v2/common/cplogs"
 	"github.com/ThatBathroom/yagpdb/v2/common/pubsub"
+	"github.com/ThatBathroom/yagpdb/v2/feeds"
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
 	"github.com/ThatBathroom/yagpdb/v2/reddit/models"
 	"github.com/ThatBathroom/yagpdb/v2/web"
@@ -40,6 +41,19 @@ type CreateForm struct {
 	NSFWMode        int    `schema:"nsfw_filter"`
 	SpoilersEnabled bool   `schema:"spoilers_enabled"`
 	MinUpvotes      int    `schema:"min_upvotes" valid:"0,"`
+
+	MessageTemplate   string `schema:"message_template" valid:"template,2000"`
+	IncludeFlairs     string `schema:"include_flairs" valid:",2000"`
+	ExcludeFlairs     string `schema:"exclude_flairs" valid:",2000"`
+	IncludeAuthors    string `schema:"include_authors" valid:",2000"`
+	ExcludeAuthors    string `schema:"exclude_authors" valid:",2000"`
+	TitleRegex        string `schema:"title_regex" valid:"regex,200"`
+	TitleExcludeRegex string `schema:"title_exclude_regex" valid:"regex,200"`
+	DigestInterval    int    `schema:"digest_interval" valid:"0,24"`
+
+	// named after the fields of the shared feed_digest_fields template
+	DigestMode    string `schema:"DigestMode"`
+	DigestDailyAt string `schema:"DigestDailyAt"`
 }
 
 type UpdateForm struct {
@@ -50,6 +64,19 @@ type UpdateForm struct {
 	SpoilersEnabled bool  `schema:"spoilers_enabled"`
 	MinUpvotes      int   `schema:"min_upvotes" valid:"0,"`
 	FeedEnabled     bool  `schema:"feed_enabled"`
+
+	MessageTemplate   string `schema:"message_template" valid:"template,2000"`
+	IncludeFlairs     string `schema:"include_flairs" valid:",2000"`
+	ExcludeFlairs     string `schema:"exclude_flairs" valid:",2000"`
+	IncludeAuthors    string `schema:"include_authors" valid:",2000"`
+	ExcludeAuthors    string `schema:"exclude_authors" valid:",2000"`
+	TitleRegex        string `schema:"title_regex" valid:"regex,200"`
+	TitleExcludeRegex string `schema:"title_exclude_regex" valid:"regex,200"`
+	DigestInterval    int    `schema:"digest_interval" valid:"0,24"`
+
+	// named after the fields of the shared feed_digest_fields template
+	DigestMode    string `schema:"DigestMode"`
+	DigestDailyAt string `schema:"DigestDailyAt"`
 }
 
 var (
@@ -156,6 +183,16 @@ func HandleNew(w http.ResponseWriter, r *http.Request) interface{} {
 		watchItem.MinUpvotes = newElem.MinUpvotes
 	}
 
+	watchItem.MessageTemplate = newElem.MessageTemplate
+	watchItem.IncludeFlairs = ParseFilterList(newElem.IncludeFlairs, MaxFilterEntries)
+	watchItem.ExcludeFlairs = ParseFilterList(newElem.ExcludeFlairs, MaxFilterEntries)
+	watchItem.IncludeAuthors = parseAuthors(newElem.IncludeAuthors)
+	watchItem.ExcludeAuthors = parseAuthors(newElem.ExcludeAuthors)
+	watchItem.TitleRegex = newElem.TitleRegex
+	watchItem.TitleExcludeRegex = newElem.TitleExcludeRegex
+	watchItem.DigestMode = newElem.DigestMode
+	watchItem.DigestIntervalHours, watchItem.DigestDailyAt = parseDigestFields(newElem.DigestMode, newElem.DigestInterval, newElem.DigestDailyAt)
+
 	err := watchItem.InsertG(ctx, boil.Infer())
 	if web.CheckErr(templateData, err, "Failed saving item :'(", web.CtxLogger(ctx).Error) {
 		return templateData
@@ -205,7 +242,19 @@ func HandleModify(w http.ResponseWriter, r *http.Request) interface{} {
 		item.MinUpvotes = updated.MinUpvotes
 	}
 
-	_, err := item.UpdateG(ctx, boil.Whitelist("channel_id", "use_embeds", "filter_nsfw", "min_upvotes", "disabled", "spoilers_enabled"))
+	item.MessageTemplate = updated.MessageTemplate
+	item.IncludeFlairs = ParseFilterList(updated.IncludeFlairs, MaxFilterEntries)
+	item.ExcludeFlairs = ParseFilterList(updated.ExcludeFlairs, MaxFilterEntries)
+	item.IncludeAuthors = parseAuthors(updated.IncludeAuthors)
+	item.ExcludeAuthors = parseAuthors(updated.ExcludeAuthors)
+	item.TitleRegex = updated.TitleRegex
+	item.TitleExcludeRegex = updated.TitleExcludeRegex
+	item.DigestMode = updated.DigestMode
+	item.DigestIntervalHours, item.DigestDailyAt = parseDigestFields(updated.DigestMode, updated.DigestInterval, updated.DigestDailyAt)
+
+	_, err := item.UpdateG(ctx, boil.Whitelist("channel_id", "use_embeds", "filter_nsfw", "min_upvotes", "disabled", "spoilers_enabled",
+		"message_template", "include_flairs", "exclude_flairs", "include_authors", "exclude_authors", "title_regex", "title_exclude_regex", "digest_interval_hours",
+		"digest_mode", "digest_daily_at"))
 	if web.CheckErr(templateData, err, "Failed saving item :'(", web.CtxLogger(ctx).Error) {
 		return templateData
 	}
@@ -265,6 +314,55 @@ func HandleRemove(w http.ResponseWriter, r *http.Request) interface{} {
 	return templateData
 }
 
+// Max entries in each of the flair and author filter lists
+const MaxFilterEntries = 25
+
+var (
+	_ web.CustomValidator = (*CreateForm)(nil)
+	_ web.CustomValidator = (*UpdateForm)(nil)
+)
+
+func (f *CreateForm) Validate(tmpl web.TemplateData, _ int64) bool {
+	return validateDigestFields(tmpl, f.DigestMode, f.DigestDailyAt)
+}
+
+func (f *UpdateForm) Validate(tmpl web.TemplateData, _ int64) bool {
+	return validateDigestFields(tmpl, f.DigestMode, f.DigestDailyAt)
+}
+
+func validateDigestFields(tmpl web.TemplateData, mode, dailyAt string) bool {
+	if _, err := feeds.ParseDigestForm(mode, dailyAt); err != nil {
+		tmpl.AddAlerts(web.ErrorAlert(err.Error()))
+		return false
+	}
+
+	return true
+}
+
+// parseDigestFields returns the digest interval and daily time to store, the interval is only kept for hourly digests
+func parseDigestFields(mode string, interval int, dailyAt string) (int, int) {
+	dailyAtMinutes, _ := feeds.ParseDigestForm(mode, dailyAt)
+	if mode != feeds.DigestModeHourly {
+		return 0, dailyAtMinutes
+	}
+
+	if interval < feeds.DigestMinIntervalHours {
+		interval = feeds.DigestMinIntervalHours
+	}
+
+	return interval, dailyAtMinutes
+}
+
+func parseAuthors(s string) []string {
+	authors := ParseFilterList(s, MaxFilterEntries)
+	for i, v := range authors {
+		v = strings.TrimPrefix(v, "/")
+		authors[i] = strings.TrimPrefix(v, "u/")
+	}
+
+	return authors
+}
+
 func FindFeed(feeds []*models.RedditFeed, id int64) *models.RedditFeed {
 	for _, v := range feeds {
 		if v.ID == id {
@@ -10,14 +10,17 @@ import (
 	"sync"
 	"time"
 
+	"emperror.dev/errors"
 	"github.com/ThatBathroom/yagpdb/v2/analytics"
 	"github.com/ThatBathroom/yagpdb/v2/common"
 	"github.com/ThatBathroom/yagpdb/v2/common/config"
 	"github.com/ThatBathroom/yagpdb/v2/common/mqueue"
+	"github.com/ThatBathroom/yagpdb/v2/common/templates"
 	"github.com/ThatBathroom/yagpdb/v2/feeds"
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
 	"github.com/ThatBathroom/yagpdb/v2/lib/go-reddit"
 	"github.com/ThatBathroom/yagpdb/v2/reddit/models"
+	"github.com/ThatBathroom/yagpdb/v2/web/discorddata"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -221,6 +224,39 @@ func (p *PostHandlerImpl) handlePost(post *reddit.Link, filterGuild int64) error
 			message, embed = messageSpoilersEnabled, embedSpoilersEnabled
 		}
 
+		custom := ""
+		if item.MessageTemplate != "" {
+			custom, err = renderPostTemplate(item, post)
+			if err != nil {
+				logger.WithError(err).WithField("feed_id", item.ID).Warn("failed executing reddit feed template")
+				continue
+			}
+
+			if strings.TrimSpace(custom) == "" {
+				// lets the template skip posts
+				continue
+			}
+		}
+
+		if !digestSettings(item).Immediate() {
+			err = queueDigestPost(item, post, custom)
+			if err != nil {
+				logger.WithError(err).WithField("feed_id", item.ID).Error("failed adding post to reddit digest")
+			}
+			continue
+		}
+
+		if custom != "" {
+			if item.UseEmbeds {
+				// the template replaces the description of the default embed
+				customEmbed := *embed
+				customEmbed.Description = common.CutStringShort(custom, 4096)
+				embed = &customEmbed
+			} else {
+				message = common.CutStringShort(custom, 2000)
+			}
+		}
+
 		if item.UseEmbeds {
 			qm.MessageEmbed = embed
 		} else {
@@ -248,6 +284,10 @@ OUTER:
 			}
 		}
 
+		if !PostMatchesFilters(c, post) {
+			continue
+		}
+
 		limit := confMaxPostsHourFast.GetInt()
 		if p.Slow {
 			limit = confMaxPostsHourSlow.GetInt()
@@ -393,6 +433,38 @@ func (p *PostHandlerImpl) createPostMessage(post *reddit.Link, allowSpoilers boo
 	return plainMessage, embed
 }
 
+// renderPostTemplate executes the custom template of the feed for the post
+func renderPostTemplate(feed *models.RedditFeed, post *reddit.Link) (string, error) {
+	gs, err := discorddata.GetFullGuild(feed.GuildID)
+	if err != nil {
+		return "", err
+	}
+
+	if gs == nil {
+		return "", errors.New("guild not found")
+	}
+
+	cs := gs.GetChannel(feed.ChannelID)
+	if cs == nil {
+		return "", errors.New("channel not found")
+	}
+
+	ctx := templates.NewContext(gs, cs, nil)
+	ctx.Data["Post"] = post
+	ctx.Data["Title"] = html.UnescapeString(post.Title)
+	ctx.Data["Author"] = post.Author
+	ctx.Data["Subreddit"] = post.Subreddit
+	ctx.Data["Flair"] = post.LinkFlairText
+	ctx.Data["URL"] = "https://redd.it/" + post.ID
+	ctx.Data["Link"] = post.URL
+	ctx.Data["SelfText"] = common.CutStringShort(html.UnescapeString(post.Selftext), 1000)
+	ctx.Data["Score"] = post.Score
+	ctx.Data["NSFW"] = post.Over18
+	ctx.Data["Spoiler"] = post.Spoiler && feed.SpoilersEnabled
+
+	return ctx.Execute(feed.MessageTemplate)
+}
+
 type RedditIdSlice []string
 
 // Len is the number of elements in the collection.
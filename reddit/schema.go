@@ -24,4 +24,28 @@ ALTER TABLE reddit_feeds ADD COLUMN IF NOT EXISTS disabled BOOLEAN NOT NULL DEFA
 
 `, `
 ALTER TABLE reddit_feeds ADD COLUMN IF NOT EXISTS spoilers_enabled BOOLEAN NOT NULL DEFAULT TRUE;
+`, `
+ALTER TABLE reddit_feeds ADD COLUMN IF NOT EXISTS message_template TEXT NOT NULL DEFAULT '';
+`, `
+ALTER TABLE reddit_feeds ADD COLUMN IF NOT EXISTS include_flairs TEXT[] NOT NULL DEFAULT '{}';
+`, `
+ALTER TABLE reddit_feeds ADD COLUMN IF NOT EXISTS exclude_flairs TEXT[] NOT NULL DEFAULT '{}';
+`, `
+ALTER TABLE reddit_feeds ADD COLUMN IF NOT EXISTS include_authors TEXT[] NOT NULL DEFAULT '{}';
+`, `
+ALTER TABLE reddit_feeds ADD COLUMN IF NOT EXISTS exclude_authors TEXT[] NOT NULL DEFAULT '{}';
+`, `
+ALTER TABLE reddit_feeds ADD COLUMN IF NOT EXISTS title_regex TEXT NOT NULL DEFAULT '';
+`, `
+ALTER TABLE reddit_feeds ADD COLUMN IF NOT EXISTS title_exclude_regex TEXT NOT NULL DEFAULT '';
+`, `
+-- 0 to post every post as its own message
+ALTER TABLE reddit_feeds ADD COLUMN IF NOT EXISTS digest_interval_hours INT NOT NULL DEFAULT 0;
+`, `
+ALTER TABLE reddit_feeds ADD COLUMN IF NOT EXISTS digest_mode TEXT NOT NULL DEFAULT '';
+`, `
+ALTER TABLE reddit_feeds ADD COLUMN IF NOT EXISTS digest_daily_at INT NOT NULL DEFAULT 0;
+`, `
+-- digest_interval_hours is now only the interval of hourly digests, it's reset when switching to another mode
+UPDATE reddit_feeds SET digest_mode = 'hourly' WHERE digest_interval_hours > 0 AND digest_mode = '';
 `}
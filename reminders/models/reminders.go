@@ -24,62 +24,72 @@ import (
 
 // Reminder is an object representing the database table.
 type Reminder struct {
-	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
-	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	ChannelID string    `boil:"channel_id" json:"channel_id" toml:"channel_id" yaml:"channel_id"`
-	GuildID   int64     `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
-	Message   string    `boil:"message" json:"message" toml:"message" yaml:"message"`
-	When      int64     `boil:"when" json:"when" toml:"when" yaml:"when"`
+	ID         int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt  null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	UserID     string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	ChannelID  string    `boil:"channel_id" json:"channel_id" toml:"channel_id" yaml:"channel_id"`
+	GuildID    int64     `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	Message    string    `boil:"message" json:"message" toml:"message" yaml:"message"`
+	When       int64     `boil:"when" json:"when" toml:"when" yaml:"when"`
+	Recurrence string    `boil:"recurrence" json:"recurrence" toml:"recurrence" yaml:"recurrence"`
+	Timezone   string    `boil:"timezone" json:"timezone" toml:"timezone" yaml:"timezone"`
 
 	R *reminderR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L reminderL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ReminderColumns = struct {
-	ID        string
-	CreatedAt string
-	UpdatedAt string
-	DeletedAt string
-	UserID    string
-	ChannelID string
-	GuildID   string
-	Message   string
-	When      string
+	ID         string
+	CreatedAt  string
+	UpdatedAt  string
+	DeletedAt  string
+	UserID     string
+	ChannelID  string
+	GuildID    string
+	Message    string
+	When       string
+	Recurrence string
+	Timezone   string
 }{
-	ID:        "id",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
-	DeletedAt: "deleted_at",
-	UserID:    "user_id",
-	ChannelID: "channel_id",
-	GuildID:   "guild_id",
-	Message:   "message",
-	When:      "when",
+	ID:         "id",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+	DeletedAt:  "deleted_at",
+	UserID:     "user_id",
+	ChannelID:  "channel_id",
+	GuildID:    "guild_id",
+	Message:    "message",
+	When:       "when",
+	Recurrence: "recurrence",
+	Timezone:   "timezone",
 }
 
 var ReminderTableColumns = struct {
-	ID        string
-	CreatedAt string
-	UpdatedAt string
-	DeletedAt string
-	UserID    string
-	ChannelID string
-	GuildID   string
-	Message   string
-	When      string
+	ID         string
+	CreatedAt  string
+	UpdatedAt  string
+	DeletedAt  string
+	UserID     string
+	ChannelID  string
+	GuildID    string
+	Message    string
+	When       string
+	Recurrence string
+	Timezone   string
 }{
-	ID:        "reminders.id",
-	CreatedAt: "reminders.created_at",
-	UpdatedAt: "reminders.updated_at",
-	DeletedAt: "reminders.deleted_at",
-	UserID:    "reminders.user_id",
-	ChannelID: "reminders.channel_id",
-	GuildID:   "reminders.guild_id",
-	Message:   "reminders.message",
-	When:      "reminders.when",
+	ID:         "reminders.id",
+	CreatedAt:  "reminders.created_at",
+	UpdatedAt:  "reminders.updated_at",
+	DeletedAt:  "reminders.deleted_at",
+	UserID:     "reminders.user_id",
+	ChannelID:  "reminders.channel_id",
+	GuildID:    "reminders.guild_id",
+	Message:    "reminders.message",
+	When:       "reminders.when",
+	Recurrence: "reminders.recurrence",
+	Timezone:   "reminders.timezone",
 }
 
 // Generated where
@@ -203,25 +213,29 @@ func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
 }
 
 var ReminderWhere = struct {
-	ID        whereHelperint
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpertime_Time
-	DeletedAt whereHelpernull_Time
-	UserID    whereHelperstring
-	ChannelID whereHelperstring
-	GuildID   whereHelperint64
-	Message   whereHelperstring
-	When      whereHelperint64
+	ID         whereHelperint
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+	DeletedAt  whereHelpernull_Time
+	UserID     whereHelperstring
+	ChannelID  whereHelperstring
+	GuildID    whereHelperint64
+	Message    whereHelperstring
+	When       whereHelperint64
+	Recurrence whereHelperstring
+	Timezone   whereHelperstring
 }{
-	ID:        whereHelperint{field: "\"reminders\".\"id\""},
-	CreatedAt: whereHelpertime_Time{field: "\"reminders\".\"created_at\""},
-	UpdatedAt: whereHelpertime_Time{field: "\"reminders\".\"updated_at\""},
-	DeletedAt: whereHelpernull_Time{field: "\"reminders\".\"deleted_at\""},
-	UserID:    whereHelperstring{field: "\"reminders\".\"user_id\""},
-	ChannelID: whereHelperstring{field: "\"reminders\".\"channel_id\""},
-	GuildID:   whereHelperint64{field: "\"reminders\".\"guild_id\""},
-	Message:   whereHelperstring{field: "\"reminders\".\"message\""},
-	When:      whereHelperint64{field: "\"reminders\".\"when\""},
+	ID:         whereHelperint{field: "\"reminders\".\"id\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"reminders\".\"created_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"reminders\".\"updated_at\""},
+	DeletedAt:  whereHelpernull_Time{field: "\"reminders\".\"deleted_at\""},
+	UserID:     whereHelperstring{field: "\"reminders\".\"user_id\""},
+	ChannelID:  whereHelperstring{field: "\"reminders\".\"channel_id\""},
+	GuildID:    whereHelperint64{field: "\"reminders\".\"guild_id\""},
+	Message:    whereHelperstring{field: "\"reminders\".\"message\""},
+	When:       whereHelperint64{field: "\"reminders\".\"when\""},
+	Recurrence: whereHelperstring{field: "\"reminders\".\"recurrence\""},
+	Timezone:   whereHelperstring{field: "\"reminders\".\"timezone\""},
 }
 
 // ReminderRels is where relationship names are stored.
@@ -241,9 +255,9 @@ func (*reminderR) NewStruct() *reminderR {
 type reminderL struct{}
 
 var (
-	reminderAllColumns            = []string{"id", "created_at", "updated_at", "deleted_at", "user_id", "channel_id", "guild_id", "message", "when"}
+	reminderAllColumns            = []string{"id", "created_at", "updated_at", "deleted_at", "user_id", "channel_id", "guild_id", "message", "when", "recurrence", "timezone"}
 	reminderColumnsWithoutDefault = []string{"created_at", "updated_at", "user_id", "channel_id", "guild_id", "message", "when"}
-	reminderColumnsWithDefault    = []string{"id", "deleted_at"}
+	reminderColumnsWithDefault    = []string{"id", "deleted_at", "recurrence", "timezone"}
 	reminderPrimaryKeyColumns     = []string{"id"}
 	reminderGeneratedColumns      = []string{}
 )
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/ThatBathroom/yagpdb/v2/bot"
 	"github.com/ThatBathroom/yagpdb/v2/commands"
@@ -17,6 +19,7 @@ import (
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
 	"github.com/ThatBathroom/yagpdb/v2/lib/dstate"
 	"github.com/ThatBathroom/yagpdb/v2/reminders/models"
+	"github.com/ThatBathroom/yagpdb/v2/timezonecompanion"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
@@ -46,11 +49,11 @@ var cmds = []*commands.YAGCommand{
 	{
 		CmdCategory:  commands.CategoryTool,
 		Name:         "Remindme",
-		Description:  "Schedules a reminder, example: 'remindme 1h30min are you still alive?'",
+		Description:  "Schedules a reminder, example: 'remindme 1h30min are you still alive?', 'remindme tomorrow at 9am standup' or 'remindme every weekday at 09:00 standup'. Times are in your registered timezone (setz), falling back to the server's.",
 		Aliases:      []string{"remind", "reminder"},
 		RequiredArgs: 2,
 		Arguments: []*dcmd.ArgDef{
-			{Name: "Time", Type: &commands.DurationArg{}},
+			{Name: "Time", Type: dcmd.String},
 			{Name: "Message", Type: dcmd.String},
 		},
 		ArgSwitches: []*dcmd.ArgDef{
@@ -69,7 +72,32 @@ var cmds = []*commands.YAGCommand{
 				return nil, errors.New("cannot create reminder for bots; you're likely trying to use `execAdmin` to create a reminder (use `exec` instead)")
 			}
 
-			offsetFromNow := parsed.Args[0].Value.(time.Duration)
+			guildID := parsed.GuildData.GS.ID
+			message := parsed.Args[1].Str()
+
+			var when time.Time
+			var recurrence, timezone string
+			if offsetFromNow, err := common.ParseDuration(parsed.Args[0].Str()); err == nil && startsWithNumber(parsed.Args[0].Str()) {
+				when = time.Now().Add(offsetFromNow)
+			} else {
+				loc := timezonecompanion.GetTimezone(parsed.Author.ID, guildID, parsed.ChannelID)
+				if loc == nil {
+					loc = time.UTC
+				}
+
+				when, recurrence, message, err = ParseReminderTime(parsed.Args[0].Str()+" "+message, time.Now().In(loc))
+				if err != nil {
+					return err.Error(), nil
+				}
+
+				if message == "" {
+					return "You need to specify what to remind you about", nil
+				}
+
+				timezone = loc.String()
+			}
+
+			offsetFromNow := time.Until(when)
 			if offsetFromNow > MaxReminderOffset {
 				return MaxReminderOffsetExceededMsg, nil
 			}
@@ -80,7 +108,7 @@ var cmds = []*commands.YAGCommand{
 				id = cs.ID
 				mention, _ := cs.Mention()
 
-				hasPerms, err := bot.AdminOrPermMS(parsed.GuildData.GS.ID, cs.ID, parsed.GuildData.MS, discordgo.PermissionSendMessages|discordgo.PermissionViewChannel)
+				hasPerms, err := bot.AdminOrPermMS(guildID, cs.ID, parsed.GuildData.MS, discordgo.PermissionSendMessages|discordgo.PermissionViewChannel)
 				if err != nil {
 					return "Failed checking permissions; please try again or join the support server.", err
 				}
@@ -111,13 +139,16 @@ var cmds = []*commands.YAGCommand{
 				}
 			}
 
-			when := time.Now().Add(offsetFromNow)
-			_, err := NewReminder(parsed.Author.ID, parsed.GuildData.GS.ID, id, parsed.Args[1].Str(), when)
+			_, err := NewRecurringReminder(parsed.Author.ID, guildID, id, message, when, recurrence, timezone)
 			if err != nil {
 				return nil, err
 			}
 
 			durString := common.HumanizeDuration(common.DurationPrecisionSeconds, offsetFromNow)
+			if recurrence != "" {
+				return fmt.Sprintf("Set a reminder repeating %s, first one in %s from now (<t:%d:f>)\nView reminders with the `reminders` command", HumanizeRecurrence(recurrence), durString, when.Unix()), nil
+			}
+
 			return fmt.Sprintf("Set a reminder in %s from now (<t:%d:f>)\nView reminders with the `reminders` command", durString, when.Unix()), nil
 		},
 	},
@@ -253,6 +284,12 @@ var cmds = []*commands.YAGCommand{
 	},
 }
 
+// startsWithNumber mirrors the check commands.DurationArg does before parsing a duration
+func startsWithNumber(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsNumber(r)
+}
+
 func memberHasAnyRole(ms *dstate.MemberState, roles []int64) bool {
 	for _, r := range ms.Member.Roles {
 		if common.ContainsInt64Slice(roles, r) {
@@ -0,0 +1,281 @@
+package reminders
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/lib/when"
+	"github.com/ThatBathroom/yagpdb/v2/lib/when/rules"
+	wcommon "github.com/ThatBathroom/yagpdb/v2/lib/when/rules/common"
+	"github.com/ThatBathroom/yagpdb/v2/lib/when/rules/en"
+	"github.com/ThatBathroom/yagpdb/v2/timezonecompanion/trules"
+	"github.com/robfig/cron/v3"
+)
+
+// Recurring reminders can't be repeated more often than this
+const MinReminderInterval = time.Hour
+
+var (
+	cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+	timeOfDayRegex = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?\s*(am|pm)?$`)
+
+	weekdayNames = map[string]int{
+		"sunday": 0, "sun": 0,
+		"monday": 1, "mon": 1,
+		"tuesday": 2, "tue": 2, "tues": 2,
+		"wednesday": 3, "wed": 3,
+		"thursday": 4, "thu": 4, "thurs": 4,
+		"friday": 5, "fri": 5,
+		"saturday": 6, "sat": 6,
+	}
+
+	ErrUnknownRecurrence  = errors.New("Couldn't understand how often to repeat the reminder, examples: `every day at 20:00`, `every weekday at 9am`, `every monday,friday at 18:30`, `every 12h`")
+	ErrRecurrenceTooOften = fmt.Errorf("Reminders can't repeat more often than every %s", common.HumanizeDuration(common.DurationPrecisionMinutes, MinReminderInterval))
+)
+
+// ParseRecurrence parses a leading `every ...` phrase, such as `every weekday at 09:00 stand up`, into a cron rule.
+// The rest of the input is returned as the reminder message, now is used for the defaults of
+// a missing time of day or day of the week/month and should be in the user's timezone.
+// If the input doesn't start with `every` an empty rule is returned.
+func ParseRecurrence(in string, now time.Time) (rule string, rest string, err error) {
+	words := strings.Fields(in)
+	if len(words) < 2 || !strings.EqualFold(words[0], "every") {
+		return "", in, nil
+	}
+
+	spec := strings.ToLower(words[1])
+	consumed := 2
+
+	if dur, err := common.ParseDuration(spec); err == nil && spec[0] >= '0' && spec[0] <= '9' {
+		if dur < MinReminderInterval {
+			return "", "", ErrRecurrenceTooOften
+		}
+
+		return "@every " + dur.String(), skipWords(in, consumed), nil
+	}
+
+	dom := "*"
+	dow := "*"
+	switch strings.TrimSuffix(spec, "s") {
+	case "day":
+	case "weekday":
+		dow = "1-5"
+	case "weekend":
+		dow = "0,6"
+	case "week":
+		dow = strconv.Itoa(int(now.Weekday()))
+	case "month":
+		dom = strconv.Itoa(now.Day())
+	default:
+		days := make([]string, 0, 7)
+		for _, v := range strings.Split(spec, ",") {
+			day, ok := weekdayNames[strings.TrimSuffix(v, "s")]
+			if !ok {
+				day, ok = weekdayNames[v]
+			}
+			if !ok {
+				return "", "", ErrUnknownRecurrence
+			}
+
+			days = append(days, strconv.Itoa(day))
+		}
+
+		dow = strings.Join(days, ",")
+	}
+
+	hour, minute := now.Hour(), now.Minute()
+	if len(words) > consumed+1 && strings.EqualFold(words[consumed], "at") {
+		timeStr := strings.ToLower(words[consumed+1])
+		consumed += 2
+
+		// "at 9 pm"
+		if len(words) > consumed && (strings.EqualFold(words[consumed], "am") || strings.EqualFold(words[consumed], "pm")) {
+			timeStr += strings.ToLower(words[consumed])
+			consumed++
+		}
+
+		hour, minute, err = parseTimeOfDay(timeStr)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	rule = fmt.Sprintf("%d %d %s * %s", minute, hour, dom, dow)
+	return rule, skipWords(in, consumed), nil
+}
+
+// skipWords returns in with the first n words removed, keeping the formatting of the rest
+func skipWords(in string, n int) string {
+	for i := 0; i < n; i++ {
+		in = strings.TrimLeftFunc(in, unicode.IsSpace)
+		end := strings.IndexFunc(in, unicode.IsSpace)
+		if end == -1 {
+			return ""
+		}
+
+		in = in[end:]
+	}
+
+	return strings.TrimSpace(in)
+}
+
+func parseTimeOfDay(in string) (hour, minute int, err error) {
+	match := timeOfDayRegex.FindStringSubmatch(in)
+	if match == nil {
+		return 0, 0, ErrUnknownRecurrence
+	}
+
+	hour, _ = strconv.Atoi(match[1])
+	if match[2] != "" {
+		minute, _ = strconv.Atoi(match[2])
+	}
+
+	if minute > 59 || hour > 23 || (match[3] != "" && (hour < 1 || hour > 12)) {
+		return 0, 0, ErrUnknownRecurrence
+	}
+
+	switch match[3] {
+	case "am":
+		if hour == 12 {
+			hour = 0
+		}
+	case "pm":
+		if hour < 12 {
+			hour += 12
+		}
+	}
+
+	return hour, minute, nil
+}
+
+// NextOccurrence returns the next time after `after` the rule matches, evaluated in loc
+func NextOccurrence(rule string, after time.Time, loc *time.Location) time.Time {
+	if rule == "" {
+		return time.Time{}
+	}
+
+	schedule, err := cronParser.Parse(rule)
+	if err != nil {
+		return time.Time{}
+	}
+
+	if spec, ok := schedule.(*cron.SpecSchedule); ok {
+		spec.Location = loc
+	}
+
+	return schedule.Next(after)
+}
+
+var weekdayDisplayNames = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+// HumanizeRecurrence returns a user facing description of a rule created by ParseRecurrence
+func HumanizeRecurrence(rule string) string {
+	if strings.HasPrefix(rule, "@every ") {
+		dur, err := time.ParseDuration(strings.TrimPrefix(rule, "@every "))
+		if err != nil {
+			return "`" + rule + "`"
+		}
+
+		return "every " + common.HumanizeDuration(common.DurationPrecisionMinutes, dur)
+	}
+
+	fields := strings.Fields(rule)
+	if len(fields) != 5 {
+		return "`" + rule + "`"
+	}
+
+	minute, _ := strconv.Atoi(fields[0])
+	hour, _ := strconv.Atoi(fields[1])
+	at := fmt.Sprintf(" at %02d:%02d", hour, minute)
+
+	if fields[2] != "*" {
+		return "every month on day " + fields[2] + at
+	}
+
+	switch fields[4] {
+	case "*":
+		return "every day" + at
+	case "1-5":
+		return "every weekday" + at
+	case "0,6":
+		return "every weekend day" + at
+	}
+
+	days := strings.Split(fields[4], ",")
+	for i, v := range days {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 || n > 6 {
+			return "`" + rule + "`"
+		}
+
+		days[i] = weekdayDisplayNames[n]
+	}
+
+	return "every " + strings.Join(days, ", ") + at
+}
+
+var dateParser *when.Parser
+
+func init() {
+	dateParser = when.New(&rules.Options{
+		Distance:     10,
+		MatchByOrder: true})
+
+	dateParser.Add(
+		en.Weekday(rules.Override),
+		en.CasualDate(rules.Override),
+		en.CasualTime(rules.Override),
+		trules.Hour(rules.Override),
+		trules.HourMinute(rules.Override),
+		en.Deadline(rules.Override),
+		en.ExactMonthDate(rules.Override),
+	)
+	dateParser.Add(wcommon.All...)
+}
+
+var (
+	ErrUnknownReminderTime = errors.New("Couldn't understand when to remind you, examples: `1h30m`, `tomorrow at 9am`, `friday 18:00`, `every weekday at 09:00`")
+	ErrReminderTimeInPast  = errors.New("That time has already passed")
+)
+
+// ParseReminderTime parses the time at the start of the input, which can be an absolute time such as
+// `tomorrow at 9am` or a recurring one such as `every weekday at 09:00`, relative to now which should be
+// in the user's timezone. The rest of the input is returned as the message.
+func ParseReminderTime(in string, now time.Time) (t time.Time, recurrence string, message string, err error) {
+	recurrence, message, err = ParseRecurrence(in, now)
+	if err != nil {
+		return
+	}
+
+	if recurrence != "" {
+		t = NextOccurrence(recurrence, now, now.Location())
+		if t.IsZero() {
+			err = ErrUnknownRecurrence
+		}
+		return
+	}
+
+	result, err := dateParser.Parse(in, now)
+	if err != nil || result == nil {
+		return t, "", "", ErrUnknownReminderTime
+	}
+
+	// only accept times at the start, "at 9am" and "on friday" are fine
+	prefix := strings.ToLower(strings.TrimSpace(in[:result.Index]))
+	if prefix != "" && prefix != "at" && prefix != "on" {
+		return t, "", "", ErrUnknownReminderTime
+	}
+
+	if !result.Time.After(now) {
+		return t, "", "", ErrReminderTimeInPast
+	}
+
+	return result.Time, "", strings.TrimSpace(in[result.Index+len(result.Text):]), nil
+}
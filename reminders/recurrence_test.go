@@ -0,0 +1,63 @@
+package reminders
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	// a wednesday
+	now := time.Date(2024, time.May, 15, 13, 45, 0, 0, time.UTC)
+
+	cases := []struct {
+		in       string
+		rule     string
+		rest     string
+		humanize string
+		err      error
+	}{
+		{"tomorrow at 9am stand up", "", "tomorrow at 9am stand up", "", nil},
+		{"every weekday at 09:00 stand up", "0 9 * * 1-5", "stand up", "every weekday at 09:00", nil},
+		{"every day at 8 pm water the plants", "0 20 * * *", "water the plants", "every day at 20:00", nil},
+		{"every mon,fri at 18:30 gym", "30 18 * * 1,5", "gym", "every monday, friday at 18:30", nil},
+		{"every sundays check mail", "45 13 * * 0", "check mail", "every sunday at 13:45", nil},
+		{"every week\nmulti\nline", "45 13 * * 3", "multi\nline", "every wednesday at 13:45", nil},
+		{"every month pay rent", "45 13 15 * *", "pay rent", "every month on day 15 at 13:45", nil},
+		{"every 12h drink water", "@every 12h0m0s", "drink water", "every 12 hours", nil},
+		{"every 5m spam", "", "", "", ErrRecurrenceTooOften},
+		{"every blursday hi", "", "", "", ErrUnknownRecurrence},
+		{"every day at 25:00 hi", "", "", "", ErrUnknownRecurrence},
+	}
+
+	for _, c := range cases {
+		rule, rest, err := ParseRecurrence(c.in, now)
+		if err != c.err {
+			t.Errorf("ParseRecurrence(%q): got error %v, want %v", c.in, err, c.err)
+			continue
+		}
+
+		if rule != c.rule || rest != c.rest {
+			t.Errorf("ParseRecurrence(%q): got %q, %q, want %q, %q", c.in, rule, rest, c.rule, c.rest)
+			continue
+		}
+
+		if rule != "" && HumanizeRecurrence(rule) != c.humanize {
+			t.Errorf("HumanizeRecurrence(%q): got %q, want %q", rule, HumanizeRecurrence(rule), c.humanize)
+		}
+	}
+}
+
+func TestNextOccurrence(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("timezone data not available")
+	}
+
+	// friday evening, the next weekday is monday
+	after := time.Date(2024, time.March, 29, 20, 0, 0, 0, berlin)
+	got := NextOccurrence("0 9 * * 1-5", after, berlin)
+	want := time.Date(2024, time.April, 1, 9, 0, 0, 0, berlin)
+	if !got.Equal(want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
@@ -3,6 +3,7 @@ package reminders
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,6 +26,7 @@ func RegisterPlugin() {
 	common.RegisterPlugin(p)
 
 	common.InitSchemas("reminders", DBSchemas...)
+	mqueue.RegisterSource("reminder", p)
 }
 
 func (p *Plugin) PluginInfo() *common.PluginInfo {
@@ -36,7 +38,11 @@ func (p *Plugin) PluginInfo() *common.PluginInfo {
 }
 
 func TriggerReminder(r *models.Reminder) error {
-	r.DeleteG(context.Background(), false /* hardDelete */)
+	if r.Recurrence != "" {
+		rescheduleReminder(r)
+	} else {
+		r.DeleteG(context.Background(), false /* hardDelete */)
+	}
 
 	logger.WithFields(logrus.Fields{"channel": r.ChannelID, "user": r.UserID, "message": r.Message, "id": r.ID}).Info("Triggered reminder")
 	embed := &discordgo.MessageEmbed{
@@ -48,7 +54,7 @@ func TriggerReminder(r *models.Reminder) error {
 	userID, _ := discordgo.ParseID(r.UserID)
 	return mqueue.QueueMessage(&mqueue.QueuedElement{
 		Source:       "reminder",
-		SourceItemID: "",
+		SourceItemID: strconv.Itoa(r.ID),
 
 		GuildID:      r.GuildID,
 		ChannelID:    channelID,
@@ -61,13 +67,66 @@ func TriggerReminder(r *models.Reminder) error {
 	})
 }
 
+// DisableFeed implements mqueue.PluginWithSourceDisabler, reminders are deleted when their channel is gone or the bot
+// lost access to it, otherwise a recurring reminder would keep failing forever
+func (p *Plugin) DisableFeed(elem *mqueue.QueuedElement, err error) {
+	// permissions can be fixed, keep the reminder around for that
+	if common.IsDiscordErr(err, discordgo.ErrCodeMissingPermissions) {
+		return
+	}
+
+	id, convErr := strconv.Atoi(elem.SourceItemID)
+	if convErr != nil {
+		return
+	}
+
+	logger.WithError(err).WithField("id", id).Warn("Deleting reminder to a channel we can't send to")
+	_, err = models.Reminders(models.ReminderWhere.ID.EQ(id)).DeleteAllG(context.Background(), false /* hardDelete */)
+	if err != nil {
+		logger.WithError(err).WithField("id", id).Error("failed deleting reminder")
+	}
+}
+
+// rescheduleReminder moves a recurring reminder to its next occurrence, deleting it if there is none
+func rescheduleReminder(r *models.Reminder) {
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	next := NextOccurrence(r.Recurrence, time.Now(), loc)
+	if next.IsZero() {
+		r.DeleteG(context.Background(), false /* hardDelete */)
+		return
+	}
+
+	r.When = next.Unix()
+	_, err = r.UpdateG(context.Background(), boil.Whitelist("when", "updated_at"))
+	if err == nil {
+		userID, _ := discordgo.ParseID(r.UserID)
+		err = scheduledevents2.ScheduleEvent("reminders_check_user", r.GuildID, next, userID)
+	}
+
+	if err != nil {
+		logger.WithError(err).WithField("id", r.ID).Error("failed rescheduling recurring reminder")
+	}
+}
+
 func NewReminder(userID int64, guildID int64, channelID int64, message string, when time.Time) (*models.Reminder, error) {
+	return NewRecurringReminder(userID, guildID, channelID, message, when, "", "")
+}
+
+// NewRecurringReminder creates a reminder that's rescheduled according to the recurrence rule (see ParseRecurrence)
+// evaluated in the timezone every time it triggers, an empty rule creates a one-off reminder
+func NewRecurringReminder(userID int64, guildID int64, channelID int64, message string, when time.Time, recurrence string, timezone string) (*models.Reminder, error) {
 	reminder := &models.Reminder{
-		UserID:    discordgo.StrID(userID),
-		ChannelID: discordgo.StrID(channelID),
-		Message:   message,
-		When:      when.Unix(),
-		GuildID:   guildID,
+		UserID:     discordgo.StrID(userID),
+		ChannelID:  discordgo.StrID(channelID),
+		Message:    message,
+		When:       when.Unix(),
+		GuildID:    guildID,
+		Recurrence: recurrence,
+		Timezone:   timezone,
 	}
 
 	err := reminder.InsertG(context.Background(), boil.Infer())
@@ -92,6 +151,11 @@ func DisplayReminders(reminders models.ReminderSlice, mode DisplayRemindersMode)
 		t := time.Unix(r.When, 0)
 		timeFromNow := common.HumanizeTime(common.DurationPrecisionMinutes, t)
 
+		repeats := ""
+		if r.Recurrence != "" {
+			repeats = " - repeats " + HumanizeRecurrence(r.Recurrence)
+		}
+
 		switch mode {
 		case ModeDisplayChannelReminders:
 			// don't show the channel; do show the user
@@ -102,12 +166,12 @@ func DisplayReminders(reminders models.ReminderSlice, mode DisplayRemindersMode)
 				username = member.User.Username
 			}
 
-			fmt.Fprintf(&out, "**%d**: %s: '%s' - %s from now (<t:%d:f>)\n", r.ID, username, CutReminderShort(r.Message), timeFromNow, t.Unix())
+			fmt.Fprintf(&out, "**%d**: %s: '%s' - %s from now (<t:%d:f>)%s\n", r.ID, username, CutReminderShort(r.Message), timeFromNow, t.Unix(), repeats)
 
 		case ModeDisplayUserReminders:
 			// do show the channel; don't show the user
 			channel := "<#" + r.ChannelID + ">"
-			fmt.Fprintf(&out, "**%d**: %s: '%s' - %s from now (<t:%d:f>)\n", r.ID, channel, CutReminderShort(r.Message), timeFromNow, t.Unix())
+			fmt.Fprintf(&out, "**%d**: %s: '%s' - %s from now (<t:%d:f>)%s\n", r.ID, channel, CutReminderShort(r.Message), timeFromNow, t.Unix(), repeats)
 		}
 	}
 
@@ -54,4 +54,8 @@ IF EXISTS(SELECT 1 FROM information_schema.columns WHERE table_name='reminders'
 	ALTER TABLE reminders ALTER COLUMN guild_id SET NOT NULL;
 END IF;
 END $$;
+`, `
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS recurrence TEXT NOT NULL DEFAULT '';
+`, `
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS timezone TEXT NOT NULL DEFAULT '';
 `}
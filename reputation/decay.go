@@ -0,0 +1,156 @@
+package reputation
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/common/scheduledevents2"
+	seventsmodels "github.com/ThatBathroom/yagpdb/v2/common/scheduledevents2/models"
+	"github.com/ThatBathroom/yagpdb/v2/reputation/models"
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	// Members lose the configured percentage of their points for every week they didn't give or receive rep
+	DecayInactivePeriod = time.Hour * 24 * 7
+
+	decayCheckInterval = time.Hour * 24
+
+	scheduledEventDecay     = "reputation_decay"
+	scheduledEventSeasonEnd = "reputation_season_end"
+)
+
+// DecayGuildRep applies decay to the points of everyone that has been inactive for a week since their last activity or decay,
+// returns the new points of the affected users
+func DecayGuildRep(ctx context.Context, guildID int64, percent int) (map[int64]int64, error) {
+	tx, err := common.PQ.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := decayGuildRepTx(ctx, tx, guildID, percent)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return result, tx.Commit()
+}
+
+func decayGuildRepTx(ctx context.Context, tx *sql.Tx, guildID int64, percent int) (map[int64]int64, error) {
+	// locked so rep given while this runs isn't overwritten
+	const selectQuery = `SELECT user_id, points FROM reputation_users
+WHERE guild_id = $1 AND points != 0 AND GREATEST(last_active_at, COALESCE(last_decayed_at, last_active_at)) < $2
+FOR UPDATE`
+
+	rows, err := tx.QueryContext(ctx, selectQuery, guildID, time.Now().Add(-DecayInactivePeriod))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64]int64)
+	var userIDs, newPoints []int64
+	for rows.Next() {
+		var userID, points int64
+		err = rows.Scan(&userID, &points)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		points = decayPoints(points, percent)
+		result[userID] = points
+		userIDs = append(userIDs, userID)
+		newPoints = append(newPoints, points)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil || len(userIDs) == 0 {
+		return result, err
+	}
+
+	const updateQuery = `UPDATE reputation_users SET points = u.points, last_decayed_at = now()
+FROM unnest($2::bigint[], $3::bigint[]) AS u(user_id, points)
+WHERE reputation_users.guild_id = $1 AND reputation_users.user_id = u.user_id`
+
+	_, err = tx.ExecContext(ctx, updateQuery, guildID, pq.Int64Array(userIDs), pq.Int64Array(newPoints))
+	return result, err
+}
+
+// decayPoints returns the points left after losing percent of them, rounded towards 0
+func decayPoints(points int64, percent int) int64 {
+	if percent <= 0 {
+		return points
+	}
+	if percent >= 100 {
+		return 0
+	}
+
+	return points * int64(100-percent) / 100
+}
+
+// markRepActive resets the decay timer of the user
+func markRepActive(ctx context.Context, guildID, userID int64) error {
+	_, err := common.PQ.ExecContext(ctx, "UPDATE reputation_users SET last_active_at = now() WHERE guild_id = $1 AND user_id = $2", guildID, userID)
+	return err
+}
+
+// ScheduleMaintenanceEvents (re)schedules the decay and season end events after the config was changed
+func ScheduleMaintenanceEvents(ctx context.Context, conf *models.ReputationConfig) error {
+	_, err := seventsmodels.ScheduledEvents(
+		qm.Where("event_name IN (?, ?)", scheduledEventDecay, scheduledEventSeasonEnd),
+		qm.Where("guild_id = ?", conf.GuildID),
+		qm.Where("processed = false"),
+	).DeleteAll(ctx, common.PQ)
+	if err != nil {
+		return err
+	}
+
+	if !conf.Enabled {
+		return nil
+	}
+
+	if conf.DecayPercent > 0 {
+		err = scheduledevents2.ScheduleEvent(scheduledEventDecay, conf.GuildID, time.Now().Add(time.Hour), nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	if conf.SeasonLengthDays > 0 {
+		endsAt := seasonEndsAt(conf)
+
+		// ending it right away would wipe everyone's points, the season start is reset whenever the length is
+		// changed so this shouldn't happen
+		if !endsAt.After(time.Now()) {
+			return ErrSeasonEndPassed
+		}
+
+		err = scheduledevents2.ScheduleEvent(scheduledEventSeasonEnd, conf.GuildID, endsAt, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var ErrSeasonEndPassed = errors.New("the end of the current season has already passed")
+
+func seasonEndsAt(conf *models.ReputationConfig) time.Time {
+	return conf.SeasonStartedAt.AddDate(0, 0, conf.SeasonLengthDays)
+}
+
+// seasonRestarts returns true if the current season should start over when the config is saved, which is the case
+// whenever the season length is changed, otherwise a season started long ago could end immediately
+func seasonRestarts(oldConf, newConf *models.ReputationConfig) bool {
+	if newConf.SeasonLengthDays < 1 {
+		return false
+	}
+
+	return oldConf == nil || oldConf.SeasonLengthDays != newConf.SeasonLengthDays
+}
@@ -0,0 +1,97 @@
+package reputation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ThatBathroom/yagpdb/v2/reputation/models"
+)
+
+func TestDecayPoints(t *testing.T) {
+	cases := []struct {
+		points  int64
+		percent int
+		want    int64
+	}{
+		{100, 10, 90},
+		{100, 0, 100},
+		{100, 100, 0},
+		{100, 150, 0},
+		{5, 10, 4},
+		{1, 50, 0},
+		{-50, 10, -45},
+		{-5, 10, -4},
+		{0, 10, 0},
+	}
+
+	for _, c := range cases {
+		if got := decayPoints(c.points, c.percent); got != c.want {
+			t.Errorf("decayPoints(%d, %d) = %d, want %d", c.points, c.percent, got, c.want)
+		}
+	}
+}
+
+func TestSeasonEndsAt(t *testing.T) {
+	start := time.Date(2024, time.January, 31, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		days int
+		want time.Time
+	}{
+		{1, time.Date(2024, time.February, 1, 12, 0, 0, 0, time.UTC)},
+		{29, time.Date(2024, time.February, 29, 12, 0, 0, 0, time.UTC)},
+		{30, time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)},
+		{366, time.Date(2025, time.January, 31, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, c := range cases {
+		conf := &models.ReputationConfig{SeasonStartedAt: start, SeasonLengthDays: c.days}
+		if got := seasonEndsAt(conf); !got.Equal(c.want) {
+			t.Errorf("seasonEndsAt(%d days) = %s, want %s", c.days, got, c.want)
+		}
+	}
+}
+
+func TestSeasonRestarts(t *testing.T) {
+	conf := func(days int) *models.ReputationConfig {
+		return &models.ReputationConfig{SeasonLengthDays: days}
+	}
+
+	cases := []struct {
+		name     string
+		old, new *models.ReputationConfig
+		want     bool
+	}{
+		{"enabled", conf(0), conf(30), true},
+		{"length changed", conf(30), conf(7), true},
+		{"unchanged", conf(30), conf(30), false},
+		{"disabled", conf(30), conf(0), false},
+		{"still disabled", conf(0), conf(0), false},
+		{"new config", nil, conf(30), true},
+	}
+
+	for _, c := range cases {
+		if got := seasonRestarts(c.old, c.new); got != c.want {
+			t.Errorf("%s: seasonRestarts = %t, want %t", c.name, got, c.want)
+		}
+	}
+}
+
+func TestRestartedSeasonEndsInFuture(t *testing.T) {
+	// a config saved before seasons existed got the migration time as its season start
+	oldConf := &models.ReputationConfig{SeasonStartedAt: time.Now().AddDate(-1, 0, 0)}
+	newConf := &models.ReputationConfig{SeasonStartedAt: oldConf.SeasonStartedAt, SeasonLengthDays: 30}
+
+	if !seasonEndsAt(newConf).Before(time.Now()) {
+		t.Fatal("expected the season to have ended without a restart")
+	}
+
+	if !seasonRestarts(oldConf, newConf) {
+		t.Fatal("expected enabling seasons to restart the season")
+	}
+
+	newConf.SeasonStartedAt = time.Now()
+	if !seasonEndsAt(newConf).After(time.Now()) {
+		t.Error("expected the restarted season to end in the future")
+	}
+}
@@ -4,13 +4,17 @@
 package models
 
 var TableNames = struct {
-	ReputationConfigs string
-	ReputationLog     string
-	ReputationRoles   string
-	ReputationUsers   string
+	ReputationConfigs     string
+	ReputationLog         string
+	ReputationRoles       string
+	ReputationSeasonUsers string
+	ReputationSeasons     string
+	ReputationUsers       string
 }{
-	ReputationConfigs: "reputation_configs",
-	ReputationLog:     "reputation_log",
-	ReputationRoles:   "reputation_roles",
-	ReputationUsers:   "reputation_users",
+	ReputationConfigs:     "reputation_configs",
+	ReputationLog:         "reputation_log",
+	ReputationRoles:       "reputation_roles",
+	ReputationSeasonUsers: "reputation_season_users",
+	ReputationSeasons:     "reputation_seasons",
+	ReputationUsers:       "reputation_users",
 }
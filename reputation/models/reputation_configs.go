@@ -45,6 +45,13 @@ type ReputationConfig struct {
 	BlacklistedGiveRoles      types.Int64Array `boil:"blacklisted_give_roles" json:"blacklisted_give_roles,omitempty" toml:"blacklisted_give_roles" yaml:"blacklisted_give_roles,omitempty"`
 	BlacklistedReceiveRoles   types.Int64Array `boil:"blacklisted_receive_roles" json:"blacklisted_receive_roles,omitempty" toml:"blacklisted_receive_roles" yaml:"blacklisted_receive_roles,omitempty"`
 	ThanksRegex               null.String      `boil:"thanks_regex" json:"thanks_regex,omitempty" toml:"thanks_regex" yaml:"thanks_regex,omitempty"`
+	DecayPercent              int              `boil:"decay_percent" json:"decay_percent" toml:"decay_percent" yaml:"decay_percent"`
+	SeasonLengthDays          int              `boil:"season_length_days" json:"season_length_days" toml:"season_length_days" yaml:"season_length_days"`
+	SeasonStartedAt           time.Time        `boil:"season_started_at" json:"season_started_at" toml:"season_started_at" yaml:"season_started_at"`
+	MultiplierChannels        types.Int64Array `boil:"multiplier_channels" json:"multiplier_channels,omitempty" toml:"multiplier_channels" yaml:"multiplier_channels,omitempty"`
+	MultiplierValues          types.Int64Array `boil:"multiplier_values" json:"multiplier_values,omitempty" toml:"multiplier_values" yaml:"multiplier_values,omitempty"`
+	GiveLimitRoles            types.Int64Array `boil:"give_limit_roles" json:"give_limit_roles,omitempty" toml:"give_limit_roles" yaml:"give_limit_roles,omitempty"`
+	GiveLimitAmounts          types.Int64Array `boil:"give_limit_amounts" json:"give_limit_amounts,omitempty" toml:"give_limit_amounts" yaml:"give_limit_amounts,omitempty"`
 
 	R *reputationConfigR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L reputationConfigL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -71,6 +78,13 @@ var ReputationConfigColumns = struct {
 	BlacklistedGiveRoles      string
 	BlacklistedReceiveRoles   string
 	ThanksRegex               string
+	DecayPercent              string
+	SeasonLengthDays          string
+	SeasonStartedAt           string
+	MultiplierChannels        string
+	MultiplierValues          string
+	GiveLimitRoles            string
+	GiveLimitAmounts          string
 }{
 	GuildID:                   "guild_id",
 	PointsName:                "points_name",
@@ -92,6 +106,13 @@ var ReputationConfigColumns = struct {
 	BlacklistedGiveRoles:      "blacklisted_give_roles",
 	BlacklistedReceiveRoles:   "blacklisted_receive_roles",
 	ThanksRegex:               "thanks_regex",
+	DecayPercent:              "decay_percent",
+	SeasonLengthDays:          "season_length_days",
+	SeasonStartedAt:           "season_started_at",
+	MultiplierChannels:        "multiplier_channels",
+	MultiplierValues:          "multiplier_values",
+	GiveLimitRoles:            "give_limit_roles",
+	GiveLimitAmounts:          "give_limit_amounts",
 }
 
 var ReputationConfigTableColumns = struct {
@@ -115,6 +136,13 @@ var ReputationConfigTableColumns = struct {
 	BlacklistedGiveRoles      string
 	BlacklistedReceiveRoles   string
 	ThanksRegex               string
+	DecayPercent              string
+	SeasonLengthDays          string
+	SeasonStartedAt           string
+	MultiplierChannels        string
+	MultiplierValues          string
+	GiveLimitRoles            string
+	GiveLimitAmounts          string
 }{
 	GuildID:                   "reputation_configs.guild_id",
 	PointsName:                "reputation_configs.points_name",
@@ -136,6 +164,13 @@ var ReputationConfigTableColumns = struct {
 	BlacklistedGiveRoles:      "reputation_configs.blacklisted_give_roles",
 	BlacklistedReceiveRoles:   "reputation_configs.blacklisted_receive_roles",
 	ThanksRegex:               "reputation_configs.thanks_regex",
+	DecayPercent:              "reputation_configs.decay_percent",
+	SeasonLengthDays:          "reputation_configs.season_length_days",
+	SeasonStartedAt:           "reputation_configs.season_started_at",
+	MultiplierChannels:        "reputation_configs.multiplier_channels",
+	MultiplierValues:          "reputation_configs.multiplier_values",
+	GiveLimitRoles:            "reputation_configs.give_limit_roles",
+	GiveLimitAmounts:          "reputation_configs.give_limit_amounts",
 }
 
 // Generated where
@@ -280,6 +315,27 @@ func (w whereHelpertypes_Int64Array) GTE(x types.Int64Array) qm.QueryMod {
 func (w whereHelpertypes_Int64Array) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpertypes_Int64Array) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
 var ReputationConfigWhere = struct {
 	GuildID                   whereHelperint64
 	PointsName                whereHelperstring
@@ -301,6 +357,13 @@ var ReputationConfigWhere = struct {
 	BlacklistedGiveRoles      whereHelpertypes_Int64Array
 	BlacklistedReceiveRoles   whereHelpertypes_Int64Array
 	ThanksRegex               whereHelpernull_String
+	DecayPercent              whereHelperint
+	SeasonLengthDays          whereHelperint
+	SeasonStartedAt           whereHelpertime_Time
+	MultiplierChannels        whereHelpertypes_Int64Array
+	MultiplierValues          whereHelpertypes_Int64Array
+	GiveLimitRoles            whereHelpertypes_Int64Array
+	GiveLimitAmounts          whereHelpertypes_Int64Array
 }{
 	GuildID:                   whereHelperint64{field: "\"reputation_configs\".\"guild_id\""},
 	PointsName:                whereHelperstring{field: "\"reputation_configs\".\"points_name\""},
@@ -322,6 +385,13 @@ var ReputationConfigWhere = struct {
 	BlacklistedGiveRoles:      whereHelpertypes_Int64Array{field: "\"reputation_configs\".\"blacklisted_give_roles\""},
 	BlacklistedReceiveRoles:   whereHelpertypes_Int64Array{field: "\"reputation_configs\".\"blacklisted_receive_roles\""},
 	ThanksRegex:               whereHelpernull_String{field: "\"reputation_configs\".\"thanks_regex\""},
+	DecayPercent:              whereHelperint{field: "\"reputation_configs\".\"decay_percent\""},
+	SeasonLengthDays:          whereHelperint{field: "\"reputation_configs\".\"season_length_days\""},
+	SeasonStartedAt:           whereHelpertime_Time{field: "\"reputation_configs\".\"season_started_at\""},
+	MultiplierChannels:        whereHelpertypes_Int64Array{field: "\"reputation_configs\".\"multiplier_channels\""},
+	MultiplierValues:          whereHelpertypes_Int64Array{field: "\"reputation_configs\".\"multiplier_values\""},
+	GiveLimitRoles:            whereHelpertypes_Int64Array{field: "\"reputation_configs\".\"give_limit_roles\""},
+	GiveLimitAmounts:          whereHelpertypes_Int64Array{field: "\"reputation_configs\".\"give_limit_amounts\""},
 }
 
 // ReputationConfigRels is where relationship names are stored.
@@ -341,9 +411,9 @@ func (*reputationConfigR) NewStruct() *reputationConfigR {
 type reputationConfigL struct{}
 
 var (
-	reputationConfigAllColumns            = []string{"guild_id", "points_name", "enabled", "cooldown", "max_give_amount", "required_give_role", "required_receive_role", "blacklisted_give_role", "blacklisted_receive_role", "admin_role", "disable_thanks_detection", "whitelisted_thanks_channels", "blacklisted_thanks_channels", "max_remove_amount", "admin_roles", "required_give_roles", "required_receive_roles", "blacklisted_give_roles", "blacklisted_receive_roles", "thanks_regex"}
+	reputationConfigAllColumns            = []string{"guild_id", "points_name", "enabled", "cooldown", "max_give_amount", "required_give_role", "required_receive_role", "blacklisted_give_role", "blacklisted_receive_role", "admin_role", "disable_thanks_detection", "whitelisted_thanks_channels", "blacklisted_thanks_channels", "max_remove_amount", "admin_roles", "required_give_roles", "required_receive_roles", "blacklisted_give_roles", "blacklisted_receive_roles", "thanks_regex", "decay_percent", "season_length_days", "season_started_at", "multiplier_channels", "multiplier_values", "give_limit_roles", "give_limit_amounts"}
 	reputationConfigColumnsWithoutDefault = []string{"guild_id", "points_name", "enabled", "cooldown", "max_give_amount"}
-	reputationConfigColumnsWithDefault    = []string{"required_give_role", "required_receive_role", "blacklisted_give_role", "blacklisted_receive_role", "admin_role", "disable_thanks_detection", "whitelisted_thanks_channels", "blacklisted_thanks_channels", "max_remove_amount", "admin_roles", "required_give_roles", "required_receive_roles", "blacklisted_give_roles", "blacklisted_receive_roles", "thanks_regex"}
+	reputationConfigColumnsWithDefault    = []string{"required_give_role", "required_receive_role", "blacklisted_give_role", "blacklisted_receive_role", "admin_role", "disable_thanks_detection", "whitelisted_thanks_channels", "blacklisted_thanks_channels", "max_remove_amount", "admin_roles", "required_give_roles", "required_receive_roles", "blacklisted_give_roles", "blacklisted_receive_roles", "thanks_regex", "decay_percent", "season_length_days", "season_started_at", "multiplier_channels", "multiplier_values", "give_limit_roles", "give_limit_amounts"}
 	reputationConfigPrimaryKeyColumns     = []string{"guild_id"}
 	reputationConfigGeneratedColumns      = []string{}
 )
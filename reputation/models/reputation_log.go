@@ -32,6 +32,7 @@ type ReputationLog struct {
 	Amount           int64     `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
 	ReceiverUsername string    `boil:"receiver_username" json:"receiver_username" toml:"receiver_username" yaml:"receiver_username"`
 	SenderUsername   string    `boil:"sender_username" json:"sender_username" toml:"sender_username" yaml:"sender_username"`
+	Reason           string    `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
 
 	R *reputationLogR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L reputationLogL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -47,6 +48,7 @@ var ReputationLogColumns = struct {
 	Amount           string
 	ReceiverUsername string
 	SenderUsername   string
+	Reason           string
 }{
 	ID:               "id",
 	CreatedAt:        "created_at",
@@ -57,6 +59,7 @@ var ReputationLogColumns = struct {
 	Amount:           "amount",
 	ReceiverUsername: "receiver_username",
 	SenderUsername:   "sender_username",
+	Reason:           "reason",
 }
 
 var ReputationLogTableColumns = struct {
@@ -69,6 +72,7 @@ var ReputationLogTableColumns = struct {
 	Amount           string
 	ReceiverUsername string
 	SenderUsername   string
+	Reason           string
 }{
 	ID:               "reputation_log.id",
 	CreatedAt:        "reputation_log.created_at",
@@ -79,31 +83,11 @@ var ReputationLogTableColumns = struct {
 	Amount:           "reputation_log.amount",
 	ReceiverUsername: "reputation_log.receiver_username",
 	SenderUsername:   "reputation_log.sender_username",
+	Reason:           "reputation_log.reason",
 }
 
 // Generated where
 
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var ReputationLogWhere = struct {
 	ID               whereHelperint64
 	CreatedAt        whereHelpertime_Time
@@ -114,6 +98,7 @@ var ReputationLogWhere = struct {
 	Amount           whereHelperint64
 	ReceiverUsername whereHelperstring
 	SenderUsername   whereHelperstring
+	Reason           whereHelperstring
 }{
 	ID:               whereHelperint64{field: "\"reputation_log\".\"id\""},
 	CreatedAt:        whereHelpertime_Time{field: "\"reputation_log\".\"created_at\""},
@@ -124,6 +109,7 @@ var ReputationLogWhere = struct {
 	Amount:           whereHelperint64{field: "\"reputation_log\".\"amount\""},
 	ReceiverUsername: whereHelperstring{field: "\"reputation_log\".\"receiver_username\""},
 	SenderUsername:   whereHelperstring{field: "\"reputation_log\".\"sender_username\""},
+	Reason:           whereHelperstring{field: "\"reputation_log\".\"reason\""},
 }
 
 // ReputationLogRels is where relationship names are stored.
@@ -143,9 +129,9 @@ func (*reputationLogR) NewStruct() *reputationLogR {
 type reputationLogL struct{}
 
 var (
-	reputationLogAllColumns            = []string{"id", "created_at", "guild_id", "sender_id", "receiver_id", "set_fixed_amount", "amount", "receiver_username", "sender_username"}
+	reputationLogAllColumns            = []string{"id", "created_at", "guild_id", "sender_id", "receiver_id", "set_fixed_amount", "amount", "receiver_username", "sender_username", "reason"}
 	reputationLogColumnsWithoutDefault = []string{"created_at", "guild_id", "sender_id", "receiver_id", "set_fixed_amount", "amount"}
-	reputationLogColumnsWithDefault    = []string{"id", "receiver_username", "sender_username"}
+	reputationLogColumnsWithDefault    = []string{"id", "receiver_username", "sender_username", "reason"}
 	reputationLogPrimaryKeyColumns     = []string{"id"}
 	reputationLogGeneratedColumns      = []string{}
 )
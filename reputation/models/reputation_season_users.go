@@ -0,0 +1,962 @@
+// Code generated by SQLBoiler 4.14.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ReputationSeasonUser is an object representing the database table.
+type ReputationSeasonUser struct {
+	SeasonID int64 `boil:"season_id" json:"season_id" toml:"season_id" yaml:"season_id"`
+	UserID   int64 `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Points   int64 `boil:"points" json:"points" toml:"points" yaml:"points"`
+	Rank     int   `boil:"rank" json:"rank" toml:"rank" yaml:"rank"`
+
+	R *reputationSeasonUserR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L reputationSeasonUserL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReputationSeasonUserColumns = struct {
+	SeasonID string
+	UserID   string
+	Points   string
+	Rank     string
+}{
+	SeasonID: "season_id",
+	UserID:   "user_id",
+	Points:   "points",
+	Rank:     "rank",
+}
+
+var ReputationSeasonUserTableColumns = struct {
+	SeasonID string
+	UserID   string
+	Points   string
+	Rank     string
+}{
+	SeasonID: "reputation_season_users.season_id",
+	UserID:   "reputation_season_users.user_id",
+	Points:   "reputation_season_users.points",
+	Rank:     "reputation_season_users.rank",
+}
+
+// Generated where
+
+var ReputationSeasonUserWhere = struct {
+	SeasonID whereHelperint64
+	UserID   whereHelperint64
+	Points   whereHelperint64
+	Rank     whereHelperint
+}{
+	SeasonID: whereHelperint64{field: "\"reputation_season_users\".\"season_id\""},
+	UserID:   whereHelperint64{field: "\"reputation_season_users\".\"user_id\""},
+	Points:   whereHelperint64{field: "\"reputation_season_users\".\"points\""},
+	Rank:     whereHelperint{field: "\"reputation_season_users\".\"rank\""},
+}
+
+// ReputationSeasonUserRels is where relationship names are stored.
+var ReputationSeasonUserRels = struct {
+	Season string
+}{
+	Season: "Season",
+}
+
+// reputationSeasonUserR is where relationships are stored.
+type reputationSeasonUserR struct {
+	Season *ReputationSeason `boil:"Season" json:"Season" toml:"Season" yaml:"Season"`
+}
+
+// NewStruct creates a new relationship struct
+func (*reputationSeasonUserR) NewStruct() *reputationSeasonUserR {
+	return &reputationSeasonUserR{}
+}
+
+func (r *reputationSeasonUserR) GetSeason() *ReputationSeason {
+	if r == nil {
+		return nil
+	}
+	return r.Season
+}
+
+// reputationSeasonUserL is where Load methods for each relationship are stored.
+type reputationSeasonUserL struct{}
+
+var (
+	reputationSeasonUserAllColumns            = []string{"season_id", "user_id", "points", "rank"}
+	reputationSeasonUserColumnsWithoutDefault = []string{"season_id", "user_id", "points", "rank"}
+	reputationSeasonUserColumnsWithDefault    = []string{}
+	reputationSeasonUserPrimaryKeyColumns     = []string{"season_id", "user_id"}
+	reputationSeasonUserGeneratedColumns      = []string{}
+)
+
+type (
+	// ReputationSeasonUserSlice is an alias for a slice of pointers to ReputationSeasonUser.
+	// This should almost always be used instead of []ReputationSeasonUser.
+	ReputationSeasonUserSlice []*ReputationSeasonUser
+
+	reputationSeasonUserQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	reputationSeasonUserType                 = reflect.TypeOf(&ReputationSeasonUser{})
+	reputationSeasonUserMapping              = queries.MakeStructMapping(reputationSeasonUserType)
+	reputationSeasonUserPrimaryKeyMapping, _ = queries.BindMapping(reputationSeasonUserType, reputationSeasonUserMapping, reputationSeasonUserPrimaryKeyColumns)
+	reputationSeasonUserInsertCacheMut       sync.RWMutex
+	reputationSeasonUserInsertCache          = make(map[string]insertCache)
+	reputationSeasonUserUpdateCacheMut       sync.RWMutex
+	reputationSeasonUserUpdateCache          = make(map[string]updateCache)
+	reputationSeasonUserUpsertCacheMut       sync.RWMutex
+	reputationSeasonUserUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single reputationSeasonUser record from the query using the global executor.
+func (q reputationSeasonUserQuery) OneG(ctx context.Context) (*ReputationSeasonUser, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single reputationSeasonUser record from the query.
+func (q reputationSeasonUserQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ReputationSeasonUser, error) {
+	o := &ReputationSeasonUser{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for reputation_season_users")
+	}
+
+	return o, nil
+}
+
+// AllG returns all ReputationSeasonUser records from the query using the global executor.
+func (q reputationSeasonUserQuery) AllG(ctx context.Context) (ReputationSeasonUserSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ReputationSeasonUser records from the query.
+func (q reputationSeasonUserQuery) All(ctx context.Context, exec boil.ContextExecutor) (ReputationSeasonUserSlice, error) {
+	var o []*ReputationSeasonUser
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ReputationSeasonUser slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ReputationSeasonUser records in the query using the global executor
+func (q reputationSeasonUserQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ReputationSeasonUser records in the query.
+func (q reputationSeasonUserQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count reputation_season_users rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q reputationSeasonUserQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q reputationSeasonUserQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if reputation_season_users exists")
+	}
+
+	return count > 0, nil
+}
+
+// Season pointed to by the foreign key.
+func (o *ReputationSeasonUser) Season(mods ...qm.QueryMod) reputationSeasonQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SeasonID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ReputationSeasons(queryMods...)
+}
+
+// LoadSeason allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reputationSeasonUserL) LoadSeason(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReputationSeasonUser interface{}, mods queries.Applicator) error {
+	var slice []*ReputationSeasonUser
+	var object *ReputationSeasonUser
+
+	if singular {
+		var ok bool
+		object, ok = maybeReputationSeasonUser.(*ReputationSeasonUser)
+		if !ok {
+			object = new(ReputationSeasonUser)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReputationSeasonUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReputationSeasonUser))
+			}
+		}
+	} else {
+		s, ok := maybeReputationSeasonUser.(*[]*ReputationSeasonUser)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReputationSeasonUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReputationSeasonUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &reputationSeasonUserR{}
+		}
+		args = append(args, object.SeasonID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reputationSeasonUserR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SeasonID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SeasonID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`reputation_seasons`),
+		qm.WhereIn(`reputation_seasons.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ReputationSeason")
+	}
+
+	var resultSlice []*ReputationSeason
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ReputationSeason")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for reputation_seasons")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reputation_seasons")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Season = foreign
+		if foreign.R == nil {
+			foreign.R = &reputationSeasonR{}
+		}
+		foreign.R.SeasonReputationSeasonUsers = append(foreign.R.SeasonReputationSeasonUsers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SeasonID == foreign.ID {
+				local.R.Season = foreign
+				if foreign.R == nil {
+					foreign.R = &reputationSeasonR{}
+				}
+				foreign.R.SeasonReputationSeasonUsers = append(foreign.R.SeasonReputationSeasonUsers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSeasonG of the reputationSeasonUser to the related item.
+// Sets o.R.Season to related.
+// Adds o to related.R.SeasonReputationSeasonUsers.
+// Uses the global database handle.
+func (o *ReputationSeasonUser) SetSeasonG(ctx context.Context, insert bool, related *ReputationSeason) error {
+	return o.SetSeason(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSeason of the reputationSeasonUser to the related item.
+// Sets o.R.Season to related.
+// Adds o to related.R.SeasonReputationSeasonUsers.
+func (o *ReputationSeasonUser) SetSeason(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ReputationSeason) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"reputation_season_users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"season_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reputationSeasonUserPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.SeasonID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SeasonID = related.ID
+	if o.R == nil {
+		o.R = &reputationSeasonUserR{
+			Season: related,
+		}
+	} else {
+		o.R.Season = related
+	}
+
+	if related.R == nil {
+		related.R = &reputationSeasonR{
+			SeasonReputationSeasonUsers: ReputationSeasonUserSlice{o},
+		}
+	} else {
+		related.R.SeasonReputationSeasonUsers = append(related.R.SeasonReputationSeasonUsers, o)
+	}
+
+	return nil
+}
+
+// ReputationSeasonUsers retrieves all the records using an executor.
+func ReputationSeasonUsers(mods ...qm.QueryMod) reputationSeasonUserQuery {
+	mods = append(mods, qm.From("\"reputation_season_users\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"reputation_season_users\".*"})
+	}
+
+	return reputationSeasonUserQuery{q}
+}
+
+// FindReputationSeasonUserG retrieves a single record by ID.
+func FindReputationSeasonUserG(ctx context.Context, seasonID int64, userID int64, selectCols ...string) (*ReputationSeasonUser, error) {
+	return FindReputationSeasonUser(ctx, boil.GetContextDB(), seasonID, userID, selectCols...)
+}
+
+// FindReputationSeasonUser retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReputationSeasonUser(ctx context.Context, exec boil.ContextExecutor, seasonID int64, userID int64, selectCols ...string) (*ReputationSeasonUser, error) {
+	reputationSeasonUserObj := &ReputationSeasonUser{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"reputation_season_users\" where \"season_id\"=$1 AND \"user_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, seasonID, userID)
+
+	err := q.Bind(ctx, exec, reputationSeasonUserObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from reputation_season_users")
+	}
+
+	return reputationSeasonUserObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ReputationSeasonUser) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ReputationSeasonUser) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no reputation_season_users provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(reputationSeasonUserColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	reputationSeasonUserInsertCacheMut.RLock()
+	cache, cached := reputationSeasonUserInsertCache[key]
+	reputationSeasonUserInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			reputationSeasonUserAllColumns,
+			reputationSeasonUserColumnsWithDefault,
+			reputationSeasonUserColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(reputationSeasonUserType, reputationSeasonUserMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(reputationSeasonUserType, reputationSeasonUserMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"reputation_season_users\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"reputation_season_users\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into reputation_season_users")
+	}
+
+	if !cached {
+		reputationSeasonUserInsertCacheMut.Lock()
+		reputationSeasonUserInsertCache[key] = cache
+		reputationSeasonUserInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single ReputationSeasonUser record using the global executor.
+// See Update for more documentation.
+func (o *ReputationSeasonUser) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ReputationSeasonUser.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ReputationSeasonUser) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	reputationSeasonUserUpdateCacheMut.RLock()
+	cache, cached := reputationSeasonUserUpdateCache[key]
+	reputationSeasonUserUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			reputationSeasonUserAllColumns,
+			reputationSeasonUserPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update reputation_season_users, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"reputation_season_users\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, reputationSeasonUserPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(reputationSeasonUserType, reputationSeasonUserMapping, append(wl, reputationSeasonUserPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update reputation_season_users row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for reputation_season_users")
+	}
+
+	if !cached {
+		reputationSeasonUserUpdateCacheMut.Lock()
+		reputationSeasonUserUpdateCache[key] = cache
+		reputationSeasonUserUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q reputationSeasonUserQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q reputationSeasonUserQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for reputation_season_users")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for reputation_season_users")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ReputationSeasonUserSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReputationSeasonUserSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reputationSeasonUserPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"reputation_season_users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, reputationSeasonUserPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in reputationSeasonUser slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all reputationSeasonUser")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ReputationSeasonUser) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ReputationSeasonUser) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no reputation_season_users provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reputationSeasonUserColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	reputationSeasonUserUpsertCacheMut.RLock()
+	cache, cached := reputationSeasonUserUpsertCache[key]
+	reputationSeasonUserUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			reputationSeasonUserAllColumns,
+			reputationSeasonUserColumnsWithDefault,
+			reputationSeasonUserColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			reputationSeasonUserAllColumns,
+			reputationSeasonUserPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert reputation_season_users, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(reputationSeasonUserPrimaryKeyColumns))
+			copy(conflict, reputationSeasonUserPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"reputation_season_users\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(reputationSeasonUserType, reputationSeasonUserMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(reputationSeasonUserType, reputationSeasonUserMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert reputation_season_users")
+	}
+
+	if !cached {
+		reputationSeasonUserUpsertCacheMut.Lock()
+		reputationSeasonUserUpsertCache[key] = cache
+		reputationSeasonUserUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single ReputationSeasonUser record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ReputationSeasonUser) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ReputationSeasonUser record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ReputationSeasonUser) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ReputationSeasonUser provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), reputationSeasonUserPrimaryKeyMapping)
+	sql := "DELETE FROM \"reputation_season_users\" WHERE \"season_id\"=$1 AND \"user_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from reputation_season_users")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for reputation_season_users")
+	}
+
+	return rowsAff, nil
+}
+
+func (q reputationSeasonUserQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q reputationSeasonUserQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no reputationSeasonUserQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from reputation_season_users")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for reputation_season_users")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ReputationSeasonUserSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReputationSeasonUserSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reputationSeasonUserPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"reputation_season_users\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reputationSeasonUserPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from reputationSeasonUser slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for reputation_season_users")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ReputationSeasonUser) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ReputationSeasonUser provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ReputationSeasonUser) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindReputationSeasonUser(ctx, exec, o.SeasonID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReputationSeasonUserSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ReputationSeasonUserSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReputationSeasonUserSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReputationSeasonUserSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reputationSeasonUserPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"reputation_season_users\".* FROM \"reputation_season_users\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reputationSeasonUserPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ReputationSeasonUserSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReputationSeasonUserExistsG checks if the ReputationSeasonUser row exists.
+func ReputationSeasonUserExistsG(ctx context.Context, seasonID int64, userID int64) (bool, error) {
+	return ReputationSeasonUserExists(ctx, boil.GetContextDB(), seasonID, userID)
+}
+
+// ReputationSeasonUserExists checks if the ReputationSeasonUser row exists.
+func ReputationSeasonUserExists(ctx context.Context, exec boil.ContextExecutor, seasonID int64, userID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"reputation_season_users\" where \"season_id\"=$1 AND \"user_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, seasonID, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, seasonID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if reputation_season_users exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ReputationSeasonUser row exists.
+func (o *ReputationSeasonUser) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ReputationSeasonUserExists(ctx, exec, o.SeasonID, o.UserID)
+}
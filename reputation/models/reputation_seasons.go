@@ -0,0 +1,974 @@
+// Code generated by SQLBoiler 4.14.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ReputationSeason is an object representing the database table.
+type ReputationSeason struct {
+	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	GuildID   int64     `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	StartedAt time.Time `boil:"started_at" json:"started_at" toml:"started_at" yaml:"started_at"`
+	EndedAt   time.Time `boil:"ended_at" json:"ended_at" toml:"ended_at" yaml:"ended_at"`
+
+	R *reputationSeasonR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L reputationSeasonL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReputationSeasonColumns = struct {
+	ID        string
+	GuildID   string
+	Name      string
+	StartedAt string
+	EndedAt   string
+}{
+	ID:        "id",
+	GuildID:   "guild_id",
+	Name:      "name",
+	StartedAt: "started_at",
+	EndedAt:   "ended_at",
+}
+
+var ReputationSeasonTableColumns = struct {
+	ID        string
+	GuildID   string
+	Name      string
+	StartedAt string
+	EndedAt   string
+}{
+	ID:        "reputation_seasons.id",
+	GuildID:   "reputation_seasons.guild_id",
+	Name:      "reputation_seasons.name",
+	StartedAt: "reputation_seasons.started_at",
+	EndedAt:   "reputation_seasons.ended_at",
+}
+
+// Generated where
+
+var ReputationSeasonWhere = struct {
+	ID        whereHelperint64
+	GuildID   whereHelperint64
+	Name      whereHelperstring
+	StartedAt whereHelpertime_Time
+	EndedAt   whereHelpertime_Time
+}{
+	ID:        whereHelperint64{field: "\"reputation_seasons\".\"id\""},
+	GuildID:   whereHelperint64{field: "\"reputation_seasons\".\"guild_id\""},
+	Name:      whereHelperstring{field: "\"reputation_seasons\".\"name\""},
+	StartedAt: whereHelpertime_Time{field: "\"reputation_seasons\".\"started_at\""},
+	EndedAt:   whereHelpertime_Time{field: "\"reputation_seasons\".\"ended_at\""},
+}
+
+// ReputationSeasonRels is where relationship names are stored.
+var ReputationSeasonRels = struct {
+	SeasonReputationSeasonUsers string
+}{
+	SeasonReputationSeasonUsers: "SeasonReputationSeasonUsers",
+}
+
+// reputationSeasonR is where relationships are stored.
+type reputationSeasonR struct {
+	SeasonReputationSeasonUsers ReputationSeasonUserSlice `boil:"SeasonReputationSeasonUsers" json:"SeasonReputationSeasonUsers" toml:"SeasonReputationSeasonUsers" yaml:"SeasonReputationSeasonUsers"`
+}
+
+// NewStruct creates a new relationship struct
+func (*reputationSeasonR) NewStruct() *reputationSeasonR {
+	return &reputationSeasonR{}
+}
+
+func (r *reputationSeasonR) GetSeasonReputationSeasonUsers() ReputationSeasonUserSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SeasonReputationSeasonUsers
+}
+
+// reputationSeasonL is where Load methods for each relationship are stored.
+type reputationSeasonL struct{}
+
+var (
+	reputationSeasonAllColumns            = []string{"id", "guild_id", "name", "started_at", "ended_at"}
+	reputationSeasonColumnsWithoutDefault = []string{"guild_id", "name", "started_at", "ended_at"}
+	reputationSeasonColumnsWithDefault    = []string{"id"}
+	reputationSeasonPrimaryKeyColumns     = []string{"id"}
+	reputationSeasonGeneratedColumns      = []string{}
+)
+
+type (
+	// ReputationSeasonSlice is an alias for a slice of pointers to ReputationSeason.
+	// This should almost always be used instead of []ReputationSeason.
+	ReputationSeasonSlice []*ReputationSeason
+
+	reputationSeasonQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	reputationSeasonType                 = reflect.TypeOf(&ReputationSeason{})
+	reputationSeasonMapping              = queries.MakeStructMapping(reputationSeasonType)
+	reputationSeasonPrimaryKeyMapping, _ = queries.BindMapping(reputationSeasonType, reputationSeasonMapping, reputationSeasonPrimaryKeyColumns)
+	reputationSeasonInsertCacheMut       sync.RWMutex
+	reputationSeasonInsertCache          = make(map[string]insertCache)
+	reputationSeasonUpdateCacheMut       sync.RWMutex
+	reputationSeasonUpdateCache          = make(map[string]updateCache)
+	reputationSeasonUpsertCacheMut       sync.RWMutex
+	reputationSeasonUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single reputationSeason record from the query using the global executor.
+func (q reputationSeasonQuery) OneG(ctx context.Context) (*ReputationSeason, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single reputationSeason record from the query.
+func (q reputationSeasonQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ReputationSeason, error) {
+	o := &ReputationSeason{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for reputation_seasons")
+	}
+
+	return o, nil
+}
+
+// AllG returns all ReputationSeason records from the query using the global executor.
+func (q reputationSeasonQuery) AllG(ctx context.Context) (ReputationSeasonSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ReputationSeason records from the query.
+func (q reputationSeasonQuery) All(ctx context.Context, exec boil.ContextExecutor) (ReputationSeasonSlice, error) {
+	var o []*ReputationSeason
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ReputationSeason slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ReputationSeason records in the query using the global executor
+func (q reputationSeasonQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ReputationSeason records in the query.
+func (q reputationSeasonQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count reputation_seasons rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q reputationSeasonQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q reputationSeasonQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if reputation_seasons exists")
+	}
+
+	return count > 0, nil
+}
+
+// SeasonReputationSeasonUsers retrieves all the reputation_season_user's ReputationSeasonUsers with an executor via season_id column.
+func (o *ReputationSeason) SeasonReputationSeasonUsers(mods ...qm.QueryMod) reputationSeasonUserQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reputation_season_users\".\"season_id\"=?", o.ID),
+	)
+
+	return ReputationSeasonUsers(queryMods...)
+}
+
+// LoadSeasonReputationSeasonUsers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (reputationSeasonL) LoadSeasonReputationSeasonUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReputationSeason interface{}, mods queries.Applicator) error {
+	var slice []*ReputationSeason
+	var object *ReputationSeason
+
+	if singular {
+		var ok bool
+		object, ok = maybeReputationSeason.(*ReputationSeason)
+		if !ok {
+			object = new(ReputationSeason)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReputationSeason)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReputationSeason))
+			}
+		}
+	} else {
+		s, ok := maybeReputationSeason.(*[]*ReputationSeason)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReputationSeason)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReputationSeason))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &reputationSeasonR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reputationSeasonR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`reputation_season_users`),
+		qm.WhereIn(`reputation_season_users.season_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reputation_season_users")
+	}
+
+	var resultSlice []*ReputationSeasonUser
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reputation_season_users")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reputation_season_users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reputation_season_users")
+	}
+
+	if singular {
+		object.R.SeasonReputationSeasonUsers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reputationSeasonUserR{}
+			}
+			foreign.R.Season = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SeasonID {
+				local.R.SeasonReputationSeasonUsers = append(local.R.SeasonReputationSeasonUsers, foreign)
+				if foreign.R == nil {
+					foreign.R = &reputationSeasonUserR{}
+				}
+				foreign.R.Season = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddSeasonReputationSeasonUsersG adds the given related objects to the existing relationships
+// of the reputation_season, optionally inserting them as new records.
+// Appends related to o.R.SeasonReputationSeasonUsers.
+// Sets related.R.Season appropriately.
+// Uses the global database handle.
+func (o *ReputationSeason) AddSeasonReputationSeasonUsersG(ctx context.Context, insert bool, related ...*ReputationSeasonUser) error {
+	return o.AddSeasonReputationSeasonUsers(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddSeasonReputationSeasonUsers adds the given related objects to the existing relationships
+// of the reputation_season, optionally inserting them as new records.
+// Appends related to o.R.SeasonReputationSeasonUsers.
+// Sets related.R.Season appropriately.
+func (o *ReputationSeason) AddSeasonReputationSeasonUsers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ReputationSeasonUser) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SeasonID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reputation_season_users\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"season_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reputationSeasonUserPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.SeasonID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SeasonID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &reputationSeasonR{
+			SeasonReputationSeasonUsers: related,
+		}
+	} else {
+		o.R.SeasonReputationSeasonUsers = append(o.R.SeasonReputationSeasonUsers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reputationSeasonUserR{
+				Season: o,
+			}
+		} else {
+			rel.R.Season = o
+		}
+	}
+	return nil
+}
+
+// ReputationSeasons retrieves all the records using an executor.
+func ReputationSeasons(mods ...qm.QueryMod) reputationSeasonQuery {
+	mods = append(mods, qm.From("\"reputation_seasons\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"reputation_seasons\".*"})
+	}
+
+	return reputationSeasonQuery{q}
+}
+
+// FindReputationSeasonG retrieves a single record by ID.
+func FindReputationSeasonG(ctx context.Context, iD int64, selectCols ...string) (*ReputationSeason, error) {
+	return FindReputationSeason(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindReputationSeason retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReputationSeason(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*ReputationSeason, error) {
+	reputationSeasonObj := &ReputationSeason{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"reputation_seasons\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, reputationSeasonObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from reputation_seasons")
+	}
+
+	return reputationSeasonObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ReputationSeason) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ReputationSeason) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no reputation_seasons provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(reputationSeasonColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	reputationSeasonInsertCacheMut.RLock()
+	cache, cached := reputationSeasonInsertCache[key]
+	reputationSeasonInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			reputationSeasonAllColumns,
+			reputationSeasonColumnsWithDefault,
+			reputationSeasonColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(reputationSeasonType, reputationSeasonMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(reputationSeasonType, reputationSeasonMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"reputation_seasons\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"reputation_seasons\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into reputation_seasons")
+	}
+
+	if !cached {
+		reputationSeasonInsertCacheMut.Lock()
+		reputationSeasonInsertCache[key] = cache
+		reputationSeasonInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single ReputationSeason record using the global executor.
+// See Update for more documentation.
+func (o *ReputationSeason) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ReputationSeason.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ReputationSeason) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	reputationSeasonUpdateCacheMut.RLock()
+	cache, cached := reputationSeasonUpdateCache[key]
+	reputationSeasonUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			reputationSeasonAllColumns,
+			reputationSeasonPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update reputation_seasons, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"reputation_seasons\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, reputationSeasonPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(reputationSeasonType, reputationSeasonMapping, append(wl, reputationSeasonPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update reputation_seasons row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for reputation_seasons")
+	}
+
+	if !cached {
+		reputationSeasonUpdateCacheMut.Lock()
+		reputationSeasonUpdateCache[key] = cache
+		reputationSeasonUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q reputationSeasonQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q reputationSeasonQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for reputation_seasons")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for reputation_seasons")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ReputationSeasonSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReputationSeasonSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reputationSeasonPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"reputation_seasons\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, reputationSeasonPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in reputationSeason slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all reputationSeason")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ReputationSeason) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ReputationSeason) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no reputation_seasons provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reputationSeasonColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	reputationSeasonUpsertCacheMut.RLock()
+	cache, cached := reputationSeasonUpsertCache[key]
+	reputationSeasonUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			reputationSeasonAllColumns,
+			reputationSeasonColumnsWithDefault,
+			reputationSeasonColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			reputationSeasonAllColumns,
+			reputationSeasonPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert reputation_seasons, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(reputationSeasonPrimaryKeyColumns))
+			copy(conflict, reputationSeasonPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"reputation_seasons\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(reputationSeasonType, reputationSeasonMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(reputationSeasonType, reputationSeasonMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert reputation_seasons")
+	}
+
+	if !cached {
+		reputationSeasonUpsertCacheMut.Lock()
+		reputationSeasonUpsertCache[key] = cache
+		reputationSeasonUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single ReputationSeason record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ReputationSeason) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ReputationSeason record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ReputationSeason) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ReputationSeason provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), reputationSeasonPrimaryKeyMapping)
+	sql := "DELETE FROM \"reputation_seasons\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from reputation_seasons")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for reputation_seasons")
+	}
+
+	return rowsAff, nil
+}
+
+func (q reputationSeasonQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q reputationSeasonQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no reputationSeasonQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from reputation_seasons")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for reputation_seasons")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ReputationSeasonSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReputationSeasonSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reputationSeasonPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"reputation_seasons\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reputationSeasonPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from reputationSeason slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for reputation_seasons")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ReputationSeason) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ReputationSeason provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ReputationSeason) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindReputationSeason(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReputationSeasonSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ReputationSeasonSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReputationSeasonSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReputationSeasonSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reputationSeasonPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"reputation_seasons\".* FROM \"reputation_seasons\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reputationSeasonPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ReputationSeasonSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReputationSeasonExistsG checks if the ReputationSeason row exists.
+func ReputationSeasonExistsG(ctx context.Context, iD int64) (bool, error) {
+	return ReputationSeasonExists(ctx, boil.GetContextDB(), iD)
+}
+
+// ReputationSeasonExists checks if the ReputationSeason row exists.
+func ReputationSeasonExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"reputation_seasons\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if reputation_seasons exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ReputationSeason row exists.
+func (o *ReputationSeason) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ReputationSeasonExists(ctx, exec, o.ID)
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,51 +24,89 @@ import (
 
 // ReputationUser is an object representing the database table.
 type ReputationUser struct {
-	UserID    int64     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	GuildID   int64     `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	Points    int64     `boil:"points" json:"points" toml:"points" yaml:"points"`
+	UserID        int64     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	GuildID       int64     `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	CreatedAt     time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	Points        int64     `boil:"points" json:"points" toml:"points" yaml:"points"`
+	LastActiveAt  time.Time `boil:"last_active_at" json:"last_active_at" toml:"last_active_at" yaml:"last_active_at"`
+	LastDecayedAt null.Time `boil:"last_decayed_at" json:"last_decayed_at,omitempty" toml:"last_decayed_at" yaml:"last_decayed_at,omitempty"`
 
 	R *reputationUserR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L reputationUserL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ReputationUserColumns = struct {
-	UserID    string
-	GuildID   string
-	CreatedAt string
-	Points    string
+	UserID        string
+	GuildID       string
+	CreatedAt     string
+	Points        string
+	LastActiveAt  string
+	LastDecayedAt string
 }{
-	UserID:    "user_id",
-	GuildID:   "guild_id",
-	CreatedAt: "created_at",
-	Points:    "points",
+	UserID:        "user_id",
+	GuildID:       "guild_id",
+	CreatedAt:     "created_at",
+	Points:        "points",
+	LastActiveAt:  "last_active_at",
+	LastDecayedAt: "last_decayed_at",
 }
 
 var ReputationUserTableColumns = struct {
-	UserID    string
-	GuildID   string
-	CreatedAt string
-	Points    string
+	UserID        string
+	GuildID       string
+	CreatedAt     string
+	Points        string
+	LastActiveAt  string
+	LastDecayedAt string
 }{
-	UserID:    "reputation_users.user_id",
-	GuildID:   "reputation_users.guild_id",
-	CreatedAt: "reputation_users.created_at",
-	Points:    "reputation_users.points",
+	UserID:        "reputation_users.user_id",
+	GuildID:       "reputation_users.guild_id",
+	CreatedAt:     "reputation_users.created_at",
+	Points:        "reputation_users.points",
+	LastActiveAt:  "reputation_users.last_active_at",
+	LastDecayedAt: "reputation_users.last_decayed_at",
 }
 
 // Generated where
 
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var ReputationUserWhere = struct {
-	UserID    whereHelperint64
-	GuildID   whereHelperint64
-	CreatedAt whereHelpertime_Time
-	Points    whereHelperint64
+	UserID        whereHelperint64
+	GuildID       whereHelperint64
+	CreatedAt     whereHelpertime_Time
+	Points        whereHelperint64
+	LastActiveAt  whereHelpertime_Time
+	LastDecayedAt whereHelpernull_Time
 }{
-	UserID:    whereHelperint64{field: "\"reputation_users\".\"user_id\""},
-	GuildID:   whereHelperint64{field: "\"reputation_users\".\"guild_id\""},
-	CreatedAt: whereHelpertime_Time{field: "\"reputation_users\".\"created_at\""},
-	Points:    whereHelperint64{field: "\"reputation_users\".\"points\""},
+	UserID:        whereHelperint64{field: "\"reputation_users\".\"user_id\""},
+	GuildID:       whereHelperint64{field: "\"reputation_users\".\"guild_id\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"reputation_users\".\"created_at\""},
+	Points:        whereHelperint64{field: "\"reputation_users\".\"points\""},
+	LastActiveAt:  whereHelpertime_Time{field: "\"reputation_users\".\"last_active_at\""},
+	LastDecayedAt: whereHelpernull_Time{field: "\"reputation_users\".\"last_decayed_at\""},
 }
 
 // ReputationUserRels is where relationship names are stored.
@@ -87,9 +126,9 @@ func (*reputationUserR) NewStruct() *reputationUserR {
 type reputationUserL struct{}
 
 var (
-	reputationUserAllColumns            = []string{"user_id", "guild_id", "created_at", "points"}
+	reputationUserAllColumns            = []string{"user_id", "guild_id", "created_at", "points", "last_active_at", "last_decayed_at"}
 	reputationUserColumnsWithoutDefault = []string{"user_id", "guild_id", "created_at", "points"}
-	reputationUserColumnsWithDefault    = []string{}
+	reputationUserColumnsWithDefault    = []string{"last_active_at", "last_decayed_at"}
 	reputationUserPrimaryKeyColumns     = []string{"guild_id", "user_id"}
 	reputationUserGeneratedColumns      = []string{}
 )
@@ -0,0 +1,160 @@
+package reputation
+
+import (
+	"time"
+
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/reputation/models"
+	"github.com/mediocregopher/radix/v3"
+)
+
+const (
+	MaxChannelMultipliers = 25
+	MaxRoleGiveLimits     = 25
+
+	MaxReasonLength = 200
+)
+
+// ChannelMultiplier returns what points given in the channel are multiplied by, thread channels should be resolved to their parent first
+func ChannelMultiplier(conf *models.ReputationConfig, channelID int64) int64 {
+	for i, v := range conf.MultiplierChannels {
+		if v == channelID && i < len(conf.MultiplierValues) && conf.MultiplierValues[i] > 0 {
+			return conf.MultiplierValues[i]
+		}
+	}
+
+	return 1
+}
+
+// RoleDailyGiveLimit returns the daily give limit of a member with the given roles,
+// if several of their roles have a limit the highest one is used
+func RoleDailyGiveLimit(conf *models.ReputationConfig, roles []int64) (limit int64, ok bool) {
+	for i, v := range conf.GiveLimitRoles {
+		if i >= len(conf.GiveLimitAmounts) || !common.ContainsInt64Slice(roles, v) {
+			continue
+		}
+
+		if !ok || conf.GiveLimitAmounts[i] > limit {
+			limit = conf.GiveLimitAmounts[i]
+			ok = true
+		}
+	}
+
+	return
+}
+
+type ChannelMultiplierEntry struct {
+	ChannelID  int64
+	Multiplier int64
+}
+
+// ChannelMultipliers returns the channel multipliers of the config in a form that's easier to display
+func ChannelMultipliers(conf *models.ReputationConfig) []*ChannelMultiplierEntry {
+	result := make([]*ChannelMultiplierEntry, 0, len(conf.MultiplierChannels))
+	for i, v := range conf.MultiplierChannels {
+		if i < len(conf.MultiplierValues) {
+			result = append(result, &ChannelMultiplierEntry{ChannelID: v, Multiplier: conf.MultiplierValues[i]})
+		}
+	}
+
+	return result
+}
+
+type RoleGiveLimitEntry struct {
+	RoleID int64
+	Limit  int64
+}
+
+// RoleGiveLimits returns the daily give limits of the config in a form that's easier to display
+func RoleGiveLimits(conf *models.ReputationConfig) []*RoleGiveLimitEntry {
+	result := make([]*RoleGiveLimitEntry, 0, len(conf.GiveLimitRoles))
+	for i, v := range conf.GiveLimitRoles {
+		if i < len(conf.GiveLimitAmounts) {
+			result = append(result, &RoleGiveLimitEntry{RoleID: v, Limit: conf.GiveLimitAmounts[i]})
+		}
+	}
+
+	return result
+}
+
+// SetChannelMultiplier adds or updates the multiplier of a channel in the config, the caller has to save it
+func SetChannelMultiplier(conf *models.ReputationConfig, channelID, multiplier int64) {
+	for i, v := range conf.MultiplierChannels {
+		if v == channelID && i < len(conf.MultiplierValues) {
+			conf.MultiplierValues[i] = multiplier
+			return
+		}
+	}
+
+	conf.MultiplierChannels = append(conf.MultiplierChannels, channelID)
+	conf.MultiplierValues = append(conf.MultiplierValues, multiplier)
+}
+
+// RemoveChannelMultiplier removes the multiplier of a channel from the config, the caller has to save it
+func RemoveChannelMultiplier(conf *models.ReputationConfig, channelID int64) {
+	conf.MultiplierChannels, conf.MultiplierValues = removeParallel(conf.MultiplierChannels, conf.MultiplierValues, channelID)
+}
+
+// SetRoleGiveLimit adds or updates the daily give limit of a role in the config, the caller has to save it
+func SetRoleGiveLimit(conf *models.ReputationConfig, roleID, limit int64) {
+	for i, v := range conf.GiveLimitRoles {
+		if v == roleID && i < len(conf.GiveLimitAmounts) {
+			conf.GiveLimitAmounts[i] = limit
+			return
+		}
+	}
+
+	conf.GiveLimitRoles = append(conf.GiveLimitRoles, roleID)
+	conf.GiveLimitAmounts = append(conf.GiveLimitAmounts, limit)
+}
+
+// RemoveRoleGiveLimit removes the daily give limit of a role from the config, the caller has to save it
+func RemoveRoleGiveLimit(conf *models.ReputationConfig, roleID int64) {
+	conf.GiveLimitRoles, conf.GiveLimitAmounts = removeParallel(conf.GiveLimitRoles, conf.GiveLimitAmounts, roleID)
+}
+
+func removeParallel(keys, values []int64, key int64) ([]int64, []int64) {
+	newKeys := make([]int64, 0, len(keys))
+	newValues := make([]int64, 0, len(values))
+	for i, v := range keys {
+		if v == key || i >= len(values) {
+			continue
+		}
+
+		newKeys = append(newKeys, v)
+		newValues = append(newValues, values[i])
+	}
+
+	return newKeys, newValues
+}
+
+// KeyDailyGiven holds the amount of points the user has given today (UTC)
+func KeyDailyGiven(guildID, senderID int64, day time.Time) string {
+	return "reputation_daily_given:" + discordgo.StrID(guildID) + ":" + discordgo.StrID(senderID) + ":" + day.UTC().Format("2006-01-02")
+}
+
+// reserveDailyGive counts amount towards the daily give limit of the sender,
+// it returns false without counting anything if that would go over the limit
+func reserveDailyGive(guildID, senderID, amount, limit int64) (bool, error) {
+	key := KeyDailyGiven(guildID, senderID, time.Now())
+
+	var given int64
+	err := common.RedisPool.Do(radix.FlatCmd(&given, "INCRBY", key, amount))
+	if err != nil {
+		return false, err
+	}
+
+	common.RedisPool.Do(radix.FlatCmd(nil, "EXPIRE", key, int((time.Hour * 48).Seconds())))
+
+	if given > limit {
+		return false, releaseDailyGive(guildID, senderID, amount)
+	}
+
+	return true, nil
+}
+
+// releaseDailyGive undoes reserveDailyGive if giving the points failed
+func releaseDailyGive(guildID, senderID, amount int64) error {
+	return common.RedisPool.Do(radix.FlatCmd(nil, "DECRBY", KeyDailyGiven(guildID, senderID, time.Now()), amount))
+}
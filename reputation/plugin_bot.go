@@ -1,11 +1,13 @@
 package reputation
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ThatBathroom/yagpdb/v2/analytics"
 	"github.com/ThatBathroom/yagpdb/v2/bot/paginatedmessages"
@@ -15,6 +17,9 @@ import (
 	"github.com/ThatBathroom/yagpdb/v2/bot/eventsystem"
 	"github.com/ThatBathroom/yagpdb/v2/commands"
 	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/common/pubsub"
+	"github.com/ThatBathroom/yagpdb/v2/common/scheduledevents2"
+	seventsmodels "github.com/ThatBathroom/yagpdb/v2/common/scheduledevents2/models"
 	"github.com/ThatBathroom/yagpdb/v2/lib/dcmd"
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
 	"github.com/ThatBathroom/yagpdb/v2/premium"
@@ -32,6 +37,138 @@ func (p *Plugin) AddCommands() {
 
 func (p *Plugin) BotInit() {
 	eventsystem.AddHandlerAsyncLastLegacy(p, handleMessageCreate, eventsystem.EventMessageCreate)
+
+	scheduledevents2.RegisterHandler(scheduledEventDecay, nil, handleDecayScheduledEvent)
+	scheduledevents2.RegisterHandler(scheduledEventSeasonEnd, nil, handleSeasonEndScheduledEvent)
+	pubsub.AddHandler("reputation_refresh_roles", handleRefreshRepRoles, nil)
+}
+
+func handleDecayScheduledEvent(evt *seventsmodels.ScheduledEvent, data interface{}) (retry bool, err error) {
+	conf, err := GetConfig(context.Background(), evt.GuildID)
+	if err != nil {
+		return true, err
+	}
+
+	// rescheduled when turned back on
+	if !conf.Enabled || conf.DecayPercent < 1 {
+		return false, nil
+	}
+
+	decayed, err := DecayGuildRep(context.Background(), evt.GuildID, conf.DecayPercent)
+	if err != nil {
+		return true, err
+	}
+
+	err = scheduledevents2.ScheduleEvent(scheduledEventDecay, evt.GuildID, time.Now().Add(decayCheckInterval), nil)
+	if err != nil {
+		logger.WithError(err).WithField("guild", evt.GuildID).Error("failed scheduling next rep decay")
+	}
+
+	if gs := bot.State.GetGuild(evt.GuildID); gs != nil && len(decayed) > 0 {
+		updateMembersRepRoles(gs, decayed)
+	}
+
+	return false, nil
+}
+
+func handleSeasonEndScheduledEvent(evt *seventsmodels.ScheduledEvent, data interface{}) (retry bool, err error) {
+	conf, err := GetConfig(context.Background(), evt.GuildID)
+	if err != nil {
+		return true, err
+	}
+
+	if !conf.Enabled || conf.SeasonLengthDays < 1 {
+		return false, nil
+	}
+
+	// the season was ended manually since this was scheduled
+	if endsAt := seasonEndsAt(conf); time.Now().Before(endsAt) {
+		return false, scheduledevents2.ScheduleEvent(scheduledEventSeasonEnd, evt.GuildID, endsAt, nil)
+	}
+
+	_, err = EndSeason(context.Background(), evt.GuildID, "")
+	if err != nil {
+		return true, err
+	}
+
+	err = scheduledevents2.ScheduleEvent(scheduledEventSeasonEnd, evt.GuildID, time.Now().AddDate(0, 0, conf.SeasonLengthDays), nil)
+	if err != nil {
+		logger.WithError(err).WithField("guild", evt.GuildID).Error("failed scheduling next rep season end")
+	}
+
+	if gs := bot.State.GetGuild(evt.GuildID); gs != nil {
+		refreshGuildRepRoles(gs)
+	}
+
+	return false, nil
+}
+
+func handleRefreshRepRoles(evt *pubsub.Event) {
+	gs := bot.State.GetGuild(evt.TargetGuildInt)
+	if gs == nil {
+		return
+	}
+
+	refreshGuildRepRoles(gs)
+}
+
+// updateMembersRepRoles updates the rep roles of the members with the given points that are in the state
+func updateMembersRepRoles(gs *dstate.GuildSet, points map[int64]int64) {
+	for userID, p := range points {
+		ms := bot.State.GetMember(gs.ID, userID)
+		if ms == nil || ms.Member == nil {
+			continue
+		}
+
+		if err := UpdateRepRoles(gs, ms, p); err != nil {
+			logger.WithError(err).WithField("guild", gs.ID).Error("failed updating rep roles")
+			return
+		}
+	}
+}
+
+// refreshGuildRepRoles re-evaluates the rep roles of everyone in the state that has one, after a reset for example
+func refreshGuildRepRoles(gs *dstate.GuildSet) {
+	repRoles, err := models.ReputationRoles(models.ReputationRoleWhere.GuildID.EQ(gs.ID)).AllG(context.Background())
+	if err != nil || len(repRoles) < 1 {
+		return
+	}
+
+	roleIDs := make([]int64, 0, len(repRoles))
+	for _, v := range repRoles {
+		roleIDs = append(roleIDs, v.Role)
+	}
+
+	users, err := models.ReputationUsers(models.ReputationUserWhere.GuildID.EQ(gs.ID)).AllG(context.Background())
+	if err != nil {
+		logger.WithError(err).WithField("guild", gs.ID).Error("failed retrieving rep users")
+		return
+	}
+
+	points := make(map[int64]int64)
+	for _, v := range users {
+		points[v.UserID] = v.Points
+	}
+
+	// members with rep roles can lose them and members with points can gain them
+	bot.State.IterateMembers(gs.ID, func(chunk []*dstate.MemberState) bool {
+		for _, ms := range chunk {
+			if ms.Member == nil {
+				continue
+			}
+
+			if _, ok := points[ms.User.ID]; !ok && !common.ContainsInt64SliceOneOf(ms.Member.Roles, roleIDs) {
+				continue
+			}
+
+			if err := UpdateRepRoles(gs, ms, points[ms.User.ID]); err != nil {
+				logger.WithError(err).WithField("guild", gs.ID).Error("failed updating rep roles")
+				return false
+			}
+		}
+
+		return true
+	})
 }
 
 var thanksRegex = regexp.MustCompile(`(?i)( |\n|^)(thanks?|danks|ty|thx|\+rep|\+ ?\<\@[0-9]*\>)( |\pP|\n|$)`)
@@ -102,7 +239,7 @@ func handleMessageCreate(evt *eventsystem.EventData) {
 		if err = CanModifyRep(conf, sender, target); err != nil {
 			continue
 		}
-		err = ModifyRep(evt.Context(), conf, evt.GS, sender, target, 1)
+		given, err := ModifyRep(evt.Context(), conf, evt.GS, sender, target, channelID, 1, "")
 		if err != nil {
 			if err == ErrCooldown {
 				// Ignore this error silently
@@ -121,7 +258,7 @@ func handleMessageCreate(evt *eventsystem.EventData) {
 			continue
 		}
 
-		content := fmt.Sprintf("Gave +1 %s to **%s** (current: `#%d` - `%d`)", conf.PointsName, who.Mention(), newRank, newScore)
+		content := fmt.Sprintf("Gave +%d %s to **%s** (current: `#%d` - `%d`)", given, conf.PointsName, who.Mention(), newRank, newScore)
 		common.BotSession.ChannelMessageSend(msg.ChannelID, content)
 	}
 }
@@ -136,7 +273,9 @@ var cmds = []*commands.YAGCommand{
 		Arguments: []*dcmd.ArgDef{
 			{Name: "User", Type: dcmd.User},
 			{Name: "Num", Type: dcmd.Int, Default: 1},
+			{Name: "Reason", Type: dcmd.String},
 		},
+		ArgumentCombos:      [][]int{{0, 1, 2}, {0, 1}, {0, 2}, {0}},
 		SlashCommandEnabled: true,
 		DefaultEnabled:      false,
 		RunFunc: func(parsed *dcmd.Data) (interface{}, error) {
@@ -158,7 +297,9 @@ var cmds = []*commands.YAGCommand{
 		Arguments: []*dcmd.ArgDef{
 			{Name: "User", Type: dcmd.User},
 			{Name: "Num", Type: dcmd.Int, Default: 1},
+			{Name: "Reason", Type: dcmd.String},
 		},
+		ArgumentCombos: [][]int{{0, 1, 2}, {0, 1}, {0, 2}, {0}},
 		RunFunc: func(parsed *dcmd.Data) (interface{}, error) {
 			if parsed.Args[1].Int() < 1 {
 				return "**rep amount should be greater than or equal to 1**", nil
@@ -344,6 +485,9 @@ var cmds = []*commands.YAGCommand{
 					f = "#%2d: %-15s: %s set %s points to: %d"
 				}
 				out.WriteString(fmt.Sprintf(f, i+offset+1, entry.CreatedAt.UTC().Format("02 Jan 06 15:04"), sender, receiver, entry.Amount))
+				if entry.Reason != "" {
+					out.WriteString(" (" + entry.Reason + ")")
+				}
 				out.WriteRune('\n')
 			}
 
@@ -504,9 +648,13 @@ func CmdGiveRep(parsed *dcmd.Data) (interface{}, error) {
 		return nil, err
 	}
 
-	amount := parsed.Args[1].Int()
+	// threads use the multiplier of their parent channel
+	channelID := parsed.ChannelID
+	if cs := parsed.GuildData.GS.GetChannelOrThread(channelID); cs != nil && cs.Type.IsThread() {
+		channelID = cs.ParentID
+	}
 
-	err = ModifyRep(parsed.Context(), conf, parsed.GuildData.GS, sender, receiver, int64(amount))
+	amount, err := ModifyRep(parsed.Context(), conf, parsed.GuildData.GS, sender, receiver, channelID, int64(parsed.Args[1].Int()), parsed.Args[2].Str())
 	if err != nil {
 		if cast, ok := err.(UserError); ok {
 			return cast, nil
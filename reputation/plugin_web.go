@@ -7,10 +7,12 @@ import (
 	"html/template"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/ThatBathroom/yagpdb/v2/common"
 	"github.com/ThatBathroom/yagpdb/v2/common/cplogs"
 	"github.com/ThatBathroom/yagpdb/v2/common/featureflags"
+	"github.com/ThatBathroom/yagpdb/v2/common/pubsub"
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
 	"github.com/ThatBathroom/yagpdb/v2/premium"
 	"github.com/ThatBathroom/yagpdb/v2/reputation/models"
@@ -43,6 +45,8 @@ type PostConfigForm struct {
 	WhitelistedThanksChannels []int64 `valid:"channel,true"`
 	BlacklistedThanksChannels []int64 `valid:"channel,true"`
 	ThanksRegex               string  `valid:"regex,2000"`
+	DecayPercent              int     `valid:"0,100"`
+	SeasonLengthDays          int     `valid:"0,3650"`
 }
 
 func (p PostConfigForm) RepConfig() *models.ReputationConfig {
@@ -61,6 +65,8 @@ func (p PostConfigForm) RepConfig() *models.ReputationConfig {
 		WhitelistedThanksChannels: p.WhitelistedThanksChannels,
 		BlacklistedThanksChannels: p.BlacklistedThanksChannels,
 		ThanksRegex:               null.String{String: p.ThanksRegex, Valid: p.ThanksRegex != ""},
+		DecayPercent:              p.DecayPercent,
+		SeasonLengthDays:          p.SeasonLengthDays,
 	}
 }
 
@@ -74,12 +80,31 @@ type PostRoleForm struct {
 	Role int64 `valid:"role,true"`
 }
 
+type EndSeasonForm struct {
+	Name string `valid:",100"`
+}
+
+type SetMultiplierForm struct {
+	Channel    int64 `valid:"channel,false"`
+	Multiplier int64 `valid:"2,10"`
+}
+
+type SetGiveLimitForm struct {
+	Role  int64 `valid:"role,false"`
+	Limit int64 `valid:"1,"`
+}
+
 var (
 	panelLogKeyUpdatedSettings = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "reputation_settings_updated", FormatString: "Updated reputation settings"})
 	panelLogKeyResetReputation = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "reputation_reset_reputation", FormatString: "Reset reputation"})
 	panelLogKeyNewRepRole      = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "reputation_role_added", FormatString: "Reputation role created"})
 	panelLogKeyUpdateRepRole   = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "reputation_role_updated", FormatString: "Reputation role updated"})
 	panelLogKeyDeleteRepRole   = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "reputation_role_deleted", FormatString: "Reputation role deleted"})
+	panelLogKeyEndSeason       = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "reputation_season_ended", FormatString: "Ended reputation season %s"})
+	panelLogKeySetMultiplier   = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "reputation_multiplier_set", FormatString: "Set reputation channel multiplier"})
+	panelLogKeyDelMultiplier   = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "reputation_multiplier_deleted", FormatString: "Removed reputation channel multiplier"})
+	panelLogKeySetGiveLimit    = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "reputation_give_limit_set", FormatString: "Set reputation daily give limit"})
+	panelLogKeyDelGiveLimit    = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "reputation_give_limit_deleted", FormatString: "Removed reputation daily give limit"})
 )
 
 func (p *Plugin) InitWeb() {
@@ -105,12 +130,18 @@ func (p *Plugin) InitWeb() {
 	subMux.Handle(pat.Post(""), web.ControllerPostHandler(HandlePostReputation, mainGetHandler, PostConfigForm{}))
 	subMux.Handle(pat.Post("/"), web.ControllerPostHandler(HandlePostReputation, mainGetHandler, PostConfigForm{}))
 	subMux.Handle(pat.Post("/reset_users"), web.ControllerPostHandler(HandleResetReputation, mainGetHandler, nil))
+	subMux.Handle(pat.Post("/end_season"), web.ControllerPostHandler(HandleEndSeason, mainGetHandler, EndSeasonForm{}))
 	subMux.Handle(pat.Get("/logs"), web.APIHandler(HandleLogsJson))
 
 	subMux.Handle(pat.Post("/new_role"), web.ControllerPostHandler(HandleNewRepRole, mainGetHandler, NewRoleForm{}))
 	subMux.Handle(pat.Post("/roles/:id/update"), web.ControllerPostHandler(HandleUpdateRepRole, mainGetHandler, PostRoleForm{}))
 	subMux.Handle(pat.Post("/roles/:id/delete"), web.ControllerPostHandler(HandleDeleteRepRole, mainGetHandler, nil))
 
+	subMux.Handle(pat.Post("/multipliers/set"), web.ControllerPostHandler(HandleSetMultiplier, mainGetHandler, SetMultiplierForm{}))
+	subMux.Handle(pat.Post("/multipliers/:channel/delete"), web.ControllerPostHandler(HandleDeleteMultiplier, mainGetHandler, nil))
+	subMux.Handle(pat.Post("/give_limits/set"), web.ControllerPostHandler(HandleSetGiveLimit, mainGetHandler, SetGiveLimitForm{}))
+	subMux.Handle(pat.Post("/give_limits/:role/delete"), web.ControllerPostHandler(HandleDeleteGiveLimit, mainGetHandler, nil))
+
 	web.ServerPublicMux.Handle(pat.Get("/reputation/leaderboard"), web.RenderHandler(HandleGetReputation, "cp_reputation_leaderboard"))
 	web.ServerPublicAPIMux.Handle(pat.Get("/reputation/leaderboard"), web.APIHandler(HandleLeaderboardJson))
 }
@@ -125,6 +156,11 @@ func HandleGetReputation(w http.ResponseWriter, r *http.Request) interface{} {
 		}
 	}
 
+	if settings, ok := templateData["RepSettings"].(*models.ReputationConfig); ok {
+		templateData["RepMultipliers"] = ChannelMultipliers(settings)
+		templateData["RepGiveLimits"] = RoleGiveLimits(settings)
+	}
+
 	repRoles, err := models.ReputationRoles(
 		models.ReputationRoleWhere.GuildID.EQ(activeGuild.ID),
 		qm.OrderBy("rep_threshold ASC"),
@@ -132,6 +168,11 @@ func HandleGetReputation(w http.ResponseWriter, r *http.Request) interface{} {
 	if !web.CheckErr(templateData, err, "Failed retrieving reputation roles", web.CtxLogger(r.Context()).Error) {
 		templateData["RepRoles"] = repRoles
 	}
+
+	seasons, err := GuildSeasons(r.Context(), activeGuild.ID)
+	if !web.CheckErr(templateData, err, "Failed retrieving reputation seasons", web.CtxLogger(r.Context()).Error) {
+		templateData["RepSeasons"] = seasons
+	}
 	return templateData
 }
 
@@ -150,7 +191,12 @@ func HandlePostReputation(w http.ResponseWriter, r *http.Request) (templateData
 
 	templateData["RepSettings"] = conf
 
-	err = conf.UpsertG(r.Context(), true, []string{"guild_id"}, boil.Whitelist(
+	oldConf, err := GetConfig(r.Context(), activeGuild.ID)
+	if err != nil {
+		return
+	}
+
+	updateCols := []string{
 		"points_name",
 		"enabled",
 		"cooldown",
@@ -165,16 +211,57 @@ func HandlePostReputation(w http.ResponseWriter, r *http.Request) (templateData
 		"whitelisted_thanks_channels",
 		"blacklisted_thanks_channels",
 		"thanks_regex",
-	), boil.Infer())
+		"decay_percent",
+		"season_length_days",
+	}
+
+	// start the season over when its length changes, the old start could be long enough ago that it would end right away
+	if seasonRestarts(oldConf, conf) {
+		conf.SeasonStartedAt = time.Now()
+		updateCols = append(updateCols, "season_started_at")
+	}
+
+	err = conf.UpsertG(r.Context(), true, []string{"guild_id"}, boil.Whitelist(updateCols...), boil.Infer())
 
 	if err == nil {
 		featureflags.MarkGuildDirty(activeGuild.ID)
 		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeyUpdatedSettings))
+
+		// reload it for the season start
+		conf, err = GetConfig(r.Context(), activeGuild.ID)
+		if err != nil {
+			return
+		}
+
+		templateData["RepSettings"] = conf
+		err = ScheduleMaintenanceEvents(r.Context(), conf)
+		if err == ErrSeasonEndPassed {
+			templateData.AddAlerts(web.ErrorAlert("The current season should already have ended, change the season length or end it manually"))
+			err = nil
+		}
 	}
 
 	return
 }
 
+func HandleEndSeason(w http.ResponseWriter, r *http.Request) (templateData web.TemplateData, err error) {
+	activeGuild, templateData := web.GetBaseCPContextData(r.Context())
+	templateData["VisibleURL"] = "/manage/" + discordgo.StrID(activeGuild.ID) + "/reputation"
+
+	form := r.Context().Value(common.ContextKeyParsedForm).(*EndSeasonForm)
+
+	season, err := EndSeason(r.Context(), activeGuild.ID, form.Name)
+	if err != nil {
+		return templateData, err
+	}
+
+	// everyone is back at 0 so the bot has to take the rep roles away
+	go pubsub.Publish("reputation_refresh_roles", activeGuild.ID, nil)
+	go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeyEndSeason, &cplogs.Param{Type: cplogs.ParamTypeString, Value: season.Name}))
+
+	return templateData.AddAlerts(web.SucessAlert("Ended ", season.Name, ", the leaderboard was archived and everyone's points were reset.")), nil
+}
+
 func HandleResetReputation(w http.ResponseWriter, r *http.Request) (templateData web.TemplateData, err error) {
 	activeGuild, templateData := web.GetBaseCPContextData(r.Context())
 	templateData["VisibleURL"] = "/manage/" + discordgo.StrID(activeGuild.ID) + "/reputation"
@@ -263,6 +350,106 @@ func HandleDeleteRepRole(w http.ResponseWriter, r *http.Request) (templateData w
 	return templateData, err
 }
 
+func HandleSetMultiplier(w http.ResponseWriter, r *http.Request) (templateData web.TemplateData, err error) {
+	activeGuild, templateData := web.GetBaseCPContextData(r.Context())
+	templateData["VisibleURL"] = "/manage/" + discordgo.StrID(activeGuild.ID) + "/reputation"
+
+	form := r.Context().Value(common.ContextKeyParsedForm).(*SetMultiplierForm)
+
+	conf, err := GetConfig(r.Context(), activeGuild.ID)
+	if err != nil {
+		return templateData, err
+	}
+
+	if !common.ContainsInt64Slice(conf.MultiplierChannels, form.Channel) && len(conf.MultiplierChannels) >= MaxChannelMultipliers {
+		return templateData.AddAlerts(web.ErrorAlert("Too many channel multipliers (max ", MaxChannelMultipliers, ")")), nil
+	}
+
+	SetChannelMultiplier(conf, form.Channel, form.Multiplier)
+	err = saveMultipliersAndLimits(r, conf)
+	if err == nil {
+		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeySetMultiplier))
+	}
+	return templateData, err
+}
+
+func HandleDeleteMultiplier(w http.ResponseWriter, r *http.Request) (templateData web.TemplateData, err error) {
+	activeGuild, templateData := web.GetBaseCPContextData(r.Context())
+	templateData["VisibleURL"] = "/manage/" + discordgo.StrID(activeGuild.ID) + "/reputation"
+
+	channelID, err := strconv.ParseInt(pat.Param(r, "channel"), 10, 64)
+	if err != nil {
+		return templateData.AddAlerts(web.ErrorAlert("Invalid channel")), nil
+	}
+
+	conf, err := GetConfig(r.Context(), activeGuild.ID)
+	if err != nil {
+		return templateData, err
+	}
+
+	RemoveChannelMultiplier(conf, channelID)
+	err = saveMultipliersAndLimits(r, conf)
+	if err == nil {
+		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeyDelMultiplier))
+	}
+	return templateData, err
+}
+
+func HandleSetGiveLimit(w http.ResponseWriter, r *http.Request) (templateData web.TemplateData, err error) {
+	activeGuild, templateData := web.GetBaseCPContextData(r.Context())
+	templateData["VisibleURL"] = "/manage/" + discordgo.StrID(activeGuild.ID) + "/reputation"
+
+	form := r.Context().Value(common.ContextKeyParsedForm).(*SetGiveLimitForm)
+
+	conf, err := GetConfig(r.Context(), activeGuild.ID)
+	if err != nil {
+		return templateData, err
+	}
+
+	if !common.ContainsInt64Slice(conf.GiveLimitRoles, form.Role) && len(conf.GiveLimitRoles) >= MaxRoleGiveLimits {
+		return templateData.AddAlerts(web.ErrorAlert("Too many daily give limits (max ", MaxRoleGiveLimits, ")")), nil
+	}
+
+	SetRoleGiveLimit(conf, form.Role, form.Limit)
+	err = saveMultipliersAndLimits(r, conf)
+	if err == nil {
+		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeySetGiveLimit))
+	}
+	return templateData, err
+}
+
+func HandleDeleteGiveLimit(w http.ResponseWriter, r *http.Request) (templateData web.TemplateData, err error) {
+	activeGuild, templateData := web.GetBaseCPContextData(r.Context())
+	templateData["VisibleURL"] = "/manage/" + discordgo.StrID(activeGuild.ID) + "/reputation"
+
+	roleID, err := strconv.ParseInt(pat.Param(r, "role"), 10, 64)
+	if err != nil {
+		return templateData.AddAlerts(web.ErrorAlert("Invalid role")), nil
+	}
+
+	conf, err := GetConfig(r.Context(), activeGuild.ID)
+	if err != nil {
+		return templateData, err
+	}
+
+	RemoveRoleGiveLimit(conf, roleID)
+	err = saveMultipliersAndLimits(r, conf)
+	if err == nil {
+		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeyDelGiveLimit))
+	}
+	return templateData, err
+}
+
+// saveMultipliersAndLimits only saves the multiplier and give limit columns, so they don't overwrite the rest of the settings
+func saveMultipliersAndLimits(r *http.Request, conf *models.ReputationConfig) error {
+	return conf.UpsertG(r.Context(), true, []string{"guild_id"}, boil.Whitelist(
+		"multiplier_channels",
+		"multiplier_values",
+		"give_limit_roles",
+		"give_limit_amounts",
+	), boil.Infer())
+}
+
 func HandleLeaderboardJson(w http.ResponseWriter, r *http.Request) interface{} {
 	activeGuild, _ := web.GetBaseCPContextData(r.Context())
 
@@ -299,7 +486,12 @@ func HandleLeaderboardJson(w http.ResponseWriter, r *http.Request) interface{} {
 		limit = 10
 	}
 
-	top, err := TopUsers(activeGuild.ID, offset, limit)
+	var top []*RankEntry
+	if seasonID, _ := strconv.ParseInt(query.Get("season"), 10, 64); seasonID != 0 {
+		top, err = SeasonTopUsers(activeGuild.ID, seasonID, offset, limit)
+	} else {
+		top, err = TopUsers(activeGuild.ID, offset, limit)
+	}
 	if err != nil {
 		return err
 	}
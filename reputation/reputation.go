@@ -146,7 +146,9 @@ var (
 	ErrUpdatingRepRoles = UserError("Failed updating rep roles for member")
 )
 
-func ModifyRep(ctx context.Context, conf *models.ReputationConfig, gs *dstate.GuildSet, sender, receiver *dstate.MemberState, amount int64) (err error) {
+// ModifyRep gives amount points to the receiver (or takes them away if negative), multiplied by the multiplier of the channel
+// it was given in, and returns the amount that was actually applied
+func ModifyRep(ctx context.Context, conf *models.ReputationConfig, gs *dstate.GuildSet, sender, receiver *dstate.MemberState, channelID, amount int64, reason string) (applied int64, err error) {
 	if conf == nil {
 		conf, err = GetConfig(ctx, gs.ID)
 		if err != nil {
@@ -165,7 +167,30 @@ func ModifyRep(ctx context.Context, conf *models.ReputationConfig, gs *dstate.Gu
 		err = UserError(fmt.Sprintf("Can't remove that much (max %d)", conf.MaxRemoveAmount))
 		return
 	} else if amount == 0 {
-		return nil
+		return 0, nil
+	}
+
+	// the daily limit counts the points before the multiplier, admins don't have one
+	dailyLimited := false
+	if amount > 0 && !common.ContainsInt64SliceOneOf(sender.Member.Roles, conf.AdminRoles) {
+		if limit, ok := RoleDailyGiveLimit(conf, sender.Member.Roles); ok {
+			ok, err = reserveDailyGive(gs.ID, sender.User.ID, amount, limit)
+			if err != nil {
+				return
+			}
+			if !ok {
+				err = UserError(fmt.Sprintf("You can't give more than %d %s per day", limit, conf.PointsName))
+				return
+			}
+
+			dailyLimited = true
+		}
+	}
+
+	releaseDaily := func() {
+		if dailyLimited {
+			releaseDailyGive(gs.ID, sender.User.ID, amount)
+		}
 	}
 
 	ok, err := CheckSetCooldown(conf, sender.User.ID, receiver.User.ID)
@@ -173,19 +198,31 @@ func ModifyRep(ctx context.Context, conf *models.ReputationConfig, gs *dstate.Gu
 		if err == nil {
 			err = ErrCooldown
 		}
+		releaseDaily()
 		return
 	}
 
-	newRep, err := insertUpdateUserRep(ctx, gs.ID, receiver.User.ID, amount)
+	applied = amount
+	if amount > 0 {
+		applied *= ChannelMultiplier(conf, channelID)
+	}
+
+	newRep, err := insertUpdateUserRep(ctx, gs.ID, receiver.User.ID, applied)
 	if err != nil {
 		// Clear the cooldown since it failed updating the rep
 		ClearCooldown(gs.ID, sender.User.ID, receiver.User.ID)
+		releaseDaily()
 		return
 	}
 
+	// giving rep also counts as activity for decay
+	if err := markRepActive(ctx, gs.ID, sender.User.ID); err != nil {
+		logger.WithError(err).WithField("guild_id", gs.ID).Error("failed marking rep sender as active")
+	}
+
 	if err := UpdateRepRoles(gs, receiver, newRep); err != nil {
 		logger.WithField("guild_id", gs.ID).Errorf("failed updating rep roles: %s", err)
-		return ErrUpdatingRepRoles
+		return applied, ErrUpdatingRepRoles
 	}
 
 	receiverUsername := receiver.User.String()
@@ -199,7 +236,8 @@ func ModifyRep(ctx context.Context, conf *models.ReputationConfig, gs *dstate.Gu
 		ReceiverID:       receiver.User.ID,
 		ReceiverUsername: receiverUsername,
 		SetFixedAmount:   false,
-		Amount:           amount,
+		Amount:           applied,
+		Reason:           common.CutStringShort(reason, MaxReasonLength),
 	}
 
 	err = entry.InsertG(ctx, boil.Infer())
@@ -313,7 +351,7 @@ func insertUpdateUserRep(ctx context.Context, guildID, userID int64, amount int6
 INSERT INTO reputation_users (created_at, guild_id, user_id, points)
 VALUES ($1, $2, $3, $4)
 ON CONFLICT (guild_id, user_id)
-DO UPDATE SET points = reputation_users.points + $4
+DO UPDATE SET points = reputation_users.points + $4, last_active_at = now()
 RETURNING points;
 `
 	row := common.PQ.QueryRowContext(ctx, query, time.Now(), guildID, userID, amount)
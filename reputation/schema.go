@@ -101,4 +101,47 @@ CREATE TABLE IF NOT EXISTS reputation_roles (
 );
 `, `
 CREATE INDEX IF NOT EXISTS reputation_roles_guild_idx ON reputation_roles(guild_id);
+`, `
+ALTER TABLE reputation_configs ADD COLUMN IF NOT EXISTS decay_percent INT NOT NULL DEFAULT 0;
+`, `
+ALTER TABLE reputation_configs ADD COLUMN IF NOT EXISTS season_length_days INT NOT NULL DEFAULT 0;
+`, `
+ALTER TABLE reputation_configs ADD COLUMN IF NOT EXISTS season_started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now();
+`, `
+ALTER TABLE reputation_users ADD COLUMN IF NOT EXISTS last_active_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now();
+`, `
+ALTER TABLE reputation_users ADD COLUMN IF NOT EXISTS last_decayed_at TIMESTAMP WITH TIME ZONE;
+`, `
+CREATE TABLE IF NOT EXISTS reputation_seasons (
+	id bigserial PRIMARY KEY,
+	guild_id bigint NOT NULL,
+	name TEXT NOT NULL,
+
+	started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	ended_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+`, `
+CREATE INDEX IF NOT EXISTS reputation_seasons_guild_idx ON reputation_seasons(guild_id);
+`, `
+CREATE TABLE IF NOT EXISTS reputation_season_users (
+	season_id bigint NOT NULL REFERENCES reputation_seasons(id) ON DELETE CASCADE,
+	user_id bigint NOT NULL,
+
+	points bigint NOT NULL,
+	rank int NOT NULL,
+
+	PRIMARY KEY(season_id, user_id)
+);
+`, `
+ALTER TABLE reputation_log ADD COLUMN IF NOT EXISTS reason TEXT NOT NULL DEFAULT '';
+`, `
+-- parallel arrays, multiplier_values[i] is the multiplier of multiplier_channels[i]
+ALTER TABLE reputation_configs ADD COLUMN IF NOT EXISTS multiplier_channels BIGINT[];
+`, `
+ALTER TABLE reputation_configs ADD COLUMN IF NOT EXISTS multiplier_values BIGINT[];
+`, `
+-- parallel arrays, give_limit_amounts[i] is the daily give limit of members with give_limit_roles[i]
+ALTER TABLE reputation_configs ADD COLUMN IF NOT EXISTS give_limit_roles BIGINT[];
+`, `
+ALTER TABLE reputation_configs ADD COLUMN IF NOT EXISTS give_limit_amounts BIGINT[];
 `}
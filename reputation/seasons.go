@@ -0,0 +1,130 @@
+package reputation
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/reputation/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const MaxSeasonNameLength = 100
+
+// EndSeason archives the current leaderboard into a new season and resets everyone's points,
+// if name is empty the season is named after its number
+func EndSeason(ctx context.Context, guildID int64, name string) (*models.ReputationSeason, error) {
+	conf, err := GetConfig(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := common.PQ.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	season, err := endSeasonTx(ctx, tx, conf, name)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	return season, nil
+}
+
+func endSeasonTx(ctx context.Context, tx *sql.Tx, conf *models.ReputationConfig, name string) (*models.ReputationSeason, error) {
+	if name == "" {
+		count, err := models.ReputationSeasons(models.ReputationSeasonWhere.GuildID.EQ(conf.GuildID)).Count(ctx, tx)
+		if err != nil {
+			return nil, errors.WithStackIf(err)
+		}
+
+		name = "Season " + strconv.FormatInt(count+1, 10)
+	}
+
+	now := time.Now()
+	season := &models.ReputationSeason{
+		GuildID:   conf.GuildID,
+		Name:      common.CutStringShort(name, MaxSeasonNameLength),
+		StartedAt: conf.SeasonStartedAt,
+		EndedAt:   now,
+	}
+
+	// configs that were never saved don't have a start
+	if season.StartedAt.IsZero() {
+		season.StartedAt = now
+	}
+
+	err := season.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	const archiveQuery = `INSERT INTO reputation_season_users (season_id, user_id, points, rank)
+SELECT $1, user_id, points, RANK() OVER(ORDER BY points DESC)
+FROM reputation_users WHERE guild_id = $2`
+
+	_, err = tx.ExecContext(ctx, archiveQuery, season.ID, conf.GuildID)
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	_, err = models.ReputationUsers(models.ReputationUserWhere.GuildID.EQ(conf.GuildID)).DeleteAll(ctx, tx)
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE reputation_configs SET season_started_at = $2 WHERE guild_id = $1", conf.GuildID, now)
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	return season, nil
+}
+
+// GuildSeasons returns the past seasons of the guild, newest first
+func GuildSeasons(ctx context.Context, guildID int64) (models.ReputationSeasonSlice, error) {
+	return models.ReputationSeasons(
+		models.ReputationSeasonWhere.GuildID.EQ(guildID),
+		qm.OrderBy("id DESC"),
+	).AllG(ctx)
+}
+
+// SeasonTopUsers works like TopUsers but for the archived leaderboard of a past season
+func SeasonTopUsers(guildID, seasonID int64, offset, limit int) ([]*RankEntry, error) {
+	const query = `SELECT su.points, su.rank, su.user_id
+FROM reputation_season_users su
+INNER JOIN reputation_seasons s ON s.id = su.season_id
+WHERE s.guild_id = $1 AND su.season_id = $2
+ORDER BY su.rank ASC, su.user_id ASC
+LIMIT $3 OFFSET $4`
+
+	rows, err := common.PQ.Query(query, guildID, seasonID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*RankEntry, 0, limit)
+	for rows.Next() {
+		entry := &RankEntry{}
+		err = rows.Scan(&entry.Points, &entry.Rank, &entry.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, entry)
+	}
+
+	return result, rows.Err()
+}
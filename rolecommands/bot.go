@@ -47,7 +47,7 @@ func (p *Plugin) AddCommands() {
 		CmdCategory:         categoryRoleMenu,
 		Aliases:             []string{"c"},
 		Description:         "Set up a role menu.",
-		LongDescription:     "Specify a message with -m to use an existing message instead of having the bot make one\n\n" + msgIDDocs,
+		LongDescription:     "Specify a message with -m to use an existing message instead of having the bot make one\n\nUse -buttons or -select to have members pick roles with buttons or a select menu instead of reactions.\n\n" + msgIDDocs,
 		RequireDiscordPerms: []int64{discordgo.PermissionManageGuild},
 		RequiredArgs:        1,
 		Arguments: []*dcmd.ArgDef{
@@ -58,6 +58,8 @@ func (p *Plugin) AddCommands() {
 			{Name: "nodm", Help: "Disable DM"},
 			{Name: "rr", Help: "Remove role on reaction removed"},
 			{Name: "skip", Help: "Number of roles to skip", Default: 0, Type: dcmd.Int},
+			{Name: "buttons", Help: "Use buttons instead of reactions"},
+			{Name: "select", Help: "Use a select menu instead of reactions"},
 		},
 		RunFunc: cmdFuncRoleMenuCreate,
 	}
@@ -136,6 +138,23 @@ func (p *Plugin) AddCommands() {
 		RunFunc: cmdFuncRoleMenuComplete,
 	}
 
+	cmdMigrate := &commands.YAGCommand{
+		Name:                "Migrate",
+		CmdCategory:         categoryRoleMenu,
+		Aliases:             []string{"convert"},
+		Description:         "Converts a reaction role menu into a button or select menu.",
+		LongDescription:     "Menus are converted to buttons by default, use -select for a select menu instead. Use -all to convert every reaction menu on the server. Only menus on messages sent by the bot can be converted.\n\n" + msgIDDocs,
+		RequireDiscordPerms: []int64{discordgo.PermissionManageGuild},
+		Arguments: []*dcmd.ArgDef{
+			{Name: "Message-ID", Type: dcmd.BigInt},
+		},
+		ArgSwitches: []*dcmd.ArgDef{
+			{Name: "select", Help: "Convert to a select menu"},
+			{Name: "all", Help: "Convert all reaction menus on the server"},
+		},
+		RunFunc: cmdFuncRoleMenuMigrate,
+	}
+
 	cmdListGroups := &commands.YAGCommand{
 		Name:                "Listgroups",
 		CmdCategory:         categoryRoleMenu,
@@ -157,6 +176,7 @@ func (p *Plugin) AddCommands() {
 	menuContainer.AddCommand(cmdResetReactions, cmdResetReactions.GetTrigger())
 	menuContainer.AddCommand(cmdEditOption, cmdEditOption.GetTrigger())
 	menuContainer.AddCommand(cmdFinishSetup, cmdFinishSetup.GetTrigger())
+	menuContainer.AddCommand(cmdMigrate, cmdMigrate.GetTrigger())
 	menuContainer.AddCommand(cmdListGroups, cmdListGroups.GetTrigger())
 	commands.RegisterSlashCommandsContainer(menuContainer, true, func(gs *dstate.GuildSet) ([]int64, error) {
 		return nil, nil
@@ -178,6 +198,7 @@ type ScheduledEventUpdateMenuMessageData struct {
 func (p *Plugin) BotInit() {
 	eventsystem.AddHandlerAsyncLastLegacy(p, handleReactionAddRemove, eventsystem.EventMessageReactionAdd, eventsystem.EventMessageReactionRemove)
 	eventsystem.AddHandlerAsyncLastLegacy(p, handleMessageRemove, eventsystem.EventMessageDelete, eventsystem.EventMessageDeleteBulk)
+	eventsystem.AddHandlerAsyncLastLegacy(p, handleInteractionCreate, eventsystem.EventInteractionCreate)
 
 	scheduledevents2.RegisterHandler("remove_member_role", ScheduledMemberRoleRemoveData{}, handleRemoveMemberRole)
 	scheduledevents2.RegisterHandler("rolemenu_update_message", ScheduledEventUpdateMenuMessageData{}, handleUpdateRolemenuMessage)
@@ -334,6 +355,10 @@ func handleRemoveMemberRole(evt *schEvtsModels.ScheduledEvent, data interface{})
 
 OUTER:
 	for _, v := range menus {
+		if v.Kind != RoleMenuKindReactions {
+			continue
+		}
+
 		for _, opt := range v.R.RoleMenuOptions {
 			if opt.R.RoleCommand.Role == dataCast.RoleID {
 				// remove it
@@ -35,6 +35,9 @@ type CommonRoleSettings struct {
 	// White list and blacklist roles for this specific role
 	WhitelistRoles []int64
 	BlacklistRoles []int64
+
+	// The message id of the menu the role was picked from, 0 if it was the role command, used for usage stats
+	MenuID int64
 }
 
 // Assumes relationships are loaded for non standalone menus
@@ -42,7 +45,9 @@ type CommonRoleSettings struct {
 func CommonRoleFromRoleMenuCommand(rm *models.RoleMenu, option *models.RoleMenuOption) *CommonRoleSettings {
 	if rm.RoleGroupID.Valid {
 		// this is not a standalone menu, its tied to a rolegroup
-		return CommonRoleFromRoleCommand(rm.R.RoleGroup, option.R.RoleCommand)
+		settings := CommonRoleFromRoleCommand(rm.R.RoleGroup, option.R.RoleCommand)
+		settings.MenuID = rm.MessageID
+		return settings
 	}
 
 	return &CommonRoleSettings{
@@ -59,6 +64,8 @@ func CommonRoleFromRoleMenuCommand(rm *models.RoleMenu, option *models.RoleMenuO
 		ParentWhitelistRoles: rm.StandaloneWhitelistRoles,
 		ParentBlacklistRoles: rm.StandaloneBlacklistRoles,
 		ParentGroupMode:      int(rm.StandaloneMode.Int16),
+
+		MenuID: rm.MessageID,
 	}
 }
 
@@ -161,6 +168,17 @@ func (c *CommonRoleSettings) CanRole(ctx context.Context, ms *dstate.MemberState
 		}
 	}
 
+	// Prerequisites only apply when getting the role, taking it away is always allowed
+	if !common.ContainsInt64Slice(ms.Member.Roles, c.RoleId) {
+		if err := c.checkMinReputation(ms); err != nil {
+			return false, err
+		}
+
+		if err := c.checkGroupRelations(ctx, ms); err != nil {
+			return false, err
+		}
+	}
+
 	if c.ParentMenu != nil || c.ParentGroup != nil {
 		return c.ParentCanRole(ctx, ms)
 	}
@@ -258,13 +276,19 @@ func (c *CommonRoleSettings) CheckToggleRole(ctx context.Context, ms *dstate.Mem
 		return false, err
 	}
 
-	// This command belongs to a group/menu, let the group handle it
 	if c.ParentGroup != nil || c.ParentMenu != nil {
-		return c.GroupToggleRole(ctx, ms)
+		// This command belongs to a group/menu, let the group handle it
+		gaveRole, err = c.GroupToggleRole(ctx, ms)
+	} else {
+		// This is a single command, just toggle it
+		gaveRole, err = c.ToggleRole(ms)
 	}
 
-	// This is a single command, just toggle it
-	return c.ToggleRole(ms)
+	if err == nil {
+		go recordRoleUsage(ms.GuildID, c.RoleId, c.MenuID, gaveRole)
+	}
+
+	return gaveRole, err
 }
 
 // ToggleRole toggles the role of a guildmember, adding it if the member does not have the role and removing it if they do
@@ -300,7 +324,9 @@ func (c *CommonRoleSettings) GroupToggleRole(ctx context.Context, ms *dstate.Mem
 	for _, v := range commands {
 		if common.ContainsInt64Slice(ms.Member.Roles, v.RoleId) {
 			if c.ModeSettings().SingleAutoToggleOff {
-				common.BotSession.GuildMemberRoleRemove(ms.GuildID, ms.User.ID, v.RoleId)
+				if common.BotSession.GuildMemberRoleRemove(ms.GuildID, ms.User.ID, v.RoleId) == nil {
+					go recordRoleUsage(ms.GuildID, v.RoleId, c.MenuID, false)
+				}
 			} else {
 				return false, NewCommonRoleError("Max 1 role in **%s** is allowed", c)
 			}
@@ -0,0 +1,490 @@
+package rolecommands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/ThatBathroom/yagpdb/v2/analytics"
+	"github.com/ThatBathroom/yagpdb/v2/bot"
+	"github.com/ThatBathroom/yagpdb/v2/bot/eventsystem"
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/lib/dcmd"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/lib/dstate"
+	"github.com/ThatBathroom/yagpdb/v2/rolecommands/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	RoleMenuButtonPrefix = "rolemenu_button_"
+	RoleMenuSelectID     = "rolemenu_select"
+
+	// 5 rows of 5 buttons, which is also the max number of options in a select menu
+	MaxComponentMenuOptions = 25
+)
+
+// menuGroupMode returns the group mode of the menu, taken from the role group if it has one
+func menuGroupMode(rm *models.RoleMenu) int {
+	if rm.RoleGroupID.Valid {
+		return int(rm.R.RoleGroup.Mode)
+	}
+
+	return int(rm.StandaloneMode.Int16)
+}
+
+func optionComponentEmoji(opt *models.RoleMenuOption) *discordgo.ComponentEmoji {
+	if opt.EmojiID != 0 {
+		return &discordgo.ComponentEmoji{ID: opt.EmojiID, Animated: opt.EmojiAnimated}
+	}
+
+	if opt.UnicodeEmoji != "" {
+		return &discordgo.ComponentEmoji{Name: opt.UnicodeEmoji}
+	}
+
+	return nil
+}
+
+// MenuComponents creates the buttons or select menu members use to pick roles from the menu,
+// returns nil for reaction menus
+func MenuComponents(gs *dstate.GuildSet, rm *models.RoleMenu) []discordgo.TopLevelComponent {
+	opts := rm.R.RoleMenuOptions
+	if rm.Kind == RoleMenuKindReactions || len(opts) < 1 {
+		return nil
+	}
+
+	sort.Slice(opts, OptionsLessFunc(!rm.RoleGroupID.Valid, opts))
+	if len(opts) > MaxComponentMenuOptions {
+		opts = opts[:MaxComponentMenuOptions]
+	}
+
+	if rm.Kind == RoleMenuKindSelect {
+		selectOpts := make([]discordgo.SelectMenuOption, 0, len(opts))
+		for _, opt := range opts {
+			selectOpts = append(selectOpts, discordgo.SelectMenuOption{
+				Label: common.CutStringShort(OptionName(gs, opt), 100),
+				Value: strconv.FormatInt(opt.ID, 10),
+				Emoji: optionComponentEmoji(opt),
+			})
+		}
+
+		maxValues := len(selectOpts)
+		if menuGroupMode(rm) == GroupModeSingle {
+			maxValues = 1
+		}
+
+		minValues := 1
+		return []discordgo.TopLevelComponent{
+			discordgo.ActionsRow{
+				Components: []discordgo.InteractiveComponent{
+					discordgo.SelectMenu{
+						CustomID:    RoleMenuSelectID,
+						Placeholder: "Pick the roles to add or remove",
+						MinValues:   &minValues,
+						MaxValues:   maxValues,
+						Options:     selectOpts,
+					},
+				},
+			},
+		}
+	}
+
+	rows := make([]discordgo.TopLevelComponent, 0, (len(opts)+4)/5)
+	var row discordgo.ActionsRow
+	for i, opt := range opts {
+		row.Components = append(row.Components, discordgo.Button{
+			Label:    common.CutStringShort(OptionName(gs, opt), 80),
+			Style:    discordgo.SecondaryButton,
+			Emoji:    optionComponentEmoji(opt),
+			CustomID: RoleMenuButtonPrefix + strconv.FormatInt(opt.ID, 10),
+		})
+
+		if len(row.Components) == 5 || i == len(opts)-1 {
+			rows = append(rows, row)
+			row = discordgo.ActionsRow{}
+		}
+	}
+
+	return rows
+}
+
+// UpdateRoleMenuComponents updates only the components of the menu message, used for menus on messages
+// the bot sent but doesn't manage the contents of
+func UpdateRoleMenuComponents(rm *models.RoleMenu) error {
+	gs := bot.State.GetGuild(rm.GuildID)
+	if gs == nil {
+		return errors.New("Guild not found")
+	}
+
+	_, err := common.BotSession.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		ID:         rm.MessageID,
+		Channel:    rm.ChannelID,
+		Components: MenuComponents(gs, rm),
+	})
+	return err
+}
+
+func refreshMenuMessage(ctx context.Context, rm *models.RoleMenu) error {
+	if rm.OwnMessage {
+		return UpdateRoleMenuMessage(ctx, rm)
+	}
+
+	return UpdateRoleMenuComponents(rm)
+}
+
+// addComponentMenuOptions adds an option for each role command in the group that's not on the menu yet,
+// button and select menus don't need an emoji for every option so they're set up in one go
+func addComponentMenuOptions(ctx context.Context, rm *models.RoleMenu) (resp string, err error) {
+	commands := rm.R.RoleGroup.R.RoleCommands
+	sort.Slice(commands, RoleCommandsLessFunc(commands))
+
+	numLeftOut := 0
+
+OUTER:
+	for i, cmd := range commands {
+		if i < rm.SkipAmount {
+			continue
+		}
+
+		for _, option := range rm.R.RoleMenuOptions {
+			if cmd.ID == option.RoleCommandID.Int64 {
+				continue OUTER
+			}
+		}
+
+		if len(rm.R.RoleMenuOptions) >= MaxComponentMenuOptions {
+			numLeftOut++
+			continue
+		}
+
+		model := &models.RoleMenuOption{
+			RoleMenuID:    rm.MessageID,
+			RoleCommandID: null.Int64From(cmd.ID),
+		}
+
+		err = model.InsertG(ctx, boil.Infer())
+		if err != nil {
+			return "Failed inserting option into the database, use `rolemenu update ...` to try again.", err
+		}
+
+		model.R = model.R.NewStruct()
+		model.R.RoleCommand = cmd
+		rm.R.RoleMenuOptions = append(rm.R.RoleMenuOptions, model)
+	}
+
+	extra := ""
+	if numLeftOut > 0 {
+		extra = fmt.Sprintf("\n\nMenus can contain max %d roles, couldn't fit them all into this one, you can add the remaining to another menu using `rolemenu create %s -skip %d`", MaxComponentMenuOptions, rm.R.RoleGroup.Name, rm.SkipAmount+MaxComponentMenuOptions)
+		rm.FixedAmount = true
+	}
+
+	rm.State = RoleMenuStateDone
+	rm.NextRoleCommandID = null.Int64{}
+	_, err = rm.UpdateG(ctx, boil.Infer())
+	ClearRolemenuCache(rm.GuildID)
+	if err != nil {
+		return "Failed saving the menu", err
+	}
+
+	err = refreshMenuMessage(ctx, rm)
+	if err != nil {
+		code, _ := common.DiscordError(err)
+		switch code {
+		case discordgo.ErrCodeMissingAccess, discordgo.ErrCodeMissingPermissions:
+			return "I do not have permissions to update the menu message, please give me the proper permissions and use `rolemenu update ...` to update the menu message.", nil
+		default:
+			return "An error occurred updating the menu message, use the `rolemenu update <id>` command to manually update the message", err
+		}
+	}
+
+	return "Done setting up! You can delete all the messages now (except for the menu itself)" + extra, nil
+}
+
+// canAddComponents returns true if the bot can add components to the menu message, which is only possible on its own messages
+func canAddComponents(rm *models.RoleMenu) (bool, error) {
+	if rm.OwnMessage {
+		return true, nil
+	}
+
+	msg, err := common.BotSession.ChannelMessage(rm.ChannelID, rm.MessageID)
+	if err != nil {
+		return false, err
+	}
+
+	return msg.Author != nil && msg.Author.ID == common.BotUser.ID, nil
+}
+
+func handleInteractionCreate(evt *eventsystem.EventData) {
+	ic := evt.InteractionCreate()
+	if ic.Type != discordgo.InteractionMessageComponent || ic.GuildID == 0 || ic.Member == nil || ic.Message == nil {
+		return
+	}
+
+	data := ic.MessageComponentData()
+	var optionIDs []string
+	if data.CustomID == RoleMenuSelectID {
+		optionIDs = data.Values
+	} else if strings.HasPrefix(data.CustomID, RoleMenuButtonPrefix) {
+		optionIDs = []string{strings.TrimPrefix(data.CustomID, RoleMenuButtonPrefix)}
+	} else {
+		return
+	}
+
+	gs := bot.State.GetGuild(ic.GuildID)
+	if gs == nil {
+		return
+	}
+
+	respond := func(msg string) {
+		err := common.BotSession.CreateInteractionResponse(ic.ID, ic.Token, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content:         msg,
+				Flags:           discordgo.MessageFlagsEphemeral,
+				AllowedMentions: &discordgo.AllowedMentions{},
+			},
+		})
+		if err != nil {
+			logger.WithError(err).WithField("guild", ic.GuildID).Error("failed responding to rolemenu interaction")
+		}
+	}
+
+	menu, err := GetRolemenuCached(evt.Context(), gs, ic.Message.ID)
+	if err != nil {
+		logger.WithError(err).WithField("guild", ic.GuildID).Error("RoleCommandsMenu: Failed finding menu")
+		respond("Something went wrong, try again later")
+		return
+	}
+
+	if menu == nil || menu.MessageID != ic.Message.ID {
+		respond("This role menu no longer exists")
+		return
+	}
+
+	if menu.State != RoleMenuStateDone {
+		respond("This menu is still being set up or edited, try again in a bit")
+		return
+	}
+
+	options := make([]*models.RoleMenuOption, 0, len(optionIDs))
+	for _, v := range optionIDs {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		for _, opt := range menu.R.RoleMenuOptions {
+			if opt.ID == id {
+				options = append(options, opt)
+				break
+			}
+		}
+	}
+
+	if len(options) < 1 {
+		respond("That role is no longer part of this menu")
+		return
+	}
+
+	resp, err := MemberChooseComponentOptions(evt.Context(), menu, gs, options, ic.Member.User.ID)
+	if err != nil && !common.IsDiscordErr(err, discordgo.ErrCodeUnknownRole, discordgo.ErrCodeMissingPermissions) {
+		logger.WithError(err).WithField("guild", menu.GuildID).Error("Failed applying role from menu")
+	}
+
+	respond(resp)
+}
+
+// MemberChooseComponentOptions toggles the roles of the options a member picked from a button or select menu,
+// respecting the group mode and role requirements, and returns a summary of what changed
+func MemberChooseComponentOptions(ctx context.Context, rm *models.RoleMenu, gs *dstate.GuildSet, options []*models.RoleMenuOption, userID int64) (resp string, err error) {
+	member, err := bot.GetMember(gs.ID, userID)
+	if err != nil {
+		return "An error occurred giving you the role", err
+	}
+
+	if member.User.Bot {
+		return "", nil
+	}
+
+	// work on a copy as the roles are updated as we go
+	memberCop := *member
+	fieldsCop := *member.Member
+	fieldsCop.Roles = append([]int64(nil), member.Member.Roles...)
+	memberCop.Member = &fieldsCop
+	member = &memberCop
+
+	var added, removed, failed []string
+	for _, option := range options {
+		cr := CommonRoleFromRoleMenuCommand(rm, option)
+
+		// roles that will be toggled off by single mode if this one is given
+		var toggledOff []int64
+		if cr.ParentGroupMode == GroupModeSingle && cr.ModeSettings().SingleAutoToggleOff {
+			for _, v := range cr.AllGroupRoles(ctx) {
+				if v.RoleId != cr.RoleId && common.ContainsInt64Slice(member.Member.Roles, v.RoleId) {
+					toggledOff = append(toggledOff, v.RoleId)
+				}
+			}
+		}
+
+		given, roleErr := cr.CheckToggleRole(ctx, member)
+		if roleErr != nil {
+			var msg string
+			msg, roleErr = HumanizeAssignError(gs, roleErr)
+			if roleErr != nil {
+				err = roleErr
+			}
+
+			failed = append(failed, fmt.Sprintf("%s: %s", OptionName(gs, option), msg))
+			continue
+		}
+
+		if given {
+			added = append(added, fmt.Sprintf("<@&%d>", cr.RoleId))
+			member.Member.Roles = append(member.Member.Roles, cr.RoleId)
+
+			for _, v := range toggledOff {
+				removed = append(removed, fmt.Sprintf("<@&%d>", v))
+				member.Member.Roles = removeRoleID(member.Member.Roles, v)
+			}
+		} else {
+			removed = append(removed, fmt.Sprintf("<@&%d>", cr.RoleId))
+			member.Member.Roles = removeRoleID(member.Member.Roles, cr.RoleId)
+		}
+	}
+
+	go analytics.RecordActiveUnit(gs.ID, &Plugin{}, "user_interacted_menu")
+
+	var out strings.Builder
+	if len(added) > 0 {
+		out.WriteString("Added " + strings.Join(added, ", ") + "\n")
+	}
+	if len(removed) > 0 {
+		out.WriteString("Removed " + strings.Join(removed, ", ") + "\n")
+	}
+	for _, v := range failed {
+		out.WriteString(v + "\n")
+	}
+
+	if out.Len() == 0 {
+		return "Nothing changed", err
+	}
+
+	return out.String(), err
+}
+
+func removeRoleID(roles []int64, roleID int64) []int64 {
+	for i, v := range roles {
+		if v == roleID {
+			return append(roles[:i], roles[i+1:]...)
+		}
+	}
+
+	return roles
+}
+
+func cmdFuncRoleMenuMigrate(data *dcmd.Data) (interface{}, error) {
+	kind := int16(RoleMenuKindButtons)
+	if data.Switch("select").Bool() {
+		kind = RoleMenuKindSelect
+	}
+
+	var menus []*models.RoleMenu
+	if data.Switch("all").Bool() {
+		var err error
+		menus, err = models.RoleMenus(
+			models.RoleMenuWhere.GuildID.EQ(data.GuildData.GS.ID),
+			models.RoleMenuWhere.Kind.EQ(RoleMenuKindReactions),
+			qm.Load("RoleMenuOptions.RoleCommand"), qm.Load("RoleGroup.RoleCommands"),
+		).AllG(data.Context())
+		if err != nil {
+			return nil, err
+		}
+
+		if len(menus) < 1 {
+			return "There are no reaction role menus on this server", nil
+		}
+	} else {
+		if data.Args[0].Value == nil {
+			return "Specify the ID of the menu message to convert, or use `-all` to convert every reaction menu on the server", nil
+		}
+
+		menu, err := FindRolemenuFull(data.Context(), data.Args[0].Int64(), data.GuildData.GS.ID)
+		if err != nil {
+			return "Couldn't find menu", nil
+		}
+
+		if menu.Kind != RoleMenuKindReactions {
+			return "This menu already uses buttons or a select menu", nil
+		}
+
+		menus = []*models.RoleMenu{menu}
+	}
+
+	var out strings.Builder
+	numConverted := 0
+	for _, menu := range menus {
+		result, err := migrateRoleMenu(data.Context(), menu, kind)
+		if err != nil {
+			logger.WithError(err).WithField("guild", menu.GuildID).WithField("rm_id", menu.MessageID).Error("failed converting role menu")
+		}
+
+		if result != "" {
+			fmt.Fprintf(&out, "`%d`: %s\n", menu.MessageID, result)
+		} else {
+			numConverted++
+		}
+	}
+
+	ClearRolemenuCache(data.GuildData.GS.ID)
+	fmt.Fprintf(&out, "Converted %d of %d menus", numConverted, len(menus))
+	return out.String(), nil
+}
+
+// migrateRoleMenu converts a reaction menu into a button or select menu, returns a non empty string
+// describing why if it couldn't be converted
+func migrateRoleMenu(ctx context.Context, menu *models.RoleMenu, kind int16) (string, error) {
+	if menu.State != RoleMenuStateDone {
+		return "This menu is still being set up or edited, use `rolemenu complete ...` to complete the setup first.", nil
+	}
+
+	if len(menu.R.RoleMenuOptions) > MaxComponentMenuOptions {
+		return fmt.Sprintf("Menus can contain max %d roles", MaxComponentMenuOptions), nil
+	}
+
+	ok, err := canAddComponents(menu)
+	if err != nil {
+		return "Failed retrieving the menu message", err
+	}
+
+	if !ok {
+		return "Buttons and select menus can only be added to messages sent by me, create a new menu instead", nil
+	}
+
+	menu.Kind = kind
+	err = refreshMenuMessage(ctx, menu)
+	if err != nil {
+		menu.Kind = RoleMenuKindReactions
+		if _, dErr := common.DiscordError(err); dErr != "" {
+			return "Failed updating the menu message, Discord responded with: " + dErr, nil
+		}
+		return "Failed updating the menu message", err
+	}
+
+	_, err = menu.UpdateG(ctx, boil.Whitelist(models.RoleMenuColumns.Kind))
+	if err != nil {
+		return "Failed saving the menu", err
+	}
+
+	err = common.BotSession.MessageReactionsRemoveAll(menu.ChannelID, menu.MessageID)
+	if err != nil {
+		return "", err
+	}
+
+	return "", nil
+}
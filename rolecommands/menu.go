@@ -106,6 +106,12 @@ func cmdFuncRoleMenuCreate(parsed *dcmd.Data) (interface{}, error) {
 		SkipAmount:                 skipAmount,
 	}
 
+	if parsed.Switch("select").Bool() {
+		model.Kind = RoleMenuKindSelect
+	} else if parsed.Switch("buttons").Bool() {
+		model.Kind = RoleMenuKindButtons
+	}
+
 	if group != nil {
 		model.RoleGroupID = null.Int64From(group.ID)
 	}
@@ -120,6 +126,10 @@ func cmdFuncRoleMenuCreate(parsed *dcmd.Data) (interface{}, error) {
 			return nil, err
 		}
 
+		if model.Kind != RoleMenuKindReactions && msg.Author.ID != common.BotUser.ID {
+			return "Buttons and select menus can only be added to messages sent by me", nil
+		}
+
 		model.MessageID = id
 	} else {
 
@@ -190,6 +200,8 @@ func UpdateMenu(parsed *dcmd.Data, menu *models.RoleMenu) (interface{}, error) {
 
 	if menu.OwnMessage {
 		UpdateRoleMenuMessage(parsed.Context(), menu)
+	} else if menu.Kind != RoleMenuKindReactions {
+		UpdateRoleMenuComponents(menu)
 	}
 
 	if !menu.RoleGroupID.Valid {
@@ -207,6 +219,9 @@ func UpdateMenu(parsed *dcmd.Data, menu *models.RoleMenu) (interface{}, error) {
 }
 
 func NextRoleMenuSetupStep(ctx context.Context, rm *models.RoleMenu, first bool) (resp string, err error) {
+	if rm.Kind != RoleMenuKindReactions {
+		return addComponentMenuOptions(ctx, rm)
+	}
 
 	commands := rm.R.RoleGroup.R.RoleCommands
 	sort.Slice(commands, RoleCommandsLessFunc(commands))
@@ -269,11 +284,19 @@ func UpdateRoleMenuMessage(ctx context.Context, rm *models.RoleMenu) error {
 		return updateCustomMessage(ctx, rm)
 	}
 
+	instructions := "React to give yourself a role."
+	switch rm.Kind {
+	case RoleMenuKindButtons:
+		instructions = "Click a button to give yourself a role, click it again to remove it."
+	case RoleMenuKindSelect:
+		instructions = "Pick roles below to give yourself a role, pick it again to remove it."
+	}
+
 	newMsg := ""
 	if rm.RoleGroupID.Valid {
-		newMsg = "**Role Menu: " + rm.R.RoleGroup.Name + "**\nReact to give yourself a role.\n\n"
+		newMsg = "**Role Menu: " + rm.R.RoleGroup.Name + "**\n" + instructions + "\n\n"
 	} else {
-		newMsg = "**Role Menu**\nReact to give yourself a role.\n\n"
+		newMsg = "**Role Menu**\n" + instructions + "\n\n"
 	}
 
 	opts := rm.R.RoleMenuOptions
@@ -284,6 +307,17 @@ func UpdateRoleMenuMessage(ctx context.Context, rm *models.RoleMenu) error {
 		return errors.New("Guild not found")
 	}
 
+	if rm.Kind != RoleMenuKindReactions {
+		// the options are listed in the components
+		_, err := common.BotSession.ChannelMessageEditComplex(&discordgo.MessageEdit{
+			ID:         rm.MessageID,
+			Channel:    rm.ChannelID,
+			Content:    &newMsg,
+			Components: MenuComponents(gs, rm),
+		})
+		return err
+	}
+
 	for _, opt := range opts {
 		emoji := opt.UnicodeEmoji
 		if opt.EmojiID != 0 {
@@ -328,6 +362,15 @@ func updateCustomMessage(ctx context.Context, rm *models.RoleMenu) error {
 		}
 	}
 
+	if rm.Kind != RoleMenuKindReactions {
+		gs := bot.State.GetGuild(rm.GuildID)
+		if gs == nil {
+			return errors.New("Guild not found")
+		}
+
+		edit.Components = MenuComponents(gs, rm)
+	}
+
 	_, err := common.BotSession.ChannelMessageEditComplex(&edit)
 	if err != nil {
 		return err
@@ -481,7 +524,8 @@ func handleReactionAddRemove(evt *eventsystem.EventData) {
 		return
 	}
 
-	if menu == nil {
+	if menu == nil || menu.Kind != RoleMenuKindReactions {
+		// button and select menus are handled in handleInteractionCreate
 		return
 	}
 
@@ -562,12 +606,7 @@ func MemberChooseOption(ctx context.Context, rm *models.RoleMenu, gs *dstate.Gui
 
 	var given bool
 
-	var cr *CommonRoleSettings = nil
-	if rm.RoleGroupID.Valid {
-		cr = CommonRoleFromRoleCommand(rm.R.RoleGroup, option.R.RoleCommand)
-	} else {
-		cr = CommonRoleFromRoleMenuCommand(rm, option)
-	}
+	cr := CommonRoleFromRoleMenuCommand(rm, option)
 
 	if rm.RemoveRoleOnReactionRemove {
 		//  Strictly assign or remove based on wether the reaction was added or removed
@@ -753,6 +792,10 @@ func cmdFuncRoleMenuResetReactions(data *dcmd.Data) (interface{}, error) {
 		return "Couldn't find menu", nil
 	}
 
+	if menu.Kind != RoleMenuKindReactions {
+		return fmt.Sprintf("This menu uses buttons or a select menu, use `rolemenu update %d` to refresh it instead.", menu.MessageID), nil
+	}
+
 	err = common.BotSession.MessageReactionsRemoveAll(menu.ChannelID, menu.MessageID)
 	if err != nil {
 		return nil, err
@@ -802,6 +845,10 @@ func cmdFuncRoleMenuEditOption(data *dcmd.Data) (interface{}, error) {
 		return "This menu isn't 'done' (still being edited, or made), use `rolemenu complete ...` to complete the setup.", nil
 	}
 
+	if menu.Kind != RoleMenuKindReactions {
+		return "Options of button and select menus don't need an emoji, use `rolemenu update ...` to refresh the menu instead.", nil
+	}
+
 	menu.State = RoleMenuStateEditingOptionSelecting
 	menu.OwnerID = data.Author.ID
 	menu.SetupMSGID = 0
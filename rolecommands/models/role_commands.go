@@ -25,67 +25,72 @@ import (
 
 // RoleCommand is an object representing the database table.
 type RoleCommand struct {
-	ID           int64            `boil:"id" json:"id" toml:"id" yaml:"id"`
-	CreatedAt    time.Time        `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt    time.Time        `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	GuildID      int64            `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
-	Name         string           `boil:"name" json:"name" toml:"name" yaml:"name"`
-	RoleGroupID  null.Int64       `boil:"role_group_id" json:"role_group_id,omitempty" toml:"role_group_id" yaml:"role_group_id,omitempty"`
-	Role         int64            `boil:"role" json:"role" toml:"role" yaml:"role"`
-	RequireRoles types.Int64Array `boil:"require_roles" json:"require_roles,omitempty" toml:"require_roles" yaml:"require_roles,omitempty"`
-	IgnoreRoles  types.Int64Array `boil:"ignore_roles" json:"ignore_roles,omitempty" toml:"ignore_roles" yaml:"ignore_roles,omitempty"`
-	Position     int64            `boil:"position" json:"position" toml:"position" yaml:"position"`
+	ID            int64            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt     time.Time        `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time        `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	GuildID       int64            `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	Name          string           `boil:"name" json:"name" toml:"name" yaml:"name"`
+	RoleGroupID   null.Int64       `boil:"role_group_id" json:"role_group_id,omitempty" toml:"role_group_id" yaml:"role_group_id,omitempty"`
+	Role          int64            `boil:"role" json:"role" toml:"role" yaml:"role"`
+	RequireRoles  types.Int64Array `boil:"require_roles" json:"require_roles,omitempty" toml:"require_roles" yaml:"require_roles,omitempty"`
+	IgnoreRoles   types.Int64Array `boil:"ignore_roles" json:"ignore_roles,omitempty" toml:"ignore_roles" yaml:"ignore_roles,omitempty"`
+	Position      int64            `boil:"position" json:"position" toml:"position" yaml:"position"`
+	MinReputation int64            `boil:"min_reputation" json:"min_reputation" toml:"min_reputation" yaml:"min_reputation"`
 
 	R *roleCommandR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L roleCommandL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var RoleCommandColumns = struct {
-	ID           string
-	CreatedAt    string
-	UpdatedAt    string
-	GuildID      string
-	Name         string
-	RoleGroupID  string
-	Role         string
-	RequireRoles string
-	IgnoreRoles  string
-	Position     string
+	ID            string
+	CreatedAt     string
+	UpdatedAt     string
+	GuildID       string
+	Name          string
+	RoleGroupID   string
+	Role          string
+	RequireRoles  string
+	IgnoreRoles   string
+	Position      string
+	MinReputation string
 }{
-	ID:           "id",
-	CreatedAt:    "created_at",
-	UpdatedAt:    "updated_at",
-	GuildID:      "guild_id",
-	Name:         "name",
-	RoleGroupID:  "role_group_id",
-	Role:         "role",
-	RequireRoles: "require_roles",
-	IgnoreRoles:  "ignore_roles",
-	Position:     "position",
+	ID:            "id",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+	GuildID:       "guild_id",
+	Name:          "name",
+	RoleGroupID:   "role_group_id",
+	Role:          "role",
+	RequireRoles:  "require_roles",
+	IgnoreRoles:   "ignore_roles",
+	Position:      "position",
+	MinReputation: "min_reputation",
 }
 
 var RoleCommandTableColumns = struct {
-	ID           string
-	CreatedAt    string
-	UpdatedAt    string
-	GuildID      string
-	Name         string
-	RoleGroupID  string
-	Role         string
-	RequireRoles string
-	IgnoreRoles  string
-	Position     string
+	ID            string
+	CreatedAt     string
+	UpdatedAt     string
+	GuildID       string
+	Name          string
+	RoleGroupID   string
+	Role          string
+	RequireRoles  string
+	IgnoreRoles   string
+	Position      string
+	MinReputation string
 }{
-	ID:           "role_commands.id",
-	CreatedAt:    "role_commands.created_at",
-	UpdatedAt:    "role_commands.updated_at",
-	GuildID:      "role_commands.guild_id",
-	Name:         "role_commands.name",
-	RoleGroupID:  "role_commands.role_group_id",
-	Role:         "role_commands.role",
-	RequireRoles: "role_commands.require_roles",
-	IgnoreRoles:  "role_commands.ignore_roles",
-	Position:     "role_commands.position",
+	ID:            "role_commands.id",
+	CreatedAt:     "role_commands.created_at",
+	UpdatedAt:     "role_commands.updated_at",
+	GuildID:       "role_commands.guild_id",
+	Name:          "role_commands.name",
+	RoleGroupID:   "role_commands.role_group_id",
+	Role:          "role_commands.role",
+	RequireRoles:  "role_commands.require_roles",
+	IgnoreRoles:   "role_commands.ignore_roles",
+	Position:      "role_commands.position",
+	MinReputation: "role_commands.min_reputation",
 }
 
 // Generated where
@@ -224,27 +229,29 @@ func (w whereHelpertypes_Int64Array) IsNull() qm.QueryMod    { return qmhelper.W
 func (w whereHelpertypes_Int64Array) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var RoleCommandWhere = struct {
-	ID           whereHelperint64
-	CreatedAt    whereHelpertime_Time
-	UpdatedAt    whereHelpertime_Time
-	GuildID      whereHelperint64
-	Name         whereHelperstring
-	RoleGroupID  whereHelpernull_Int64
-	Role         whereHelperint64
-	RequireRoles whereHelpertypes_Int64Array
-	IgnoreRoles  whereHelpertypes_Int64Array
-	Position     whereHelperint64
+	ID            whereHelperint64
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+	GuildID       whereHelperint64
+	Name          whereHelperstring
+	RoleGroupID   whereHelpernull_Int64
+	Role          whereHelperint64
+	RequireRoles  whereHelpertypes_Int64Array
+	IgnoreRoles   whereHelpertypes_Int64Array
+	Position      whereHelperint64
+	MinReputation whereHelperint64
 }{
-	ID:           whereHelperint64{field: "\"role_commands\".\"id\""},
-	CreatedAt:    whereHelpertime_Time{field: "\"role_commands\".\"created_at\""},
-	UpdatedAt:    whereHelpertime_Time{field: "\"role_commands\".\"updated_at\""},
-	GuildID:      whereHelperint64{field: "\"role_commands\".\"guild_id\""},
-	Name:         whereHelperstring{field: "\"role_commands\".\"name\""},
-	RoleGroupID:  whereHelpernull_Int64{field: "\"role_commands\".\"role_group_id\""},
-	Role:         whereHelperint64{field: "\"role_commands\".\"role\""},
-	RequireRoles: whereHelpertypes_Int64Array{field: "\"role_commands\".\"require_roles\""},
-	IgnoreRoles:  whereHelpertypes_Int64Array{field: "\"role_commands\".\"ignore_roles\""},
-	Position:     whereHelperint64{field: "\"role_commands\".\"position\""},
+	ID:            whereHelperint64{field: "\"role_commands\".\"id\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"role_commands\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"role_commands\".\"updated_at\""},
+	GuildID:       whereHelperint64{field: "\"role_commands\".\"guild_id\""},
+	Name:          whereHelperstring{field: "\"role_commands\".\"name\""},
+	RoleGroupID:   whereHelpernull_Int64{field: "\"role_commands\".\"role_group_id\""},
+	Role:          whereHelperint64{field: "\"role_commands\".\"role\""},
+	RequireRoles:  whereHelpertypes_Int64Array{field: "\"role_commands\".\"require_roles\""},
+	IgnoreRoles:   whereHelpertypes_Int64Array{field: "\"role_commands\".\"ignore_roles\""},
+	Position:      whereHelperint64{field: "\"role_commands\".\"position\""},
+	MinReputation: whereHelperint64{field: "\"role_commands\".\"min_reputation\""},
 }
 
 // RoleCommandRels is where relationship names are stored.
@@ -295,9 +302,9 @@ func (r *roleCommandR) GetNextRoleCommandRoleMenus() RoleMenuSlice {
 type roleCommandL struct{}
 
 var (
-	roleCommandAllColumns            = []string{"id", "created_at", "updated_at", "guild_id", "name", "role_group_id", "role", "require_roles", "ignore_roles", "position"}
+	roleCommandAllColumns            = []string{"id", "created_at", "updated_at", "guild_id", "name", "role_group_id", "role", "require_roles", "ignore_roles", "position", "min_reputation"}
 	roleCommandColumnsWithoutDefault = []string{"created_at", "updated_at", "guild_id", "name", "role", "position"}
-	roleCommandColumnsWithDefault    = []string{"id", "role_group_id", "require_roles", "ignore_roles"}
+	roleCommandColumnsWithDefault    = []string{"id", "role_group_id", "require_roles", "ignore_roles", "min_reputation"}
 	roleCommandPrimaryKeyColumns     = []string{"id"}
 	roleCommandGeneratedColumns      = []string{}
 )
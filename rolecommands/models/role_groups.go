@@ -35,6 +35,9 @@ type RoleGroup struct {
 	SingleAutoToggleOff   bool             `boil:"single_auto_toggle_off" json:"single_auto_toggle_off" toml:"single_auto_toggle_off" yaml:"single_auto_toggle_off"`
 	SingleRequireOne      bool             `boil:"single_require_one" json:"single_require_one" toml:"single_require_one" yaml:"single_require_one"`
 	TemporaryRoleDuration int              `boil:"temporary_role_duration" json:"temporary_role_duration" toml:"temporary_role_duration" yaml:"temporary_role_duration"`
+	RequireGroups         types.Int64Array `boil:"require_groups" json:"require_groups,omitempty" toml:"require_groups" yaml:"require_groups,omitempty"`
+	ExclusiveGroups       types.Int64Array `boil:"exclusive_groups" json:"exclusive_groups,omitempty" toml:"exclusive_groups" yaml:"exclusive_groups,omitempty"`
+	MinReputation         int64            `boil:"min_reputation" json:"min_reputation" toml:"min_reputation" yaml:"min_reputation"`
 
 	R *roleGroupR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L roleGroupL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -52,6 +55,9 @@ var RoleGroupColumns = struct {
 	SingleAutoToggleOff   string
 	SingleRequireOne      string
 	TemporaryRoleDuration string
+	RequireGroups         string
+	ExclusiveGroups       string
+	MinReputation         string
 }{
 	ID:                    "id",
 	GuildID:               "guild_id",
@@ -64,6 +70,9 @@ var RoleGroupColumns = struct {
 	SingleAutoToggleOff:   "single_auto_toggle_off",
 	SingleRequireOne:      "single_require_one",
 	TemporaryRoleDuration: "temporary_role_duration",
+	RequireGroups:         "require_groups",
+	ExclusiveGroups:       "exclusive_groups",
+	MinReputation:         "min_reputation",
 }
 
 var RoleGroupTableColumns = struct {
@@ -78,6 +87,9 @@ var RoleGroupTableColumns = struct {
 	SingleAutoToggleOff   string
 	SingleRequireOne      string
 	TemporaryRoleDuration string
+	RequireGroups         string
+	ExclusiveGroups       string
+	MinReputation         string
 }{
 	ID:                    "role_groups.id",
 	GuildID:               "role_groups.guild_id",
@@ -90,6 +102,9 @@ var RoleGroupTableColumns = struct {
 	SingleAutoToggleOff:   "role_groups.single_auto_toggle_off",
 	SingleRequireOne:      "role_groups.single_require_one",
 	TemporaryRoleDuration: "role_groups.temporary_role_duration",
+	RequireGroups:         "role_groups.require_groups",
+	ExclusiveGroups:       "role_groups.exclusive_groups",
+	MinReputation:         "role_groups.min_reputation",
 }
 
 // Generated where
@@ -138,6 +153,9 @@ var RoleGroupWhere = struct {
 	SingleAutoToggleOff   whereHelperbool
 	SingleRequireOne      whereHelperbool
 	TemporaryRoleDuration whereHelperint
+	RequireGroups         whereHelpertypes_Int64Array
+	ExclusiveGroups       whereHelpertypes_Int64Array
+	MinReputation         whereHelperint64
 }{
 	ID:                    whereHelperint64{field: "\"role_groups\".\"id\""},
 	GuildID:               whereHelperint64{field: "\"role_groups\".\"guild_id\""},
@@ -150,6 +168,9 @@ var RoleGroupWhere = struct {
 	SingleAutoToggleOff:   whereHelperbool{field: "\"role_groups\".\"single_auto_toggle_off\""},
 	SingleRequireOne:      whereHelperbool{field: "\"role_groups\".\"single_require_one\""},
 	TemporaryRoleDuration: whereHelperint{field: "\"role_groups\".\"temporary_role_duration\""},
+	RequireGroups:         whereHelpertypes_Int64Array{field: "\"role_groups\".\"require_groups\""},
+	ExclusiveGroups:       whereHelpertypes_Int64Array{field: "\"role_groups\".\"exclusive_groups\""},
+	MinReputation:         whereHelperint64{field: "\"role_groups\".\"min_reputation\""},
 }
 
 // RoleGroupRels is where relationship names are stored.
@@ -190,9 +211,9 @@ func (r *roleGroupR) GetRoleMenus() RoleMenuSlice {
 type roleGroupL struct{}
 
 var (
-	roleGroupAllColumns            = []string{"id", "guild_id", "name", "require_roles", "ignore_roles", "mode", "multiple_max", "multiple_min", "single_auto_toggle_off", "single_require_one", "temporary_role_duration"}
+	roleGroupAllColumns            = []string{"id", "guild_id", "name", "require_roles", "ignore_roles", "mode", "multiple_max", "multiple_min", "single_auto_toggle_off", "single_require_one", "temporary_role_duration", "require_groups", "exclusive_groups", "min_reputation"}
 	roleGroupColumnsWithoutDefault = []string{"guild_id", "name", "mode", "multiple_max", "multiple_min", "single_auto_toggle_off", "single_require_one"}
-	roleGroupColumnsWithDefault    = []string{"id", "require_roles", "ignore_roles", "temporary_role_duration"}
+	roleGroupColumnsWithDefault    = []string{"id", "require_roles", "ignore_roles", "temporary_role_duration", "require_groups", "exclusive_groups", "min_reputation"}
 	roleGroupPrimaryKeyColumns     = []string{"id"}
 	roleGroupGeneratedColumns      = []string{}
 )
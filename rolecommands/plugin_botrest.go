@@ -0,0 +1,47 @@
+package rolecommands
+
+import (
+	"net/http"
+	"strconv"
+
+	"emperror.dev/errors"
+	"github.com/ThatBathroom/yagpdb/v2/bot"
+	"github.com/ThatBathroom/yagpdb/v2/common/internalapi"
+	"github.com/ThatBathroom/yagpdb/v2/lib/dstate"
+	"goji.io"
+	"goji.io/pat"
+)
+
+var _ internalapi.InternalAPIPlugin = (*Plugin)(nil)
+
+func (p *Plugin) InitInternalAPIRoutes(mux *goji.Mux) {
+	mux.Handle(pat.Get("/:guild/rolecommands/holders"), http.HandlerFunc(botRestHandleRoleHolders))
+}
+
+// botRestHandleRoleHolders returns the number of members that have each role
+func botRestHandleRoleHolders(w http.ResponseWriter, r *http.Request) {
+	guildID, _ := strconv.ParseInt(pat.Param(r, "guild"), 10, 64)
+
+	gs := bot.State.GetGuild(guildID)
+	if gs == nil {
+		internalapi.ServerError(w, r, errors.New("unknown server"))
+		return
+	}
+
+	holders := make(map[int64]int)
+	bot.State.IterateMembers(guildID, func(chunk []*dstate.MemberState) bool {
+		for _, ms := range chunk {
+			if ms.Member == nil {
+				continue
+			}
+
+			for _, role := range ms.Member.Roles {
+				holders[role]++
+			}
+		}
+
+		return true
+	})
+
+	internalapi.ServeJson(w, r, holders)
+}
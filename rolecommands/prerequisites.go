@@ -0,0 +1,88 @@
+package rolecommands
+
+import (
+	"context"
+
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/lib/dstate"
+	"github.com/ThatBathroom/yagpdb/v2/reputation"
+	"github.com/ThatBathroom/yagpdb/v2/rolecommands/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// MinReputation returns the reputation needed to get the role, the highest of the role command's and group's requirement
+func (c *CommonRoleSettings) MinReputation() int64 {
+	min := int64(0)
+	if c.RoleCmd != nil {
+		min = c.RoleCmd.MinReputation
+	}
+
+	if c.ParentGroup != nil && c.ParentGroup.MinReputation > min {
+		min = c.ParentGroup.MinReputation
+	}
+
+	return min
+}
+
+func (c *CommonRoleSettings) checkMinReputation(ms *dstate.MemberState) error {
+	min := c.MinReputation()
+	if min <= 0 {
+		return nil
+	}
+
+	score, _, err := reputation.GetUserStats(ms.GuildID, ms.User.ID)
+	if err != nil && err != reputation.ErrUserNotFound {
+		return err
+	}
+
+	if score < min {
+		return NewSimpleError("You need at least `%d` reputation for this role, you have `%d`.", min, score)
+	}
+
+	return nil
+}
+
+// checkGroupRelations checks the prerequisite and exclusive groups of the parent group,
+// groups that are exclusive with the parent group are also checked so exclusivity works both ways
+func (c *CommonRoleSettings) checkGroupRelations(ctx context.Context, ms *dstate.MemberState) error {
+	group := c.ParentGroup
+	if group == nil {
+		return nil
+	}
+
+	related, err := models.RoleGroups(
+		models.RoleGroupWhere.GuildID.EQ(group.GuildID),
+		models.RoleGroupWhere.ID.NEQ(group.ID),
+		qm.Where("id = ANY(?) OR id = ANY(?) OR ? = ANY(exclusive_groups)", types.Int64Array(group.RequireGroups), types.Int64Array(group.ExclusiveGroups), group.ID),
+		qm.Load("RoleCommands"),
+	).AllG(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range related {
+		if common.ContainsInt64Slice(group.RequireGroups, v.ID) && !hasRoleFromGroup(ms, v) {
+			return NewGroupError("You need a role from **%s** before you can get this role.", v)
+		}
+	}
+
+	for _, v := range related {
+		exclusive := common.ContainsInt64Slice(group.ExclusiveGroups, v.ID) || common.ContainsInt64Slice(v.ExclusiveGroups, group.ID)
+		if exclusive && hasRoleFromGroup(ms, v) {
+			return NewGroupError("This role can't be combined with roles from **%s**, remove those first.", v)
+		}
+	}
+
+	return nil
+}
+
+func hasRoleFromGroup(ms *dstate.MemberState, group *models.RoleGroup) bool {
+	for _, cmd := range group.R.RoleCommands {
+		if common.ContainsInt64Slice(ms.Member.Roles, cmd.Role) {
+			return true
+		}
+	}
+
+	return false
+}
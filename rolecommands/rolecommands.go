@@ -45,6 +45,13 @@ const (
 	RoleMenuStateEditingOptionReplacing = 3
 )
 
+// How members pick roles from a menu, stored in the kind column
+const (
+	RoleMenuKindReactions = 0
+	RoleMenuKindButtons   = 1
+	RoleMenuKindSelect    = 2
+)
+
 var (
 	_ common.Plugin            = (*Plugin)(nil)
 	_ web.Plugin               = (*Plugin)(nil)
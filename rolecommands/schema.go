@@ -114,4 +114,25 @@ CREATE INDEX IF NOT EXISTS role_menu_options_role_command_idx ON role_menu_optio
 CREATE INDEX IF NOT EXISTS role_menu_options_role_menu_id_idx ON role_menu_options(role_menu_id);
 `, `
 ALTER TABLE role_groups ADD COLUMN IF NOT EXISTS temporary_role_duration INT NOT NULL DEFAULT 0;
+`, `
+ALTER TABLE role_groups ADD COLUMN IF NOT EXISTS require_groups BIGINT[];
+`, `
+ALTER TABLE role_groups ADD COLUMN IF NOT EXISTS exclusive_groups BIGINT[];
+`, `
+ALTER TABLE role_groups ADD COLUMN IF NOT EXISTS min_reputation BIGINT NOT NULL DEFAULT 0;
+`, `
+ALTER TABLE role_commands ADD COLUMN IF NOT EXISTS min_reputation BIGINT NOT NULL DEFAULT 0;
+`, `
+CREATE TABLE IF NOT EXISTS role_usage_daily (
+	guild_id BIGINT NOT NULL,
+	day DATE NOT NULL,
+	role_id BIGINT NOT NULL,
+	-- 0 if the role was toggled using the role command
+	role_menu_id BIGINT NOT NULL,
+
+	assigns INT NOT NULL DEFAULT 0,
+	removes INT NOT NULL DEFAULT 0,
+
+	PRIMARY KEY(guild_id, day, role_id, role_menu_id)
+);
 `}
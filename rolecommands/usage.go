@@ -0,0 +1,130 @@
+package rolecommands
+
+import (
+	"context"
+	"time"
+
+	"github.com/ThatBathroom/yagpdb/v2/common"
+)
+
+// recordRoleUsage counts a role being given or taken away through a role command or menu, menuID is 0 for role commands
+func recordRoleUsage(guildID, roleID, menuID int64, assigned bool) {
+	const q = `INSERT INTO role_usage_daily (guild_id, day, role_id, role_menu_id, assigns, removes)
+	VALUES ($1, CURRENT_DATE, $2, $3, $4, $5)
+	ON CONFLICT (guild_id, day, role_id, role_menu_id)
+	DO UPDATE SET assigns = role_usage_daily.assigns + EXCLUDED.assigns, removes = role_usage_daily.removes + EXCLUDED.removes`
+
+	assigns, removes := 0, 0
+	if assigned {
+		assigns = 1
+	} else {
+		removes = 1
+	}
+
+	_, err := common.PQ.Exec(q, guildID, roleID, menuID, assigns, removes)
+	if err != nil {
+		logger.WithError(err).WithField("guild", guildID).Error("failed recording role usage")
+	}
+}
+
+type RoleUsageDay struct {
+	T       time.Time `json:"t"`
+	Assigns int       `json:"assigns"`
+	Removes int       `json:"removes"`
+}
+
+// RetrieveRoleUsageDays returns the number of assigns and removes per day over the last days,
+// optionally only for the given role or menu
+func RetrieveRoleUsageDays(ctx context.Context, guildID int64, days int, roleID, menuID int64) ([]*RoleUsageDay, error) {
+	const q = `SELECT day, SUM(assigns), SUM(removes)
+	FROM role_usage_daily
+	WHERE guild_id = $1 AND day > $2 AND ($3 = 0 OR role_id = $3) AND ($4 = 0 OR role_menu_id = $4)
+	GROUP BY day
+	ORDER BY day ASC`
+
+	rows, err := common.PQ.QueryContext(ctx, q, guildID, time.Now().AddDate(0, 0, -days), roleID, menuID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*RoleUsageDay, 0, days)
+	for rows.Next() {
+		var day RoleUsageDay
+		err = rows.Scan(&day.T, &day.Assigns, &day.Removes)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &day)
+	}
+
+	return result, rows.Err()
+}
+
+type RoleUsageTotal struct {
+	// Either the role id or the menu message id depending on what it's grouped by
+	ID      int64
+	Name    string
+	Assigns int
+	Removes int
+
+	// Number of members that currently have the role, -1 if unknown
+	Holders int
+}
+
+// ChurnPercent returns how many of the assigns were removed again, in percent
+func (r *RoleUsageTotal) ChurnPercent() int {
+	if r.Assigns < 1 {
+		if r.Removes > 0 {
+			return 100
+		}
+		return 0
+	}
+
+	churn := r.Removes * 100 / r.Assigns
+	if churn > 100 {
+		churn = 100
+	}
+
+	return churn
+}
+
+// RetrieveRoleUsageTotals returns the number of assigns and removes over the last days, grouped by role or by menu
+func RetrieveRoleUsageTotals(ctx context.Context, guildID int64, days int, byMenu bool) ([]*RoleUsageTotal, error) {
+	const qRoles = `SELECT role_id, SUM(assigns), SUM(removes)
+	FROM role_usage_daily
+	WHERE guild_id = $1 AND day > $2
+	GROUP BY role_id
+	ORDER BY SUM(assigns) + SUM(removes) DESC`
+
+	const qMenus = `SELECT role_menu_id, SUM(assigns), SUM(removes)
+	FROM role_usage_daily
+	WHERE guild_id = $1 AND day > $2
+	GROUP BY role_menu_id
+	ORDER BY SUM(assigns) + SUM(removes) DESC`
+
+	q := qRoles
+	if byMenu {
+		q = qMenus
+	}
+
+	rows, err := common.PQ.QueryContext(ctx, q, guildID, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*RoleUsageTotal, 0)
+	for rows.Next() {
+		total := &RoleUsageTotal{Holders: -1}
+		err = rows.Scan(&total.ID, &total.Assigns, &total.Removes)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, total)
+	}
+
+	return result, rows.Err()
+}
@@ -1,6 +1,7 @@
 package rolecommands
 
 import (
+	"context"
 	"database/sql"
 	_ "embed"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	"emperror.dev/errors"
 	"github.com/ThatBathroom/yagpdb/v2/common"
 	"github.com/ThatBathroom/yagpdb/v2/common/cplogs"
+	"github.com/ThatBathroom/yagpdb/v2/common/internalapi"
 	"github.com/ThatBathroom/yagpdb/v2/common/pubsub"
 	schEvtsModels "github.com/ThatBathroom/yagpdb/v2/common/scheduledevents2/models"
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
@@ -21,6 +23,7 @@ import (
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
 	"goji.io"
 	"goji.io/pat"
 )
@@ -28,6 +31,12 @@ import (
 //go:embed assets/rolecommands.html
 var PageHTML string
 
+//go:embed assets/rolecommands_stats.html
+var PageHTMLStats string
+
+// Number of days shown on the usage stats page
+const RoleUsageStatsDays = 30
+
 var (
 	panelLogKeyNewCommand        = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "rolecommands_new_command", FormatString: "Created a new role command: %s"})
 	panelLogKeyUpdatedCommand    = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "rolecommands_updated_command", FormatString: "Updated role command: %s"})
@@ -46,6 +55,8 @@ type FormCommand struct {
 	Group        int64
 	RequireRoles []int64 `valid:"role,true"`
 	IgnoreRoles  []int64 `valid:"role,true"`
+
+	MinReputation int64 `valid:"0,"`
 }
 
 type FormGroup struct {
@@ -62,10 +73,15 @@ type FormGroup struct {
 	SingleAutoToggleOff   bool
 	SingleRequireOne      bool
 	TemporaryRoleDuration int `valid:"0,1440"`
+
+	RequireGroups   []int64
+	ExclusiveGroups []int64
+	MinReputation   int64 `valid:"0,"`
 }
 
 func (p *Plugin) InitWeb() {
 	web.AddHTMLTemplate("rolecommands/assets/rolecommands.html", PageHTML)
+	web.AddHTMLTemplate("rolecommands/assets/rolecommands_stats.html", PageHTMLStats)
 
 	web.AddSidebarItem(web.SidebarCategoryRoles, &web.SidebarItem{
 		Name: "Role Commands",
@@ -91,6 +107,8 @@ func (p *Plugin) InitWeb() {
 
 	subMux.Handle(pat.Get("/"), getIndexHandler)
 	subMux.Handle(pat.Get("/group/:groupID"), getGroupHandler)
+	subMux.Handle(pat.Get("/stats"), web.ControllerHandler(HandleGetStats, "cp_rolecommands_stats"))
+	subMux.Handle(pat.Get("/stats/chart"), web.APIHandler(HandleStatsChart))
 
 	// either serve the group page or the index page, kinda convoluted but eh
 	getIndexpPostHandler := web.ControllerHandler(func(w http.ResponseWriter, r *http.Request) (tmpl web.TemplateData, err error) {
@@ -203,9 +221,10 @@ func HandleNewCommand(w http.ResponseWriter, r *http.Request) (web.TemplateData,
 		Name:    form.Name,
 		GuildID: g.ID,
 
-		Role:         form.Role,
-		RequireRoles: form.RequireRoles,
-		IgnoreRoles:  form.IgnoreRoles,
+		Role:          form.Role,
+		RequireRoles:  form.RequireRoles,
+		IgnoreRoles:   form.IgnoreRoles,
+		MinReputation: form.MinReputation,
 	}
 
 	if form.Group != -1 {
@@ -257,6 +276,7 @@ func HandleUpdateCommand(w http.ResponseWriter, r *http.Request) (tmpl web.Templ
 	cmd.Role = formCmd.Role
 	cmd.IgnoreRoles = formCmd.IgnoreRoles
 	cmd.RequireRoles = formCmd.RequireRoles
+	cmd.MinReputation = formCmd.MinReputation
 
 	groupChanged := cmd.RoleGroupID.Int64 != formCmd.Group
 	if !cmd.RoleGroupID.Valid && formCmd.Group <= 0 {
@@ -289,7 +309,7 @@ func HandleUpdateCommand(w http.ResponseWriter, r *http.Request) (tmpl web.Templ
 
 	_, err = cmd.UpdateG(r.Context(),
 		boil.Whitelist(models.RoleCommandColumns.Name, models.RoleCommandColumns.Role, models.RoleCommandColumns.IgnoreRoles,
-			models.RoleCommandColumns.RequireRoles, models.RoleCommandColumns.RoleGroupID))
+			models.RoleCommandColumns.RequireRoles, models.RoleCommandColumns.RoleGroupID, models.RoleCommandColumns.MinReputation))
 	if err == nil {
 		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeyUpdatedCommand, &cplogs.Param{Type: cplogs.ParamTypeString, Value: cmd.Name}))
 		sendEvictMenuCachePubSub(g.ID)
@@ -442,9 +462,22 @@ func HandleNewGroup(w http.ResponseWriter, r *http.Request) (web.TemplateData, e
 		MultipleMin:         int64(form.MultipleMin),
 		SingleRequireOne:    form.SingleRequireOne,
 		SingleAutoToggleOff: form.SingleAutoToggleOff,
+
+		MinReputation: form.MinReputation,
 	}
 
-	err := model.InsertG(r.Context(), boil.Infer())
+	var err error
+	model.RequireGroups, err = filterGroupIDs(r.Context(), g.ID, 0, form.RequireGroups)
+	if err != nil {
+		return tmpl, err
+	}
+
+	model.ExclusiveGroups, err = filterGroupIDs(r.Context(), g.ID, 0, form.ExclusiveGroups)
+	if err != nil {
+		return tmpl, err
+	}
+
+	err = model.InsertG(r.Context(), boil.Infer())
 	if err != nil {
 		return tmpl, err
 	}
@@ -476,6 +509,17 @@ func HandleUpdateGroup(w http.ResponseWriter, r *http.Request) (tmpl web.Templat
 	group.MultipleMin = int64(formGroup.MultipleMin)
 	group.Mode = int64(formGroup.Mode)
 	group.TemporaryRoleDuration = formGroup.TemporaryRoleDuration
+	group.MinReputation = formGroup.MinReputation
+
+	group.RequireGroups, err = filterGroupIDs(r.Context(), g.ID, group.ID, formGroup.RequireGroups)
+	if err != nil {
+		return
+	}
+
+	group.ExclusiveGroups, err = filterGroupIDs(r.Context(), g.ID, group.ID, formGroup.ExclusiveGroups)
+	if err != nil {
+		return
+	}
 
 	tmpl["GroupID"] = group.ID
 
@@ -494,6 +538,27 @@ func HandleUpdateGroup(w http.ResponseWriter, r *http.Request) (tmpl web.Templat
 	return
 }
 
+// filterGroupIDs removes the ids that aren't groups on the server, and the group itself
+func filterGroupIDs(ctx context.Context, guildID, selfID int64, ids []int64) (types.Int64Array, error) {
+	if len(ids) < 1 {
+		return nil, nil
+	}
+
+	groups, err := models.RoleGroups(models.RoleGroupWhere.GuildID.EQ(guildID), models.RoleGroupWhere.ID.IN(ids), qm.Select(models.RoleGroupColumns.ID)).AllG(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make(types.Int64Array, 0, len(groups))
+	for _, v := range groups {
+		if v.ID != selfID {
+			filtered = append(filtered, v.ID)
+		}
+	}
+
+	return filtered, nil
+}
+
 func HandleRemoveGroup(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
 	g, _ := web.GetBaseCPContextData(r.Context())
 
@@ -506,6 +571,131 @@ func HandleRemoveGroup(w http.ResponseWriter, r *http.Request) (web.TemplateData
 	return nil, err
 }
 
+func HandleGetStats(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+	g, tmpl := web.GetBaseCPContextData(r.Context())
+
+	roleUsage, err := RetrieveRoleUsageTotals(r.Context(), g.ID, RoleUsageStatsDays, false)
+	if err != nil {
+		return tmpl, err
+	}
+
+	menuUsage, err := RetrieveRoleUsageTotals(r.Context(), g.ID, RoleUsageStatsDays, true)
+	if err != nil {
+		return tmpl, err
+	}
+
+	// also list the roles nobody used in this period
+	roleCommands, err := models.RoleCommands(models.RoleCommandWhere.GuildID.EQ(g.ID)).AllG(r.Context())
+	if err != nil {
+		return tmpl, err
+	}
+
+OUTER:
+	for _, cmd := range roleCommands {
+		for _, v := range roleUsage {
+			if v.ID == cmd.Role {
+				continue OUTER
+			}
+		}
+
+		roleUsage = append(roleUsage, &RoleUsageTotal{ID: cmd.Role, Holders: -1})
+	}
+
+	var holders map[int64]int
+	err = internalapi.GetWithGuild(g.ID, discordgo.StrID(g.ID)+"/rolecommands/holders", &holders)
+	if err != nil {
+		web.CtxLogger(r.Context()).WithError(err).Error("failed retrieving role holder counts")
+		holders = nil
+	}
+
+	for _, v := range roleUsage {
+		v.Name = "Deleted role"
+		if role := g.GetRole(v.ID); role != nil {
+			v.Name = role.Name
+		}
+
+		if holders != nil {
+			v.Holders = holders[v.ID]
+		}
+	}
+
+	menuIDs := make([]int64, 0, len(menuUsage))
+	for _, v := range menuUsage {
+		menuIDs = append(menuIDs, v.ID)
+	}
+
+	var menus models.RoleMenuSlice
+	if len(menuIDs) > 0 {
+		menus, err = models.RoleMenus(models.RoleMenuWhere.GuildID.EQ(g.ID), models.RoleMenuWhere.MessageID.IN(menuIDs), qm.Load("RoleGroup")).AllG(r.Context())
+		if err != nil {
+			return tmpl, err
+		}
+	}
+
+	for _, v := range menuUsage {
+		if v.ID == 0 {
+			v.Name = "Role command"
+			continue
+		}
+
+		v.Name = "Deleted menu"
+		for _, menu := range menus {
+			if menu.MessageID != v.ID {
+				continue
+			}
+
+			v.Name = "Standalone menu"
+			if menu.R.RoleGroup != nil {
+				v.Name = menu.R.RoleGroup.Name
+			}
+
+			if c := g.GetChannel(menu.ChannelID); c != nil {
+				v.Name += " in #" + c.Name
+			}
+		}
+	}
+
+	churned := make([]*RoleUsageTotal, 0, 5)
+	for _, v := range roleUsage {
+		if v.Removes > 0 {
+			churned = append(churned, v)
+		}
+	}
+	sort.SliceStable(churned, func(i, j int) bool {
+		return churned[i].Removes > churned[j].Removes
+	})
+	if len(churned) > 5 {
+		churned = churned[:5]
+	}
+
+	tmpl["RoleUsage"] = roleUsage
+	tmpl["MenuUsage"] = menuUsage
+	tmpl["ChurnedRoles"] = churned
+	tmpl["StatsDays"] = RoleUsageStatsDays
+	tmpl["HolderCountsAvailable"] = holders != nil
+
+	return tmpl, nil
+}
+
+// HandleStatsChart serves the assigns and removes per day, optionally for a single role or menu
+func HandleStatsChart(w http.ResponseWriter, r *http.Request) interface{} {
+	g, _ := web.GetBaseCPContextData(r.Context())
+
+	roleID, _ := strconv.ParseInt(r.URL.Query().Get("role"), 10, 64)
+	menuID, _ := strconv.ParseInt(r.URL.Query().Get("menu"), 10, 64)
+
+	days, err := RetrieveRoleUsageDays(r.Context(), g.ID, RoleUsageStatsDays, roleID, menuID)
+	if err != nil {
+		web.CtxLogger(r.Context()).WithError(err).Error("failed retrieving role usage")
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil
+	}
+
+	return map[string]interface{}{
+		"data": days,
+	}
+}
+
 var _ web.PluginWithServerHomeWidget = (*Plugin)(nil)
 
 func (p *Plugin) LoadServerHomeWidget(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
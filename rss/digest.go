@@ -0,0 +1,95 @@
+package rss
+
+import (
+	"context"
+	"database/sql"
+	"html"
+	"strings"
+
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/feeds"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/rss/models"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/mmcdole/gofeed"
+)
+
+var _ feeds.DigestPlugin = (*Plugin)(nil)
+
+func digestSettings(sub *models.RSSFeedSubscription) feeds.DigestSettings {
+	return feeds.DigestSettings{
+		Mode:    sub.DigestMode,
+		DailyAt: sub.DigestDailyAt,
+	}
+}
+
+// queueDigestItems adds the items to the next digest of the subscription instead of posting them, returning how many were queued
+func queueDigestItems(sub *models.RSSFeedSubscription, items []*gofeed.Item) (queued int) {
+	settings := digestSettings(sub)
+	sanitizer := bluemonday.StrictPolicy()
+
+	for _, item := range items {
+		if err := markItemSeen(sub.ID, item.Link, item.PublishedParsed); err != nil {
+			logger.WithError(err).WithField("feed_id", sub.ID).Warn("Failed to mark RSS item as seen")
+		}
+
+		digestItem := &feeds.DigestItem{
+			Title: html.UnescapeString(sanitizer.Sanitize(item.Title)),
+			URL:   item.Link,
+		}
+		if item.Author != nil {
+			digestItem.Author = item.Author.Name
+		}
+
+		err := feeds.QueueDigestItem("rss", int64(sub.ID), sub.GuildID, sub.ChannelID, settings, digestItem)
+		if err != nil {
+			logger.WithError(err).WithField("feed_id", sub.ID).Error("Failed to queue RSS item for digest")
+			continue
+		}
+		queued++
+	}
+
+	return queued
+}
+
+// DigestTarget implements feeds.DigestPlugin
+func (p *Plugin) DigestTarget(subscriptionID int64) (*feeds.DigestTarget, error) {
+	sub, err := models.FindRSSFeedSubscriptionG(context.Background(), int(subscriptionID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	settings := digestSettings(sub)
+	if !sub.Enabled || settings.Immediate() {
+		return nil, nil
+	}
+
+	target := &feeds.DigestTarget{
+		GuildID:   sub.GuildID,
+		ChannelID: sub.ChannelID,
+		Settings:  settings,
+		Name:      common.CutStringShort(sub.FeedURL, 256),
+		URL:       sub.FeedURL,
+		Color:     0x2b7cff,
+		AllowedMentions: discordgo.AllowedMentions{
+			Parse: []discordgo.AllowedMentionType{},
+		},
+	}
+
+	if sub.MentionEveryone {
+		target.Content = "@everyone"
+		target.AllowedMentions.Parse = append(target.AllowedMentions.Parse, discordgo.AllowedMentionTypeEveryone)
+	} else if len(sub.MentionRoles) > 0 {
+		mentions := make([]string, 0, len(sub.MentionRoles))
+		for _, roleID := range sub.MentionRoles {
+			mentions = append(mentions, "<@&"+discordgo.StrID(roleID)+">")
+		}
+		target.Content = strings.Join(mentions, " ")
+		target.AllowedMentions.Parse = append(target.AllowedMentions.Parse, discordgo.AllowedMentionTypeRoles)
+	}
+
+	return target, nil
+}
@@ -3,7 +3,6 @@ package rss
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"net/url"
 	"regexp"
 	"strconv"
@@ -18,8 +17,10 @@ import (
 
 	"github.com/ThatBathroom/yagpdb/v2/common"
 	"github.com/ThatBathroom/yagpdb/v2/common/mqueue"
+	"github.com/ThatBathroom/yagpdb/v2/common/templates"
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
 	"github.com/ThatBathroom/yagpdb/v2/rss/models"
+	"github.com/ThatBathroom/yagpdb/v2/web/discorddata"
 	"github.com/mediocregopher/radix/v3"
 	"github.com/microcosm-cc/bluemonday"
 	"github.com/mmcdole/gofeed"
@@ -156,32 +157,25 @@ func cleanupOldItems(feedID int) error {
 
 func (p *Plugin) processFeed(sub *models.RSSFeedSubscription) {
 	parser := gofeed.NewParser()
-	//use an http proxy if configured
-	proxy := common.ConfHttpProxy.GetString()
-	if len(proxy) > 0 {
-		proxyURL, err := url.Parse(proxy)
-		if err != nil {
-			parser.Client = &http.Client{
-				Transport: &http.Transport{
-					Proxy: http.ProxyURL(proxyURL),
-				},
-			}
-		}
-	}
 
-	feed, err := parser.ParseURL(sub.FeedURL)
+	result, err := fetchFeed(context.Background(), newFeedHTTPClient(), parser, sub)
 	if err != nil {
-		logger.WithError(err).WithField("url", sub.FeedURL).Warn("Failed to parse RSS feed, requesting disable via mqueue")
-		p.DisableFeed(&mqueue.QueuedElement{
-			GuildID:      sub.GuildID,
-			ChannelID:    sub.ChannelID,
-			Source:       "rss",
-			SourceItemID: strconv.Itoa(sub.ID),
-		}, err)
+		logger.WithError(err).WithField("url", sub.FeedURL).Warn("Failed to fetch RSS feed")
+		p.handleFeedFailure(sub, err)
+		return
+	}
+
+	recordFeedSuccess(sub, result)
+
+	feed := result.Feed
+	if feed == nil || len(feed.Items) == 0 {
+		// not modified since the last poll
 		return
 	}
 
-	if len(feed.Items) == 0 {
+	filter, err := NewItemFilter(sub.IncludeFilters, sub.ExcludeFilters, sub.FiltersRegex)
+	if err != nil {
+		logger.WithError(err).WithField("feed_id", sub.ID).Warn("Invalid RSS feed filters")
 		return
 	}
 
@@ -219,6 +213,15 @@ func (p *Plugin) processFeed(sub *models.RSSFeedSubscription) {
 		if seen {
 			continue
 		}
+
+		if !filter.Match(html.UnescapeString(item.Title), item.Categories) {
+			// mark filtered items as seen so they aren't checked again every poll
+			if err := markItemSeen(sub.ID, link, item.PublishedParsed); err != nil {
+				logger.WithError(err).WithField("feed_id", sub.ID).Warn("Failed to mark RSS item as seen")
+			}
+			continue
+		}
+
 		newItems = append(newItems, item)
 	}
 
@@ -226,6 +229,31 @@ func (p *Plugin) processFeed(sub *models.RSSFeedSubscription) {
 		return
 	}
 
+	if !digestSettings(sub).Immediate() {
+		queued := queueDigestItems(sub, newItems)
+		if queued > 0 {
+			recordItemsPosted(sub.ID, queued)
+		}
+
+		if err := cleanupOldItems(sub.ID); err != nil {
+			logger.WithError(err).WithField("feed_id", sub.ID).Warn("Failed to cleanup old RSS deduplication entries")
+		}
+		return
+	}
+
+	if sub.MessageTemplate != "" {
+		posted := p.sendTemplatedItems(sub, feed, newItems)
+		if posted > 0 {
+			recordItemsPosted(sub.ID, posted)
+		}
+
+		if err := cleanupOldItems(sub.ID); err != nil {
+			logger.WithError(err).WithField("feed_id", sub.ID).Warn("Failed to cleanup old RSS deduplication entries")
+		}
+		return
+	}
+
+	posted := 0
 	batchSize := 5
 	for i := 0; i < len(newItems); i += batchSize {
 		end := min(i+batchSize, len(newItems))
@@ -277,27 +305,7 @@ func (p *Plugin) processFeed(sub *models.RSSFeedSubscription) {
 			}
 			desc = html.UnescapeString(desc)
 
-			// Try to find an image for the post
-			var imageURL string
-			if item.Image != nil && item.Image.URL != "" {
-				imageURL = item.Image.URL
-			} else if len(item.Enclosures) > 0 {
-				for _, enc := range item.Enclosures {
-					if enc.Type != "" && len(enc.Type) >= 6 && enc.Type[:6] == "image/" && enc.URL != "" {
-						imageURL = enc.URL
-						break
-					}
-				}
-			}
-			if imageURL == "" {
-				imageURL = extractImageFromMediaExtensions(item)
-			}
-			if imageURL == "" {
-				imageURL = extractFirstImageFromHTML(item.Content)
-			}
-			if imageURL == "" {
-				imageURL = extractFirstImageFromHTML(item.Description)
-			}
+			imageURL := findItemImage(item)
 
 			text := fmt.Sprintf("### [%s](%s)", title, link)
 			if item.PublishedParsed != nil {
@@ -347,7 +355,7 @@ func (p *Plugin) processFeed(sub *models.RSSFeedSubscription) {
 			}
 		}
 		if added == 0 {
-			return
+			break
 		}
 
 		msgSend := &discordgo.MessageSend{
@@ -376,6 +384,11 @@ func (p *Plugin) processFeed(sub *models.RSSFeedSubscription) {
 				Parse: parseMentions,
 			},
 		})
+		posted += added
+	}
+
+	if posted > 0 {
+		recordItemsPosted(sub.ID, posted)
 	}
 
 	// Cleanup old items after processing the feed
@@ -384,6 +397,104 @@ func (p *Plugin) processFeed(sub *models.RSSFeedSubscription) {
 	}
 }
 
+// sendTemplatedItems posts each item as its own message using the custom template of the subscription,
+// returning how many were posted
+func (p *Plugin) sendTemplatedItems(sub *models.RSSFeedSubscription, feed *gofeed.Feed, items []*gofeed.Item) (posted int) {
+	guildState, err := discorddata.GetFullGuild(sub.GuildID)
+	if err != nil {
+		logger.WithError(err).WithField("guild", sub.GuildID).Error("Failed to get guild state for RSS feed")
+		return
+	}
+
+	if guildState == nil {
+		logger.WithField("guild", sub.GuildID).Warn("Guild not found in state for RSS feed")
+		return
+	}
+
+	channelState := guildState.GetChannel(sub.ChannelID)
+	if channelState == nil {
+		logger.WithField("guild", sub.GuildID).WithField("channel", sub.ChannelID).Warn("Channel not found in state for RSS feed")
+		return
+	}
+
+	sanitizer := bluemonday.StrictPolicy()
+	for _, item := range items {
+		// mark it as seen first, a broken template shouldn't make us retry the item every poll
+		if err := markItemSeen(sub.ID, item.Link, item.PublishedParsed); err != nil {
+			logger.WithError(err).WithField("feed_id", sub.ID).Warn("Failed to mark RSS item as seen")
+		}
+
+		author := ""
+		if item.Author != nil {
+			author = item.Author.Name
+		}
+
+		ctx := templates.NewContext(guildState, channelState, nil)
+		ctx.Data["Title"] = html.UnescapeString(sanitizer.Sanitize(item.Title))
+		ctx.Data["URL"] = item.Link
+		ctx.Data["Link"] = item.Link
+		ctx.Data["Author"] = author
+		ctx.Data["Categories"] = item.Categories
+		ctx.Data["Image"] = findItemImage(item)
+		ctx.Data["Description"] = html.UnescapeString(sanitizer.Sanitize(item.Description))
+		ctx.Data["Published"] = item.PublishedParsed
+		ctx.Data["FeedTitle"] = feed.Title
+		ctx.Data["FeedURL"] = sub.FeedURL
+		// full item in case people want to do more advanced stuff
+		ctx.Data["Item"] = item
+
+		content, err := ctx.Execute(sub.MessageTemplate)
+		if err != nil {
+			logger.WithError(err).WithField("feed_id", sub.ID).Warn("RSS message template execution failed")
+			continue
+		}
+
+		if strings.TrimSpace(content) == "" {
+			continue
+		}
+
+		mqueue.QueueMessage(&mqueue.QueuedElement{
+			GuildID:      sub.GuildID,
+			ChannelID:    sub.ChannelID,
+			Source:       "rss",
+			SourceItemID: strconv.Itoa(sub.ID),
+			MessageStr:   content,
+			Priority:     2,
+			AllowedMentions: discordgo.AllowedMentions{
+				// the mention settings of the feed don't apply to templates, mentions go in the template itself
+				Parse: []discordgo.AllowedMentionType{discordgo.AllowedMentionTypeRoles, discordgo.AllowedMentionTypeEveryone},
+			},
+			PublishAnnouncement: ctx.CurrentFrame.PublishResponse,
+		})
+		posted++
+	}
+
+	return posted
+}
+
+// findItemImage tries to find an image for the post
+func findItemImage(item *gofeed.Item) string {
+	if item.Image != nil && item.Image.URL != "" {
+		return item.Image.URL
+	}
+
+	for _, enc := range item.Enclosures {
+		if enc.Type != "" && len(enc.Type) >= 6 && enc.Type[:6] == "image/" && enc.URL != "" {
+			return enc.URL
+		}
+	}
+
+	if imageURL := extractImageFromMediaExtensions(item); imageURL != "" {
+		return imageURL
+	}
+
+	if imageURL := extractFirstImageFromHTML(item.Content); imageURL != "" {
+		return imageURL
+	}
+
+	return extractFirstImageFromHTML(item.Description)
+}
+
 // Helper: extract first <img src=...> from HTML
 var imgSrcRegexp = regexp.MustCompile(`<img[^>]+src=["']([^"']+)["']`)
 
@@ -0,0 +1,100 @@
+package rss
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	MaxFilterTerms      = 25
+	MaxFilterTermLength = 200
+)
+
+// ItemFilter decides which feed items get posted based on their title and categories
+type ItemFilter struct {
+	Include []*regexp.Regexp
+	Exclude []*regexp.Regexp
+}
+
+// ParseFilterTerms splits the newline separated filter terms from the control panel
+func ParseFilterTerms(s string) []string {
+	terms := make([]string, 0)
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			terms = append(terms, line)
+		}
+	}
+
+	return terms
+}
+
+// NewItemFilter compiles the filter terms, they're treated as case insensitive keywords unless useRegex is set
+func NewItemFilter(include, exclude []string, useRegex bool) (*ItemFilter, error) {
+	if len(include)+len(exclude) > MaxFilterTerms {
+		return nil, fmt.Errorf("max %d filter terms allowed", MaxFilterTerms)
+	}
+
+	inc, err := compileFilterTerms(include, useRegex)
+	if err != nil {
+		return nil, err
+	}
+
+	exc, err := compileFilterTerms(exclude, useRegex)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ItemFilter{Include: inc, Exclude: exc}, nil
+}
+
+func compileFilterTerms(terms []string, useRegex bool) ([]*regexp.Regexp, error) {
+	result := make([]*regexp.Regexp, 0, len(terms))
+	for _, t := range terms {
+		if utf8.RuneCountInString(t) > MaxFilterTermLength {
+			return nil, fmt.Errorf("filter term too long (max %d)", MaxFilterTermLength)
+		}
+
+		if !useRegex {
+			t = regexp.QuoteMeta(t)
+		}
+
+		re, err := regexp.Compile("(?i)" + t)
+		if err != nil {
+			return nil, errors.New("invalid regex " + t + ": " + err.Error())
+		}
+
+		result = append(result, re)
+	}
+
+	return result, nil
+}
+
+// Match returns true if the item should be posted, that is if it matches any of the include filters (or there are none)
+// and none of the exclude filters
+func (f *ItemFilter) Match(title string, categories []string) bool {
+	if len(f.Include) > 0 && !matchesAny(f.Include, title, categories) {
+		return false
+	}
+
+	return !matchesAny(f.Exclude, title, categories)
+}
+
+func matchesAny(res []*regexp.Regexp, title string, categories []string) bool {
+	for _, re := range res {
+		if re.MatchString(title) {
+			return true
+		}
+
+		for _, c := range categories {
+			if re.MatchString(c) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
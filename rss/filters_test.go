@@ -0,0 +1,39 @@
+package rss
+
+import "testing"
+
+func TestItemFilter(t *testing.T) {
+	cases := []struct {
+		name             string
+		include, exclude []string
+		regex            bool
+		title            string
+		categories       []string
+		want             bool
+	}{
+		{"no filters", nil, nil, false, "anything", nil, true},
+		{"include title", []string{"release"}, nil, false, "New Release out", nil, true},
+		{"include category", []string{"go"}, nil, false, "Something", []string{"Go"}, true},
+		{"include miss", []string{"release"}, nil, false, "Blog post", []string{"news"}, false},
+		{"exclude", nil, []string{"sponsored"}, false, "Sponsored: buy this", nil, false},
+		{"keyword is literal", []string{"v1.0"}, nil, false, "v1x0", nil, false},
+		{"regex", []string{`^v\d+\.\d+`}, nil, true, "v2.3 released", nil, true},
+		{"exclude wins", []string{"release"}, []string{"beta"}, false, "Beta release", nil, false},
+	}
+
+	for _, c := range cases {
+		f, err := NewItemFilter(c.include, c.exclude, c.regex)
+		if err != nil {
+			t.Errorf("%s: failed compiling filter: %v", c.name, err)
+			continue
+		}
+
+		if got := f.Match(c.title, c.categories); got != c.want {
+			t.Errorf("%s: got %t, want %t", c.name, got, c.want)
+		}
+	}
+
+	if _, err := NewItemFilter([]string{"("}, nil, true); err == nil {
+		t.Error("expected error for invalid regex")
+	}
+}
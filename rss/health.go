@@ -0,0 +1,171 @@
+package rss
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/common/mqueue"
+	"github.com/ThatBathroom/yagpdb/v2/rss/models"
+	"github.com/mmcdole/gofeed"
+)
+
+const (
+	// a warning is posted in the feed channel after this many failed polls in a row (12 hours)
+	FeedFailureNoticeThreshold = 144
+	// and the feed is disabled after this many (24 hours)
+	FeedFailureDisableThreshold = 288
+
+	maxStoredErrorLength = 250
+	feedFetchTimeout     = time.Second * 30
+)
+
+// feedFetchResult is the result of a conditional feed request,
+// Feed is nil if the feed wasn't modified since the last fetch
+type feedFetchResult struct {
+	Feed         *gofeed.Feed
+	ETag         string
+	LastModified string
+}
+
+func newFeedHTTPClient() *http.Client {
+	client := &http.Client{
+		Timeout: feedFetchTimeout,
+	}
+
+	//use an http proxy if configured
+	proxy := common.ConfHttpProxy.GetString()
+	if len(proxy) > 0 {
+		proxyURL, err := url.Parse(proxy)
+		if err == nil {
+			client.Transport = &http.Transport{
+				Proxy: http.ProxyURL(proxyURL),
+			}
+		}
+	}
+
+	return client
+}
+
+// fetchFeed requests the feed using the ETag and Last-Modified validators from the previous fetch,
+// so that unchanged feeds don't have to be downloaded and parsed again
+func fetchFeed(ctx context.Context, client *http.Client, parser *gofeed.Parser, sub *models.RSSFeedSubscription) (*feedFetchResult, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", sub.FeedURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", parser.UserAgent)
+	if sub.Etag != "" {
+		req.Header.Set("If-None-Match", sub.Etag)
+	}
+	if sub.LastModified != "" {
+		req.Header.Set("If-Modified-Since", sub.LastModified)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return &feedFetchResult{
+			ETag:         sub.Etag,
+			LastModified: sub.LastModified,
+		}, nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, gofeed.HTTPError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+		}
+	}
+
+	feed, err := parser.Parse(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feedFetchResult{
+		Feed:         feed,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}, nil
+}
+
+// recordFeedSuccess resets the failure count and stores the validators for the next conditional request
+func recordFeedSuccess(sub *models.RSSFeedSubscription, result *feedFetchResult) {
+	_, err := models.RSSFeedSubscriptions(models.RSSFeedSubscriptionWhere.ID.EQ(sub.ID)).UpdateAllG(context.Background(), models.M{
+		"last_success_at":      time.Now(),
+		"consecutive_failures": 0,
+		"etag":                 result.ETag,
+		"last_modified":        result.LastModified,
+	})
+	if err != nil {
+		logger.WithError(err).WithField("feed_id", sub.ID).Error("Failed to update RSS feed stats")
+	}
+}
+
+func recordItemsPosted(feedID int, n int) {
+	_, err := common.PQ.Exec("UPDATE rss_feed_subscriptions SET items_posted = items_posted + $2 WHERE id = $1", feedID, n)
+	if err != nil {
+		logger.WithError(err).WithField("feed_id", feedID).Error("Failed to update RSS feed posted items count")
+	}
+}
+
+// handleFeedFailure records a failed poll, warns the feed channel once the feed has been failing for a while
+// and disables it if it keeps failing after that
+func (p *Plugin) handleFeedFailure(sub *models.RSSFeedSubscription, fetchErr error) {
+	failures := sub.ConsecutiveFailures + 1
+
+	errMsg := fetchErr.Error()
+	if utf8.RuneCountInString(errMsg) > maxStoredErrorLength {
+		errMsg = string([]rune(errMsg)[:maxStoredErrorLength-3]) + "..."
+	}
+
+	_, err := models.RSSFeedSubscriptions(models.RSSFeedSubscriptionWhere.ID.EQ(sub.ID)).UpdateAllG(context.Background(), models.M{
+		"consecutive_failures": failures,
+		"last_error":           errMsg,
+		"last_error_at":        time.Now(),
+	})
+	if err != nil {
+		logger.WithError(err).WithField("feed_id", sub.ID).Error("Failed to update RSS feed stats")
+	}
+
+	switch {
+	case failures == FeedFailureNoticeThreshold:
+		sendFailureNotice(sub, errMsg)
+	case failures >= FeedFailureDisableThreshold:
+		logger.WithError(fetchErr).WithField("url", sub.FeedURL).Warn("RSS feed kept failing, disabling it")
+		p.DisableFeed(&mqueue.QueuedElement{
+			GuildID:      sub.GuildID,
+			ChannelID:    sub.ChannelID,
+			Source:       "rss",
+			SourceItemID: strconv.Itoa(sub.ID),
+		}, fetchErr)
+	}
+}
+
+func sendFailureNotice(sub *models.RSSFeedSubscription, errMsg string) {
+	hours := int((PollInterval * FeedFailureNoticeThreshold).Hours())
+	left := int((PollInterval * (FeedFailureDisableThreshold - FeedFailureNoticeThreshold)).Hours())
+
+	msg := fmt.Sprintf("The RSS feed <%s> has been failing for %d hours (last error: %s).\nIt will be disabled automatically if it keeps failing for another %d hours, you can check its status in the control panel.",
+		sub.FeedURL, hours, errMsg, left)
+
+	mqueue.QueueMessage(&mqueue.QueuedElement{
+		GuildID:      sub.GuildID,
+		ChannelID:    sub.ChannelID,
+		Source:       "rss",
+		SourceItemID: strconv.Itoa(sub.ID),
+		MessageStr:   msg,
+		Priority:     2,
+	})
+}
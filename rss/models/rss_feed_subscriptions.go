@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -24,62 +25,127 @@ import (
 
 // RSSFeedSubscription is an object representing the database table.
 type RSSFeedSubscription struct {
-	ID              int              `boil:"id" json:"id" toml:"id" yaml:"id"`
-	CreatedAt       time.Time        `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt       time.Time        `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	GuildID         int64            `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
-	ChannelID       int64            `boil:"channel_id" json:"channel_id" toml:"channel_id" yaml:"channel_id"`
-	FeedURL         string           `boil:"feed_url" json:"feed_url" toml:"feed_url" yaml:"feed_url"`
-	MentionEveryone bool             `boil:"mention_everyone" json:"mention_everyone" toml:"mention_everyone" yaml:"mention_everyone"`
-	MentionRoles    types.Int64Array `boil:"mention_roles" json:"mention_roles,omitempty" toml:"mention_roles" yaml:"mention_roles,omitempty"`
-	Enabled         bool             `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	ID                  int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt           time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt           time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	GuildID             int64             `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	ChannelID           int64             `boil:"channel_id" json:"channel_id" toml:"channel_id" yaml:"channel_id"`
+	FeedURL             string            `boil:"feed_url" json:"feed_url" toml:"feed_url" yaml:"feed_url"`
+	MentionEveryone     bool              `boil:"mention_everyone" json:"mention_everyone" toml:"mention_everyone" yaml:"mention_everyone"`
+	MentionRoles        types.Int64Array  `boil:"mention_roles" json:"mention_roles,omitempty" toml:"mention_roles" yaml:"mention_roles,omitempty"`
+	Enabled             bool              `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	MessageTemplate     string            `boil:"message_template" json:"message_template" toml:"message_template" yaml:"message_template"`
+	IncludeFilters      types.StringArray `boil:"include_filters" json:"include_filters" toml:"include_filters" yaml:"include_filters"`
+	ExcludeFilters      types.StringArray `boil:"exclude_filters" json:"exclude_filters" toml:"exclude_filters" yaml:"exclude_filters"`
+	FiltersRegex        bool              `boil:"filters_regex" json:"filters_regex" toml:"filters_regex" yaml:"filters_regex"`
+	Etag                string            `boil:"etag" json:"etag" toml:"etag" yaml:"etag"`
+	LastModified        string            `boil:"last_modified" json:"last_modified" toml:"last_modified" yaml:"last_modified"`
+	LastSuccessAt       null.Time         `boil:"last_success_at" json:"last_success_at,omitempty" toml:"last_success_at" yaml:"last_success_at,omitempty"`
+	LastError           string            `boil:"last_error" json:"last_error" toml:"last_error" yaml:"last_error"`
+	LastErrorAt         null.Time         `boil:"last_error_at" json:"last_error_at,omitempty" toml:"last_error_at" yaml:"last_error_at,omitempty"`
+	ConsecutiveFailures int               `boil:"consecutive_failures" json:"consecutive_failures" toml:"consecutive_failures" yaml:"consecutive_failures"`
+	ItemsPosted         int64             `boil:"items_posted" json:"items_posted" toml:"items_posted" yaml:"items_posted"`
+	DigestMode          string            `boil:"digest_mode" json:"digest_mode" toml:"digest_mode" yaml:"digest_mode"`
+	DigestDailyAt       int               `boil:"digest_daily_at" json:"digest_daily_at" toml:"digest_daily_at" yaml:"digest_daily_at"`
 
 	R *rssFeedSubscriptionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L rssFeedSubscriptionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var RSSFeedSubscriptionColumns = struct {
-	ID              string
-	CreatedAt       string
-	UpdatedAt       string
-	GuildID         string
-	ChannelID       string
-	FeedURL         string
-	MentionEveryone string
-	MentionRoles    string
-	Enabled         string
+	ID                  string
+	CreatedAt           string
+	UpdatedAt           string
+	GuildID             string
+	ChannelID           string
+	FeedURL             string
+	MentionEveryone     string
+	MentionRoles        string
+	Enabled             string
+	MessageTemplate     string
+	IncludeFilters      string
+	ExcludeFilters      string
+	FiltersRegex        string
+	Etag                string
+	LastModified        string
+	LastSuccessAt       string
+	LastError           string
+	LastErrorAt         string
+	ConsecutiveFailures string
+	ItemsPosted         string
+	DigestMode          string
+	DigestDailyAt       string
 }{
-	ID:              "id",
-	CreatedAt:       "created_at",
-	UpdatedAt:       "updated_at",
-	GuildID:         "guild_id",
-	ChannelID:       "channel_id",
-	FeedURL:         "feed_url",
-	MentionEveryone: "mention_everyone",
-	MentionRoles:    "mention_roles",
-	Enabled:         "enabled",
+	ID:                  "id",
+	CreatedAt:           "created_at",
+	UpdatedAt:           "updated_at",
+	GuildID:             "guild_id",
+	ChannelID:           "channel_id",
+	FeedURL:             "feed_url",
+	MentionEveryone:     "mention_everyone",
+	MentionRoles:        "mention_roles",
+	Enabled:             "enabled",
+	MessageTemplate:     "message_template",
+	IncludeFilters:      "include_filters",
+	ExcludeFilters:      "exclude_filters",
+	FiltersRegex:        "filters_regex",
+	Etag:                "etag",
+	LastModified:        "last_modified",
+	LastSuccessAt:       "last_success_at",
+	LastError:           "last_error",
+	LastErrorAt:         "last_error_at",
+	ConsecutiveFailures: "consecutive_failures",
+	ItemsPosted:         "items_posted",
+	DigestMode:          "digest_mode",
+	DigestDailyAt:       "digest_daily_at",
 }
 
 var RSSFeedSubscriptionTableColumns = struct {
-	ID              string
-	CreatedAt       string
-	UpdatedAt       string
-	GuildID         string
-	ChannelID       string
-	FeedURL         string
-	MentionEveryone string
-	MentionRoles    string
-	Enabled         string
+	ID                  string
+	CreatedAt           string
+	UpdatedAt           string
+	GuildID             string
+	ChannelID           string
+	FeedURL             string
+	MentionEveryone     string
+	MentionRoles        string
+	Enabled             string
+	MessageTemplate     string
+	IncludeFilters      string
+	ExcludeFilters      string
+	FiltersRegex        string
+	Etag                string
+	LastModified        string
+	LastSuccessAt       string
+	LastError           string
+	LastErrorAt         string
+	ConsecutiveFailures string
+	ItemsPosted         string
+	DigestMode          string
+	DigestDailyAt       string
 }{
-	ID:              "rss_feed_subscriptions.id",
-	CreatedAt:       "rss_feed_subscriptions.created_at",
-	UpdatedAt:       "rss_feed_subscriptions.updated_at",
-	GuildID:         "rss_feed_subscriptions.guild_id",
-	ChannelID:       "rss_feed_subscriptions.channel_id",
-	FeedURL:         "rss_feed_subscriptions.feed_url",
-	MentionEveryone: "rss_feed_subscriptions.mention_everyone",
-	MentionRoles:    "rss_feed_subscriptions.mention_roles",
-	Enabled:         "rss_feed_subscriptions.enabled",
+	ID:                  "rss_feed_subscriptions.id",
+	CreatedAt:           "rss_feed_subscriptions.created_at",
+	UpdatedAt:           "rss_feed_subscriptions.updated_at",
+	GuildID:             "rss_feed_subscriptions.guild_id",
+	ChannelID:           "rss_feed_subscriptions.channel_id",
+	FeedURL:             "rss_feed_subscriptions.feed_url",
+	MentionEveryone:     "rss_feed_subscriptions.mention_everyone",
+	MentionRoles:        "rss_feed_subscriptions.mention_roles",
+	Enabled:             "rss_feed_subscriptions.enabled",
+	MessageTemplate:     "rss_feed_subscriptions.message_template",
+	IncludeFilters:      "rss_feed_subscriptions.include_filters",
+	ExcludeFilters:      "rss_feed_subscriptions.exclude_filters",
+	FiltersRegex:        "rss_feed_subscriptions.filters_regex",
+	Etag:                "rss_feed_subscriptions.etag",
+	LastModified:        "rss_feed_subscriptions.last_modified",
+	LastSuccessAt:       "rss_feed_subscriptions.last_success_at",
+	LastError:           "rss_feed_subscriptions.last_error",
+	LastErrorAt:         "rss_feed_subscriptions.last_error_at",
+	ConsecutiveFailures: "rss_feed_subscriptions.consecutive_failures",
+	ItemsPosted:         "rss_feed_subscriptions.items_posted",
+	DigestMode:          "rss_feed_subscriptions.digest_mode",
+	DigestDailyAt:       "rss_feed_subscriptions.digest_daily_at",
 }
 
 // Generated where
@@ -211,26 +277,97 @@ func (w whereHelpertypes_Int64Array) GTE(x types.Int64Array) qm.QueryMod {
 func (w whereHelpertypes_Int64Array) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpertypes_Int64Array) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
+type whereHelpertypes_StringArray struct{ field string }
+
+func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var RSSFeedSubscriptionWhere = struct {
-	ID              whereHelperint
-	CreatedAt       whereHelpertime_Time
-	UpdatedAt       whereHelpertime_Time
-	GuildID         whereHelperint64
-	ChannelID       whereHelperint64
-	FeedURL         whereHelperstring
-	MentionEveryone whereHelperbool
-	MentionRoles    whereHelpertypes_Int64Array
-	Enabled         whereHelperbool
+	ID                  whereHelperint
+	CreatedAt           whereHelpertime_Time
+	UpdatedAt           whereHelpertime_Time
+	GuildID             whereHelperint64
+	ChannelID           whereHelperint64
+	FeedURL             whereHelperstring
+	MentionEveryone     whereHelperbool
+	MentionRoles        whereHelpertypes_Int64Array
+	Enabled             whereHelperbool
+	MessageTemplate     whereHelperstring
+	IncludeFilters      whereHelpertypes_StringArray
+	ExcludeFilters      whereHelpertypes_StringArray
+	FiltersRegex        whereHelperbool
+	Etag                whereHelperstring
+	LastModified        whereHelperstring
+	LastSuccessAt       whereHelpernull_Time
+	LastError           whereHelperstring
+	LastErrorAt         whereHelpernull_Time
+	ConsecutiveFailures whereHelperint
+	ItemsPosted         whereHelperint64
+	DigestMode          whereHelperstring
+	DigestDailyAt       whereHelperint
 }{
-	ID:              whereHelperint{field: "\"rss_feed_subscriptions\".\"id\""},
-	CreatedAt:       whereHelpertime_Time{field: "\"rss_feed_subscriptions\".\"created_at\""},
-	UpdatedAt:       whereHelpertime_Time{field: "\"rss_feed_subscriptions\".\"updated_at\""},
-	GuildID:         whereHelperint64{field: "\"rss_feed_subscriptions\".\"guild_id\""},
-	ChannelID:       whereHelperint64{field: "\"rss_feed_subscriptions\".\"channel_id\""},
-	FeedURL:         whereHelperstring{field: "\"rss_feed_subscriptions\".\"feed_url\""},
-	MentionEveryone: whereHelperbool{field: "\"rss_feed_subscriptions\".\"mention_everyone\""},
-	MentionRoles:    whereHelpertypes_Int64Array{field: "\"rss_feed_subscriptions\".\"mention_roles\""},
-	Enabled:         whereHelperbool{field: "\"rss_feed_subscriptions\".\"enabled\""},
+	ID:                  whereHelperint{field: "\"rss_feed_subscriptions\".\"id\""},
+	CreatedAt:           whereHelpertime_Time{field: "\"rss_feed_subscriptions\".\"created_at\""},
+	UpdatedAt:           whereHelpertime_Time{field: "\"rss_feed_subscriptions\".\"updated_at\""},
+	GuildID:             whereHelperint64{field: "\"rss_feed_subscriptions\".\"guild_id\""},
+	ChannelID:           whereHelperint64{field: "\"rss_feed_subscriptions\".\"channel_id\""},
+	FeedURL:             whereHelperstring{field: "\"rss_feed_subscriptions\".\"feed_url\""},
+	MentionEveryone:     whereHelperbool{field: "\"rss_feed_subscriptions\".\"mention_everyone\""},
+	MentionRoles:        whereHelpertypes_Int64Array{field: "\"rss_feed_subscriptions\".\"mention_roles\""},
+	Enabled:             whereHelperbool{field: "\"rss_feed_subscriptions\".\"enabled\""},
+	MessageTemplate:     whereHelperstring{field: "\"rss_feed_subscriptions\".\"message_template\""},
+	IncludeFilters:      whereHelpertypes_StringArray{field: "\"rss_feed_subscriptions\".\"include_filters\""},
+	ExcludeFilters:      whereHelpertypes_StringArray{field: "\"rss_feed_subscriptions\".\"exclude_filters\""},
+	FiltersRegex:        whereHelperbool{field: "\"rss_feed_subscriptions\".\"filters_regex\""},
+	Etag:                whereHelperstring{field: "\"rss_feed_subscriptions\".\"etag\""},
+	LastModified:        whereHelperstring{field: "\"rss_feed_subscriptions\".\"last_modified\""},
+	LastSuccessAt:       whereHelpernull_Time{field: "\"rss_feed_subscriptions\".\"last_success_at\""},
+	LastError:           whereHelperstring{field: "\"rss_feed_subscriptions\".\"last_error\""},
+	LastErrorAt:         whereHelpernull_Time{field: "\"rss_feed_subscriptions\".\"last_error_at\""},
+	ConsecutiveFailures: whereHelperint{field: "\"rss_feed_subscriptions\".\"consecutive_failures\""},
+	ItemsPosted:         whereHelperint64{field: "\"rss_feed_subscriptions\".\"items_posted\""},
+	DigestMode:          whereHelperstring{field: "\"rss_feed_subscriptions\".\"digest_mode\""},
+	DigestDailyAt:       whereHelperint{field: "\"rss_feed_subscriptions\".\"digest_daily_at\""},
 }
 
 // RSSFeedSubscriptionRels is where relationship names are stored.
@@ -250,9 +387,9 @@ func (*rssFeedSubscriptionR) NewStruct() *rssFeedSubscriptionR {
 type rssFeedSubscriptionL struct{}
 
 var (
-	rssFeedSubscriptionAllColumns            = []string{"id", "created_at", "updated_at", "guild_id", "channel_id", "feed_url", "mention_everyone", "mention_roles", "enabled"}
+	rssFeedSubscriptionAllColumns            = []string{"id", "created_at", "updated_at", "guild_id", "channel_id", "feed_url", "mention_everyone", "mention_roles", "enabled", "message_template", "include_filters", "exclude_filters", "filters_regex", "etag", "last_modified", "last_success_at", "last_error", "last_error_at", "consecutive_failures", "items_posted", "digest_mode", "digest_daily_at"}
 	rssFeedSubscriptionColumnsWithoutDefault = []string{"created_at", "updated_at", "guild_id", "channel_id", "feed_url", "mention_everyone"}
-	rssFeedSubscriptionColumnsWithDefault    = []string{"id", "mention_roles", "enabled"}
+	rssFeedSubscriptionColumnsWithDefault    = []string{"id", "mention_roles", "enabled", "message_template", "include_filters", "exclude_filters", "filters_regex", "etag", "last_modified", "last_success_at", "last_error", "last_error_at", "consecutive_failures", "items_posted", "digest_mode", "digest_daily_at"}
 	rssFeedSubscriptionPrimaryKeyColumns     = []string{"id"}
 	rssFeedSubscriptionGeneratedColumns      = []string{}
 )
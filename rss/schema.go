@@ -14,4 +14,30 @@ CREATE TABLE IF NOT EXISTS rss_feed_subscriptions (
 	mention_roles BIGINT[],
 	enabled BOOLEAN NOT NULL DEFAULT TRUE
 );
+`, `
+ALTER TABLE rss_feed_subscriptions ADD COLUMN IF NOT EXISTS message_template TEXT NOT NULL DEFAULT '';
+`, `
+ALTER TABLE rss_feed_subscriptions ADD COLUMN IF NOT EXISTS include_filters TEXT[] NOT NULL DEFAULT '{}';
+`, `
+ALTER TABLE rss_feed_subscriptions ADD COLUMN IF NOT EXISTS exclude_filters TEXT[] NOT NULL DEFAULT '{}';
+`, `
+ALTER TABLE rss_feed_subscriptions ADD COLUMN IF NOT EXISTS filters_regex BOOLEAN NOT NULL DEFAULT FALSE;
+`, `
+ALTER TABLE rss_feed_subscriptions ADD COLUMN IF NOT EXISTS etag TEXT NOT NULL DEFAULT '';
+`, `
+ALTER TABLE rss_feed_subscriptions ADD COLUMN IF NOT EXISTS last_modified TEXT NOT NULL DEFAULT '';
+`, `
+ALTER TABLE rss_feed_subscriptions ADD COLUMN IF NOT EXISTS last_success_at TIMESTAMP WITH TIME ZONE;
+`, `
+ALTER TABLE rss_feed_subscriptions ADD COLUMN IF NOT EXISTS last_error TEXT NOT NULL DEFAULT '';
+`, `
+ALTER TABLE rss_feed_subscriptions ADD COLUMN IF NOT EXISTS last_error_at TIMESTAMP WITH TIME ZONE;
+`, `
+ALTER TABLE rss_feed_subscriptions ADD COLUMN IF NOT EXISTS consecutive_failures INT NOT NULL DEFAULT 0;
+`, `
+ALTER TABLE rss_feed_subscriptions ADD COLUMN IF NOT EXISTS items_posted BIGINT NOT NULL DEFAULT 0;
+`, `
+ALTER TABLE rss_feed_subscriptions ADD COLUMN IF NOT EXISTS digest_mode TEXT NOT NULL DEFAULT '';
+`, `
+ALTER TABLE rss_feed_subscriptions ADD COLUMN IF NOT EXISTS digest_daily_at INT NOT NULL DEFAULT 0;
 `}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/ThatBathroom/yagpdb/v2/common"
 	"github.com/ThatBathroom/yagpdb/v2/common/cplogs"
+	"github.com/ThatBathroom/yagpdb/v2/feeds"
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
 	"github.com/ThatBathroom/yagpdb/v2/premium"
 	"github.com/ThatBathroom/yagpdb/v2/rss/models"
@@ -75,6 +76,29 @@ type RSSFeedForm struct {
 	MentionEveryone bool
 	MentionRoles    []int64
 	Enabled         bool
+	MessageTemplate string `valid:"template,2000"`
+	IncludeFilters  string `valid:",2000"`
+	ExcludeFilters  string `valid:",2000"`
+	FiltersRegex    bool
+	DigestMode      string
+	DigestDailyAt   string
+}
+
+var _ web.CustomValidator = (*RSSFeedForm)(nil)
+
+func (f *RSSFeedForm) Validate(tmpl web.TemplateData, _ int64) bool {
+	_, err := NewItemFilter(ParseFilterTerms(f.IncludeFilters), ParseFilterTerms(f.ExcludeFilters), f.FiltersRegex)
+	if err != nil {
+		tmpl.AddAlerts(web.ErrorAlert("Invalid filters: " + err.Error()))
+		return false
+	}
+
+	if _, err := feeds.ParseDigestForm(f.DigestMode, f.DigestDailyAt); err != nil {
+		tmpl.AddAlerts(web.ErrorAlert(err.Error()))
+		return false
+	}
+
+	return true
 }
 
 func (p *Plugin) HandleRSS(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
@@ -90,6 +114,8 @@ func (p *Plugin) HandleRSS(w http.ResponseWriter, r *http.Request) (web.Template
 	}
 
 	templateData["FeedItems"] = subs
+	templateData["FailureNoticeHours"] = int((PollInterval * FeedFailureNoticeThreshold).Hours())
+	templateData["FailureDisableHours"] = int((PollInterval * FeedFailureDisableThreshold).Hours())
 	templateData["VisibleURL"] = "/manage/" + discordgo.StrID(activeGuild.ID) + "/rss"
 	return templateData, nil
 }
@@ -131,6 +157,8 @@ func (p *Plugin) HandleNew(w http.ResponseWriter, r *http.Request) (web.Template
 		}
 	}
 
+	digestDailyAt, _ := feeds.ParseDigestForm(data.DigestMode, data.DigestDailyAt)
+
 	sub := &models.RSSFeedSubscription{
 		GuildID:         activeGuild.ID,
 		ChannelID:       data.DiscordChannel,
@@ -138,6 +166,12 @@ func (p *Plugin) HandleNew(w http.ResponseWriter, r *http.Request) (web.Template
 		MentionEveryone: data.MentionEveryone,
 		MentionRoles:    mentionRoles,
 		Enabled:         true,
+		MessageTemplate: data.MessageTemplate,
+		IncludeFilters:  ParseFilterTerms(data.IncludeFilters),
+		ExcludeFilters:  ParseFilterTerms(data.ExcludeFilters),
+		FiltersRegex:    data.FiltersRegex,
+		DigestMode:      data.DigestMode,
+		DigestDailyAt:   digestDailyAt,
 	}
 	if err := sub.InsertG(ctx, boil.Infer()); err != nil {
 		return templateData.AddAlerts(web.ErrorAlert(fmt.Sprintf("Failed to add RSS feed: %v", err))), err
@@ -201,12 +235,26 @@ func (p *Plugin) HandleEdit(w http.ResponseWriter, r *http.Request) (web.Templat
 		}
 	}
 
+	columns := []string{"channel_id", "enabled", "mention_everyone", "mention_roles",
+		"message_template", "include_filters", "exclude_filters", "filters_regex", "digest_mode", "digest_daily_at"}
+	if !sub.Enabled && data.Enabled {
+		// give re-enabled feeds a fresh start
+		sub.ConsecutiveFailures = 0
+		columns = append(columns, "consecutive_failures")
+	}
+
 	sub.ChannelID = data.DiscordChannel
 	sub.MentionEveryone = data.MentionEveryone
 	sub.MentionRoles = data.MentionRoles
 	sub.Enabled = data.Enabled
-
-	_, err := sub.UpdateG(ctx, boil.Whitelist("channel_id", "enabled", "mention_everyone", "mention_roles"))
+	sub.MessageTemplate = data.MessageTemplate
+	sub.IncludeFilters = ParseFilterTerms(data.IncludeFilters)
+	sub.ExcludeFilters = ParseFilterTerms(data.ExcludeFilters)
+	sub.FiltersRegex = data.FiltersRegex
+	sub.DigestMode = data.DigestMode
+	sub.DigestDailyAt, _ = feeds.ParseDigestForm(data.DigestMode, data.DigestDailyAt)
+
+	_, err := sub.UpdateG(ctx, boil.Whitelist(columns...))
 	if err != nil {
 		return templateData.AddAlerts(web.ErrorAlert("Failed to update RSS feed.")), err
 	}
@@ -0,0 +1,374 @@
+package rsvp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ThatBathroom/yagpdb/v2/bot"
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/lib/dstate"
+	"github.com/ThatBathroom/yagpdb/v2/rsvp/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	EventAttendancePrefix     = "event_attendance_"
+	EventAttendancePagePrefix = "event_attendance_page_"
+
+	// max members a select menu can have selected
+	attendancePageSize = 25
+)
+
+// recordAttendance saves the participants of a session that's starting, so that the organiser can mark who attended afterwards
+func recordAttendance(m *models.RSVPSession) error {
+	if m.R == nil {
+		return nil
+	}
+
+	for _, v := range m.R.RSVPSessionsMessageRSVPParticipants {
+		if v.JoinState != int16(ParticipantStateJoining) {
+			continue
+		}
+
+		record := &models.RSVPAttendance{
+			GuildID:         m.GuildID,
+			UserID:          v.UserID,
+			SessionLocalID:  m.LocalID,
+			SessionAuthorID: m.AuthorID,
+			SessionTitle:    m.Title,
+			StartsAt:        m.StartsAt,
+			Slot:            v.Slot,
+		}
+
+		err := record.UpsertG(context.Background(), false, []string{"guild_id", "session_local_id", "user_id"}, boil.None(), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// canMarkAttendance returns true if the member is the organiser of the event or has manage server permissions
+func canMarkAttendance(guildID, channelID int64, ms *dstate.MemberState, authorID int64) (bool, error) {
+	if ms.User.ID == authorID {
+		return true, nil
+	}
+
+	return bot.AdminOrPermMS(guildID, channelID, ms, discordgo.PermissionManageGuild)
+}
+
+// attendanceMarkingMessage creates the message organisers use to mark who attended an event, a select menu only fits
+// 25 members so bigger events are split into pages
+func attendanceMarkingMessage(localID int64, records []*models.RSVPAttendance, page int) *discordgo.MessageSend {
+	numPages := (len(records) + attendancePageSize - 1) / attendancePageSize
+	if page >= numPages {
+		page = numPages - 1
+	}
+	if page < 0 {
+		page = 0
+	}
+
+	defaults := make([]discordgo.SelectMenuDefaultValue, 0, attendancePageSize)
+	for _, v := range attendancePage(records, page) {
+		if v.Attended.Valid && !v.Attended.Bool {
+			continue
+		}
+
+		defaults = append(defaults, discordgo.SelectMenuDefaultValue{
+			ID:   discordgo.StrID(v.UserID),
+			Type: discordgo.SelectMenuDefaultValueUser,
+		})
+	}
+
+	content := fmt.Sprintf("Select the members that attended event #%d (**%s**), %d members were signed up. Members left out will be marked as absent.", localID, records[0].SessionTitle, len(records))
+	if numPages > 1 {
+		content += fmt.Sprintf("\nShowing page %d of %d, only the members on this page are marked as absent when left out.", page+1, numPages)
+	}
+
+	minValues := 0
+	components := []discordgo.TopLevelComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.InteractiveComponent{
+				discordgo.SelectMenu{
+					MenuType:      discordgo.UserSelectMenu,
+					CustomID:      fmt.Sprintf("%s%d_%d", EventAttendancePrefix, localID, page),
+					Placeholder:   "Members that attended",
+					MinValues:     &minValues,
+					MaxValues:     attendancePageSize,
+					DefaultValues: defaults,
+				},
+			},
+		},
+	}
+
+	if numPages > 1 {
+		components = append(components, discordgo.ActionsRow{
+			Components: []discordgo.InteractiveComponent{
+				discordgo.Button{
+					Label:    "Previous page",
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("%s%d_%d", EventAttendancePagePrefix, localID, page-1),
+					Disabled: page == 0,
+				},
+				discordgo.Button{
+					Label:    "Next page",
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("%s%d_%d", EventAttendancePagePrefix, localID, page+1),
+					Disabled: page >= numPages-1,
+				},
+			},
+		})
+	}
+
+	return &discordgo.MessageSend{
+		Content:         content,
+		Components:      components,
+		AllowedMentions: discordgo.AllowedMentions{},
+	}
+}
+
+// attendancePage returns the records shown on the page of the attendance marking message
+func attendancePage(records []*models.RSVPAttendance, page int) []*models.RSVPAttendance {
+	start := page * attendancePageSize
+	if start >= len(records) || start < 0 {
+		return nil
+	}
+
+	end := start + attendancePageSize
+	if end > len(records) {
+		end = len(records)
+	}
+
+	return records[start:end]
+}
+
+// parseAttendanceCustomID returns the event and page of an attendance component, messages from before paging was
+// added only have the event
+func parseAttendanceCustomID(customID, prefix string) (localID int64, page int, err error) {
+	idStr, pageStr, hasPage := strings.Cut(strings.TrimPrefix(customID, prefix), "_")
+	localID, err = strconv.ParseInt(idStr, 10, 64)
+	if err != nil || !hasPage {
+		return
+	}
+
+	page, err = strconv.Atoi(pageStr)
+	return
+}
+
+// attendanceRecords returns the recorded attendance of the event, in the order they're shown on the pages
+func attendanceRecords(guildID, localID int64) ([]*models.RSVPAttendance, error) {
+	return models.RSVPAttendances(
+		models.RSVPAttendanceWhere.GuildID.EQ(guildID),
+		models.RSVPAttendanceWhere.SessionLocalID.EQ(localID),
+		qm.OrderBy("id asc"),
+	).AllG(context.Background())
+}
+
+func respondAttendanceInteraction(ic *discordgo.InteractionCreate, msg string) {
+	err := common.BotSession.CreateInteractionResponse(ic.ID, ic.Token, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: msg,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		logger.WithError(err).WithField("guild", ic.GuildID).Error("failed responding to attendance interaction")
+	}
+}
+
+// checkAttendanceInteraction returns the attendance of the event, or responds with why the member can't mark it
+// and returns nil
+func checkAttendanceInteraction(ic *discordgo.InteractionCreate, localID int64) []*models.RSVPAttendance {
+	records, err := attendanceRecords(ic.GuildID, localID)
+	if err != nil {
+		logger.WithError(err).WithField("guild", ic.GuildID).Error("failed retrieving attendance")
+		respondAttendanceInteraction(ic, "Something went wrong, try again later")
+		return nil
+	}
+
+	if len(records) < 1 {
+		respondAttendanceInteraction(ic, "No attendance recorded for that event")
+		return nil
+	}
+
+	ok, err := canMarkAttendance(ic.GuildID, ic.ChannelID, dstate.MemberStateFromMember(ic.Member), records[0].SessionAuthorID)
+	if err != nil {
+		logger.WithError(err).WithField("guild", ic.GuildID).Error("failed checking permissions")
+		respondAttendanceInteraction(ic, "Something went wrong, try again later")
+		return nil
+	}
+
+	if !ok {
+		respondAttendanceInteraction(ic, "Only the organiser of the event or members with the Manage Server permission can mark attendance")
+		return nil
+	}
+
+	return records
+}
+
+func (p *Plugin) handleAttendancePageInteraction(ic *discordgo.InteractionCreate) {
+	localID, page, err := parseAttendanceCustomID(ic.MessageComponentData().CustomID, EventAttendancePagePrefix)
+	if err != nil {
+		return
+	}
+
+	records := checkAttendanceInteraction(ic, localID)
+	if records == nil {
+		return
+	}
+
+	msg := attendanceMarkingMessage(localID, records, page)
+	err = common.BotSession.CreateInteractionResponse(ic.ID, ic.Token, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:         msg.Content,
+			Components:      msg.Components,
+			AllowedMentions: &msg.AllowedMentions,
+		},
+	})
+	if err != nil {
+		logger.WithError(err).WithField("guild", ic.GuildID).Error("failed updating attendance page")
+	}
+}
+
+func (p *Plugin) handleAttendanceInteraction(ic *discordgo.InteractionCreate) {
+	data := ic.MessageComponentData()
+	localID, page, err := parseAttendanceCustomID(data.CustomID, EventAttendancePrefix)
+	if err != nil {
+		return
+	}
+
+	records := checkAttendanceInteraction(ic, localID)
+	if records == nil {
+		return
+	}
+	respond := func(msg string) { respondAttendanceInteraction(ic, msg) }
+
+	attended := make(map[int64]bool)
+	for _, v := range data.Values {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err == nil {
+			attended[id] = true
+		}
+	}
+
+	// only the members on the page are marked as absent when left out, members on other pages can still be
+	// selected as attending
+	onPage := make(map[int64]bool)
+	for _, v := range attendancePage(records, page) {
+		onPage[v.UserID] = true
+	}
+
+	numAttended := 0
+	numAbsent := 0
+	for _, v := range records {
+		if !onPage[v.UserID] && !attended[v.UserID] {
+			continue
+		}
+
+		v.Attended = null.BoolFrom(attended[v.UserID])
+		if attended[v.UserID] {
+			numAttended++
+			delete(attended, v.UserID)
+		} else {
+			numAbsent++
+		}
+
+		_, err = v.UpdateG(context.Background(), boil.Whitelist("attended"))
+		if err != nil {
+			logger.WithError(err).WithField("guild", ic.GuildID).Error("failed updating attendance")
+			respond("Something went wrong, try again later")
+			return
+		}
+	}
+
+	// members that showed up without signing up
+	for userID := range attended {
+		record := &models.RSVPAttendance{
+			GuildID:         ic.GuildID,
+			UserID:          userID,
+			SessionLocalID:  localID,
+			SessionAuthorID: records[0].SessionAuthorID,
+			SessionTitle:    records[0].SessionTitle,
+			StartsAt:        records[0].StartsAt,
+			Attended:        null.BoolFrom(true),
+		}
+
+		err = record.InsertG(context.Background(), boil.Infer())
+		if err != nil {
+			logger.WithError(err).WithField("guild", ic.GuildID).Error("failed inserting attendance")
+			respond("Something went wrong, try again later")
+			return
+		}
+
+		numAttended++
+	}
+
+	respond(fmt.Sprintf("Saved attendance for #%d: %d attended, %d absent", localID, numAttended, numAbsent))
+}
+
+// attendanceHistory formats the attendance history of a member
+func attendanceHistory(guildID int64, user *discordgo.User) (string, error) {
+	records, err := models.RSVPAttendances(
+		models.RSVPAttendanceWhere.GuildID.EQ(guildID),
+		models.RSVPAttendanceWhere.UserID.EQ(user.ID),
+		qm.OrderBy("starts_at desc"),
+	).AllG(context.Background())
+	if err != nil {
+		return "", err
+	}
+
+	if len(records) < 1 {
+		return fmt.Sprintf("%s hasn't signed up for any events yet", user.Username), nil
+	}
+
+	numAttended := 0
+	numAbsent := 0
+	for _, v := range records {
+		if !v.Attended.Valid {
+			continue
+		}
+
+		if v.Attended.Bool {
+			numAttended++
+		} else {
+			numAbsent++
+		}
+	}
+
+	var out strings.Builder
+	out.WriteString(fmt.Sprintf("**%s** signed up for %d events, attended %d and missed %d (%d not marked yet)\n\n",
+		user.Username, len(records), numAttended, numAbsent, len(records)-numAttended-numAbsent))
+
+	for i, v := range records {
+		if i >= 15 {
+			out.WriteString(fmt.Sprintf("+ %d older events", len(records)-i))
+			break
+		}
+
+		status := "❔ not marked"
+		if v.Attended.Valid {
+			if v.Attended.Bool {
+				status = "✅ attended"
+			} else {
+				status = "❌ absent"
+			}
+		}
+
+		slot := ""
+		if v.Slot != "" {
+			slot = " as " + v.Slot
+		}
+
+		out.WriteString(fmt.Sprintf("<t:%d:d> #%d **%s**%s - %s\n", v.StartsAt.Unix(), v.SessionLocalID, v.SessionTitle, slot, status))
+	}
+
+	return out.String(), nil
+}
@@ -0,0 +1,60 @@
+package rsvp
+
+import (
+	"testing"
+
+	"github.com/ThatBathroom/yagpdb/v2/rsvp/models"
+)
+
+func TestParseAttendanceCustomID(t *testing.T) {
+	cases := []struct {
+		customID, prefix string
+		localID          int64
+		page             int
+	}{
+		{"event_attendance_12", EventAttendancePrefix, 12, 0},
+		{"event_attendance_12_3", EventAttendancePrefix, 12, 3},
+		{"event_attendance_page_7_1", EventAttendancePagePrefix, 7, 1},
+	}
+
+	for _, c := range cases {
+		localID, page, err := parseAttendanceCustomID(c.customID, c.prefix)
+		if err != nil || localID != c.localID || page != c.page {
+			t.Errorf("parseAttendanceCustomID(%q) = %d, %d, %v, want %d, %d", c.customID, localID, page, err, c.localID, c.page)
+		}
+	}
+
+	if _, _, err := parseAttendanceCustomID("event_attendance_abc", EventAttendancePrefix); err == nil {
+		t.Error("expected an error for an invalid id")
+	}
+}
+
+func TestAttendancePage(t *testing.T) {
+	records := make([]*models.RSVPAttendance, attendancePageSize*2+5)
+	for i := range records {
+		records[i] = &models.RSVPAttendance{UserID: int64(i)}
+	}
+
+	cases := []struct {
+		page, length int
+		first        int64
+	}{
+		{0, attendancePageSize, 0},
+		{1, attendancePageSize, attendancePageSize},
+		{2, 5, attendancePageSize * 2},
+		{3, 0, 0},
+		{-1, 0, 0},
+	}
+
+	for _, c := range cases {
+		got := attendancePage(records, c.page)
+		if len(got) != c.length {
+			t.Errorf("page %d: got %d records, want %d", c.page, len(got), c.length)
+			continue
+		}
+
+		if len(got) > 0 && got[0].UserID != c.first {
+			t.Errorf("page %d: first record is %d, want %d", c.page, got[0].UserID, c.first)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package rsvp
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ThatBathroom/yagpdb/v2/rsvp/models"
+)
+
+const icalTimeFormat = "20060102T150405Z"
+
+var icalEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// WriteICalendar writes the sessions as an iCalendar (RFC 5545) feed
+func WriteICalendar(w io.Writer, calendarName string, sessions []*models.RSVPSession) error {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//YAGPDB//RSVP Events//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:" + icalEscaper.Replace(calendarName),
+	}
+
+	for _, v := range sessions {
+		link := fmt.Sprintf("https://discord.com/channels/%d/%d/%d", v.GuildID, v.ChannelID, v.MessageID)
+
+		description := link
+		if v.Description != "" {
+			description = v.Description + "\n\n" + link
+		}
+
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			fmt.Sprintf("UID:rsvp-%d@yagpdb", v.MessageID),
+			"DTSTAMP:"+v.CreatedAt.UTC().Format(icalTimeFormat),
+			"DTSTART:"+v.StartsAt.UTC().Format(icalTimeFormat),
+			"DTEND:"+v.StartsAt.Add(EventDuration).UTC().Format(icalTimeFormat),
+			"SUMMARY:"+icalEscaper.Replace(v.Title),
+			"DESCRIPTION:"+icalEscaper.Replace(description),
+			"URL:"+link,
+			"END:VEVENT",
+		)
+	}
+
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, v := range lines {
+		_, err := io.WriteString(w, foldICalLine(v))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// foldICalLine splits lines longer than 75 octets as required by the spec,
+// continuation lines start with a single space
+func foldICalLine(line string) string {
+	var out strings.Builder
+	lineLen := 0
+	for _, r := range line {
+		size := utf8.RuneLen(r)
+		if lineLen+size > 75 {
+			out.WriteString("\r\n ")
+			lineLen = 1
+		}
+
+		out.WriteRune(r)
+		lineLen += size
+	}
+
+	out.WriteString("\r\n")
+	return out.String()
+}
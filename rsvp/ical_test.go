@@ -0,0 +1,39 @@
+package rsvp
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ThatBathroom/yagpdb/v2/rsvp/models"
+)
+
+func TestWriteICalendar(t *testing.T) {
+	startsAt := time.Date(2024, time.May, 3, 18, 30, 0, 0, time.FixedZone("", 2*60*60))
+	sessions := []*models.RSVPSession{
+		{
+			MessageID: 3,
+			GuildID:   1,
+			ChannelID: 2,
+			CreatedAt: startsAt.Add(-time.Hour * 24),
+			StartsAt:  startsAt,
+			Title:     "Raid, part 2",
+		},
+	}
+
+	var out strings.Builder
+	err := WriteICalendar(&out, "Events", sessions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"DTSTART:20240503T163000Z\r\n",
+		"DTEND:20240503T183000Z\r\n",
+		`SUMMARY:Raid\, part 2` + "\r\n",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("missing %q in:\n%s", want, out.String())
+		}
+	}
+}
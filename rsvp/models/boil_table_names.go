@@ -4,9 +4,13 @@
 package models
 
 var TableNames = struct {
+	RSVPAttendance   string
+	RSVPGuildConfigs string
 	RSVPParticipants string
 	RSVPSessions     string
 }{
+	RSVPAttendance:   "rsvp_attendance",
+	RSVPGuildConfigs: "rsvp_guild_configs",
 	RSVPParticipants: "rsvp_participants",
 	RSVPSessions:     "rsvp_sessions",
 }
@@ -0,0 +1,910 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// RSVPAttendance is an object representing the database table.
+type RSVPAttendance struct {
+	ID              int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	GuildID         int64     `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	UserID          int64     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	SessionLocalID  int64     `boil:"session_local_id" json:"session_local_id" toml:"session_local_id" yaml:"session_local_id"`
+	SessionAuthorID int64     `boil:"session_author_id" json:"session_author_id" toml:"session_author_id" yaml:"session_author_id"`
+	SessionTitle    string    `boil:"session_title" json:"session_title" toml:"session_title" yaml:"session_title"`
+	StartsAt        time.Time `boil:"starts_at" json:"starts_at" toml:"starts_at" yaml:"starts_at"`
+	Slot            string    `boil:"slot" json:"slot" toml:"slot" yaml:"slot"`
+	Attended        null.Bool `boil:"attended" json:"attended,omitempty" toml:"attended" yaml:"attended,omitempty"`
+
+	R *rsvpAttendanceR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L rsvpAttendanceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RSVPAttendanceColumns = struct {
+	ID              string
+	GuildID         string
+	UserID          string
+	SessionLocalID  string
+	SessionAuthorID string
+	SessionTitle    string
+	StartsAt        string
+	Slot            string
+	Attended        string
+}{
+	ID:              "id",
+	GuildID:         "guild_id",
+	UserID:          "user_id",
+	SessionLocalID:  "session_local_id",
+	SessionAuthorID: "session_author_id",
+	SessionTitle:    "session_title",
+	StartsAt:        "starts_at",
+	Slot:            "slot",
+	Attended:        "attended",
+}
+
+var RSVPAttendanceTableColumns = struct {
+	ID              string
+	GuildID         string
+	UserID          string
+	SessionLocalID  string
+	SessionAuthorID string
+	SessionTitle    string
+	StartsAt        string
+	Slot            string
+	Attended        string
+}{
+	ID:              "rsvp_attendance.id",
+	GuildID:         "rsvp_attendance.guild_id",
+	UserID:          "rsvp_attendance.user_id",
+	SessionLocalID:  "rsvp_attendance.session_local_id",
+	SessionAuthorID: "rsvp_attendance.session_author_id",
+	SessionTitle:    "rsvp_attendance.session_title",
+	StartsAt:        "rsvp_attendance.starts_at",
+	Slot:            "rsvp_attendance.slot",
+	Attended:        "rsvp_attendance.attended",
+}
+
+// Generated where
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
+func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpernull_Bool struct{ field string }
+
+func (w whereHelpernull_Bool) EQ(x null.Bool) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Bool) NEQ(x null.Bool) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Bool) LT(x null.Bool) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Bool) LTE(x null.Bool) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Bool) GT(x null.Bool) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Bool) GTE(x null.Bool) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Bool) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Bool) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var RSVPAttendanceWhere = struct {
+	ID              whereHelperint64
+	GuildID         whereHelperint64
+	UserID          whereHelperint64
+	SessionLocalID  whereHelperint64
+	SessionAuthorID whereHelperint64
+	SessionTitle    whereHelperstring
+	StartsAt        whereHelpertime_Time
+	Slot            whereHelperstring
+	Attended        whereHelpernull_Bool
+}{
+	ID:              whereHelperint64{field: "\"rsvp_attendance\".\"id\""},
+	GuildID:         whereHelperint64{field: "\"rsvp_attendance\".\"guild_id\""},
+	UserID:          whereHelperint64{field: "\"rsvp_attendance\".\"user_id\""},
+	SessionLocalID:  whereHelperint64{field: "\"rsvp_attendance\".\"session_local_id\""},
+	SessionAuthorID: whereHelperint64{field: "\"rsvp_attendance\".\"session_author_id\""},
+	SessionTitle:    whereHelperstring{field: "\"rsvp_attendance\".\"session_title\""},
+	StartsAt:        whereHelpertime_Time{field: "\"rsvp_attendance\".\"starts_at\""},
+	Slot:            whereHelperstring{field: "\"rsvp_attendance\".\"slot\""},
+	Attended:        whereHelpernull_Bool{field: "\"rsvp_attendance\".\"attended\""},
+}
+
+// RSVPAttendanceRels is where relationship names are stored.
+var RSVPAttendanceRels = struct {
+}{}
+
+// rsvpAttendanceR is where relationships are stored.
+type rsvpAttendanceR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*rsvpAttendanceR) NewStruct() *rsvpAttendanceR {
+	return &rsvpAttendanceR{}
+}
+
+// rsvpAttendanceL is where Load methods for each relationship are stored.
+type rsvpAttendanceL struct{}
+
+var (
+	rsvpAttendanceAllColumns            = []string{"id", "guild_id", "user_id", "session_local_id", "session_author_id", "session_title", "starts_at", "slot", "attended"}
+	rsvpAttendanceColumnsWithoutDefault = []string{"guild_id", "user_id", "session_local_id", "session_author_id", "session_title", "starts_at", "slot"}
+	rsvpAttendanceColumnsWithDefault    = []string{"id", "attended"}
+	rsvpAttendancePrimaryKeyColumns     = []string{"id"}
+	rsvpAttendanceGeneratedColumns      = []string{}
+)
+
+type (
+	// RSVPAttendanceSlice is an alias for a slice of pointers to RSVPAttendance.
+	// This should almost always be used instead of []RSVPAttendance.
+	RSVPAttendanceSlice []*RSVPAttendance
+
+	rsvpAttendanceQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	rsvpAttendanceType                 = reflect.TypeOf(&RSVPAttendance{})
+	rsvpAttendanceMapping              = queries.MakeStructMapping(rsvpAttendanceType)
+	rsvpAttendancePrimaryKeyMapping, _ = queries.BindMapping(rsvpAttendanceType, rsvpAttendanceMapping, rsvpAttendancePrimaryKeyColumns)
+	rsvpAttendanceInsertCacheMut       sync.RWMutex
+	rsvpAttendanceInsertCache          = make(map[string]insertCache)
+	rsvpAttendanceUpdateCacheMut       sync.RWMutex
+	rsvpAttendanceUpdateCache          = make(map[string]updateCache)
+	rsvpAttendanceUpsertCacheMut       sync.RWMutex
+	rsvpAttendanceUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single rsvpAttendance record from the query using the global executor.
+func (q rsvpAttendanceQuery) OneG(ctx context.Context) (*RSVPAttendance, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single rsvpAttendance record from the query.
+func (q rsvpAttendanceQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RSVPAttendance, error) {
+	o := &RSVPAttendance{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for rsvp_attendance")
+	}
+
+	return o, nil
+}
+
+// AllG returns all RSVPAttendance records from the query using the global executor.
+func (q rsvpAttendanceQuery) AllG(ctx context.Context) (RSVPAttendanceSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all RSVPAttendance records from the query.
+func (q rsvpAttendanceQuery) All(ctx context.Context, exec boil.ContextExecutor) (RSVPAttendanceSlice, error) {
+	var o []*RSVPAttendance
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to RSVPAttendance slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all RSVPAttendance records in the query using the global executor
+func (q rsvpAttendanceQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all RSVPAttendance records in the query.
+func (q rsvpAttendanceQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count rsvp_attendance rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q rsvpAttendanceQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q rsvpAttendanceQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if rsvp_attendance exists")
+	}
+
+	return count > 0, nil
+}
+
+// RSVPAttendances retrieves all the records using an executor.
+func RSVPAttendances(mods ...qm.QueryMod) rsvpAttendanceQuery {
+	mods = append(mods, qm.From("\"rsvp_attendance\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"rsvp_attendance\".*"})
+	}
+
+	return rsvpAttendanceQuery{q}
+}
+
+// FindRSVPAttendanceG retrieves a single record by ID.
+func FindRSVPAttendanceG(ctx context.Context, iD int64, selectCols ...string) (*RSVPAttendance, error) {
+	return FindRSVPAttendance(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindRSVPAttendance retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRSVPAttendance(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*RSVPAttendance, error) {
+	rsvpAttendanceObj := &RSVPAttendance{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"rsvp_attendance\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, rsvpAttendanceObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from rsvp_attendance")
+	}
+
+	return rsvpAttendanceObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *RSVPAttendance) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RSVPAttendance) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no rsvp_attendance provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(rsvpAttendanceColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	rsvpAttendanceInsertCacheMut.RLock()
+	cache, cached := rsvpAttendanceInsertCache[key]
+	rsvpAttendanceInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			rsvpAttendanceAllColumns,
+			rsvpAttendanceColumnsWithDefault,
+			rsvpAttendanceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(rsvpAttendanceType, rsvpAttendanceMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(rsvpAttendanceType, rsvpAttendanceMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"rsvp_attendance\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"rsvp_attendance\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into rsvp_attendance")
+	}
+
+	if !cached {
+		rsvpAttendanceInsertCacheMut.Lock()
+		rsvpAttendanceInsertCache[key] = cache
+		rsvpAttendanceInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single RSVPAttendance record using the global executor.
+// See Update for more documentation.
+func (o *RSVPAttendance) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the RSVPAttendance.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RSVPAttendance) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	rsvpAttendanceUpdateCacheMut.RLock()
+	cache, cached := rsvpAttendanceUpdateCache[key]
+	rsvpAttendanceUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			rsvpAttendanceAllColumns,
+			rsvpAttendancePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update rsvp_attendance, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"rsvp_attendance\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, rsvpAttendancePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(rsvpAttendanceType, rsvpAttendanceMapping, append(wl, rsvpAttendancePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update rsvp_attendance row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for rsvp_attendance")
+	}
+
+	if !cached {
+		rsvpAttendanceUpdateCacheMut.Lock()
+		rsvpAttendanceUpdateCache[key] = cache
+		rsvpAttendanceUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q rsvpAttendanceQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q rsvpAttendanceQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for rsvp_attendance")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for rsvp_attendance")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o RSVPAttendanceSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RSVPAttendanceSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rsvpAttendancePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"rsvp_attendance\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, rsvpAttendancePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in rsvpAttendance slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all rsvpAttendance")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *RSVPAttendance) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RSVPAttendance) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no rsvp_attendance provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rsvpAttendanceColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	rsvpAttendanceUpsertCacheMut.RLock()
+	cache, cached := rsvpAttendanceUpsertCache[key]
+	rsvpAttendanceUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			rsvpAttendanceAllColumns,
+			rsvpAttendanceColumnsWithDefault,
+			rsvpAttendanceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			rsvpAttendanceAllColumns,
+			rsvpAttendancePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert rsvp_attendance, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(rsvpAttendanceAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(rsvpAttendancePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert rsvp_attendance, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(rsvpAttendancePrimaryKeyColumns))
+			copy(conflict, rsvpAttendancePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"rsvp_attendance\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(rsvpAttendanceType, rsvpAttendanceMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(rsvpAttendanceType, rsvpAttendanceMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert rsvp_attendance")
+	}
+
+	if !cached {
+		rsvpAttendanceUpsertCacheMut.Lock()
+		rsvpAttendanceUpsertCache[key] = cache
+		rsvpAttendanceUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single RSVPAttendance record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *RSVPAttendance) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single RSVPAttendance record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RSVPAttendance) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no RSVPAttendance provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), rsvpAttendancePrimaryKeyMapping)
+	sql := "DELETE FROM \"rsvp_attendance\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from rsvp_attendance")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for rsvp_attendance")
+	}
+
+	return rowsAff, nil
+}
+
+func (q rsvpAttendanceQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q rsvpAttendanceQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no rsvpAttendanceQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rsvp_attendance")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rsvp_attendance")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o RSVPAttendanceSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RSVPAttendanceSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rsvpAttendancePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"rsvp_attendance\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rsvpAttendancePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rsvpAttendance slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rsvp_attendance")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *RSVPAttendance) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no RSVPAttendance provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RSVPAttendance) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRSVPAttendance(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RSVPAttendanceSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty RSVPAttendanceSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RSVPAttendanceSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RSVPAttendanceSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rsvpAttendancePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"rsvp_attendance\".* FROM \"rsvp_attendance\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rsvpAttendancePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RSVPAttendanceSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RSVPAttendanceExistsG checks if the RSVPAttendance row exists.
+func RSVPAttendanceExistsG(ctx context.Context, iD int64) (bool, error) {
+	return RSVPAttendanceExists(ctx, boil.GetContextDB(), iD)
+}
+
+// RSVPAttendanceExists checks if the RSVPAttendance row exists.
+func RSVPAttendanceExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"rsvp_attendance\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if rsvp_attendance exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RSVPAttendance row exists.
+func (o *RSVPAttendance) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RSVPAttendanceExists(ctx, exec, o.ID)
+}
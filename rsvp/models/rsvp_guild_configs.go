@@ -0,0 +1,781 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// RSVPGuildConfig is an object representing the database table.
+type RSVPGuildConfig struct {
+	GuildID          int64  `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	CalendarToken    string `boil:"calendar_token" json:"calendar_token" toml:"calendar_token" yaml:"calendar_token"`
+	SyncNativeEvents bool   `boil:"sync_native_events" json:"sync_native_events" toml:"sync_native_events" yaml:"sync_native_events"`
+
+	R *rsvpGuildConfigR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L rsvpGuildConfigL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RSVPGuildConfigColumns = struct {
+	GuildID          string
+	CalendarToken    string
+	SyncNativeEvents string
+}{
+	GuildID:          "guild_id",
+	CalendarToken:    "calendar_token",
+	SyncNativeEvents: "sync_native_events",
+}
+
+var RSVPGuildConfigTableColumns = struct {
+	GuildID          string
+	CalendarToken    string
+	SyncNativeEvents string
+}{
+	GuildID:          "rsvp_guild_configs.guild_id",
+	CalendarToken:    "rsvp_guild_configs.calendar_token",
+	SyncNativeEvents: "rsvp_guild_configs.sync_native_events",
+}
+
+// Generated where
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var RSVPGuildConfigWhere = struct {
+	GuildID          whereHelperint64
+	CalendarToken    whereHelperstring
+	SyncNativeEvents whereHelperbool
+}{
+	GuildID:          whereHelperint64{field: "\"rsvp_guild_configs\".\"guild_id\""},
+	CalendarToken:    whereHelperstring{field: "\"rsvp_guild_configs\".\"calendar_token\""},
+	SyncNativeEvents: whereHelperbool{field: "\"rsvp_guild_configs\".\"sync_native_events\""},
+}
+
+// RSVPGuildConfigRels is where relationship names are stored.
+var RSVPGuildConfigRels = struct {
+}{}
+
+// rsvpGuildConfigR is where relationships are stored.
+type rsvpGuildConfigR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*rsvpGuildConfigR) NewStruct() *rsvpGuildConfigR {
+	return &rsvpGuildConfigR{}
+}
+
+// rsvpGuildConfigL is where Load methods for each relationship are stored.
+type rsvpGuildConfigL struct{}
+
+var (
+	rsvpGuildConfigAllColumns            = []string{"guild_id", "calendar_token", "sync_native_events"}
+	rsvpGuildConfigColumnsWithoutDefault = []string{"guild_id", "calendar_token"}
+	rsvpGuildConfigColumnsWithDefault    = []string{"sync_native_events"}
+	rsvpGuildConfigPrimaryKeyColumns     = []string{"guild_id"}
+	rsvpGuildConfigGeneratedColumns      = []string{}
+)
+
+type (
+	// RSVPGuildConfigSlice is an alias for a slice of pointers to RSVPGuildConfig.
+	// This should almost always be used instead of []RSVPGuildConfig.
+	RSVPGuildConfigSlice []*RSVPGuildConfig
+
+	rsvpGuildConfigQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	rsvpGuildConfigType                 = reflect.TypeOf(&RSVPGuildConfig{})
+	rsvpGuildConfigMapping              = queries.MakeStructMapping(rsvpGuildConfigType)
+	rsvpGuildConfigPrimaryKeyMapping, _ = queries.BindMapping(rsvpGuildConfigType, rsvpGuildConfigMapping, rsvpGuildConfigPrimaryKeyColumns)
+	rsvpGuildConfigInsertCacheMut       sync.RWMutex
+	rsvpGuildConfigInsertCache          = make(map[string]insertCache)
+	rsvpGuildConfigUpdateCacheMut       sync.RWMutex
+	rsvpGuildConfigUpdateCache          = make(map[string]updateCache)
+	rsvpGuildConfigUpsertCacheMut       sync.RWMutex
+	rsvpGuildConfigUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single rsvpGuildConfig record from the query using the global executor.
+func (q rsvpGuildConfigQuery) OneG(ctx context.Context) (*RSVPGuildConfig, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single rsvpGuildConfig record from the query.
+func (q rsvpGuildConfigQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RSVPGuildConfig, error) {
+	o := &RSVPGuildConfig{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for rsvp_guild_configs")
+	}
+
+	return o, nil
+}
+
+// AllG returns all RSVPGuildConfig records from the query using the global executor.
+func (q rsvpGuildConfigQuery) AllG(ctx context.Context) (RSVPGuildConfigSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all RSVPGuildConfig records from the query.
+func (q rsvpGuildConfigQuery) All(ctx context.Context, exec boil.ContextExecutor) (RSVPGuildConfigSlice, error) {
+	var o []*RSVPGuildConfig
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to RSVPGuildConfig slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all RSVPGuildConfig records in the query using the global executor
+func (q rsvpGuildConfigQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all RSVPGuildConfig records in the query.
+func (q rsvpGuildConfigQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count rsvp_guild_configs rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q rsvpGuildConfigQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q rsvpGuildConfigQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if rsvp_guild_configs exists")
+	}
+
+	return count > 0, nil
+}
+
+// RSVPGuildConfigs retrieves all the records using an executor.
+func RSVPGuildConfigs(mods ...qm.QueryMod) rsvpGuildConfigQuery {
+	mods = append(mods, qm.From("\"rsvp_guild_configs\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"rsvp_guild_configs\".*"})
+	}
+
+	return rsvpGuildConfigQuery{q}
+}
+
+// FindRSVPGuildConfigG retrieves a single record by ID.
+func FindRSVPGuildConfigG(ctx context.Context, guildID int64, selectCols ...string) (*RSVPGuildConfig, error) {
+	return FindRSVPGuildConfig(ctx, boil.GetContextDB(), guildID, selectCols...)
+}
+
+// FindRSVPGuildConfig retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRSVPGuildConfig(ctx context.Context, exec boil.ContextExecutor, guildID int64, selectCols ...string) (*RSVPGuildConfig, error) {
+	rsvpGuildConfigObj := &RSVPGuildConfig{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"rsvp_guild_configs\" where \"guild_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, guildID)
+
+	err := q.Bind(ctx, exec, rsvpGuildConfigObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from rsvp_guild_configs")
+	}
+
+	return rsvpGuildConfigObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *RSVPGuildConfig) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RSVPGuildConfig) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no rsvp_guild_configs provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(rsvpGuildConfigColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	rsvpGuildConfigInsertCacheMut.RLock()
+	cache, cached := rsvpGuildConfigInsertCache[key]
+	rsvpGuildConfigInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			rsvpGuildConfigAllColumns,
+			rsvpGuildConfigColumnsWithDefault,
+			rsvpGuildConfigColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(rsvpGuildConfigType, rsvpGuildConfigMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(rsvpGuildConfigType, rsvpGuildConfigMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"rsvp_guild_configs\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"rsvp_guild_configs\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into rsvp_guild_configs")
+	}
+
+	if !cached {
+		rsvpGuildConfigInsertCacheMut.Lock()
+		rsvpGuildConfigInsertCache[key] = cache
+		rsvpGuildConfigInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single RSVPGuildConfig record using the global executor.
+// See Update for more documentation.
+func (o *RSVPGuildConfig) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the RSVPGuildConfig.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RSVPGuildConfig) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	rsvpGuildConfigUpdateCacheMut.RLock()
+	cache, cached := rsvpGuildConfigUpdateCache[key]
+	rsvpGuildConfigUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			rsvpGuildConfigAllColumns,
+			rsvpGuildConfigPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update rsvp_guild_configs, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"rsvp_guild_configs\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, rsvpGuildConfigPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(rsvpGuildConfigType, rsvpGuildConfigMapping, append(wl, rsvpGuildConfigPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update rsvp_guild_configs row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for rsvp_guild_configs")
+	}
+
+	if !cached {
+		rsvpGuildConfigUpdateCacheMut.Lock()
+		rsvpGuildConfigUpdateCache[key] = cache
+		rsvpGuildConfigUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q rsvpGuildConfigQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q rsvpGuildConfigQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for rsvp_guild_configs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for rsvp_guild_configs")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o RSVPGuildConfigSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RSVPGuildConfigSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rsvpGuildConfigPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"rsvp_guild_configs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, rsvpGuildConfigPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in rsvpGuildConfig slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all rsvpGuildConfig")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *RSVPGuildConfig) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RSVPGuildConfig) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no rsvp_guild_configs provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rsvpGuildConfigColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	rsvpGuildConfigUpsertCacheMut.RLock()
+	cache, cached := rsvpGuildConfigUpsertCache[key]
+	rsvpGuildConfigUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			rsvpGuildConfigAllColumns,
+			rsvpGuildConfigColumnsWithDefault,
+			rsvpGuildConfigColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			rsvpGuildConfigAllColumns,
+			rsvpGuildConfigPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert rsvp_guild_configs, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(rsvpGuildConfigAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(rsvpGuildConfigPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert rsvp_guild_configs, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(rsvpGuildConfigPrimaryKeyColumns))
+			copy(conflict, rsvpGuildConfigPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"rsvp_guild_configs\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(rsvpGuildConfigType, rsvpGuildConfigMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(rsvpGuildConfigType, rsvpGuildConfigMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert rsvp_guild_configs")
+	}
+
+	if !cached {
+		rsvpGuildConfigUpsertCacheMut.Lock()
+		rsvpGuildConfigUpsertCache[key] = cache
+		rsvpGuildConfigUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single RSVPGuildConfig record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *RSVPGuildConfig) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single RSVPGuildConfig record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RSVPGuildConfig) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no RSVPGuildConfig provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), rsvpGuildConfigPrimaryKeyMapping)
+	sql := "DELETE FROM \"rsvp_guild_configs\" WHERE \"guild_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from rsvp_guild_configs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for rsvp_guild_configs")
+	}
+
+	return rowsAff, nil
+}
+
+func (q rsvpGuildConfigQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q rsvpGuildConfigQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no rsvpGuildConfigQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rsvp_guild_configs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rsvp_guild_configs")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o RSVPGuildConfigSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RSVPGuildConfigSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rsvpGuildConfigPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"rsvp_guild_configs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rsvpGuildConfigPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rsvpGuildConfig slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rsvp_guild_configs")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *RSVPGuildConfig) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no RSVPGuildConfig provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RSVPGuildConfig) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRSVPGuildConfig(ctx, exec, o.GuildID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RSVPGuildConfigSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty RSVPGuildConfigSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RSVPGuildConfigSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RSVPGuildConfigSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rsvpGuildConfigPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"rsvp_guild_configs\".* FROM \"rsvp_guild_configs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rsvpGuildConfigPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RSVPGuildConfigSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RSVPGuildConfigExistsG checks if the RSVPGuildConfig row exists.
+func RSVPGuildConfigExistsG(ctx context.Context, guildID int64) (bool, error) {
+	return RSVPGuildConfigExists(ctx, boil.GetContextDB(), guildID)
+}
+
+// RSVPGuildConfigExists checks if the RSVPGuildConfig row exists.
+func RSVPGuildConfigExists(ctx context.Context, exec boil.ContextExecutor, guildID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"rsvp_guild_configs\" where \"guild_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, guildID)
+	}
+	row := exec.QueryRowContext(ctx, sql, guildID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if rsvp_guild_configs exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RSVPGuildConfig row exists.
+func (o *RSVPGuildConfig) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RSVPGuildConfigExists(ctx, exec, o.GuildID)
+}
@@ -29,6 +29,7 @@ type RSVPParticipant struct {
 	JoinState               int16     `boil:"join_state" json:"join_state" toml:"join_state" yaml:"join_state"`
 	ReminderEnabled         bool      `boil:"reminder_enabled" json:"reminder_enabled" toml:"reminder_enabled" yaml:"reminder_enabled"`
 	MarkedAsParticipatingAt time.Time `boil:"marked_as_participating_at" json:"marked_as_participating_at" toml:"marked_as_participating_at" yaml:"marked_as_participating_at"`
+	Slot                    string    `boil:"slot" json:"slot" toml:"slot" yaml:"slot"`
 
 	R *rsvpParticipantR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L rsvpParticipantL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -41,6 +42,7 @@ var RSVPParticipantColumns = struct {
 	JoinState               string
 	ReminderEnabled         string
 	MarkedAsParticipatingAt string
+	Slot                    string
 }{
 	UserID:                  "user_id",
 	RSVPSessionsMessageID:   "rsvp_sessions_message_id",
@@ -48,6 +50,7 @@ var RSVPParticipantColumns = struct {
 	JoinState:               "join_state",
 	ReminderEnabled:         "reminder_enabled",
 	MarkedAsParticipatingAt: "marked_as_participating_at",
+	Slot:                    "slot",
 }
 
 var RSVPParticipantTableColumns = struct {
@@ -57,6 +60,7 @@ var RSVPParticipantTableColumns = struct {
 	JoinState               string
 	ReminderEnabled         string
 	MarkedAsParticipatingAt string
+	Slot                    string
 }{
 	UserID:                  "rsvp_participants.user_id",
 	RSVPSessionsMessageID:   "rsvp_participants.rsvp_sessions_message_id",
@@ -64,33 +68,11 @@ var RSVPParticipantTableColumns = struct {
 	JoinState:               "rsvp_participants.join_state",
 	ReminderEnabled:         "rsvp_participants.reminder_enabled",
 	MarkedAsParticipatingAt: "rsvp_participants.marked_as_participating_at",
+	Slot:                    "rsvp_participants.slot",
 }
 
 // Generated where
 
-type whereHelperint64 struct{ field string }
-
-func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelperint16 struct{ field string }
 
 func (w whereHelperint16) EQ(x int16) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
@@ -114,36 +96,6 @@ func (w whereHelperint16) NIN(slice []int16) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-type whereHelperbool struct{ field string }
-
-func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var RSVPParticipantWhere = struct {
 	UserID                  whereHelperint64
 	RSVPSessionsMessageID   whereHelperint64
@@ -151,6 +103,7 @@ var RSVPParticipantWhere = struct {
 	JoinState               whereHelperint16
 	ReminderEnabled         whereHelperbool
 	MarkedAsParticipatingAt whereHelpertime_Time
+	Slot                    whereHelperstring
 }{
 	UserID:                  whereHelperint64{field: "\"rsvp_participants\".\"user_id\""},
 	RSVPSessionsMessageID:   whereHelperint64{field: "\"rsvp_participants\".\"rsvp_sessions_message_id\""},
@@ -158,6 +111,7 @@ var RSVPParticipantWhere = struct {
 	JoinState:               whereHelperint16{field: "\"rsvp_participants\".\"join_state\""},
 	ReminderEnabled:         whereHelperbool{field: "\"rsvp_participants\".\"reminder_enabled\""},
 	MarkedAsParticipatingAt: whereHelpertime_Time{field: "\"rsvp_participants\".\"marked_as_participating_at\""},
+	Slot:                    whereHelperstring{field: "\"rsvp_participants\".\"slot\""},
 }
 
 // RSVPParticipantRels is where relationship names are stored.
@@ -188,9 +142,9 @@ func (r *rsvpParticipantR) GetRSVPSessionsMessage() *RSVPSession {
 type rsvpParticipantL struct{}
 
 var (
-	rsvpParticipantAllColumns            = []string{"user_id", "rsvp_sessions_message_id", "guild_id", "join_state", "reminder_enabled", "marked_as_participating_at"}
+	rsvpParticipantAllColumns            = []string{"user_id", "rsvp_sessions_message_id", "guild_id", "join_state", "reminder_enabled", "marked_as_participating_at", "slot"}
 	rsvpParticipantColumnsWithoutDefault = []string{"user_id", "rsvp_sessions_message_id", "guild_id", "join_state", "reminder_enabled", "marked_as_participating_at"}
-	rsvpParticipantColumnsWithDefault    = []string{}
+	rsvpParticipantColumnsWithDefault    = []string{"slot"}
 	rsvpParticipantPrimaryKeyColumns     = []string{"rsvp_sessions_message_id", "user_id"}
 	rsvpParticipantGeneratedColumns      = []string{}
 )
@@ -35,6 +35,10 @@ type RSVPSession struct {
 	MaxParticipants int       `boil:"max_participants" json:"max_participants" toml:"max_participants" yaml:"max_participants"`
 	SendReminders   bool      `boil:"send_reminders" json:"send_reminders" toml:"send_reminders" yaml:"send_reminders"`
 	SentReminders   bool      `boil:"sent_reminders" json:"sent_reminders" toml:"sent_reminders" yaml:"sent_reminders"`
+	Recurrence      string    `boil:"recurrence" json:"recurrence" toml:"recurrence" yaml:"recurrence"`
+	Timezone        string    `boil:"timezone" json:"timezone" toml:"timezone" yaml:"timezone"`
+	Slots           string    `boil:"slots" json:"slots" toml:"slots" yaml:"slots"`
+	NativeEventID   int64     `boil:"native_event_id" json:"native_event_id" toml:"native_event_id" yaml:"native_event_id"`
 
 	R *rsvpSessionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L rsvpSessionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -53,6 +57,10 @@ var RSVPSessionColumns = struct {
 	MaxParticipants string
 	SendReminders   string
 	SentReminders   string
+	Recurrence      string
+	Timezone        string
+	Slots           string
+	NativeEventID   string
 }{
 	MessageID:       "message_id",
 	GuildID:         "guild_id",
@@ -66,6 +74,10 @@ var RSVPSessionColumns = struct {
 	MaxParticipants: "max_participants",
 	SendReminders:   "send_reminders",
 	SentReminders:   "sent_reminders",
+	Recurrence:      "recurrence",
+	Timezone:        "timezone",
+	Slots:           "slots",
+	NativeEventID:   "native_event_id",
 }
 
 var RSVPSessionTableColumns = struct {
@@ -81,6 +93,10 @@ var RSVPSessionTableColumns = struct {
 	MaxParticipants string
 	SendReminders   string
 	SentReminders   string
+	Recurrence      string
+	Timezone        string
+	Slots           string
+	NativeEventID   string
 }{
 	MessageID:       "rsvp_sessions.message_id",
 	GuildID:         "rsvp_sessions.guild_id",
@@ -94,37 +110,14 @@ var RSVPSessionTableColumns = struct {
 	MaxParticipants: "rsvp_sessions.max_participants",
 	SendReminders:   "rsvp_sessions.send_reminders",
 	SentReminders:   "rsvp_sessions.sent_reminders",
+	Recurrence:      "rsvp_sessions.recurrence",
+	Timezone:        "rsvp_sessions.timezone",
+	Slots:           "rsvp_sessions.slots",
+	NativeEventID:   "rsvp_sessions.native_event_id",
 }
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelperint struct{ field string }
 
 func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
@@ -161,6 +154,10 @@ var RSVPSessionWhere = struct {
 	MaxParticipants whereHelperint
 	SendReminders   whereHelperbool
 	SentReminders   whereHelperbool
+	Recurrence      whereHelperstring
+	Timezone        whereHelperstring
+	Slots           whereHelperstring
+	NativeEventID   whereHelperint64
 }{
 	MessageID:       whereHelperint64{field: "\"rsvp_sessions\".\"message_id\""},
 	GuildID:         whereHelperint64{field: "\"rsvp_sessions\".\"guild_id\""},
@@ -174,6 +171,10 @@ var RSVPSessionWhere = struct {
 	MaxParticipants: whereHelperint{field: "\"rsvp_sessions\".\"max_participants\""},
 	SendReminders:   whereHelperbool{field: "\"rsvp_sessions\".\"send_reminders\""},
 	SentReminders:   whereHelperbool{field: "\"rsvp_sessions\".\"sent_reminders\""},
+	Recurrence:      whereHelperstring{field: "\"rsvp_sessions\".\"recurrence\""},
+	Timezone:        whereHelperstring{field: "\"rsvp_sessions\".\"timezone\""},
+	Slots:           whereHelperstring{field: "\"rsvp_sessions\".\"slots\""},
+	NativeEventID:   whereHelperint64{field: "\"rsvp_sessions\".\"native_event_id\""},
 }
 
 // RSVPSessionRels is where relationship names are stored.
@@ -204,9 +205,9 @@ func (r *rsvpSessionR) GetRSVPSessionsMessageRSVPParticipants() RSVPParticipantS
 type rsvpSessionL struct{}
 
 var (
-	rsvpSessionAllColumns            = []string{"message_id", "guild_id", "channel_id", "local_id", "author_id", "created_at", "starts_at", "title", "description", "max_participants", "send_reminders", "sent_reminders"}
+	rsvpSessionAllColumns            = []string{"message_id", "guild_id", "channel_id", "local_id", "author_id", "created_at", "starts_at", "title", "description", "max_participants", "send_reminders", "sent_reminders", "recurrence", "timezone", "slots", "native_event_id"}
 	rsvpSessionColumnsWithoutDefault = []string{"message_id", "guild_id", "channel_id", "local_id", "author_id", "created_at", "starts_at", "title", "description", "max_participants", "send_reminders", "sent_reminders"}
-	rsvpSessionColumnsWithDefault    = []string{}
+	rsvpSessionColumnsWithDefault    = []string{"recurrence", "timezone", "slots", "native_event_id"}
 	rsvpSessionPrimaryKeyColumns     = []string{"message_id"}
 	rsvpSessionGeneratedColumns      = []string{}
 )
@@ -0,0 +1,170 @@
+package rsvp
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/rsvp/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	// rsvp events have no end time, they're assumed to last this long where one is needed,
+	// discord requires one for external events and calendars show events without one as zero length
+	EventDuration = time.Hour * 2
+
+	// max number of interested users looked at per update
+	MaxNativeEventImport = 1000
+)
+
+// nativeEventsEnabled returns true if the server mirrors its rsvp events as discord scheduled events
+func nativeEventsEnabled(guildID int64) bool {
+	conf, err := models.FindRSVPGuildConfigG(context.Background(), guildID)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			logger.WithError(err).WithField("guild", guildID).Error("failed retrieving rsvp config")
+		}
+		return false
+	}
+
+	return conf.SyncNativeEvents
+}
+
+func nativeEventParams(m *models.RSVPSession) *discordgo.GuildScheduledEventParams {
+	startsAt := m.StartsAt
+	endsAt := m.StartsAt.Add(EventDuration)
+
+	description := fmt.Sprintf("Sign up here: https://discord.com/channels/%d/%d/%d", m.GuildID, m.ChannelID, m.MessageID)
+	if m.Description != "" {
+		description = common.CutStringShort(m.Description, 800) + "\n\n" + description
+	}
+
+	return &discordgo.GuildScheduledEventParams{
+		Name:               common.CutStringShort(m.Title, 100),
+		Description:        description,
+		ScheduledStartTime: &startsAt,
+		ScheduledEndTime:   &endsAt,
+		PrivacyLevel:       discordgo.GuildScheduledEventPrivacyLevelGuildOnly,
+		EntityType:         discordgo.GuildScheduledEventEntityTypeExternal,
+		EntityMetadata: &discordgo.GuildScheduledEventEntityMetadata{
+			Location: fmt.Sprintf("https://discord.com/channels/%d/%d", m.GuildID, m.ChannelID),
+		},
+	}
+}
+
+// syncNativeEvent creates or updates the discord scheduled event mirroring the session, if enabled in the server
+func syncNativeEvent(m *models.RSVPSession) error {
+	if time.Until(m.StartsAt) < time.Minute || !nativeEventsEnabled(m.GuildID) {
+		return nil
+	}
+
+	params := nativeEventParams(m)
+	if m.NativeEventID != 0 {
+		_, err := common.BotSession.GuildScheduledEventEdit(m.GuildID, m.NativeEventID, params)
+		if err == nil || !common.IsDiscordErr(err, discordgo.ErrCodeUnknownGuildScheduledEvent) {
+			return err
+		}
+
+		// it was deleted on discord's end, create a new one
+	}
+
+	evt, err := common.BotSession.GuildScheduledEventCreate(m.GuildID, params)
+	if err != nil {
+		return err
+	}
+
+	m.NativeEventID = evt.ID
+	_, err = m.UpdateG(context.Background(), boil.Whitelist("native_event_id"))
+	return err
+}
+
+// deleteNativeEvent deletes the discord scheduled event mirroring the session, if there is one
+func deleteNativeEvent(m *models.RSVPSession) error {
+	if m.NativeEventID == 0 {
+		return nil
+	}
+
+	err := common.BotSession.GuildScheduledEventDelete(m.GuildID, m.NativeEventID)
+	if err != nil && !common.IsDiscordErr(err, discordgo.ErrCodeUnknownGuildScheduledEvent) {
+		return err
+	}
+
+	return nil
+}
+
+// startNativeEvent marks the discord scheduled event as active, discord ends it on its own once the end time has passed
+func startNativeEvent(m *models.RSVPSession) error {
+	if m.NativeEventID == 0 {
+		return nil
+	}
+
+	_, err := common.BotSession.GuildScheduledEventEdit(m.GuildID, m.NativeEventID, &discordgo.GuildScheduledEventParams{
+		Status: discordgo.GuildScheduledEventStatusActive,
+	})
+	if err != nil && !common.IsDiscordErr(err, discordgo.ErrCodeUnknownGuildScheduledEvent) {
+		return err
+	}
+
+	return nil
+}
+
+// importNativeEventUsers adds the users interested in the discord scheduled event as participants,
+// users that already responded to the rsvp event are left alone. m needs to have its participants loaded.
+func importNativeEventUsers(m *models.RSVPSession) (changed bool, err error) {
+	if m.NativeEventID == 0 {
+		return false, nil
+	}
+
+	existing := make(map[int64]bool)
+	for _, v := range m.R.RSVPSessionsMessageRSVPParticipants {
+		existing[v.UserID] = true
+	}
+
+	var after int64
+	for i := 0; i < MaxNativeEventImport/100; i++ {
+		users, err := common.BotSession.GuildScheduledEventUsers(m.GuildID, m.NativeEventID, 100, after)
+		if err != nil {
+			if common.IsDiscordErr(err, discordgo.ErrCodeUnknownGuildScheduledEvent) {
+				return changed, nil
+			}
+			return changed, err
+		}
+
+		for _, v := range users {
+			if v.User == nil {
+				continue
+			}
+
+			after = v.User.ID
+			if existing[v.User.ID] || v.User.Bot {
+				continue
+			}
+
+			participant := &models.RSVPParticipant{
+				RSVPSessionsMessageID:   m.MessageID,
+				UserID:                  v.User.ID,
+				GuildID:                 m.GuildID,
+				JoinState:               int16(ParticipantStateJoining),
+				MarkedAsParticipatingAt: time.Now(),
+			}
+
+			err = m.AddRSVPSessionsMessageRSVPParticipantsG(context.Background(), true, participant)
+			if err != nil {
+				return changed, err
+			}
+
+			existing[v.User.ID] = true
+			changed = true
+		}
+
+		if len(users) < 100 {
+			break
+		}
+	}
+
+	return changed, nil
+}
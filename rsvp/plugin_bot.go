@@ -21,6 +21,7 @@ import (
 	"github.com/ThatBathroom/yagpdb/v2/lib/dstate"
 	"github.com/ThatBathroom/yagpdb/v2/rsvp/models"
 	"github.com/ThatBathroom/yagpdb/v2/timezonecompanion"
+	"github.com/ThatBathroom/yagpdb/v2/web"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
@@ -113,6 +114,8 @@ func (p *Plugin) AddCommands() {
 			{Name: "title", Help: "Change the title of the event", Type: dcmd.String},
 			{Name: "time", Help: "Change the start time of the event", Type: dcmd.String},
 			{Name: "max", Help: "Change max participants", Type: dcmd.Int},
+			{Name: "repeat", Help: "Change how often the event repeats (none, daily, weekly, monthly or a cron expression)", Type: dcmd.String},
+			{Name: "slots", Help: "Change the slots participants can sign up as (none, or e.g tank:2, healer:2, dps:6)", Type: dcmd.String},
 		},
 		RunFunc: func(parsed *dcmd.Data) (interface{}, error) {
 			m, err := models.RSVPSessions(
@@ -139,7 +142,7 @@ func (p *Plugin) AddCommands() {
 
 			timeChanged := false
 			if parsed.Switch("time").Value != nil {
-				registeredTimezone := timezonecompanion.GetUserTimezone(parsed.Author.ID)
+				registeredTimezone := timezonecompanion.GetTimezone(parsed.Author.ID, m.GuildID, m.ChannelID)
 				if registeredTimezone == nil || UTCRegex.MatchString(parsed.Switch("time").Str()) {
 					registeredTimezone = time.UTC
 				}
@@ -150,9 +153,28 @@ func (p *Plugin) AddCommands() {
 				}
 
 				m.StartsAt = t.Time
+				m.Timezone = registeredTimezone.String()
 				timeChanged = true
 			}
 
+			if parsed.Switch("repeat").Value != nil {
+				rule, err := ParseRecurrence(parsed.Switch("repeat").Str())
+				if err != nil {
+					return err.Error(), nil
+				}
+
+				m.Recurrence = rule
+			}
+
+			if parsed.Switch("slots").Value != nil {
+				slots, err := ParseSlots(parsed.Switch("slots").Str())
+				if err != nil {
+					return err.Error(), nil
+				}
+
+				m.Slots = FormatSlots(slots)
+			}
+
 			_, err = m.UpdateG(parsed.Context(), boil.Infer())
 			if err != nil {
 				return nil, err
@@ -172,7 +194,20 @@ func (p *Plugin) AddCommands() {
 
 			UpdateEventEmbed(m)
 
-			return fmt.Sprintf("Updated #%d to '%s' - with max %d participants, starting at: %s", m.LocalID, m.Title, m.MaxParticipants, m.StartsAt.Format("02 Jan 2006 15:04 MST")), nil
+			err = syncNativeEvent(m)
+			if err != nil {
+				logger.WithError(err).WithField("guild", m.GuildID).Error("failed updating native event")
+			}
+
+			resp := fmt.Sprintf("Updated #%d to '%s' - with max %d participants, starting at: %s", m.LocalID, m.Title, m.MaxParticipants, m.StartsAt.Format("02 Jan 2006 15:04 MST"))
+			if m.Recurrence != "" {
+				resp += ", repeating: " + HumanizeRecurrence(m.Recurrence)
+			}
+			if m.Slots != "" {
+				resp += ", slots: " + strings.ReplaceAll(m.Slots, ",", ", ")
+			}
+
+			return resp, nil
 		},
 	}
 
@@ -198,8 +233,13 @@ func (p *Plugin) AddCommands() {
 				timeUntil := time.Until(v.StartsAt)
 				humanized := common.HumanizeDuration(common.DurationPrecisionMinutes, timeUntil)
 
-				output.WriteString(fmt.Sprintf("#%2d: **%s** in `%s` https://ptb.discordapp.com/channels/%d/%d/%d\n",
-					v.LocalID, v.Title, humanized, parsed.GuildData.GS.ID, v.ChannelID, v.MessageID))
+				repeats := ""
+				if v.Recurrence != "" {
+					repeats = " (repeats: " + HumanizeRecurrence(v.Recurrence) + ")"
+				}
+
+				output.WriteString(fmt.Sprintf("#%2d: **%s** in `%s`%s https://ptb.discordapp.com/channels/%d/%d/%d\n",
+					v.LocalID, v.Title, humanized, repeats, parsed.GuildData.GS.ID, v.ChannelID, v.MessageID))
 			}
 
 			return output.String(), nil
@@ -237,6 +277,11 @@ func (p *Plugin) AddCommands() {
 				return nil, err
 			}
 
+			err = deleteNativeEvent(m)
+			if err != nil {
+				logger.WithError(err).WithField("guild", m.GuildID).Error("failed deleting native event")
+			}
+
 			return "Deleted `" + m.Title + "`", nil
 		},
 	}
@@ -264,11 +309,183 @@ func (p *Plugin) AddCommands() {
 		},
 	}
 
+	cmdCalendar := &commands.YAGCommand{
+		CmdCategory: catEvents,
+		Name:        "Calendar",
+		Aliases:     []string{"ical", "ics"},
+		Description: "Gives you a link to an iCalendar feed of this server's upcoming events, which you can subscribe to in most calendar apps",
+		Plugin:      p,
+		ArgSwitches: []*dcmd.ArgDef{
+			{Name: "reset", Help: "Generate a new link, the old one will stop working"},
+		},
+		RunFunc: func(parsed *dcmd.Data) (interface{}, error) {
+			reset := parsed.Switch("reset").Bool()
+			if reset {
+				hasPerms, err := bot.AdminOrPermMS(parsed.GuildData.GS.ID, parsed.ChannelID, parsed.GuildData.MS, discordgo.PermissionManageGuild)
+				if err != nil {
+					return nil, err
+				}
+
+				if !hasPerms {
+					return "You need the Manage Server permission to reset the calendar link", nil
+				}
+			}
+
+			conf, err := models.FindRSVPGuildConfigG(parsed.Context(), parsed.GuildData.GS.ID)
+			if err != nil && err != sql.ErrNoRows {
+				return nil, err
+			}
+
+			if conf == nil {
+				conf = &models.RSVPGuildConfig{GuildID: parsed.GuildData.GS.ID}
+			}
+
+			if conf.CalendarToken == "" || reset {
+				conf.CalendarToken = web.RandBase64(24)
+				err = conf.UpsertG(parsed.Context(), true, []string{"guild_id"}, boil.Whitelist("calendar_token"), boil.Infer())
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			return "Subscribe to this server's events in your calendar app using this link:\n<" + CalendarURL(parsed.GuildData.GS.ID, conf.CalendarToken) + ">", nil
+		},
+	}
+
+	cmdAttendance := &commands.YAGCommand{
+		CmdCategory: catEvents,
+		Name:        "Attendance",
+		Description: "Mark who attended an event that has started, only usable by the organiser of the event or members with the Manage Server permission",
+		Plugin:      p,
+		Arguments: []*dcmd.ArgDef{
+			{Name: "ID", Type: dcmd.Int},
+		},
+		RequiredArgs: 1,
+		RunFunc: func(parsed *dcmd.Data) (interface{}, error) {
+			records, err := models.RSVPAttendances(
+				models.RSVPAttendanceWhere.GuildID.EQ(parsed.GuildData.GS.ID),
+				models.RSVPAttendanceWhere.SessionLocalID.EQ(parsed.Args[0].Int64()),
+				qm.OrderBy("id asc"),
+			).AllG(parsed.Context())
+			if err != nil {
+				return nil, err
+			}
+
+			if len(records) < 1 {
+				return "No attendance recorded for that event, attendance is recorded once an event starts", nil
+			}
+
+			ok, err := canMarkAttendance(parsed.GuildData.GS.ID, parsed.ChannelID, parsed.GuildData.MS, records[0].SessionAuthorID)
+			if err != nil {
+				return nil, err
+			}
+
+			if !ok {
+				return "Only the organiser of the event or members with the Manage Server permission can mark attendance", nil
+			}
+
+			return attendanceMarkingMessage(parsed.Args[0].Int64(), records, 0), nil
+		},
+	}
+
+	cmdHistory := &commands.YAGCommand{
+		CmdCategory: catEvents,
+		Name:        "History",
+		Aliases:     []string{"attended"},
+		Description: "Shows the event attendance history of yourself or the specified member",
+		Plugin:      p,
+		Arguments: []*dcmd.ArgDef{
+			{Name: "User", Type: dcmd.User},
+		},
+		RunFunc: func(parsed *dcmd.Data) (interface{}, error) {
+			user := parsed.Author
+			if parsed.Args[0].Value != nil {
+				user = parsed.Args[0].Value.(*discordgo.User)
+			}
+
+			return attendanceHistory(parsed.GuildData.GS.ID, user)
+		},
+	}
+
+	cmdNativeEvents := &commands.YAGCommand{
+		CmdCategory:         catEvents,
+		Name:                "NativeEvents",
+		Aliases:             []string{"discordevents"},
+		Description:         "Mirrors events as Discord scheduled events, members marking themselves as interested in those are added as participants",
+		RequireDiscordPerms: []int64{discordgo.PermissionManageGuild},
+		Plugin:              p,
+		ArgSwitches: []*dcmd.ArgDef{
+			{Name: "enable", Help: "Start mirroring events"},
+			{Name: "disable", Help: "Stop mirroring events and delete the mirrored Discord events"},
+		},
+		RunFunc: func(parsed *dcmd.Data) (interface{}, error) {
+			conf, err := models.FindRSVPGuildConfigG(parsed.Context(), parsed.GuildData.GS.ID)
+			if err != nil && err != sql.ErrNoRows {
+				return nil, err
+			}
+
+			if conf == nil {
+				conf = &models.RSVPGuildConfig{GuildID: parsed.GuildData.GS.ID}
+			}
+
+			enable := parsed.Switch("enable").Bool()
+			disable := parsed.Switch("disable").Bool()
+			if !enable && !disable {
+				if conf.SyncNativeEvents {
+					return "Events are mirrored as Discord scheduled events, use `-disable` to stop", nil
+				}
+
+				return "Events are not mirrored as Discord scheduled events, use `-enable` to start", nil
+			}
+
+			conf.SyncNativeEvents = enable
+			err = conf.UpsertG(parsed.Context(), true, []string{"guild_id"}, boil.Whitelist("sync_native_events"), boil.Infer())
+			if err != nil {
+				return nil, err
+			}
+
+			sessions, err := models.RSVPSessions(models.RSVPSessionWhere.GuildID.EQ(parsed.GuildData.GS.ID)).AllG(parsed.Context())
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range sessions {
+				if enable {
+					err = syncNativeEvent(v)
+				} else {
+					err = deleteNativeEvent(v)
+					if err == nil {
+						v.NativeEventID = 0
+						_, err = v.UpdateG(parsed.Context(), boil.Whitelist("native_event_id"))
+					}
+				}
+
+				if err != nil {
+					if code, _ := common.DiscordError(err); code == discordgo.ErrCodeMissingPermissions || code == discordgo.ErrCodeMissingAccess {
+						return "The bot needs the Manage Events permission to mirror events", nil
+					}
+
+					return nil, err
+				}
+			}
+
+			if enable {
+				return fmt.Sprintf("Now mirroring events as Discord scheduled events, synced %d upcoming events", len(sessions)), nil
+			}
+
+			return "No longer mirroring events as Discord scheduled events", nil
+		},
+	}
+
 	container.AddCommand(cmdCreateEvent, cmdCreateEvent.GetTrigger())
 	container.AddCommand(cmdEdit, cmdEdit.GetTrigger())
 	container.AddCommand(cmdList, cmdList.GetTrigger())
 	container.AddCommand(cmdDel, cmdDel.GetTrigger())
 	container.AddCommand(cmdStopSetup, cmdStopSetup.GetTrigger())
+	container.AddCommand(cmdCalendar, cmdCalendar.GetTrigger())
+	container.AddCommand(cmdAttendance, cmdAttendance.GetTrigger())
+	container.AddCommand(cmdHistory, cmdHistory.GetTrigger())
+	container.AddCommand(cmdNativeEvents, cmdNativeEvents.GetTrigger())
 	container.Description = "Manage events"
 	commands.RegisterSlashCommandsContainer(container, true, func(gs *dstate.GuildSet) ([]int64, error) {
 		return nil, nil
@@ -376,6 +593,23 @@ func UpdateEventEmbed(m *models.RSVPSession) error {
 		Value: "React to mark you as a participant, undecided, or not joining",
 	})
 
+	if m.Recurrence != "" {
+		repeats := HumanizeRecurrence(m.Recurrence)
+		if next := NextOccurrence(m.Recurrence, m.StartsAt, m.StartsAt, sessionLocation(m)); !next.IsZero() {
+			repeats += fmt.Sprintf(", next time: <t:%d:F>", next.Unix())
+		}
+
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "Repeats",
+			Value: repeats,
+		})
+	}
+
+	slots := sessionSlots(m)
+	if len(slots) > 0 {
+		embed.Fields = append(embed.Fields, slotsField(slots, participants))
+	}
+
 	participantsEmbed := &discordgo.MessageEmbedField{
 		Name:   "Participants",
 		Inline: false,
@@ -402,12 +636,17 @@ func UpdateEventEmbed(m *models.RSVPSession) error {
 		}
 
 		user := findUser(fetchedMembers, v.UserID)
+		mention := user.Mention()
+		if v.Slot != "" && len(slots) > 0 {
+			mention += " - " + v.Slot
+		}
+
 		if (addedParticipants >= m.MaxParticipants && m.MaxParticipants > 0) || v.JoinState == int16(ParticipantStateWaitlist) {
 			// On the waiting list
 			if !waitingListHitMax {
 
 				// we hit the max limit so add them to the waiting list instead
-				toAdd := user.Mention() + "\n"
+				toAdd := mention + "\n"
 				if utf8.RuneCountInString(toAdd)+utf8.RuneCountInString(waitingListField.Value) >= 990 {
 					waitingListHitMax = true
 				} else {
@@ -421,7 +660,7 @@ func UpdateEventEmbed(m *models.RSVPSession) error {
 		}
 
 		if !participantsHitMax {
-			toAdd := user.Mention() + "\n"
+			toAdd := mention + "\n"
 			if utf8.RuneCountInString(toAdd)+utf8.RuneCountInString(participantsEmbed.Value) > 990 {
 				participantsHitMax = true
 			} else {
@@ -476,6 +715,12 @@ func UpdateEventEmbed(m *models.RSVPSession) error {
 	if m.StartsAt.Before(time.Now()) {
 		// Remove the buttons if event has started
 		editMessage.Components = []discordgo.TopLevelComponent{}
+	} else if len(slots) > 0 {
+		// refresh the slot counts in the select menu
+		editMessage.Components = append(createInteractionButtons(), createSlotSelectMenu(slots, participants))
+	} else {
+		// rebuild the components so the slot menu is removed if the slots were removed
+		editMessage.Components = createInteractionButtons()
 	}
 
 	_, err := common.BotSession.ChannelMessageEditComplex(&editMessage)
@@ -560,11 +805,20 @@ func (p *Plugin) handleScheduledUpdate(evt *eventModels.ScheduledEvent, data int
 		return false, err
 	}
 
+	// updates get more frequent close to the start, don't hammer the api with those
+	if time.Until(m.StartsAt) > time.Minute*2 {
+		_, err = importNativeEventUsers(m)
+		if err != nil {
+			logger.WithError(err).WithField("guild", m.GuildID).Error("failed importing users from native event")
+		}
+	}
+
 	err = UpdateEventEmbed(m)
 	if err != nil {
 		code, _ := common.DiscordError(err)
 		if code == discordgo.ErrCodeUnknownMessage || code == discordgo.ErrCodeUnknownChannel {
 			m.DeleteG(context.Background())
+			deleteNativeEvent(m)
 			return false, nil
 		}
 
@@ -601,7 +855,24 @@ func (p *Plugin) startEvent(m *models.RSVPSession) error {
 
 	p.sendReminders(m, "Event starting now!", "The event you signed up for: **"+m.Title+"** is starting now!")
 
-	_, err := m.DeleteG(context.Background())
+	err := recordAttendance(m)
+	if err != nil {
+		logger.WithError(err).WithField("guild", m.GuildID).Error("failed recording attendance")
+	}
+
+	err = startNativeEvent(m)
+	if err != nil {
+		logger.WithError(err).WithField("guild", m.GuildID).Error("failed starting native event")
+	}
+
+	if m.Recurrence != "" {
+		_, err := spawnNextOccurrence(m)
+		if err != nil {
+			logger.WithError(err).WithField("guild", m.GuildID).Error("failed setting up the next occurrence of a recurring event")
+		}
+	}
+
+	_, err = m.DeleteG(context.Background())
 	return err
 }
 
@@ -646,6 +917,18 @@ func (p *Plugin) handleInteractionCreate(evt *eventsystem.EventData) {
 	}
 
 	eventResponse := ic.MessageComponentData().CustomID
+	if eventResponse == EventSlot {
+		p.handleSlotInteraction(ic)
+		return
+	} else if strings.HasPrefix(eventResponse, EventAttendancePagePrefix) {
+		// checked first as it shares the attendance prefix
+		p.handleAttendancePageInteraction(ic)
+		return
+	} else if strings.HasPrefix(eventResponse, EventAttendancePrefix) {
+		p.handleAttendanceInteraction(ic)
+		return
+	}
+
 	joining := eventResponse == EventAccepted
 	notJoining := eventResponse == EventRejected
 	maybe := eventResponse == EventUndecided
@@ -727,6 +1010,11 @@ func (p *Plugin) handleInteractionCreate(evt *eventsystem.EventData) {
 		logger.WithError(err).WithField("guild", ic.GuildID).Error("failed updating rsvp participant")
 	}
 
+	queueEmbedUpdate(m)
+}
+
+// queueEmbedUpdate updates the embed of the session, while making sure it's not updated too often
+func queueEmbedUpdate(m *models.RSVPSession) {
 	updatingSessiosMU.Lock()
 	for _, v := range updatingSessionEmbeds {
 		if v.ID == m.MessageID {
@@ -744,7 +1032,6 @@ func (p *Plugin) handleInteractionCreate(evt *eventsystem.EventData) {
 	updatingSessionEmbeds = append(updatingSessionEmbeds, s)
 	go s.run()
 	updatingSessiosMU.Unlock()
-
 }
 
 var (
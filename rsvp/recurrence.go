@@ -0,0 +1,197 @@
+package rsvp
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/common/scheduledevents2"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/rsvp/models"
+	"github.com/robfig/cron/v3"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	RecurrenceDaily   = "daily"
+	RecurrenceWeekly  = "weekly"
+	RecurrenceMonthly = "monthly"
+
+	// Recurring events can't be repeated more often than this
+	MinRecurrenceInterval = time.Hour
+)
+
+var (
+	cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+	ErrUnknownRecurrence   = errors.New("Unknown repeat rule, use `daily`, `weekly`, `monthly` or a cron expression like `0 20 * * 5` (every friday at 20:00)")
+	ErrRecurrenceTooOften  = errors.New("Events can't repeat more often than once an hour")
+	ErrRecurrenceNeverRuns = errors.New("That repeat rule never matches any time")
+)
+
+// ParseRecurrence validates a recurrence rule and returns it in the form it's stored in,
+// an empty string means the event doesn't repeat.
+func ParseRecurrence(in string) (string, error) {
+	rule := strings.ToLower(strings.TrimSpace(in))
+	switch rule {
+	case "", "no", "none", "never", "off":
+		return "", nil
+	case RecurrenceDaily, "every day":
+		return RecurrenceDaily, nil
+	case RecurrenceWeekly, "every week":
+		return RecurrenceWeekly, nil
+	case RecurrenceMonthly, "every month":
+		return RecurrenceMonthly, nil
+	}
+
+	schedule, err := cronParser.Parse(rule)
+	if err != nil {
+		return "", ErrUnknownRecurrence
+	}
+
+	// Disallow spammy rules such as "* * * * *"
+	first := schedule.Next(time.Now())
+	if first.IsZero() {
+		return "", ErrRecurrenceNeverRuns
+	}
+
+	if schedule.Next(first).Sub(first) < MinRecurrenceInterval {
+		return "", ErrRecurrenceTooOften
+	}
+
+	return rule, nil
+}
+
+// NextOccurrence returns the first time after `after` the rule matches, daily, weekly and monthly rules
+// keep the wall clock time of `last` in loc, cron rules are evaluated in loc.
+func NextOccurrence(rule string, last, after time.Time, loc *time.Location) time.Time {
+	var months, days int
+	switch rule {
+	case "":
+		return time.Time{}
+	case RecurrenceDaily:
+		days = 1
+	case RecurrenceWeekly:
+		days = 7
+	case RecurrenceMonthly:
+		months = 1
+	default:
+		schedule, err := cronParser.Parse(rule)
+		if err != nil {
+			return time.Time{}
+		}
+
+		if spec, ok := schedule.(*cron.SpecSchedule); ok {
+			spec.Location = loc
+		}
+
+		return schedule.Next(after)
+	}
+
+	local := last.In(loc)
+	next := local
+	for i := 1; !next.After(after); i++ {
+		// always offset from the original time so that monthly rules on the 31st don't drift
+		next = local.AddDate(0, months*i, days*i)
+	}
+
+	return next
+}
+
+// HumanizeRecurrence returns a user facing description of the rule
+func HumanizeRecurrence(rule string) string {
+	switch rule {
+	case "":
+		return "Never"
+	case RecurrenceDaily:
+		return "Every day"
+	case RecurrenceWeekly:
+		return "Every week"
+	case RecurrenceMonthly:
+		return "Every month"
+	}
+
+	return "`" + rule + "`"
+}
+
+// sessionLocation returns the timezone the session was set up in, used for keeping recurring events at the same local time
+func sessionLocation(m *models.RSVPSession) *time.Location {
+	if m.Timezone == "" {
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(m.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// spawnNextOccurrence posts a fresh event for the next occurrence of the recurring session m
+func spawnNextOccurrence(m *models.RSVPSession) (*models.RSVPSession, error) {
+	next := NextOccurrence(m.Recurrence, m.StartsAt, time.Now(), sessionLocation(m))
+	if next.IsZero() {
+		return nil, nil
+	}
+
+	reservedMessage, err := common.BotSession.ChannelMessageSendComplex(m.ChannelID, &discordgo.MessageSend{
+		Embeds:     []*discordgo.MessageEmbed{{Description: "Setting up RSVP Event..."}},
+		Components: createInteractionButtons(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	localID, err := common.GenLocalIncrID(m.GuildID, "rsvp_session")
+	if err != nil {
+		return nil, err
+	}
+
+	nm := &models.RSVPSession{
+		MessageID: reservedMessage.ID,
+
+		AuthorID:  m.AuthorID,
+		ChannelID: m.ChannelID,
+		GuildID:   m.GuildID,
+		LocalID:   localID,
+
+		CreatedAt: time.Now(),
+		StartsAt:  next,
+
+		Title:           m.Title,
+		Description:     m.Description,
+		MaxParticipants: m.MaxParticipants,
+		SendReminders:   m.SendReminders,
+
+		Recurrence: m.Recurrence,
+		Timezone:   m.Timezone,
+		Slots:      m.Slots,
+	}
+
+	err = nm.InsertG(context.Background(), boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+
+	err = UpdateEventEmbed(nm)
+	if err != nil {
+		nm.DeleteG(context.Background())
+		return nil, err
+	}
+
+	err = scheduledevents2.ScheduleEvent("rsvp_update_session", nm.GuildID, NextUpdateTime(nm), nm.MessageID)
+	if err != nil {
+		nm.DeleteG(context.Background())
+		return nil, err
+	}
+
+	err = syncNativeEvent(nm)
+	if err != nil {
+		logger.WithError(err).WithField("guild", nm.GuildID).Error("failed creating native event")
+	}
+
+	return nm, nil
+}
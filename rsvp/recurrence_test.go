@@ -0,0 +1,79 @@
+package rsvp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	cases := []struct {
+		in      string
+		want    string
+		wantErr error
+	}{
+		{"", "", nil},
+		{"no", "", nil},
+		{"Daily", RecurrenceDaily, nil},
+		{"every week", RecurrenceWeekly, nil},
+		{" monthly ", RecurrenceMonthly, nil},
+		{"0 20 * * 5", "0 20 * * 5", nil},
+		{"*/5 * * * *", "", ErrRecurrenceTooOften},
+		{"sometimes", "", ErrUnknownRecurrence},
+	}
+
+	for _, c := range cases {
+		got, err := ParseRecurrence(c.in)
+		if err != c.wantErr {
+			t.Errorf("ParseRecurrence(%q): got error %v, want %v", c.in, err, c.wantErr)
+			continue
+		}
+
+		if got != c.want {
+			t.Errorf("ParseRecurrence(%q): got %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestNextOccurrence(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("timezone data not available")
+	}
+
+	// 20:00 local time the saturday before the switch to summer time
+	last := time.Date(2024, time.March, 23, 20, 0, 0, 0, berlin)
+
+	cases := []struct {
+		name  string
+		rule  string
+		after time.Time
+		want  time.Time
+	}{
+		{"daily keeps wall clock across dst", RecurrenceDaily, last, time.Date(2024, time.March, 24, 20, 0, 0, 0, berlin)},
+		{"weekly", RecurrenceWeekly, last, time.Date(2024, time.March, 30, 20, 0, 0, 0, berlin)},
+		{"weekly skips missed occurrences", RecurrenceWeekly, last.AddDate(0, 0, 10), time.Date(2024, time.April, 6, 20, 0, 0, 0, berlin)},
+		{"monthly", RecurrenceMonthly, last, time.Date(2024, time.April, 23, 20, 0, 0, 0, berlin)},
+		{"cron in location", "30 18 * * 1", last, time.Date(2024, time.March, 25, 18, 30, 0, 0, berlin)},
+		{"no recurrence", "", last, time.Time{}},
+	}
+
+	for _, c := range cases {
+		got := NextOccurrence(c.rule, last, c.after, berlin)
+		if !got.Equal(c.want) {
+			t.Errorf("%s: got %s, want %s", c.name, got, c.want)
+		}
+	}
+}
+
+func TestFoldICalLine(t *testing.T) {
+	short := "SUMMARY:raid night"
+	if got := foldICalLine(short); got != short+"\r\n" {
+		t.Errorf("short line was folded: %q", got)
+	}
+
+	long := "DESCRIPTION:" + string(make([]byte, 100))
+	folded := foldICalLine(long)
+	if folded[:75] != long[:75] || folded[75:78] != "\r\n " {
+		t.Errorf("long line was not folded at 75 octets: %q", folded)
+	}
+}
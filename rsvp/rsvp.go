@@ -24,6 +24,7 @@ const (
 	EventRejected  = "event_rejected"
 	EventWaitlist  = "event_waitlist"
 	EventUndecided = "event_undecided"
+	EventSlot      = "event_slot"
 )
 
 func init() {
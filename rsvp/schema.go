@@ -32,4 +32,43 @@ CREATE TABLE IF NOT EXISTS rsvp_participants (
 
 	PRIMARY KEY(rsvp_sessions_message_id, user_id)
 );
+`, `
+ALTER TABLE rsvp_sessions ADD COLUMN IF NOT EXISTS recurrence TEXT NOT NULL DEFAULT '';
+`, `
+ALTER TABLE rsvp_sessions ADD COLUMN IF NOT EXISTS timezone TEXT NOT NULL DEFAULT '';
+`, `
+CREATE TABLE IF NOT EXISTS rsvp_guild_configs (
+	guild_id BIGINT PRIMARY KEY,
+
+	calendar_token TEXT NOT NULL
+);
+`, `
+ALTER TABLE rsvp_sessions ADD COLUMN IF NOT EXISTS slots TEXT NOT NULL DEFAULT '';
+`, `
+ALTER TABLE rsvp_participants ADD COLUMN IF NOT EXISTS slot TEXT NOT NULL DEFAULT '';
+`, `
+CREATE TABLE IF NOT EXISTS rsvp_attendance (
+	id BIGSERIAL PRIMARY KEY,
+
+	guild_id BIGINT NOT NULL,
+	user_id BIGINT NOT NULL,
+
+	session_local_id BIGINT NOT NULL,
+	session_author_id BIGINT NOT NULL,
+	session_title TEXT NOT NULL,
+	starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+	slot TEXT NOT NULL,
+
+	-- null until the organiser has marked the attendance
+	attended BOOLEAN
+);
+`, `
+CREATE INDEX IF NOT EXISTS rsvp_attendance_guild_id_user_id_idx ON rsvp_attendance(guild_id, user_id);
+`, `
+CREATE UNIQUE INDEX IF NOT EXISTS rsvp_attendance_guild_id_session_local_id_user_id_idx ON rsvp_attendance(guild_id, session_local_id, user_id);
+`, `
+ALTER TABLE rsvp_sessions ADD COLUMN IF NOT EXISTS native_event_id BIGINT NOT NULL DEFAULT 0;
+`, `
+ALTER TABLE rsvp_guild_configs ADD COLUMN IF NOT EXISTS sync_native_events BOOLEAN NOT NULL DEFAULT false;
 `}
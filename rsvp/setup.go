@@ -29,6 +29,8 @@ const (
 	SetupStateMaxParticipants
 	SetupStateWhen
 	SetupStateWhenConfirm
+	SetupStateRecurrence
+	SetupStateSlots
 )
 
 type SetupSession struct {
@@ -48,6 +50,9 @@ type SetupSession struct {
 	Title           string
 	Channel         int64
 	When            time.Time
+	Timezone        string
+	Recurrence      string
+	Slots           string
 
 	LastAction time.Time
 	stopCH     chan bool
@@ -90,6 +95,10 @@ func (s *SetupSession) handleMessage(m *discordgo.Message) {
 		s.handleMessageSetupStateWhen(m)
 	case SetupStateWhenConfirm:
 		s.handleMessageSetupStateWhenConfirm(m)
+	case SetupStateRecurrence:
+		s.handleMessageSetupStateRecurrence(m)
+	case SetupStateSlots:
+		s.handleMessageSetupStateSlots(m)
 	}
 }
 
@@ -177,13 +186,13 @@ func (s *SetupSession) handleMessageSetupStateMaxParticipants(m *discordgo.Messa
 	s.MaxParticipants = int(participants)
 	s.State = SetupStateWhen
 
-	s.sendMessage("Set max participants to **%d**, now please enter when this event starts, in either your registered time zone (using the `setz` command) or UTC. (example: `tomorrow 10pm`, `10 may 2pm UTC`)", s.MaxParticipants)
+	s.sendMessage("Set max participants to **%d**, now please enter when this event starts, in either your registered time zone (using the `setz` command), the server's time zone or UTC. (example: `tomorrow 10pm`, `10 may 2pm UTC`)", s.MaxParticipants)
 }
 
 var UTCRegex = regexp.MustCompile(`(?i)\butc\b`)
 
 func (s *SetupSession) handleMessageSetupStateWhen(m *discordgo.Message) {
-	registeredTimezone := timezonecompanion.GetUserTimezone(s.AuthorID)
+	registeredTimezone := timezonecompanion.GetTimezone(s.AuthorID, s.GuildID, s.Channel)
 	if registeredTimezone == nil || UTCRegex.MatchString(m.Content) {
 		registeredTimezone = time.UTC
 	}
@@ -197,6 +206,7 @@ func (s *SetupSession) handleMessageSetupStateWhen(m *discordgo.Message) {
 	}
 
 	s.When = t.Time
+	s.Timezone = registeredTimezone.String()
 	s.State = SetupStateWhenConfirm
 
 	in := common.HumanizeDuration(common.DurationPrecisionMinutes, t.Time.Sub(now))
@@ -211,13 +221,37 @@ func (s *SetupSession) handleMessageSetupStateWhenConfirm(m *discordgo.Message)
 	}
 
 	if lower[0] == 'y' {
-		s.Finish()
+		s.State = SetupStateRecurrence
+		s.sendMessage("Should this event repeat? Enter `no`, `daily`, `weekly`, `monthly` or a cron expression in the same timezone (example: `0 20 * * 5` for every friday at 20:00)")
 	} else {
 		s.State = SetupStateWhen
 		s.sendMessage("Please enter when this event starts. (example: `tomorrow 10pm`, `10 may 2pm`)")
 	}
 }
 
+func (s *SetupSession) handleMessageSetupStateRecurrence(m *discordgo.Message) {
+	rule, err := ParseRecurrence(m.Content)
+	if err != nil {
+		s.sendMessage("%s", err.Error())
+		return
+	}
+
+	s.Recurrence = rule
+	s.State = SetupStateSlots
+	s.sendMessage("Should participants sign up for specific slots? Enter `no`, or the slots and how many people each can hold (example: `tank:2, healer:2, dps:6`)")
+}
+
+func (s *SetupSession) handleMessageSetupStateSlots(m *discordgo.Message) {
+	slots, err := ParseSlots(m.Content)
+	if err != nil {
+		s.sendMessage("%s", err.Error())
+		return
+	}
+
+	s.Slots = FormatSlots(slots)
+	s.Finish()
+}
+
 func (s *SetupSession) Finish() {
 
 	// reserve the message
@@ -260,6 +294,10 @@ func (s *SetupSession) Finish() {
 		Title:           s.Title,
 		MaxParticipants: s.MaxParticipants,
 		SendReminders:   true,
+
+		Recurrence: s.Recurrence,
+		Timezone:   s.Timezone,
+		Slots:      s.Slots,
 	}
 
 	err = m.InsertG(context.Background(), boil.Infer())
@@ -283,6 +321,11 @@ func (s *SetupSession) Finish() {
 		return
 	}
 
+	err = syncNativeEvent(m)
+	if err != nil {
+		logger.WithError(err).WithField("guild", m.GuildID).Error("failed creating native event")
+	}
+
 	go s.remove()
 
 	// finish by deleting the setup messages
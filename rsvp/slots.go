@@ -0,0 +1,292 @@
+package rsvp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/rsvp/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	// discord allows 25 options in a select menu
+	MaxSlots       = 25
+	MaxSlotNameLen = 50
+)
+
+// Slot is a named role participants can sign up as, e.g "tank", with a max amount of participants
+type Slot struct {
+	Name     string
+	Capacity int
+}
+
+var (
+	slotRegex = regexp.MustCompile(`^(.+?)\s*[:= ]\s*(\d+)$`)
+
+	ErrTooManySlots = fmt.Errorf("Too many slots, max %d", MaxSlots)
+)
+
+// ParseSlots parses a comma separated list of slots in the form of `name:capacity`, e.g `tank:2, healer:2, dps:6`
+func ParseSlots(in string) ([]*Slot, error) {
+	in = strings.TrimSpace(in)
+	switch strings.ToLower(in) {
+	case "", "no", "none":
+		return nil, nil
+	}
+
+	var slots []*Slot
+	for _, part := range strings.Split(in, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		match := slotRegex.FindStringSubmatch(part)
+		if match == nil {
+			return nil, fmt.Errorf("Invalid slot `%s`, slots are specified like this: `tank:2, healer:2, dps:6`", part)
+		}
+
+		name := strings.TrimSpace(match[1])
+		if utf8.RuneCountInString(name) > MaxSlotNameLen {
+			return nil, fmt.Errorf("Slot names can be at most %d characters long", MaxSlotNameLen)
+		}
+
+		if findSlot(slots, name) != nil {
+			return nil, fmt.Errorf("Slot `%s` specified more than once", name)
+		}
+
+		capacity, _ := strconv.Atoi(match[2])
+		if capacity < 1 {
+			return nil, errors.New("Slots need room for at least 1 participant")
+		}
+
+		slots = append(slots, &Slot{Name: name, Capacity: capacity})
+	}
+
+	if len(slots) > MaxSlots {
+		return nil, ErrTooManySlots
+	}
+
+	return slots, nil
+}
+
+// FormatSlots returns the slots in the form they're stored in and parsed by ParseSlots
+func FormatSlots(slots []*Slot) string {
+	parts := make([]string, 0, len(slots))
+	for _, v := range slots {
+		parts = append(parts, v.Name+":"+strconv.Itoa(v.Capacity))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// sessionSlots returns the slots of the session, or nil if it has none
+func sessionSlots(m *models.RSVPSession) []*Slot {
+	slots, err := ParseSlots(m.Slots)
+	if err != nil {
+		logger.WithError(err).WithField("guild", m.GuildID).Error("invalid slots stored on rsvp session")
+		return nil
+	}
+
+	return slots
+}
+
+func findSlot(slots []*Slot, name string) *Slot {
+	for _, v := range slots {
+		if strings.EqualFold(v.Name, name) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// slotCounts returns the number of joining participants in each slot
+func slotCounts(participants []*models.RSVPParticipant) map[string]int {
+	counts := make(map[string]int)
+	for _, v := range participants {
+		if v.Slot != "" && v.JoinState == int16(ParticipantStateJoining) {
+			counts[strings.ToLower(v.Slot)]++
+		}
+	}
+
+	return counts
+}
+
+func createSlotSelectMenu(slots []*Slot, participants []*models.RSVPParticipant) discordgo.TopLevelComponent {
+	counts := slotCounts(participants)
+
+	options := make([]discordgo.SelectMenuOption, 0, len(slots))
+	for _, v := range slots {
+		options = append(options, discordgo.SelectMenuOption{
+			Label: fmt.Sprintf("%s (%d/%d)", v.Name, counts[strings.ToLower(v.Name)], v.Capacity),
+			Value: v.Name,
+		})
+	}
+
+	return discordgo.ActionsRow{
+		Components: []discordgo.InteractiveComponent{
+			discordgo.SelectMenu{
+				MenuType:    discordgo.StringSelectMenu,
+				CustomID:    EventSlot,
+				Placeholder: "Pick a slot to join as",
+				Options:     options,
+			},
+		},
+	}
+}
+
+// slotsField returns an embed field showing how filled up each slot is
+func slotsField(slots []*Slot, participants []*models.RSVPParticipant) *discordgo.MessageEmbedField {
+	counts := slotCounts(participants)
+
+	var value strings.Builder
+	for _, v := range slots {
+		value.WriteString(fmt.Sprintf("**%s**: %d/%d\n", v.Name, counts[strings.ToLower(v.Name)], v.Capacity))
+	}
+
+	return &discordgo.MessageEmbedField{
+		Name:  "Slots",
+		Value: value.String(),
+	}
+}
+
+// handleSlotInteraction signs the member up for the slot they picked in the select menu
+func (p *Plugin) handleSlotInteraction(ic *discordgo.InteractionCreate) {
+	data := ic.MessageComponentData()
+	if len(data.Values) < 1 {
+		return
+	}
+
+	var m *models.RSVPSession
+	var rejected string
+	changed := false
+	err := common.SqlTX(func(tx *sql.Tx) error {
+		var err error
+		m, rejected, changed, err = signUpForSlot(tx, ic.Message.ID, ic.GuildID, ic.Member.User.ID, data.Values[0])
+		return err
+	})
+	if err != nil {
+		if err != sql.ErrNoRows {
+			logger.WithError(err).WithField("guild", ic.GuildID).Error("failed signing up for rsvp slot")
+		}
+		return
+	}
+
+	response := &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	}
+	if rejected != "" {
+		response = &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: rejected,
+				Flags:   discordgo.MessageFlagsEphemeral,
+			},
+		}
+	}
+
+	err = common.BotSession.CreateInteractionResponse(ic.ID, ic.Token, response)
+	if err != nil {
+		logger.WithError(err).WithField("guild", ic.GuildID).Error("failed responding to slot interaction")
+	}
+
+	if changed {
+		queueEmbedUpdate(m)
+	}
+}
+
+// signUpForSlot moves the member into the slot, the session row is locked so concurrent signups can't overbook it,
+// returns why the member couldn't sign up if they couldn't, and whether anything changed
+func signUpForSlot(tx *sql.Tx, messageID, guildID, userID int64, slotName string) (m *models.RSVPSession, rejected string, changed bool, err error) {
+	ctx := context.Background()
+
+	m, err = models.RSVPSessions(models.RSVPSessionWhere.MessageID.EQ(messageID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	slot := findSlot(sessionSlots(m), slotName)
+	if slot == nil {
+		return m, "", false, nil
+	}
+
+	participants, err := models.RSVPParticipants(models.RSVPParticipantWhere.RSVPSessionsMessageID.EQ(m.MessageID)).All(ctx, tx)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	var participant *models.RSVPParticipant
+	for _, v := range participants {
+		if v.UserID == userID {
+			participant = v
+			break
+		}
+	}
+
+	if participant != nil && participant.JoinState == int16(ParticipantStateJoining) && strings.EqualFold(participant.Slot, slot.Name) {
+		// already in it
+		return m, "", false, nil
+	}
+
+	if rejected = checkSlotSignup(participants, participant, slot, m.MaxParticipants); rejected != "" {
+		return m, rejected, false, nil
+	}
+
+	if participant == nil {
+		participant = &models.RSVPParticipant{
+			RSVPSessionsMessageID: m.MessageID,
+			UserID:                userID,
+			GuildID:               guildID,
+		}
+	}
+
+	if participant.JoinState != int16(ParticipantStateJoining) {
+		participant.MarkedAsParticipatingAt = time.Now()
+	}
+	participant.JoinState = int16(ParticipantStateJoining)
+	participant.Slot = slot.Name
+
+	err = participant.Upsert(ctx, tx, true, []string{"rsvp_sessions_message_id", "user_id"}, boil.Infer(), boil.Infer())
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	return m, "", true, nil
+}
+
+// checkSlotSignup returns why the participant (nil if they haven't responded yet) can't sign up for the slot,
+// an empty string if they can
+func checkSlotSignup(participants []*models.RSVPParticipant, participant *models.RSVPParticipant, slot *Slot, maxParticipants int) string {
+	if slotCounts(participants)[strings.ToLower(slot.Name)] >= slot.Capacity {
+		return fmt.Sprintf("The **%s** slot is full, pick another one", slot.Name)
+	}
+
+	// switching slots doesn't change the number of participants
+	if participant != nil && participant.JoinState == int16(ParticipantStateJoining) {
+		return ""
+	}
+
+	joining := 0
+	for _, v := range participants {
+		if v.JoinState == int16(ParticipantStateJoining) {
+			joining++
+		}
+	}
+
+	if maxParticipants > 0 && joining >= maxParticipants {
+		return "This event is full"
+	}
+
+	return ""
+}
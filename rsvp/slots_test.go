@@ -0,0 +1,72 @@
+package rsvp
+
+import (
+	"testing"
+
+	"github.com/ThatBathroom/yagpdb/v2/rsvp/models"
+)
+
+func TestParseSlots(t *testing.T) {
+	slots, err := ParseSlots("tank:2, Healer 2,dps = 6")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := FormatSlots(slots); got != "tank:2,Healer:2,dps:6" {
+		t.Errorf("got %q", got)
+	}
+
+	if findSlot(slots, "healer") == nil {
+		t.Error("slot lookup should be case insensitive")
+	}
+
+	for _, in := range []string{"", "none", "No"} {
+		slots, err := ParseSlots(in)
+		if err != nil || slots != nil {
+			t.Errorf("ParseSlots(%q): expected no slots, got %v, %v", in, slots, err)
+		}
+	}
+
+	for _, in := range []string{"tank", "tank:0", "tank:1, TANK:2"} {
+		if _, err := ParseSlots(in); err == nil {
+			t.Errorf("ParseSlots(%q): expected an error", in)
+		}
+	}
+}
+
+func TestCheckSlotSignup(t *testing.T) {
+	tank := &Slot{Name: "tank", Capacity: 1}
+	dps := &Slot{Name: "dps", Capacity: 3}
+
+	joining := func(userID int64, slot string) *models.RSVPParticipant {
+		return &models.RSVPParticipant{UserID: userID, Slot: slot, JoinState: int16(ParticipantStateJoining)}
+	}
+
+	participants := []*models.RSVPParticipant{
+		joining(1, "Tank"),
+		joining(2, "dps"),
+		{UserID: 3, JoinState: int16(ParticipantStateMaybe)},
+	}
+
+	cases := []struct {
+		name            string
+		participant     *models.RSVPParticipant
+		slot            *Slot
+		maxParticipants int
+		allowed         bool
+	}{
+		{"full slot", nil, tank, 0, false},
+		{"free slot", nil, dps, 0, true},
+		{"event full", nil, dps, 2, false},
+		{"switching slot in a full event", participants[0], dps, 2, true},
+		{"maybe joining a full event", participants[2], dps, 2, false},
+		{"maybe joining", participants[2], dps, 3, true},
+	}
+
+	for _, c := range cases {
+		got := checkSlotSignup(participants, c.participant, c.slot, c.maxParticipants)
+		if (got == "") != c.allowed {
+			t.Errorf("%s: got %q, allowed %t", c.name, got, c.allowed)
+		}
+	}
+}
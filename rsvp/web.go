@@ -0,0 +1,63 @@
+package rsvp
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"net/http"
+
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/rsvp/models"
+	"github.com/ThatBathroom/yagpdb/v2/web"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"goji.io/pat"
+)
+
+var _ web.Plugin = (*Plugin)(nil)
+
+func (p *Plugin) InitWeb() {
+	web.ServerPublicMux.Handle(pat.Get("/rsvp/:token/calendar.ics"), http.HandlerFunc(handleGetCalendar))
+}
+
+// handleGetCalendar serves the upcoming events of a server as an iCalendar feed,
+// the token in the url is retrieved through the `events calendar` command
+func handleGetCalendar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	activeGuild, _ := web.GetBaseCPContextData(ctx)
+
+	conf, err := models.FindRSVPGuildConfigG(ctx, activeGuild.ID)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			web.CtxLogger(ctx).WithError(err).Error("failed retrieving rsvp config")
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+
+		http.NotFound(w, r)
+		return
+	}
+
+	token := pat.Param(r, "token")
+	if conf.CalendarToken == "" || subtle.ConstantTimeCompare([]byte(conf.CalendarToken), []byte(token)) != 1 {
+		http.NotFound(w, r)
+		return
+	}
+
+	sessions, err := models.RSVPSessions(models.RSVPSessionWhere.GuildID.EQ(activeGuild.ID), qm.OrderBy("starts_at asc")).AllG(ctx)
+	if err != nil {
+		web.CtxLogger(ctx).WithError(err).Error("failed retrieving rsvp sessions")
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="events.ics"`)
+	err = WriteICalendar(w, activeGuild.Name+" events", sessions)
+	if err != nil {
+		web.CtxLogger(ctx).WithError(err).Error("failed writing calendar")
+	}
+}
+
+// CalendarURL returns the public iCalendar feed url for a server
+func CalendarURL(guildID int64, token string) string {
+	return web.BaseURL() + "/public/" + discordgo.StrID(guildID) + "/rsvp/" + token + "/calendar.ics"
+}
@@ -441,9 +441,9 @@ func (p *Plugin) cleanupOldStats(t time.Time) error {
 }
 
 func (c *Compressor) runCompression(t time.Time) error {
-	// check up to 5 days back
+	// check up to 5 days back, oldest first so that member leaves are applied after their joins
 	logger.Infof("Running compression, t is %d:%d: %s", t.Year(), t.YearDay(), t)
-	for i := 0; i < 5; i++ {
+	for i := 4; i >= 0; i-- {
 		newT := t.AddDate(0, 0, -i)
 		year := newT.Year()
 		day := newT.YearDay()
@@ -454,7 +454,12 @@ func (c *Compressor) runCompression(t time.Time) error {
 		}
 	}
 
-	return nil
+	err := cleanupUserStats(time.Now())
+	if err != nil {
+		return err
+	}
+
+	return c.updateCohorts(time.Now())
 }
 
 type GuildStatsFrame struct {
@@ -491,8 +496,18 @@ func (c *Compressor) runCompressionDay(year, day int) error {
 		return errors.WithStackIf(err)
 	}
 
-	if len(stats) > 0 {
-		err = c.saveCollectedStats(year, day, stats)
+	joins, leaves, err := c.collectMemberEvents(year, day)
+	if err != nil {
+		return errors.WithStackIf(err)
+	}
+
+	userStats, err := c.collectUserStats(year, day)
+	if err != nil {
+		return errors.WithStackIf(err)
+	}
+
+	if len(stats) > 0 || len(joins) > 0 || len(leaves) > 0 || len(userStats) > 0 {
+		err = c.saveCollectedStats(year, day, stats, joins, leaves, userStats)
 		if err != nil {
 			return err
 		}
@@ -540,6 +555,14 @@ func (c *Compressor) cleanTempRedisStats(year, day int) error {
 	if err != nil {
 		return errors.WithStackIf(err)
 	}
+	err = common.RedisPool.Do(radix.Cmd(nil, "DEL", keyMemberJoinEvents(year, day), keyMemberLeaveEvents(year, day)))
+	if err != nil {
+		return errors.WithStackIf(err)
+	}
+	err = c.cleanTempUserStats(year, day)
+	if err != nil {
+		return err
+	}
 
 	// finally, clean up this state of this day
 	err = common.RedisPool.Do(radix.Cmd(nil, "DEL", keyCompressionCompressionRanDays, fmt.Sprintf("%d:%d", year, day)))
@@ -550,7 +573,7 @@ func (c *Compressor) cleanTempRedisStats(year, day int) error {
 	return err
 }
 
-func (c *Compressor) saveCollectedStats(year, day int, stats map[int64]*GuildStatsFrame) error {
+func (c *Compressor) saveCollectedStats(year, day int, stats map[int64]*GuildStatsFrame, joins []*memberEvent, leaves []*memberEvent, userStats map[int64]*guildUserStats) error {
 	t := time.Date(year, 1, day, 0, 0, 0, 0, time.UTC)
 
 	allPremiumGuilds, err := premium.AllGuildsOncePremium()
@@ -584,6 +607,18 @@ func (c *Compressor) saveCollectedStats(year, day int, stats map[int64]*GuildSta
 		}
 	}
 
+	err = saveUserStats(tx, t, userStats)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = saveMemberEvents(tx, t, joins, leaves)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	// mark this day as compressed
 	err = common.RedisPool.Do(radix.Cmd(nil, "SADD", keyCompressionCompressionRanDays, fmt.Sprintf("%d:%d", year, day)))
 	if err != nil {
@@ -0,0 +1,229 @@
+package serverstats
+
+import (
+	"database/sql"
+	"sort"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/mediocregopher/radix/v3"
+)
+
+// RetentionWindows are how long after joining we check if members of a cohort are still present
+var RetentionWindows = [3]time.Duration{time.Hour * 24, time.Hour * 24 * 7, time.Hour * 24 * 30}
+
+const (
+	// cohorts that started before this can no longer change, since all their members
+	// have been around for the longest retention window
+	cohortMaxAge = time.Hour * 24 * (30 + 7 + 1)
+
+	// individual joins are deleted after this, with a good margin to cohortMaxAge
+	memberJoinsMaxAge = time.Hour * 24 * 60
+)
+
+// RetentionCohort is the members that joined in the week starting at Week,
+// Eligible[i] is how many of them joined at least RetentionWindows[i] ago
+// and Retained[i] how many of those were still present after RetentionWindows[i]
+type RetentionCohort struct {
+	Week     time.Time `json:"week"`
+	Joins    int       `json:"joins"`
+	Eligible [3]int    `json:"eligible"`
+	Retained [3]int    `json:"retained"`
+}
+
+type cohortMember struct {
+	JoinedAt time.Time
+	// zero if the member is still present
+	LeftAt time.Time
+}
+
+// weekStart returns the start of the (monday based) week t is in
+func weekStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// computeCohorts groups the members into weekly cohorts, sorted by week
+func computeCohorts(members []*cohortMember, now time.Time) []*RetentionCohort {
+	byWeek := make(map[time.Time]*RetentionCohort)
+	result := make([]*RetentionCohort, 0)
+
+	for _, m := range members {
+		week := weekStart(m.JoinedAt)
+		cohort, ok := byWeek[week]
+		if !ok {
+			cohort = &RetentionCohort{Week: week}
+			byWeek[week] = cohort
+			result = append(result, cohort)
+		}
+
+		cohort.Joins++
+		for i, window := range RetentionWindows {
+			checkAt := m.JoinedAt.Add(window)
+			if checkAt.After(now) {
+				continue
+			}
+
+			cohort.Eligible[i]++
+			if m.LeftAt.IsZero() || !m.LeftAt.Before(checkAt) {
+				cohort.Retained[i]++
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Week.Before(result[j].Week)
+	})
+
+	return result
+}
+
+func (c *Compressor) collectMemberEvents(year, day int) (joins []*memberEvent, leaves []*memberEvent, err error) {
+	var rawJoins, rawLeaves []string
+	err = common.RedisPool.Do(radix.Pipeline(
+		radix.Cmd(&rawJoins, "LRANGE", keyMemberJoinEvents(year, day), "0", "-1"),
+		radix.Cmd(&rawLeaves, "LRANGE", keyMemberLeaveEvents(year, day), "0", "-1"),
+	))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, v := range rawJoins {
+		if evt, ok := parseMemberEvent(v); ok {
+			joins = append(joins, evt)
+		}
+	}
+
+	for _, v := range rawLeaves {
+		if evt, ok := parseMemberEvent(v); ok {
+			leaves = append(leaves, evt)
+		}
+	}
+
+	return joins, leaves, nil
+}
+
+// saveMemberEvents records the joins and leaves of the day t, joins are saved first so that
+// members who joined and left on the same day are tracked properly
+func saveMemberEvents(tx *sql.Tx, t time.Time, joins []*memberEvent, leaves []*memberEvent) error {
+	type inviteKey struct {
+		GuildID int64
+		Code    string
+	}
+	inviteJoins := make(map[inviteKey]int)
+
+	for _, v := range joins {
+		_, err := tx.Exec(`INSERT INTO server_stats_member_joins (guild_id, user_id, joined_at, invite_code)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (guild_id, user_id, joined_at) DO NOTHING;`, v.GuildID, v.UserID, v.T, v.InviteCode)
+		if err != nil {
+			return errors.WithStackIf(err)
+		}
+
+		inviteJoins[inviteKey{GuildID: v.GuildID, Code: v.InviteCode}]++
+	}
+
+	for k, n := range inviteJoins {
+		_, err := tx.Exec(`INSERT INTO server_stats_invite_sources (guild_id, t, invite_code, joins)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (guild_id, t, invite_code) DO UPDATE SET
+joins = server_stats_invite_sources.joins + $4;`, k.GuildID, t, k.Code, n)
+		if err != nil {
+			return errors.WithStackIf(err)
+		}
+	}
+
+	for _, v := range leaves {
+		_, err := tx.Exec(`UPDATE server_stats_member_joins SET left_at = $3
+WHERE guild_id = $1 AND user_id = $2 AND left_at IS NULL AND joined_at <= $3;`, v.GuildID, v.UserID, v.T)
+		if err != nil {
+			return errors.WithStackIf(err)
+		}
+
+		// members that left shouldn't show up in the inactive members report
+		_, err = tx.Exec(`DELETE FROM server_stats_user_activity WHERE guild_id = $1 AND user_id = $2;`, v.GuildID, v.UserID)
+		if err != nil {
+			return errors.WithStackIf(err)
+		}
+	}
+
+	return nil
+}
+
+// updateCohorts recalculates the cohorts that can still change and removes old individual joins
+func (c *Compressor) updateCohorts(now time.Time) error {
+	since := weekStart(now.Add(-cohortMaxAge))
+
+	rows, err := common.PQ.Query(`SELECT DISTINCT guild_id FROM server_stats_member_joins WHERE joined_at >= $1`, since)
+	if err != nil {
+		return errors.WithStackIf(err)
+	}
+
+	var guilds []int64
+	for rows.Next() {
+		var g int64
+		err = rows.Scan(&g)
+		if err != nil {
+			rows.Close()
+			return errors.WithStackIf(err)
+		}
+
+		guilds = append(guilds, g)
+	}
+	rows.Close()
+
+	for _, g := range guilds {
+		err = updateGuildCohorts(g, since, now)
+		if err != nil {
+			return err
+		}
+
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	_, err = common.PQ.Exec("DELETE FROM server_stats_member_joins WHERE joined_at < $1", now.Add(-memberJoinsMaxAge))
+	return errors.WithStackIf(err)
+}
+
+func updateGuildCohorts(guildID int64, since time.Time, now time.Time) error {
+	rows, err := common.PQ.Query(`SELECT joined_at, left_at FROM server_stats_member_joins WHERE guild_id = $1 AND joined_at >= $2`, guildID, since)
+	if err != nil {
+		return errors.WithStackIf(err)
+	}
+
+	members := make([]*cohortMember, 0)
+	for rows.Next() {
+		var joinedAt time.Time
+		var leftAt sql.NullTime
+		err = rows.Scan(&joinedAt, &leftAt)
+		if err != nil {
+			rows.Close()
+			return errors.WithStackIf(err)
+		}
+
+		members = append(members, &cohortMember{JoinedAt: joinedAt, LeftAt: leftAt.Time})
+	}
+	rows.Close()
+
+	const updateQ = `INSERT INTO server_stats_cohorts
+	(guild_id, week, joins, eligible_1d, retained_1d, eligible_7d, retained_7d, eligible_30d, retained_30d)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	ON CONFLICT (guild_id, week) DO UPDATE SET
+	joins = $3,
+	eligible_1d = $4, retained_1d = $5,
+	eligible_7d = $6, retained_7d = $7,
+	eligible_30d = $8, retained_30d = $9;`
+
+	for _, v := range computeCohorts(members, now) {
+		_, err = common.PQ.Exec(updateQ, guildID, v.Week, v.Joins,
+			v.Eligible[0], v.Retained[0], v.Eligible[1], v.Retained[1], v.Eligible[2], v.Retained[2])
+		if err != nil {
+			return errors.WithStackIf(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package serverstats
+
+import (
+	"testing"
+	"time"
+)
+
+func TestComputeCohorts(t *testing.T) {
+	// wednesday
+	now := time.Date(2020, time.March, 4, 12, 0, 0, 0, time.UTC)
+	day := time.Hour * 24
+
+	members := []*cohortMember{
+		// week of 2020-01-27
+		{JoinedAt: now.Add(-day * 35)},
+		{JoinedAt: now.Add(-day * 35), LeftAt: now.Add(-day * 34).Add(-time.Hour)},
+		{JoinedAt: now.Add(-day * 36), LeftAt: now.Add(-day * 20)},
+		// week of 2020-03-02, too recent for the 7 and 30 day windows
+		{JoinedAt: now.Add(-day * 2), LeftAt: now.Add(-time.Hour)},
+		{JoinedAt: now.Add(-time.Hour)},
+	}
+
+	cohorts := computeCohorts(members, now)
+	if len(cohorts) != 2 {
+		t.Fatalf("got %d cohorts, want 2", len(cohorts))
+	}
+
+	first := cohorts[0]
+	if !first.Week.Equal(time.Date(2020, time.January, 27, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("first cohort week is %s", first.Week)
+	}
+	if first.Joins != 3 || first.Eligible != [3]int{3, 3, 3} || first.Retained != [3]int{2, 2, 1} {
+		t.Errorf("unexpected first cohort: %+v", first)
+	}
+
+	second := cohorts[1]
+	if !second.Week.Equal(time.Date(2020, time.March, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("second cohort week is %s", second.Week)
+	}
+	if second.Joins != 2 || second.Eligible != [3]int{1, 0, 0} || second.Retained != [3]int{1, 0, 0} {
+		t.Errorf("unexpected second cohort: %+v", second)
+	}
+}
+
+func TestDiffInviteUses(t *testing.T) {
+	cases := []struct {
+		name          string
+		last, current map[string]int
+		want          string
+	}{
+		{"one used", map[string]int{"a": 1, "b": 5}, map[string]int{"a": 2, "b": 5}, "a"},
+		{"new invite used", map[string]int{"a": 1}, map[string]int{"a": 1, "b": 1}, "b"},
+		{"ambiguous", map[string]int{"a": 1, "b": 5}, map[string]int{"a": 2, "b": 6}, ""},
+		{"max uses reached", map[string]int{"a": 1, "b": 0}, map[string]int{"a": 1}, "b"},
+		{"nothing changed", map[string]int{"a": 1}, map[string]int{"a": 1}, ""},
+	}
+
+	for _, c := range cases {
+		if got := diffInviteUses(c.last, c.current); got != c.want {
+			t.Errorf("%s: got %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestInviteUsedUp(t *testing.T) {
+	cases := []struct {
+		uses, maxUses int
+		want          bool
+	}{
+		{0, 0, false},
+		{10, 0, false},
+		{0, 1, true},
+		{4, 5, true},
+		{3, 5, false},
+	}
+
+	for _, c := range cases {
+		if got := inviteUsedUp(c.uses, c.maxUses); got != c.want {
+			t.Errorf("inviteUsedUp(%d, %d) = %t, want %t", c.uses, c.maxUses, got, c.want)
+		}
+	}
+}
+
+func TestParseMemberEvent(t *testing.T) {
+	tim := time.Date(2020, time.March, 4, 12, 0, 0, 0, time.UTC)
+
+	evt, ok := parseMemberEvent(formatMemberEvent(1, 2, tim, "abc-123"))
+	if !ok {
+		t.Fatal("failed parsing member event")
+	}
+
+	if evt.GuildID != 1 || evt.UserID != 2 || !evt.T.Equal(tim) || evt.InviteCode != "abc-123" {
+		t.Errorf("unexpected event: %+v", evt)
+	}
+
+	if _, ok := parseMemberEvent("1:2"); ok {
+		t.Error("parsed invalid member event")
+	}
+}
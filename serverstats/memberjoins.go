@@ -0,0 +1,305 @@
+package serverstats
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ThatBathroom/yagpdb/v2/bot"
+	"github.com/ThatBathroom/yagpdb/v2/bot/eventsystem"
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/common/keylock"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/mediocregopher/radix/v3"
+)
+
+// The individual member joins and leaves of a day are kept in redis lists until the compressor
+// moves them into server_stats_member_joins, where they're used for the retention cohorts
+
+func keyMemberJoinEvents(year, day int) string {
+	return "serverstats_member_join_events:" + strconv.Itoa(year) + ":" + strconv.Itoa(day)
+}
+
+func keyMemberLeaveEvents(year, day int) string {
+	return "serverstats_member_leave_events:" + strconv.Itoa(year) + ":" + strconv.Itoa(day)
+}
+
+// keyInviteUses is a hash of invite code -> uses, used to find out which invite a new member joined through
+func keyInviteUses(guildID int64) string {
+	return "serverstats_invite_uses:" + discordgo.StrID(guildID)
+}
+
+// keyInviteMaxUses is a hash of invite code -> max uses of the invites in the snapshot that have a limit, used to
+// tell invites that were used up apart from ones that were deleted
+func keyInviteMaxUses(guildID int64) string {
+	return "serverstats_invite_max_uses:" + discordgo.StrID(guildID)
+}
+
+// how long the invite snapshot of a guild is kept without any joins
+const inviteSnapshotTTL = 60 * 60 * 24 * 7
+
+// inviteUsesMarkerField is always present in the invite uses hash, so that we can tell
+// a guild without any invites apart from one we have not fetched the invites of yet
+const inviteUsesMarkerField = "_"
+
+var inviteLock = keylock.NewKeyLock[int64]()
+
+func handleMemberJoinLeave(evt *eventsystem.EventData) {
+	t := time.Now()
+
+	switch evt.Type {
+	case eventsystem.EventGuildMemberAdd:
+		m := evt.GuildMemberAdd()
+		if m.User == nil || m.User.Bot {
+			return
+		}
+
+		code := ""
+		conf, err := BotCachedFetchGuildConfig(evt.Context(), evt.GS.ID)
+		if err != nil {
+			logger.WithError(err).WithField("guild", evt.GS.ID).Error("failed retrieving config")
+		} else if conf.TrackInvites {
+			code = findUsedInvite(evt.GS.ID)
+		}
+
+		entry := formatMemberEvent(evt.GS.ID, m.User.ID, t, code)
+		err = common.RedisPool.Do(radix.FlatCmd(nil, "RPUSH", keyMemberJoinEvents(t.Year(), t.YearDay()), entry))
+		if err != nil {
+			logger.WithError(err).WithField("guild", evt.GS.ID).Error("failed recording member join")
+		}
+	case eventsystem.EventGuildMemberRemove:
+		m := evt.GuildMemberRemove()
+		if m.User == nil || m.User.Bot {
+			return
+		}
+
+		entry := formatMemberEvent(evt.GS.ID, m.User.ID, t, "")
+		err := common.RedisPool.Do(radix.FlatCmd(nil, "RPUSH", keyMemberLeaveEvents(t.Year(), t.YearDay()), entry))
+		if err != nil {
+			logger.WithError(err).WithField("guild", evt.GS.ID).Error("failed recording member leave")
+		}
+	}
+}
+
+// findUsedInvite compares the current uses of the guild's invites with the last known ones,
+// returns an empty string if the bot can't see the invites or the invite couldn't be determined
+func findUsedInvite(guildID int64) string {
+	gs := bot.State.GetGuild(guildID)
+	if gs == nil {
+		return ""
+	}
+
+	if hasPerms, _ := bot.BotHasPermissionGS(gs, 0, discordgo.PermissionManageGuild); !hasPerms {
+		return ""
+	}
+
+	// joins are handled concurrently, make sure they don't diff against the same snapshot
+	handle := inviteLock.Lock(guildID, time.Second*10, time.Second*10)
+	if handle == -1 {
+		return ""
+	}
+	defer inviteLock.Unlock(guildID, handle)
+
+	invites, err := common.BotSession.GuildInvites(guildID)
+	if err != nil {
+		logger.WithError(err).WithField("guild", guildID).Error("failed retrieving invites")
+		return ""
+	}
+
+	var last map[string]int
+	err = common.RedisPool.Do(radix.Cmd(&last, "HGETALL", keyInviteUses(guildID)))
+	if err != nil {
+		logger.WithError(err).WithField("guild", guildID).Error("failed retrieving last invite uses")
+		return ""
+	}
+
+	current := make(map[string]int, len(invites))
+	fields := []interface{}{inviteUsesMarkerField, 0}
+	var maxFields []interface{}
+	for _, v := range invites {
+		current[v.Code] = v.Uses
+		fields = append(fields, v.Code, v.Uses)
+		if v.MaxUses > 0 {
+			maxFields = append(maxFields, v.Code, v.MaxUses)
+		}
+	}
+
+	actions := []radix.CmdAction{
+		radix.Cmd(nil, "DEL", keyInviteUses(guildID), keyInviteMaxUses(guildID)),
+		radix.FlatCmd(nil, "HSET", keyInviteUses(guildID), fields...),
+		radix.FlatCmd(nil, "EXPIRE", keyInviteUses(guildID), inviteSnapshotTTL),
+	}
+	if len(maxFields) > 0 {
+		actions = append(actions,
+			radix.FlatCmd(nil, "HSET", keyInviteMaxUses(guildID), maxFields...),
+			radix.FlatCmd(nil, "EXPIRE", keyInviteMaxUses(guildID), inviteSnapshotTTL))
+	}
+
+	err = common.RedisPool.Do(radix.Pipeline(actions...))
+	if err != nil {
+		logger.WithError(err).WithField("guild", guildID).Error("failed saving invite uses")
+	}
+
+	if _, ok := last[inviteUsesMarkerField]; !ok {
+		// first time we see the invites of this guild, nothing to compare against
+		return ""
+	}
+	delete(last, inviteUsesMarkerField)
+
+	return diffInviteUses(last, current)
+}
+
+// handleInviteCreateDelete keeps the invite snapshot up to date between joins, so a new invite used by the next
+// member isn't missed and invites deleted by hand aren't mistaken for ones that were used up
+func handleInviteCreateDelete(evt *eventsystem.EventData) {
+	var guildID int64
+	switch evt.Type {
+	case eventsystem.EventInviteCreate:
+		guildID = evt.InviteCreate().GuildID
+	case eventsystem.EventInviteDelete:
+		guildID = evt.InviteDelete().GuildID
+	}
+
+	conf, err := BotCachedFetchGuildConfig(evt.Context(), guildID)
+	if err != nil {
+		logger.WithError(err).WithField("guild", guildID).Error("failed retrieving config")
+		return
+	}
+
+	if !conf.TrackInvites {
+		return
+	}
+
+	handle := inviteLock.Lock(guildID, time.Second*10, time.Second*10)
+	if handle == -1 {
+		return
+	}
+	defer inviteLock.Unlock(guildID, handle)
+
+	// nothing to update if the snapshot wasn't made yet, the next join makes it
+	var haveSnapshot int
+	err = common.RedisPool.Do(radix.Cmd(&haveSnapshot, "HEXISTS", keyInviteUses(guildID), inviteUsesMarkerField))
+	if err != nil || haveSnapshot == 0 {
+		if err != nil {
+			logger.WithError(err).WithField("guild", guildID).Error("failed checking invite snapshot")
+		}
+		return
+	}
+
+	if evt.Type == eventsystem.EventInviteCreate {
+		invite := evt.InviteCreate()
+		actions := []radix.CmdAction{radix.FlatCmd(nil, "HSET", keyInviteUses(guildID), invite.Code, invite.Uses)}
+		if invite.MaxUses > 0 {
+			actions = append(actions,
+				radix.FlatCmd(nil, "HSET", keyInviteMaxUses(guildID), invite.Code, invite.MaxUses),
+				radix.FlatCmd(nil, "EXPIRE", keyInviteMaxUses(guildID), inviteSnapshotTTL))
+		}
+
+		err = common.RedisPool.Do(radix.Pipeline(actions...))
+		if err != nil {
+			logger.WithError(err).WithField("guild", guildID).Error("failed adding invite to snapshot")
+		}
+		return
+	}
+
+	code := evt.InviteDelete().Code
+
+	var uses, maxUses int
+	err = common.RedisPool.Do(radix.Pipeline(
+		radix.Cmd(&uses, "HGET", keyInviteUses(guildID), code),
+		radix.Cmd(&maxUses, "HGET", keyInviteMaxUses(guildID), code),
+	))
+	if err != nil {
+		logger.WithError(err).WithField("guild", guildID).Error("failed retrieving invite from snapshot")
+		return
+	}
+
+	if inviteUsedUp(uses, maxUses) {
+		// deleted because a member joined through it, the join needs it in the snapshot to find it
+		return
+	}
+
+	err = common.RedisPool.Do(radix.Pipeline(
+		radix.Cmd(nil, "HDEL", keyInviteUses(guildID), code),
+		radix.Cmd(nil, "HDEL", keyInviteMaxUses(guildID), code),
+	))
+	if err != nil {
+		logger.WithError(err).WithField("guild", guildID).Error("failed removing invite from snapshot")
+	}
+}
+
+// inviteUsedUp returns true if one more use of the invite reaches its max uses
+func inviteUsedUp(uses, maxUses int) bool {
+	return maxUses > 0 && uses+1 >= maxUses
+}
+
+// clearInviteSnapshot is used when invite tracking is turned off
+func clearInviteSnapshot(guildID int64) error {
+	return common.RedisPool.Do(radix.Cmd(nil, "DEL", keyInviteUses(guildID), keyInviteMaxUses(guildID)))
+}
+
+// diffInviteUses returns the invite that was used between the two snapshots, or an empty string if it's ambiguous.
+// Invites that disappeared are counted as used if no other invite was, since invites are deleted once they hit their max uses
+func diffInviteUses(last, current map[string]int) string {
+	used := ""
+	numUsed := 0
+	for code, uses := range current {
+		if uses > last[code] {
+			used = code
+			numUsed++
+		}
+	}
+
+	if numUsed == 1 {
+		return used
+	} else if numUsed > 1 {
+		return ""
+	}
+
+	gone := ""
+	numGone := 0
+	for code := range last {
+		if _, ok := current[code]; !ok {
+			gone = code
+			numGone++
+		}
+	}
+
+	if numGone == 1 {
+		return gone
+	}
+
+	return ""
+}
+
+type memberEvent struct {
+	GuildID    int64
+	UserID     int64
+	T          time.Time
+	InviteCode string
+}
+
+func formatMemberEvent(guildID, userID int64, t time.Time, inviteCode string) string {
+	return discordgo.StrID(guildID) + ":" + discordgo.StrID(userID) + ":" + strconv.FormatInt(t.Unix(), 10) + ":" + inviteCode
+}
+
+func parseMemberEvent(entry string) (*memberEvent, bool) {
+	split := strings.SplitN(entry, ":", 4)
+	if len(split) < 4 {
+		return nil, false
+	}
+
+	guildID, err1 := strconv.ParseInt(split[0], 10, 64)
+	userID, err2 := strconv.ParseInt(split[1], 10, 64)
+	unix, err3 := strconv.ParseInt(split[2], 10, 64)
+	if err1 != nil || err2 != nil || err3 != nil {
+		return nil, false
+	}
+
+	return &memberEvent{
+		GuildID:    guildID,
+		UserID:     userID,
+		T:          time.Unix(unix, 0).UTC(),
+		InviteCode: split[3],
+	}, true
+}
@@ -13,10 +13,19 @@ import (
 // Collector is a message stats collector which will preiodically update the serberstats messages table with stats
 type Collector struct {
 	MsgEvtChan chan *discordgo.Message
+	// UserMsgEvtChan is for messages that should also be counted per author and role,
+	// they still need to be sent to MsgEvtChan for the channel stats
+	UserMsgEvtChan chan *discordgo.Message
 
 	interval time.Duration
 
 	channels map[int64]*entry
+	guilds   map[int64]*guildEntry
+
+	// the user stats are flushed in their own goroutine, so a long flush doesn't stall the message handlers,
+	// it sends back the guilds it couldn't flush once it's done
+	flushingUsers  bool
+	usersFlushDone chan map[int64]*guildEntry
 	// buf      []*discordgo.Message
 	// channels []int64
 	l *logrus.Entry
@@ -28,13 +37,21 @@ type entry struct {
 	Count     int64
 }
 
+type guildEntry struct {
+	Users map[int64]int64
+	Roles map[int64]int64
+}
+
 // NewCollector creates a new Collector
 func NewCollector(l *logrus.Entry, updateInterval time.Duration) *Collector {
 	col := &Collector{
-		MsgEvtChan: make(chan *discordgo.Message, 10000),
-		interval:   updateInterval,
-		l:          l,
-		channels:   make(map[int64]*entry),
+		MsgEvtChan:     make(chan *discordgo.Message, 10000),
+		UserMsgEvtChan: make(chan *discordgo.Message, 10000),
+		interval:       updateInterval,
+		l:              l,
+		channels:       make(map[int64]*entry),
+		guilds:         make(map[int64]*guildEntry),
+		usersFlushDone: make(chan map[int64]*guildEntry, 1),
 	}
 
 	go col.run()
@@ -50,7 +67,20 @@ func (c *Collector) run() {
 		select {
 		case msg := <-c.MsgEvtChan:
 			c.handleIncMessage(msg)
+		case msg := <-c.UserMsgEvtChan:
+			c.handleIncUserMessage(msg)
+		case left := <-c.usersFlushDone:
+			c.flushingUsers = false
+			c.mergeGuilds(left)
 		case <-ticker.C:
+			// the last one is still going, the new counts are flushed with the next one
+			if !c.flushingUsers && len(c.guilds) > 0 {
+				c.flushingUsers = true
+				guilds := c.guilds
+				c.guilds = make(map[int64]*guildEntry)
+				go c.flushUsers(guilds)
+			}
+
 			err := c.flush()
 			if err != nil {
 				c.l.Errorf("failed updating temp serverstats: %+v", err)
@@ -72,6 +102,43 @@ func (c *Collector) handleIncMessage(msg *discordgo.Message) {
 	}
 }
 
+func (c *Collector) handleIncUserMessage(msg *discordgo.Message) {
+	g := c.guildEntry(msg.GuildID)
+
+	g.Users[msg.Author.ID]++
+	if msg.Member != nil {
+		for _, r := range msg.Member.Roles {
+			g.Roles[r]++
+		}
+	}
+}
+
+func (c *Collector) guildEntry(guildID int64) *guildEntry {
+	g, ok := c.guilds[guildID]
+	if !ok {
+		g = &guildEntry{
+			Users: make(map[int64]int64),
+			Roles: make(map[int64]int64),
+		}
+		c.guilds[guildID] = g
+	}
+
+	return g
+}
+
+// mergeGuilds adds the counts of guilds that failed to be flushed back, so they're tried again with the next flush
+func (c *Collector) mergeGuilds(guilds map[int64]*guildEntry) {
+	for guildID, left := range guilds {
+		g := c.guildEntry(guildID)
+		for user, count := range left.Users {
+			g.Users[user] += count
+		}
+		for role, count := range left.Roles {
+			g.Roles[role] += count
+		}
+	}
+}
+
 func KeyMessageStats(guildID int64, year, day int) string {
 	return "serverstats_message_stats:" + strconv.FormatInt(guildID, 10) + ":" + strconv.Itoa(year) + ":" + strconv.Itoa(day)
 }
@@ -79,6 +146,21 @@ func KeyActiveGuilds(year, day int) string {
 	return "serverstats_active_guilds:" + strconv.Itoa(year) + ":" + strconv.Itoa(day)
 }
 
+// KeyUserMessageStats is a sorted set of user id -> messages
+func KeyUserMessageStats(guildID int64, year, day int) string {
+	return "serverstats_user_message_stats:" + strconv.FormatInt(guildID, 10) + ":" + strconv.Itoa(year) + ":" + strconv.Itoa(day)
+}
+
+// KeyRoleMessageStats is a sorted set of role id -> messages by members with that role
+func KeyRoleMessageStats(guildID int64, year, day int) string {
+	return "serverstats_role_message_stats:" + strconv.FormatInt(guildID, 10) + ":" + strconv.Itoa(year) + ":" + strconv.Itoa(day)
+}
+
+// KeyActiveUserStatsGuilds is the set of guilds that have user or role stats for the day
+func KeyActiveUserStatsGuilds(year, day int) string {
+	return "serverstats_active_user_stats_guilds:" + strconv.Itoa(year) + ":" + strconv.Itoa(day)
+}
+
 func (c *Collector) flush() error {
 	sleepBetweenCalls := time.Second
 	if len(c.channels) > 0 {
@@ -114,6 +196,43 @@ func (c *Collector) flush() error {
 	return nil
 }
 
+// flushUsers runs in its own goroutine with the guilds taken out of the collector, sending the ones it couldn't flush
+// back to the collector when it's done
+func (c *Collector) flushUsers(guilds map[int64]*guildEntry) {
+	defer func() {
+		c.usersFlushDone <- guilds
+	}()
+
+	// spread out over half the interval, alongside the channel stats
+	sleepBetweenCalls := c.interval / time.Duration(len(guilds)) / 2
+
+	ticker := time.NewTicker(sleepBetweenCalls)
+	defer ticker.Stop()
+
+	t := time.Now().UTC()
+	day := t.YearDay()
+	year := t.Year()
+	for guildID, g := range guilds {
+		actions := make([]radix.CmdAction, 0, len(g.Users)+len(g.Roles)+1)
+		for user, count := range g.Users {
+			actions = append(actions, radix.FlatCmd(nil, "ZINCRBY", KeyUserMessageStats(guildID, year, day), count, user))
+		}
+		for role, count := range g.Roles {
+			actions = append(actions, radix.FlatCmd(nil, "ZINCRBY", KeyRoleMessageStats(guildID, year, day), count, role))
+		}
+		actions = append(actions, radix.FlatCmd(nil, "SADD", KeyActiveUserStatsGuilds(year, day), guildID))
+
+		err := common.RedisPool.Do(radix.Pipeline(actions...))
+		if err != nil {
+			c.l.Errorf("failed updating temp user serverstats: %+v", err)
+			return
+		}
+
+		delete(guilds, guildID)
+		<-ticker.C
+	}
+}
+
 func RoundHour(t time.Time) time.Time {
 	t = t.UTC()
 	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
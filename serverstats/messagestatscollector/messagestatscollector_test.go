@@ -0,0 +1,27 @@
+package messagestatscollector
+
+import "testing"
+
+func TestMergeGuilds(t *testing.T) {
+	c := &Collector{guilds: make(map[int64]*guildEntry)}
+	c.guildEntry(1).Users[10] = 2
+	c.guildEntry(1).Roles[20] = 1
+
+	c.mergeGuilds(map[int64]*guildEntry{
+		1: {Users: map[int64]int64{10: 3, 11: 1}, Roles: map[int64]int64{20: 4}},
+		2: {Users: map[int64]int64{12: 5}, Roles: map[int64]int64{}},
+	})
+
+	if got := c.guilds[1].Users[10]; got != 5 {
+		t.Errorf("user 10: got %d, want 5", got)
+	}
+	if got := c.guilds[1].Users[11]; got != 1 {
+		t.Errorf("user 11: got %d, want 1", got)
+	}
+	if got := c.guilds[1].Roles[20]; got != 5 {
+		t.Errorf("role 20: got %d, want 5", got)
+	}
+	if got := c.guilds[2].Users[12]; got != 5 {
+		t.Errorf("user 12: got %d, want 5", got)
+	}
+}
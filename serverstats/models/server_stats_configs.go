@@ -29,6 +29,8 @@ type ServerStatsConfig struct {
 	UpdatedAt      null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
 	Public         null.Bool   `boil:"public" json:"public,omitempty" toml:"public" yaml:"public,omitempty"`
 	IgnoreChannels null.String `boil:"ignore_channels" json:"ignore_channels,omitempty" toml:"ignore_channels" yaml:"ignore_channels,omitempty"`
+	UserStats      bool        `boil:"user_stats" json:"user_stats" toml:"user_stats" yaml:"user_stats"`
+	TrackInvites   bool        `boil:"track_invites" json:"track_invites" toml:"track_invites" yaml:"track_invites"`
 
 	R *serverStatsConfigR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L serverStatsConfigL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -40,12 +42,16 @@ var ServerStatsConfigColumns = struct {
 	UpdatedAt      string
 	Public         string
 	IgnoreChannels string
+	UserStats      string
+	TrackInvites   string
 }{
 	GuildID:        "guild_id",
 	CreatedAt:      "created_at",
 	UpdatedAt:      "updated_at",
 	Public:         "public",
 	IgnoreChannels: "ignore_channels",
+	UserStats:      "user_stats",
+	TrackInvites:   "track_invites",
 }
 
 var ServerStatsConfigTableColumns = struct {
@@ -54,12 +60,16 @@ var ServerStatsConfigTableColumns = struct {
 	UpdatedAt      string
 	Public         string
 	IgnoreChannels string
+	UserStats      string
+	TrackInvites   string
 }{
 	GuildID:        "server_stats_configs.guild_id",
 	CreatedAt:      "server_stats_configs.created_at",
 	UpdatedAt:      "server_stats_configs.updated_at",
 	Public:         "server_stats_configs.public",
 	IgnoreChannels: "server_stats_configs.ignore_channels",
+	UserStats:      "server_stats_configs.user_stats",
+	TrackInvites:   "server_stats_configs.track_invites",
 }
 
 // Generated where
@@ -185,18 +195,31 @@ func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
 func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
 var ServerStatsConfigWhere = struct {
 	GuildID        whereHelperint64
 	CreatedAt      whereHelpernull_Time
 	UpdatedAt      whereHelpernull_Time
 	Public         whereHelpernull_Bool
 	IgnoreChannels whereHelpernull_String
+	UserStats      whereHelperbool
+	TrackInvites   whereHelperbool
 }{
 	GuildID:        whereHelperint64{field: "\"server_stats_configs\".\"guild_id\""},
 	CreatedAt:      whereHelpernull_Time{field: "\"server_stats_configs\".\"created_at\""},
 	UpdatedAt:      whereHelpernull_Time{field: "\"server_stats_configs\".\"updated_at\""},
 	Public:         whereHelpernull_Bool{field: "\"server_stats_configs\".\"public\""},
 	IgnoreChannels: whereHelpernull_String{field: "\"server_stats_configs\".\"ignore_channels\""},
+	UserStats:      whereHelperbool{field: "\"server_stats_configs\".\"user_stats\""},
+	TrackInvites:   whereHelperbool{field: "\"server_stats_configs\".\"track_invites\""},
 }
 
 // ServerStatsConfigRels is where relationship names are stored.
@@ -216,9 +239,9 @@ func (*serverStatsConfigR) NewStruct() *serverStatsConfigR {
 type serverStatsConfigL struct{}
 
 var (
-	serverStatsConfigAllColumns            = []string{"guild_id", "created_at", "updated_at", "public", "ignore_channels"}
+	serverStatsConfigAllColumns            = []string{"guild_id", "created_at", "updated_at", "public", "ignore_channels", "user_stats", "track_invites"}
 	serverStatsConfigColumnsWithoutDefault = []string{"guild_id"}
-	serverStatsConfigColumnsWithDefault    = []string{"created_at", "updated_at", "public", "ignore_channels"}
+	serverStatsConfigColumnsWithDefault    = []string{"created_at", "updated_at", "public", "ignore_channels", "user_stats", "track_invites"}
 	serverStatsConfigPrimaryKeyColumns     = []string{"guild_id"}
 	serverStatsConfigGeneratedColumns      = []string{}
 )
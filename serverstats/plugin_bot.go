@@ -11,6 +11,7 @@ import (
 	"github.com/ThatBathroom/yagpdb/v2/bot/eventsystem"
 	"github.com/ThatBathroom/yagpdb/v2/commands"
 	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/common/pubsub"
 	"github.com/ThatBathroom/yagpdb/v2/lib/dcmd"
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
 	"github.com/ThatBathroom/yagpdb/v2/serverstats/messagestatscollector"
@@ -36,6 +37,8 @@ func (p *Plugin) BotInit() {
 
 	if !confDeprecated.GetBool() {
 		eventsystem.AddHandlerAsyncLastLegacy(p, handleUpdateMemberStats, eventsystem.EventGuildMemberAdd, eventsystem.EventGuildMemberRemove, eventsystem.EventGuildCreate)
+		eventsystem.AddHandlerAsyncLastLegacy(p, handleMemberJoinLeave, eventsystem.EventGuildMemberAdd, eventsystem.EventGuildMemberRemove)
+		eventsystem.AddHandlerAsyncLastLegacy(p, handleInviteCreateDelete, eventsystem.EventInviteCreate, eventsystem.EventInviteDelete)
 		eventsystem.AddHandlerAsyncLast(p, eventsystem.RequireCSMW(HandleMessageCreate), eventsystem.EventMessageCreate)
 		go p.runOnlineUpdater()
 	} else {
@@ -49,13 +52,20 @@ func (p *Plugin) AddCommands() {
 		CmdCategory:   commands.CategoryTool,
 		Cooldown:      5,
 		Name:          "Stats",
-		Description:   "Shows server stats (if public stats are enabled)",
+		Description:   "Shows server stats (if public stats are enabled), or the message stats of a user (if per user stats are enabled)",
+		Arguments: []*dcmd.ArgDef{
+			{Name: "User", Type: dcmd.User},
+		},
 		RunFunc: func(data *dcmd.Data) (interface{}, error) {
 			config, err := GetConfig(data.Context(), data.GuildData.GS.ID)
 			if err != nil {
 				return nil, errors.WithMessage(err, "getconfig")
 			}
 
+			if data.Args[0].Value != nil {
+				return userStatsEmbed(data, config, data.Args[0].Value.(*discordgo.User))
+			}
+
 			if !config.Public {
 				return fmt.Sprintf("Stats are set to private on this server, this can be changed in the control panel on <https://%s>", common.ConfHost.GetString()), nil
 			}
@@ -84,9 +94,81 @@ func (p *Plugin) AddCommands() {
 
 			return embed, nil
 		},
+	}, &commands.YAGCommand{
+		CmdCategory: commands.CategoryTool,
+		Name:        "StatsOptOut",
+		Description: "Stops counting your messages in the per user stats of all servers and deletes the ones counted so far",
+		RunFunc: func(data *dcmd.Data) (interface{}, error) {
+			err := SetUserOptOut(data.Context(), data.Author.ID, true)
+			if err != nil {
+				return nil, errors.WithMessage(err, "optout")
+			}
+
+			pubsub.EvictCacheSet(cachedOptOuts, data.Author.ID)
+			return "Your messages will no longer be counted in the per user stats and your existing stats have been deleted, use `StatsOptIn` to undo this.", nil
+		},
+	}, &commands.YAGCommand{
+		CmdCategory: commands.CategoryTool,
+		Name:        "StatsOptIn",
+		Description: "Allows your messages to be counted in the per user stats again after opting out",
+		RunFunc: func(data *dcmd.Data) (interface{}, error) {
+			err := SetUserOptOut(data.Context(), data.Author.ID, false)
+			if err != nil {
+				return nil, errors.WithMessage(err, "optin")
+			}
+
+			pubsub.EvictCacheSet(cachedOptOuts, data.Author.ID)
+			return "Your messages will be counted in the per user stats of servers that have them enabled again.", nil
+		},
 	})
 }
 
+func userStatsEmbed(data *dcmd.Data, config *ServerStatsConfig, target *discordgo.User) (interface{}, error) {
+	if !config.UserStats {
+		return fmt.Sprintf("Per user stats are not enabled on this server, this can be changed in the control panel on <https://%s>", common.ConfHost.GetString()), nil
+	}
+
+	optedOut, err := IsUserOptedOut(data.Context(), target.ID)
+	if err != nil {
+		return nil, errors.WithMessage(err, "optedout")
+	}
+
+	if optedOut {
+		return fmt.Sprintf("**%s** has opted out of the per user stats", target.Username), nil
+	}
+
+	stats, err := RetrieveUserMessageStats(data.Context(), data.GuildData.GS.ID, target.ID, time.Now())
+	if err != nil {
+		return nil, errors.WithMessage(err, "retrieveuserstats")
+	}
+
+	rank := "-"
+	if stats.Rank > 0 {
+		rank = "#" + strconv.Itoa(stats.Rank)
+	}
+
+	lastActive := "Never"
+	if !stats.LastActive.IsZero() {
+		lastActive = stats.LastActive.Format("2006-01-02")
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: "Message stats for " + target.Username,
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: target.AvatarURL("256"),
+		},
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Messages today", Value: fmt.Sprint(stats.Today), Inline: true},
+			{Name: "Messages 7d", Value: fmt.Sprint(stats.Week), Inline: true},
+			{Name: "Messages 30d", Value: fmt.Sprint(stats.Month), Inline: true},
+			{Name: "Rank 30d", Value: rank, Inline: true},
+			{Name: "Last active", Value: lastActive, Inline: true},
+		},
+	}
+
+	return embed, nil
+}
+
 func handleUpdateMemberStats(evt *eventsystem.EventData) {
 	select {
 	case memberSatatsUpdater.incoming <- evt:
@@ -116,6 +198,21 @@ func HandleMessageCreate(evt *eventsystem.EventData) (retry bool, err error) {
 	}
 
 	msgStatsCollector.MsgEvtChan <- m.Message
+
+	if !config.UserStats {
+		return false, nil
+	}
+
+	// don't retry here, the message was already counted in the channel stats
+	optedOut, err := BotCachedIsUserOptedOut(evt.Context(), m.Author.ID)
+	if err != nil {
+		return false, errors.WithStackIf(err)
+	}
+
+	if !optedOut {
+		msgStatsCollector.UserMsgEvtChan <- m.Message
+	}
+
 	return false, nil
 }
 
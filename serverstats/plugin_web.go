@@ -30,11 +30,15 @@ var PageHTML string
 
 var WebStatsCache = rcache.New(cacheChartFetcher, time.Minute)
 var WebConfigCache = rcache.NewInt(cacheConfigFetcher, time.Minute)
+var WebRetentionCache = rcache.New(cacheRetentionFetcher, time.Minute)
+var WebActivityCache = rcache.New(cacheActivityFetcher, time.Minute)
 
 var panelLogKey = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "serverstats_settings_updated", FormatString: "Updated serverstats settings"})
 
 type FormData struct {
 	Public         bool
+	UserStats      bool
+	TrackInvites   bool
 	IgnoreChannels []int64 `valid:"channel,false"`
 }
 
@@ -58,11 +62,16 @@ func (p *Plugin) InitWeb() {
 	statsCPMux.Handle(pat.Post("/settings"), web.ControllerPostHandler(HandleSaveStatsSettings, cpGetHandler, FormData{}))
 	statsCPMux.Handle(pat.Get("/daily_json"), web.APIHandler(publicHandlerJson(HandleStatsJson, false)))
 	statsCPMux.Handle(pat.Get("/charts"), web.APIHandler(publicHandlerJson(HandleStatsCharts, false)))
+	statsCPMux.Handle(pat.Get("/retention"), web.APIHandler(publicHandlerJson(HandleStatsRetention, false)))
+	statsCPMux.Handle(pat.Get("/activity"), web.APIHandler(publicHandlerJson(HandleStatsActivity, false)))
+	statsCPMux.Handle(pat.Get("/inactive"), web.APIHandler(HandleInactiveMembers))
 
 	// Public
 	web.ServerPublicMux.Handle(pat.Get("/stats"), web.ControllerHandler(publicHandler(HandleStatsHtml, true), "cp_serverstats"))
 	web.ServerPublicMux.Handle(pat.Get("/stats/daily_json"), web.APIHandler(publicHandlerJson(HandleStatsJson, true)))
 	web.ServerPublicMux.Handle(pat.Get("/stats/charts"), web.APIHandler(publicHandlerJson(HandleStatsCharts, true)))
+	web.ServerPublicMux.Handle(pat.Get("/stats/retention"), web.APIHandler(publicHandlerJson(HandleStatsRetention, true)))
+	web.ServerPublicMux.Handle(pat.Get("/stats/activity"), web.APIHandler(publicHandlerJson(HandleStatsActivity, true)))
 }
 
 type publicHandlerFunc func(w http.ResponseWriter, r *http.Request, publicAccess bool) (web.TemplateData, error)
@@ -134,10 +143,21 @@ OUTER:
 		GuildID:        ag.ID,
 		Public:         null.BoolFrom(formData.Public),
 		IgnoreChannels: null.StringFrom(stringedChannels),
+		UserStats:      formData.UserStats,
+		TrackInvites:   formData.TrackInvites,
 		CreatedAt:      null.TimeFrom(time.Now()),
 	}
 
-	err := model.UpsertG(r.Context(), true, []string{"guild_id"}, boil.Whitelist("public", "ignore_channels"), boil.Infer())
+	err := model.UpsertG(r.Context(), true, []string{"guild_id"}, boil.Whitelist("public", "ignore_channels", "user_stats", "track_invites"), boil.Infer())
+	if err == nil && !formData.UserStats {
+		// don't keep per user stats around when they're turned off
+		err = DeleteGuildUserStats(r.Context(), ag.ID)
+	}
+
+	if err == nil && !formData.TrackInvites {
+		err = clearInviteSnapshot(ag.ID)
+	}
+
 	if err == nil {
 		pubsub.EvictCacheSet(cachedConfig, ag.ID)
 		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKey))
@@ -289,6 +309,222 @@ func cacheChartFetcher(key string) interface{} {
 	}
 }
 
+type RetentionResponse struct {
+	Days    int                `json:"days"`
+	Weeks   int                `json:"weeks"`
+	Cohorts []*RetentionCohort `json:"cohorts"`
+	Invites []*InviteSource    `json:"invites"`
+}
+
+// HandleStatsRetention returns the weekly retention cohorts and which invites members joined through,
+// the invite breakdown is left out on the public page since it would leak the servers invites
+func HandleStatsRetention(w http.ResponseWriter, r *http.Request, isPublicAccess bool) interface{} {
+	activeGuild, _ := web.GetBaseCPContextData(r.Context())
+
+	conf := GetConfigWeb(activeGuild.ID)
+	if conf == nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil
+	}
+
+	if !conf.Public && isPublicAccess {
+		return nil
+	}
+
+	numDays := 7
+	if r.URL.Query().Get("days") != "" {
+		numDays, _ = strconv.Atoi(r.URL.Query().Get("days"))
+	}
+
+	numWeeks := 8
+	if premium.ContextPremium(r.Context()) {
+		numWeeks = 26
+	} else if numDays > 7 || numDays <= 0 {
+		numDays = 7
+	}
+
+	// only cache the same periods as the charts dropdown
+	if numDays > 0 && numDays < 7 {
+		numDays = 7
+	} else if numDays != 7 && numDays != 30 && numDays != 365 {
+		numDays = -1
+	}
+
+	key := "retention:" + strconv.FormatInt(activeGuild.ID, 10) + ":" + strconv.Itoa(numDays) + ":" + strconv.Itoa(numWeeks)
+	resp := WebRetentionCache.Get(key)
+	if resp == nil {
+		return &RetentionResponse{Days: numDays, Weeks: numWeeks, Cohorts: []*RetentionCohort{}, Invites: []*InviteSource{}}
+	}
+
+	cop := *resp.(*RetentionResponse)
+	if isPublicAccess {
+		cop.Invites = []*InviteSource{}
+	}
+
+	return &cop
+}
+
+func cacheRetentionFetcher(key string) interface{} {
+	split := strings.Split(key, ":")
+	if len(split) < 4 {
+		logger.Error("invalid cache key: ", key)
+		return nil
+	}
+
+	guildID, _ := strconv.ParseInt(split[1], 10, 64)
+	days, _ := strconv.Atoi(split[2])
+	weeks, _ := strconv.Atoi(split[3])
+
+	cohorts, err := RetrieveRetentionCohorts(context.Background(), guildID, time.Now(), weeks)
+	if err != nil {
+		logger.WithError(err).WithField("cache_key", key).Error("failed retrieving retention cohorts")
+		return nil
+	}
+
+	invites, err := RetrieveInviteSources(context.Background(), guildID, time.Now(), days)
+	if err != nil {
+		logger.WithError(err).WithField("cache_key", key).Error("failed retrieving invite sources")
+		return nil
+	}
+
+	return &RetentionResponse{
+		Days:    days,
+		Weeks:   weeks,
+		Cohorts: cohorts,
+		Invites: invites,
+	}
+}
+
+type ActivityResponse struct {
+	Days       int               `json:"days"`
+	TopMembers []*MemberActivity `json:"top_members"`
+	Roles      []*RoleActivity   `json:"roles"`
+}
+
+// HandleStatsActivity returns the most active members and the messages per role,
+// the members are left out on the public page
+func HandleStatsActivity(w http.ResponseWriter, r *http.Request, isPublicAccess bool) interface{} {
+	activeGuild, _ := web.GetBaseCPContextData(r.Context())
+
+	conf := GetConfigWeb(activeGuild.ID)
+	if conf == nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil
+	}
+
+	if (!conf.Public && isPublicAccess) || !conf.UserStats {
+		return nil
+	}
+
+	numDays := 7
+	if r.URL.Query().Get("days") != "" {
+		numDays, _ = strconv.Atoi(r.URL.Query().Get("days"))
+	}
+
+	if !premium.ContextPremium(r.Context()) && (numDays > 7 || numDays <= 0) {
+		numDays = 7
+	}
+
+	// user stats are only kept for 90 days
+	if numDays <= 0 || numDays > 90 {
+		numDays = 90
+	} else if numDays < 7 {
+		numDays = 7
+	}
+
+	key := "activity:" + strconv.FormatInt(activeGuild.ID, 10) + ":" + strconv.Itoa(numDays)
+	resp := WebActivityCache.Get(key)
+	if resp == nil {
+		return &ActivityResponse{Days: numDays, TopMembers: []*MemberActivity{}, Roles: []*RoleActivity{}}
+	}
+
+	cop := *resp.(*ActivityResponse)
+	if isPublicAccess {
+		cop.TopMembers = []*MemberActivity{}
+	}
+
+	// fill in the role names, leaving out roles that have been deleted
+	roles := make([]*RoleActivity, 0, len(cop.Roles))
+	for _, v := range cop.Roles {
+		for _, gr := range activeGuild.Roles {
+			if gr.ID == v.RoleID {
+				roleCop := *v
+				roleCop.Name = gr.Name
+				roles = append(roles, &roleCop)
+				break
+			}
+		}
+	}
+	cop.Roles = roles
+
+	return &cop
+}
+
+func cacheActivityFetcher(key string) interface{} {
+	split := strings.Split(key, ":")
+	if len(split) < 3 {
+		logger.Error("invalid cache key: ", key)
+		return nil
+	}
+
+	guildID, _ := strconv.ParseInt(split[1], 10, 64)
+	days, _ := strconv.Atoi(split[2])
+
+	topMembers, err := RetrieveTopMembers(context.Background(), guildID, time.Now(), days, 15)
+	if err != nil {
+		logger.WithError(err).WithField("cache_key", key).Error("failed retrieving top members")
+		return nil
+	}
+
+	err = AddMemberDetails(guildID, topMembers)
+	if err != nil {
+		logger.WithError(err).WithField("cache_key", key).Error("failed retrieving top members details")
+	}
+
+	roles, err := RetrieveRoleActivity(context.Background(), guildID, time.Now(), days)
+	if err != nil {
+		logger.WithError(err).WithField("cache_key", key).Error("failed retrieving role activity")
+		return nil
+	}
+
+	return &ActivityResponse{
+		Days:       days,
+		TopMembers: topMembers,
+		Roles:      roles,
+	}
+}
+
+// HandleInactiveMembers returns the members that have not sent a message in the last days, control panel only
+func HandleInactiveMembers(w http.ResponseWriter, r *http.Request) interface{} {
+	activeGuild, _ := web.GetBaseCPContextData(r.Context())
+
+	conf := GetConfigWeb(activeGuild.ID)
+	if conf == nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil
+	}
+
+	if !conf.UserStats {
+		return []*InactiveMember{}
+	}
+
+	days, _ := strconv.Atoi(r.URL.Query().Get("days"))
+	if days < 1 {
+		days = 30
+	} else if days > 365 {
+		days = 365
+	}
+
+	members, err := RetrieveInactiveMembers(r.Context(), activeGuild.ID, time.Now(), days, 100)
+	if err != nil {
+		web.CtxLogger(r.Context()).WithError(err).Error("Failed retrieving inactive members")
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil
+	}
+
+	return members
+}
+
 func GetConfigWeb(guildID int64) *ServerStatsConfig {
 	config := WebConfigCache.Get(int(guildID))
 	if config == nil {
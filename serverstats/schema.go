@@ -46,7 +46,10 @@ var dbSchemas = []string{
 		public BOOLEAN,
 		ignore_channels TEXT
 	);
-	`, `
+	`,
+	`ALTER TABLE server_stats_configs ADD COLUMN IF NOT EXISTS user_stats BOOLEAN NOT NULL DEFAULT FALSE;`,
+	`ALTER TABLE server_stats_configs ADD COLUMN IF NOT EXISTS track_invites BOOLEAN NOT NULL DEFAULT FALSE;`,
+	`
 	CREATE TABLE IF NOT EXISTS server_stats_hourly_periods_messages (
 		guild_id BIGINT NOT NULL,
 		t TIMESTAMP WITH TIME ZONE NOT NULL,
@@ -90,4 +93,88 @@ var dbSchemas = []string{
 	// we don't care about indexing t of non-premium rows, this means we can also use it in the cleanup
 	// without needing to filter out premium rows, since they're not included in the index at all
 	`CREATE INDEX IF NOT EXISTS server_stats_periods_compressed_t_nonpremium_idx ON server_stats_periods_compressed(t) WHERE premium=false;`,
+
+	// individual joins are only kept until the cohorts they're part of can no longer change
+	`
+	CREATE TABLE IF NOT EXISTS server_stats_member_joins (
+		guild_id BIGINT NOT NULL,
+		user_id BIGINT NOT NULL,
+		joined_at TIMESTAMP WITH TIME ZONE NOT NULL,
+		left_at TIMESTAMP WITH TIME ZONE,
+		invite_code TEXT NOT NULL DEFAULT '',
+
+		PRIMARY KEY(guild_id, user_id, joined_at)
+	);
+	`,
+	`CREATE INDEX IF NOT EXISTS server_stats_member_joins_guild_joined_at_idx ON server_stats_member_joins(guild_id, joined_at);`,
+	`CREATE INDEX IF NOT EXISTS server_stats_member_joins_joined_at_idx ON server_stats_member_joins(joined_at);`,
+	`
+	CREATE TABLE IF NOT EXISTS server_stats_cohorts (
+		guild_id BIGINT NOT NULL,
+		week DATE NOT NULL,
+
+		joins INT NOT NULL,
+		eligible_1d INT NOT NULL,
+		retained_1d INT NOT NULL,
+		eligible_7d INT NOT NULL,
+		retained_7d INT NOT NULL,
+		eligible_30d INT NOT NULL,
+		retained_30d INT NOT NULL,
+
+		PRIMARY KEY(guild_id, week)
+	);
+	`, `
+	CREATE TABLE IF NOT EXISTS server_stats_invite_sources (
+		guild_id BIGINT NOT NULL,
+		t DATE NOT NULL,
+		invite_code TEXT NOT NULL,
+
+		joins INT NOT NULL,
+
+		PRIMARY KEY(guild_id, t, invite_code)
+	);
+	`,
+
+	// per user and role message counts, only recorded if enabled in the config
+	`
+	CREATE TABLE IF NOT EXISTS server_stats_user_messages (
+		guild_id BIGINT NOT NULL,
+		t DATE NOT NULL,
+		user_id BIGINT NOT NULL,
+
+		num_messages INT NOT NULL,
+
+		PRIMARY KEY(guild_id, t, user_id)
+	);
+	`,
+	`CREATE INDEX IF NOT EXISTS server_stats_user_messages_user_id_idx ON server_stats_user_messages(user_id);`,
+	`CREATE INDEX IF NOT EXISTS server_stats_user_messages_t_idx ON server_stats_user_messages(t);`,
+	`
+	CREATE TABLE IF NOT EXISTS server_stats_role_messages (
+		guild_id BIGINT NOT NULL,
+		t DATE NOT NULL,
+		role_id BIGINT NOT NULL,
+
+		num_messages INT NOT NULL,
+
+		PRIMARY KEY(guild_id, t, role_id)
+	);
+	`, `
+	CREATE TABLE IF NOT EXISTS server_stats_user_activity (
+		guild_id BIGINT NOT NULL,
+		user_id BIGINT NOT NULL,
+
+		last_active DATE NOT NULL,
+
+		PRIMARY KEY(guild_id, user_id)
+	);
+	`,
+	`CREATE INDEX IF NOT EXISTS server_stats_user_activity_guild_last_active_idx ON server_stats_user_activity(guild_id, last_active);`,
+	`CREATE INDEX IF NOT EXISTS server_stats_user_activity_user_id_idx ON server_stats_user_activity(user_id);`,
+	`
+	CREATE TABLE IF NOT EXISTS server_stats_user_optouts (
+		user_id BIGINT PRIMARY KEY,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL
+	);
+	`,
 }
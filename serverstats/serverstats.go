@@ -47,6 +47,8 @@ func RegisterPlugin() {
 type ServerStatsConfig struct {
 	Public         bool
 	IgnoreChannels string
+	UserStats      bool
+	TrackInvites   bool
 
 	ParsedChannels []int64
 }
@@ -65,6 +67,8 @@ func configFromModel(model *models.ServerStatsConfig) *ServerStatsConfig {
 	conf := &ServerStatsConfig{
 		Public:         model.Public.Bool,
 		IgnoreChannels: model.IgnoreChannels.String,
+		UserStats:      model.UserStats,
+		TrackInvites:   model.TrackInvites,
 	}
 	conf.ParseChannels()
 
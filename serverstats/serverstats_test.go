@@ -14,7 +14,7 @@ import (
 var db *sql.DB
 
 func TestMain(m *testing.M) {
-	conn, err := testutils.InitPQ([]string{"server_stats_hourly_periods_messages", "server_stats_hourly_periods_misc", "server_stats_periods_compressed", "server_stats_periods", "server_stats_member_periods"}, append(legacyDBSchemas, dbSchemas...))
+	conn, err := testutils.InitPQ([]string{"server_stats_hourly_periods_messages", "server_stats_hourly_periods_misc", "server_stats_periods_compressed", "server_stats_periods", "server_stats_member_periods", "server_stats_member_joins", "server_stats_cohorts", "server_stats_invite_sources", "server_stats_user_messages", "server_stats_role_messages", "server_stats_user_activity", "server_stats_user_optouts"}, append(legacyDBSchemas, dbSchemas...))
 	if err != nil {
 		fmt.Println("Failed connecting to postgres database, not running tests: ", err)
 		return
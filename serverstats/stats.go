@@ -143,3 +143,69 @@ func RetrieveChartDataPeriods(ctx context.Context, guildID int64, t time.Time, d
 
 	return periods, nil
 }
+
+func RetrieveRetentionCohorts(ctx context.Context, guildID int64, t time.Time, weeks int) ([]*RetentionCohort, error) {
+	const q = `SELECT week, joins, eligible_1d, retained_1d, eligible_7d, retained_7d, eligible_30d, retained_30d
+	FROM server_stats_cohorts
+	WHERE guild_id = $1 AND week >= $2
+	ORDER BY week DESC;`
+
+	rows, err := common.PQ.QueryContext(ctx, q, guildID, weekStart(t).AddDate(0, 0, -7*(weeks-1)))
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	defer rows.Close()
+
+	cohorts := make([]*RetentionCohort, 0, weeks)
+	for rows.Next() {
+		c := &RetentionCohort{}
+		err = rows.Scan(&c.Week, &c.Joins, &c.Eligible[0], &c.Retained[0], &c.Eligible[1], &c.Retained[1], &c.Eligible[2], &c.Retained[2])
+		if err != nil {
+			return nil, errors.WithStackIf(err)
+		}
+
+		cohorts = append(cohorts, c)
+	}
+
+	return cohorts, nil
+}
+
+type InviteSource struct {
+	// empty if it could not be determined, e.g. because the bot lacks the manage server permission or the vanity url was used
+	Code  string `json:"code"`
+	Joins int    `json:"joins"`
+}
+
+func RetrieveInviteSources(ctx context.Context, guildID int64, t time.Time, days int) ([]*InviteSource, error) {
+	const q = `SELECT invite_code, SUM(joins)
+	FROM server_stats_invite_sources
+	WHERE guild_id = $1 AND t > $2
+	GROUP BY invite_code
+	ORDER BY SUM(joins) DESC
+	LIMIT 25;`
+
+	if days <= 0 {
+		days = 1000
+	}
+
+	rows, err := common.PQ.QueryContext(ctx, q, guildID, t.Add(time.Hour*-24*time.Duration(days+1)))
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	defer rows.Close()
+
+	sources := make([]*InviteSource, 0)
+	for rows.Next() {
+		s := &InviteSource{}
+		err = rows.Scan(&s.Code, &s.Joins)
+		if err != nil {
+			return nil, errors.WithStackIf(err)
+		}
+
+		sources = append(sources, s)
+	}
+
+	return sources, nil
+}
@@ -0,0 +1,441 @@
+package serverstats
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/ThatBathroom/yagpdb/v2/bot"
+	"github.com/ThatBathroom/yagpdb/v2/bot/botrest"
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/serverstats/messagestatscollector"
+	"github.com/lib/pq"
+	"github.com/mediocregopher/radix/v3"
+)
+
+// per user message counts are deleted after this, the per role counts and the last active day are kept
+const userMessagesMaxAge = time.Hour * 24 * 90
+
+var cachedOptOuts = common.CacheSet.RegisterSlot("serverstats_user_optouts", nil, int64(0))
+
+// BotCachedIsUserOptedOut returns true if the user opted out of having their messages counted
+func BotCachedIsUserOptedOut(ctx context.Context, userID int64) (bool, error) {
+	v, err := cachedOptOuts.GetCustomFetch(userID, func(key interface{}) (interface{}, error) {
+		return IsUserOptedOut(ctx, userID)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return v.(bool), nil
+}
+
+func IsUserOptedOut(ctx context.Context, userID int64) (bool, error) {
+	var optedOut bool
+	err := common.PQ.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM server_stats_user_optouts WHERE user_id = $1)", userID).Scan(&optedOut)
+	return optedOut, err
+}
+
+// SetUserOptOut opts the user out of (or back into) per user message stats in all servers,
+// opting out deletes the stats recorded for them so far
+func SetUserOptOut(ctx context.Context, userID int64, optOut bool) error {
+	if !optOut {
+		_, err := common.PQ.ExecContext(ctx, "DELETE FROM server_stats_user_optouts WHERE user_id = $1", userID)
+		return err
+	}
+
+	tx, err := common.PQ.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.WithStackIf(err)
+	}
+
+	_, err = tx.Exec("INSERT INTO server_stats_user_optouts (user_id, created_at) VALUES ($1, now()) ON CONFLICT (user_id) DO NOTHING", userID)
+	if err != nil {
+		tx.Rollback()
+		return errors.WithStackIf(err)
+	}
+
+	_, err = tx.Exec("DELETE FROM server_stats_user_messages WHERE user_id = $1", userID)
+	if err != nil {
+		tx.Rollback()
+		return errors.WithStackIf(err)
+	}
+
+	_, err = tx.Exec("DELETE FROM server_stats_user_activity WHERE user_id = $1", userID)
+	if err != nil {
+		tx.Rollback()
+		return errors.WithStackIf(err)
+	}
+
+	return errors.WithStackIf(tx.Commit())
+}
+
+// DeleteGuildUserStats deletes the per user stats of a guild, used when they're disabled
+func DeleteGuildUserStats(ctx context.Context, guildID int64) error {
+	_, err := common.PQ.ExecContext(ctx, "DELETE FROM server_stats_user_messages WHERE guild_id = $1", guildID)
+	if err != nil {
+		return errors.WithStackIf(err)
+	}
+
+	_, err = common.PQ.ExecContext(ctx, "DELETE FROM server_stats_user_activity WHERE guild_id = $1", guildID)
+	return errors.WithStackIf(err)
+}
+
+type guildUserStats struct {
+	Users map[int64]int64
+	Roles map[int64]int64
+}
+
+func (c *Compressor) collectUserStats(year, day int) (map[int64]*guildUserStats, error) {
+	var activeGuilds []int64
+	err := common.RedisPool.Do(radix.Cmd(&activeGuilds, "SMEMBERS", messagestatscollector.KeyActiveUserStatsGuilds(year, day)))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64]*guildUserStats)
+	for _, g := range activeGuilds {
+		stats := &guildUserStats{
+			Users: make(map[int64]int64),
+			Roles: make(map[int64]int64),
+		}
+
+		err = common.RedisPool.Do(radix.Pipeline(
+			radix.Cmd(&stats.Users, "ZRANGE", messagestatscollector.KeyUserMessageStats(g, year, day), "0", "-1", "WITHSCORES"),
+			radix.Cmd(&stats.Roles, "ZRANGE", messagestatscollector.KeyRoleMessageStats(g, year, day), "0", "-1", "WITHSCORES"),
+		))
+		if err != nil {
+			return nil, err
+		}
+
+		result[g] = stats
+	}
+
+	return result, nil
+}
+
+// saveUserStats saves the per user and role message counts of the day t,
+// users that opted out after their messages were collected are skipped
+func saveUserStats(tx *sql.Tx, t time.Time, stats map[int64]*guildUserStats) error {
+	const userQ = `INSERT INTO server_stats_user_messages (guild_id, t, user_id, num_messages)
+SELECT $1, $2, $3, $4
+WHERE NOT EXISTS (SELECT 1 FROM server_stats_user_optouts WHERE user_id = $3)
+ON CONFLICT (guild_id, t, user_id) DO UPDATE SET
+num_messages = server_stats_user_messages.num_messages + $4;`
+
+	const activityQ = `INSERT INTO server_stats_user_activity (guild_id, user_id, last_active)
+SELECT $1, $2, $3
+WHERE NOT EXISTS (SELECT 1 FROM server_stats_user_optouts WHERE user_id = $2)
+ON CONFLICT (guild_id, user_id) DO UPDATE SET
+last_active = GREATEST(server_stats_user_activity.last_active, $3);`
+
+	const roleQ = `INSERT INTO server_stats_role_messages (guild_id, t, role_id, num_messages)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (guild_id, t, role_id) DO UPDATE SET
+num_messages = server_stats_role_messages.num_messages + $4;`
+
+	for g, s := range stats {
+		for user, count := range s.Users {
+			_, err := tx.Exec(userQ, g, t, user, count)
+			if err != nil {
+				return errors.WithStackIf(err)
+			}
+
+			_, err = tx.Exec(activityQ, g, user, t)
+			if err != nil {
+				return errors.WithStackIf(err)
+			}
+		}
+
+		for role, count := range s.Roles {
+			_, err := tx.Exec(roleQ, g, t, role, count)
+			if err != nil {
+				return errors.WithStackIf(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Compressor) cleanTempUserStats(year, day int) error {
+	var activeGuilds []int64
+	err := common.RedisPool.Do(radix.Cmd(&activeGuilds, "SMEMBERS", messagestatscollector.KeyActiveUserStatsGuilds(year, day)))
+	if err != nil {
+		return errors.WithStackIf(err)
+	}
+
+	for _, g := range activeGuilds {
+		err = common.RedisPool.Do(radix.Cmd(nil, "DEL", messagestatscollector.KeyUserMessageStats(g, year, day), messagestatscollector.KeyRoleMessageStats(g, year, day)))
+		if err != nil {
+			return errors.WithStackIf(err)
+		}
+	}
+
+	err = common.RedisPool.Do(radix.Cmd(nil, "DEL", messagestatscollector.KeyActiveUserStatsGuilds(year, day)))
+	return errors.WithStackIf(err)
+}
+
+func cleanupUserStats(now time.Time) error {
+	_, err := common.PQ.Exec("DELETE FROM server_stats_user_messages WHERE t < $1", now.Add(-userMessagesMaxAge))
+	return errors.WithStackIf(err)
+}
+
+type UserMessageStats struct {
+	Today      int64
+	Week       int64
+	Month      int64
+	LastActive time.Time
+	// rank by messages in the last 30 days, 0 if they haven't sent any
+	Rank int
+}
+
+// RetrieveUserMessageStats returns the message stats of a single user, today's messages are
+// read from redis since they haven't been compressed yet
+func RetrieveUserMessageStats(ctx context.Context, guildID, userID int64, t time.Time) (*UserMessageStats, error) {
+	t = t.UTC()
+	stats := &UserMessageStats{}
+
+	var today int64
+	err := common.RedisPool.Do(radix.FlatCmd(&today, "ZSCORE", messagestatscollector.KeyUserMessageStats(guildID, t.Year(), t.YearDay()), userID))
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+	stats.Today = today
+
+	const q = `SELECT
+	COALESCE(SUM(num_messages) FILTER (WHERE t > $3), 0),
+	COALESCE(SUM(num_messages), 0)
+	FROM server_stats_user_messages
+	WHERE guild_id = $1 AND user_id = $2 AND t > $4;`
+
+	err = common.PQ.QueryRowContext(ctx, q, guildID, userID, t.AddDate(0, 0, -7), t.AddDate(0, 0, -30)).Scan(&stats.Week, &stats.Month)
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	if stats.Month > 0 {
+		const rankQ = `SELECT COUNT(*) + 1 FROM (
+		SELECT user_id FROM server_stats_user_messages
+		WHERE guild_id = $1 AND t > $3
+		GROUP BY user_id
+		HAVING SUM(num_messages) > $2
+	) AS ranked;`
+
+		err = common.PQ.QueryRowContext(ctx, rankQ, guildID, stats.Month, t.AddDate(0, 0, -30)).Scan(&stats.Rank)
+		if err != nil {
+			return nil, errors.WithStackIf(err)
+		}
+	}
+
+	err = common.PQ.QueryRowContext(ctx, "SELECT last_active FROM server_stats_user_activity WHERE guild_id = $1 AND user_id = $2", guildID, userID).Scan(&stats.LastActive)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, errors.WithStackIf(err)
+	}
+
+	if stats.Today > 0 {
+		stats.LastActive = t
+	}
+
+	// today's messages are not in the compressed stats yet
+	stats.Week += stats.Today
+	stats.Month += stats.Today
+
+	return stats, nil
+}
+
+type MemberActivity struct {
+	UserID   int64  `json:"user_id,string"`
+	Username string `json:"username"`
+	Avatar   string `json:"avatar"`
+	Messages int64  `json:"messages"`
+}
+
+func RetrieveTopMembers(ctx context.Context, guildID int64, t time.Time, days int, limit int) ([]*MemberActivity, error) {
+	const q = `SELECT user_id, SUM(num_messages)
+	FROM server_stats_user_messages
+	WHERE guild_id = $1 AND t > $2
+	GROUP BY user_id
+	ORDER BY SUM(num_messages) DESC
+	LIMIT $3;`
+
+	if days <= 0 {
+		days = 1000
+	}
+
+	rows, err := common.PQ.QueryContext(ctx, q, guildID, t.Add(time.Hour*-24*time.Duration(days+1)), limit)
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	defer rows.Close()
+
+	result := make([]*MemberActivity, 0, limit)
+	for rows.Next() {
+		m := &MemberActivity{}
+		err = rows.Scan(&m.UserID, &m.Messages)
+		if err != nil {
+			return nil, errors.WithStackIf(err)
+		}
+
+		result = append(result, m)
+	}
+
+	return result, nil
+}
+
+type RoleActivity struct {
+	RoleID   int64  `json:"role_id,string"`
+	Name     string `json:"name"`
+	Messages int64  `json:"messages"`
+}
+
+func RetrieveRoleActivity(ctx context.Context, guildID int64, t time.Time, days int) ([]*RoleActivity, error) {
+	const q = `SELECT role_id, SUM(num_messages)
+	FROM server_stats_role_messages
+	WHERE guild_id = $1 AND t > $2
+	GROUP BY role_id
+	ORDER BY SUM(num_messages) DESC
+	LIMIT 25;`
+
+	if days <= 0 {
+		days = 1000
+	}
+
+	rows, err := common.PQ.QueryContext(ctx, q, guildID, t.Add(time.Hour*-24*time.Duration(days+1)))
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	defer rows.Close()
+
+	result := make([]*RoleActivity, 0)
+	for rows.Next() {
+		r := &RoleActivity{}
+		err = rows.Scan(&r.RoleID, &r.Messages)
+		if err != nil {
+			return nil, errors.WithStackIf(err)
+		}
+
+		result = append(result, r)
+	}
+
+	return result, nil
+}
+
+type InactiveMember struct {
+	UserID     int64     `json:"user_id,string"`
+	Username   string    `json:"username"`
+	Avatar     string    `json:"avatar"`
+	LastActive time.Time `json:"last_active"`
+}
+
+// RetrieveInactiveMembers returns the members that have sent messages before but not in the last days days,
+// members that can't be found anymore are assumed to have left and removed from the activity table
+func RetrieveInactiveMembers(ctx context.Context, guildID int64, t time.Time, days int, limit int) ([]*InactiveMember, error) {
+	const q = `SELECT user_id, last_active
+	FROM server_stats_user_activity
+	WHERE guild_id = $1 AND last_active < $2
+	ORDER BY last_active ASC
+	LIMIT $3;`
+
+	rows, err := common.PQ.QueryContext(ctx, q, guildID, t.AddDate(0, 0, -days), limit)
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
+	result := make([]*InactiveMember, 0, limit)
+	userIDs := make([]int64, 0, limit)
+	for rows.Next() {
+		m := &InactiveMember{}
+		err = rows.Scan(&m.UserID, &m.LastActive)
+		if err != nil {
+			rows.Close()
+			return nil, errors.WithStackIf(err)
+		}
+
+		result = append(result, m)
+		userIDs = append(userIDs, m.UserID)
+	}
+	rows.Close()
+
+	if len(result) < 1 {
+		return result, nil
+	}
+
+	members, err := fetchMembers(guildID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]*InactiveMember, 0, len(result))
+	var gone []int64
+OUTER:
+	for _, v := range result {
+		for _, m := range members {
+			if m.User.ID == v.UserID {
+				v.Username = m.User.String()
+				v.Avatar = m.User.AvatarURL("256")
+				filtered = append(filtered, v)
+				continue OUTER
+			}
+		}
+
+		gone = append(gone, v.UserID)
+	}
+
+	if len(gone) > 0 {
+		_, err = common.PQ.ExecContext(ctx, "DELETE FROM server_stats_user_activity WHERE guild_id = $1 AND user_id = ANY($2)", guildID, pq.Int64Array(gone))
+		if err != nil {
+			logger.WithError(err).WithField("guild", guildID).Error("failed removing members that left from activity")
+		}
+	}
+
+	return filtered, nil
+}
+
+// AddMemberDetails fills in the username and avatar of the members
+func AddMemberDetails(guildID int64, activity []*MemberActivity) error {
+	if len(activity) < 1 {
+		return nil
+	}
+
+	userIDs := make([]int64, len(activity))
+	for i, v := range activity {
+		userIDs[i] = v.UserID
+		v.Username = discordgo.StrID(v.UserID)
+	}
+
+	members, err := fetchMembers(guildID, userIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range activity {
+		for _, m := range members {
+			if m.User.ID == v.UserID {
+				v.Username = m.User.String()
+				v.Avatar = m.User.AvatarURL("256")
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func fetchMembers(guildID int64, userIDs []int64) ([]*discordgo.Member, error) {
+	if !bot.Running {
+		return botrest.GetMembers(guildID, userIDs...)
+	}
+
+	var members []*discordgo.Member
+	tmp, err := bot.GetMembers(guildID, userIDs...)
+	for _, v := range tmp {
+		members = append(members, v.DgoMember())
+	}
+
+	return members, err
+}
@@ -1,12 +1,22 @@
 package soundboard
 
 import (
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"emperror.dev/errors"
 	"github.com/ThatBathroom/yagpdb/v2/analytics"
+	"github.com/ThatBathroom/yagpdb/v2/bot"
 	"github.com/ThatBathroom/yagpdb/v2/commands"
+	"github.com/ThatBathroom/yagpdb/v2/common"
 	"github.com/ThatBathroom/yagpdb/v2/lib/dcmd"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
 	"github.com/ThatBathroom/yagpdb/v2/lib/dstate"
 	"github.com/ThatBathroom/yagpdb/v2/soundboard/models"
 )
@@ -64,23 +74,152 @@ func (p *Plugin) AddCommands() {
 					}
 				}
 
-				var voiceChannel int64
-				vs := data.GuildData.GS.GetVoiceState(data.Author.ID)
-				if vs != nil {
-					voiceChannel = vs.ChannelID
+				return p.playSounds(data, sound)
+			},
+		},
+
+		&commands.YAGCommand{
+			CmdCategory:  commands.CategoryFun,
+			Name:         "SoundboardAdd",
+			Aliases:      []string{"sbadd"},
+			Description:  "Adds a soundboard sound from the file attached to the message, optionally trimmed to start and end at the given seconds. Requires the Manage Server permission or one of the upload roles set in the control panel.",
+			RequiredArgs: 1,
+			Arguments: []*dcmd.ArgDef{
+				{Name: "Name", Type: dcmd.String},
+			},
+			ArgSwitches: []*dcmd.ArgDef{
+				{Name: "start", Help: "Start of the sound in seconds", Type: &dcmd.FloatArg{Min: 0, Max: MaxTrimMS / 1000}},
+				{Name: "end", Help: "End of the sound in seconds", Type: &dcmd.FloatArg{Min: 0, Max: MaxTrimMS / 1000}},
+			},
+			DefaultEnabled: true,
+			RunFunc:        cmdFuncAddSound,
+		},
+
+		&commands.YAGCommand{
+			CmdCategory:         commands.CategoryFun,
+			Name:                "SoundboardRandom",
+			Aliases:             []string{"sbrandom", "sbr"},
+			Description:         "Play a random soundboard sound out of the ones you can play",
+			SlashCommandEnabled: true,
+			DefaultEnabled:      true,
+			RunFunc: func(data *dcmd.Data) (interface{}, error) {
+				sounds, err := GetSoundboardSounds(data.GuildData.GS.ID, data.Context())
+				if err != nil {
+					return nil, errors.WithMessage(err, "GetSoundboardSounds")
+				}
+
+				candidates := make([]*models.SoundboardSound, 0, len(sounds))
+				for _, v := range PlayableSounds(sounds, data.GuildData.MS.Member.Roles) {
+					left, err := cooldownLeft(KeySoundCooldown(v.ID))
+					if err != nil {
+						return nil, err
+					}
+
+					if left <= 0 {
+						candidates = append(candidates, v)
+					}
 				}
 
-				if voiceChannel == 0 {
-					return "You're not in a voice channel", nil
+				if len(candidates) < 1 {
+					return "There are no sounds you can play right now", nil
 				}
 
-				go analytics.RecordActiveUnit(data.GuildData.GS.ID, p, "playing sound")
+				return p.playSounds(data, candidates[rand.Intn(len(candidates))])
+			},
+		},
 
-				if RequestPlaySound(data.GuildData.GS.ID, voiceChannel, data.ChannelID, sound.ID) {
-					return "Queued up", nil
+		&commands.YAGCommand{
+			CmdCategory: commands.CategoryFun,
+			Name:        "SoundboardPlaylist",
+			Aliases:     []string{"sbplaylist", "sbpl"},
+			Description: "Play, or list soundboard playlists. Sounds in the playlist you can't play are left out.",
+			Arguments: []*dcmd.ArgDef{
+				{Name: "Name", Type: dcmd.String},
+			},
+			SlashCommandEnabled: true,
+			DefaultEnabled:      true,
+			RunFunc: func(data *dcmd.Data) (interface{}, error) {
+				playlists, err := GetPlaylists(data.GuildData.GS.ID, data.Context())
+				if err != nil {
+					return nil, errors.WithMessage(err, "GetPlaylists")
+				}
+
+				if data.Args[0].Str() == "" {
+					return ListPlaylists(playlists), nil
+				}
+
+				var playlist *models.SoundboardPlaylist
+				for _, v := range playlists {
+					if strings.EqualFold(v.Name, data.Args[0].Str()) {
+						playlist = v
+						break
+					}
 				}
 
-				return "Playing it now", nil
+				if playlist == nil {
+					return "Playlist not found, " + ListPlaylists(playlists), nil
+				}
+
+				sounds, err := GetSoundboardSounds(data.GuildData.GS.ID, data.Context())
+				if err != nil {
+					return nil, errors.WithMessage(err, "GetSoundboardSounds")
+				}
+
+				toPlay := PlaylistSounds(playlist, PlayableSounds(sounds, data.GuildData.MS.Member.Roles))
+				if len(toPlay) < 1 {
+					return "You can't play any of the sounds in that playlist", nil
+				}
+
+				return p.playSounds(data, toPlay...)
+			},
+		},
+
+		&commands.YAGCommand{
+			CmdCategory: commands.CategoryFun,
+			Name:        "SoundboardQueue",
+			Aliases:     []string{"sbqueue", "sbq"},
+			Description: "Shows the soundboard queue. Skipping sounds others requested, or clearing the queue requires the Move Members permission.",
+			ArgSwitches: []*dcmd.ArgDef{
+				{Name: "skip", Help: "Skip the currently playing sound"},
+				{Name: "clear", Help: "Remove all the queued up sounds"},
+			},
+			SlashCommandEnabled: true,
+			DefaultEnabled:      true,
+			RunFunc: func(data *dcmd.Data) (interface{}, error) {
+				guildID := data.GuildData.GS.ID
+				current, queue := GetQueue(guildID)
+
+				skip := data.Switch("skip").Bool()
+				clearAll := data.Switch("clear").Bool()
+				if !skip && !clearAll {
+					return formatQueue(current, queue), nil
+				}
+
+				if current == nil && len(queue) < 1 {
+					return "Nothing is playing", nil
+				}
+
+				// members can always skip their own sounds
+				if clearAll || current == nil || current.RequestedBy != data.Author.ID {
+					ok, err := bot.AdminOrPermMS(guildID, data.ChannelID, data.GuildData.MS, discordgo.PermissionVoiceMoveMembers)
+					if err != nil {
+						return nil, err
+					}
+					if !ok {
+						return "You need the Move Members permission to do that", nil
+					}
+				}
+
+				if clearAll {
+					return fmt.Sprintf("Removed %d sound(s) from the queue", clearQueue(guildID)), nil
+				}
+
+				skipped := skipSound(guildID)
+				if skipped == nil {
+					return "Nothing is playing", nil
+				}
+
+				return "Skipped `" + skipped.SoundName + "`", nil
 			},
 		},
 
@@ -101,6 +240,234 @@ func (p *Plugin) AddCommands() {
 		})
 }
 
+func cmdFuncAddSound(data *dcmd.Data) (interface{}, error) {
+	// slash commands don't have the attachments of a message
+	if data.TraditionalTriggerData == nil {
+		return "Use this command as a normal message command with the sound attached", nil
+	}
+
+	guildID := data.GuildData.GS.ID
+
+	conf, err := GetConfig(guildID, data.Context())
+	if err != nil {
+		return nil, errors.WithMessage(err, "GetConfig")
+	}
+
+	if !common.ContainsInt64SliceOneOf(data.GuildData.MS.Member.Roles, conf.UploadRoles) {
+		ok, err := bot.AdminOrPermMS(guildID, data.ChannelID, data.GuildData.MS, discordgo.PermissionManageGuild)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return "You need the Manage Server permission or one of the soundboard upload roles to add sounds", nil
+		}
+	}
+
+	name := strings.TrimSpace(data.Args[0].Str())
+	if name == "" || utf8.RuneCountInString(name) > 100 {
+		return "The name has to be between 1 and 100 characters long", nil
+	}
+
+	attachments := data.TraditionalTriggerData.Message.Attachments
+	if len(attachments) < 1 {
+		return "Attach the sound file to the message", nil
+	}
+
+	attachment := attachments[0]
+	if attachment.Size > MaxSoundFileSize {
+		return "Max 10MB files allowed", nil
+	}
+
+	// they would skip the transcoder, and with it the trimming and loudness normalization
+	if strings.HasSuffix(strings.ToLower(attachment.Filename), ".dca") {
+		return "Already transcoded .dca files can only be added in the control panel, attach the original sound file instead", nil
+	}
+
+	trimStart := int(data.Switch("start").Float64() * 1000)
+	trimEnd := int(data.Switch("end").Float64() * 1000)
+	if err := ValidateTrim(trimStart, trimEnd); err != nil {
+		return err.Error(), nil
+	}
+
+	maxSounds, err := MaxSoundsForGuild(guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	problem, err := CheckNewSound(data.Context(), guildID, name, maxSounds)
+	if err != nil || problem != "" {
+		return problem, err
+	}
+
+	resp, err := attachmentHTTPClient.Get(attachment.URL)
+	if err != nil {
+		return "Failed downloading the attachment", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "Failed downloading the attachment", nil
+	}
+
+	sound := &models.SoundboardSound{
+		GuildID:     guildID,
+		Name:        name,
+		TrimStartMS: trimStart,
+		TrimEndMS:   trimEnd,
+	}
+
+	// don't trust the size discord reported, read at most one byte more than allowed so too big files are noticed
+	tooBig, err := QueueNewSound(data.Context(), sound, io.LimitReader(resp.Body, MaxSoundFileSize+1), false)
+	if tooBig {
+		return "Max 10MB files allowed", nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return "Added `" + name + "`, it will be ready to play once it's been processed", nil
+}
+
+// used to download the attachments of SoundboardAdd
+var attachmentHTTPClient = &http.Client{
+	Timeout: time.Minute,
+}
+
+// playSounds queues up the sounds in the voice channel of the author, unless the author or the sounds are on cooldown
+func (p *Plugin) playSounds(data *dcmd.Data, sounds ...*models.SoundboardSound) (interface{}, error) {
+	guildID := data.GuildData.GS.ID
+
+	var voiceChannel int64
+	vs := data.GuildData.GS.GetVoiceState(data.Author.ID)
+	if vs != nil {
+		voiceChannel = vs.ChannelID
+	}
+
+	if voiceChannel == 0 {
+		return "You're not in a voice channel", nil
+	}
+
+	conf, err := GetConfig(guildID, data.Context())
+	if err != nil {
+		return nil, errors.WithMessage(err, "GetConfig")
+	}
+
+	keyUser := KeyUserCooldown(guildID, data.Author.ID)
+	left, err := takeCooldown(keyUser, conf.UserCooldownSeconds)
+	if err != nil {
+		return nil, err
+	}
+	if left > 0 {
+		return "You're on cooldown, you can play another sound in " + humanizeCooldown(left), nil
+	}
+
+	// the cooldowns are taken before playing, they're given back if nothing ends up being played
+	taken := []string{keyUser}
+	if conf.UserCooldownSeconds < 1 {
+		taken = nil
+	}
+
+	toPlay := make([]*models.SoundboardSound, 0, len(sounds))
+	for _, v := range sounds {
+		key := KeySoundCooldown(v.ID)
+		left, err := takeCooldown(key, v.CooldownSeconds)
+		if err != nil {
+			releaseCooldowns(taken...)
+			return nil, err
+		}
+
+		if left > 0 {
+			if len(sounds) == 1 {
+				releaseCooldowns(taken...)
+				return "`" + v.Name + "` is on cooldown for another " + humanizeCooldown(left), nil
+			}
+			continue
+		}
+
+		if v.CooldownSeconds > 0 {
+			taken = append(taken, key)
+		}
+		toPlay = append(toPlay, v)
+	}
+
+	if len(toPlay) < 1 {
+		releaseCooldowns(taken...)
+		return "All of those sounds are on cooldown", nil
+	}
+
+	queued, err := RequestPlaySound(guildID, voiceChannel, data.ChannelID, data.Author, toPlay...)
+	if err != nil {
+		releaseCooldowns(taken...)
+		if err == ErrQueueFull {
+			return fmt.Sprintf("The queue is full (max %d sounds), try again later", MaxQueueLength), nil
+		}
+		return nil, err
+	}
+
+	go analytics.RecordActiveUnit(guildID, p, "playing sound")
+
+	resp := "Playing it now"
+	if queued {
+		resp = "Queued up"
+	}
+
+	if len(toPlay) > 1 {
+		resp += fmt.Sprintf(" (%d sounds)", len(toPlay))
+	}
+	if skipped := len(sounds) - len(toPlay); skipped > 0 {
+		resp += fmt.Sprintf(", left out %d sound(s) on cooldown", skipped)
+	}
+
+	return resp, nil
+}
+
+func formatQueue(current *PlayRequest, queue []*PlayRequest) string {
+	if current == nil && len(queue) < 1 {
+		return "Nothing is playing"
+	}
+
+	var out strings.Builder
+	if current != nil {
+		out.WriteString("Now playing: `" + current.SoundName + "` (requested by " + current.RequestedByName + ")\n")
+	}
+
+	if len(queue) > 0 {
+		out.WriteString("Up next:\n")
+		for i, v := range queue {
+			out.WriteString(strconv.Itoa(i+1) + ". `" + v.SoundName + "` (requested by " + v.RequestedByName + ")\n")
+		}
+	}
+
+	return out.String()
+}
+
+// PlayableSounds returns the sounds that are ready to be played by a member with the given roles
+func PlayableSounds(sounds []*models.SoundboardSound, roles []int64) []*models.SoundboardSound {
+	result := make([]*models.SoundboardSound, 0, len(sounds))
+	for _, v := range sounds {
+		if TranscodingStatus(v.Status) == TranscodingStatusReady && CanPlaySound(v, roles) {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// PlaylistSounds returns the sounds of the playlist in order, leaving out the ones not in available
+func PlaylistSounds(playlist *models.SoundboardPlaylist, available []*models.SoundboardSound) []*models.SoundboardSound {
+	result := make([]*models.SoundboardSound, 0, len(playlist.Sounds))
+	for _, id := range playlist.Sounds {
+		for _, v := range available {
+			if int64(v.ID) == id {
+				result = append(result, v)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
 func ListSounds(sounds []*models.SoundboardSound, ms *dstate.MemberState) string {
 	canPlay := ""
 	restricted := ""
@@ -121,6 +488,19 @@ func ListSounds(sounds []*models.SoundboardSound, ms *dstate.MemberState) string
 		out += "No access: " + restricted[:len(restricted)-2] + "\n"
 	}
 
-	out += "\nPlay a sound with `sb <soundname>`"
+	out += "\nPlay a sound with `sb <soundname>`, or a random one with `sbrandom`"
 	return out
 }
+
+func ListPlaylists(playlists []*models.SoundboardPlaylist) string {
+	if len(playlists) < 1 {
+		return "This server has no soundboard playlists, they can be set up in the control panel"
+	}
+
+	names := make([]string, 0, len(playlists))
+	for _, v := range playlists {
+		names = append(names, "`"+v.Name+"`")
+	}
+
+	return "Soundboard playlists: " + strings.Join(names, ", ") + "\n\nPlay a playlist with `sbplaylist <name>`"
+}
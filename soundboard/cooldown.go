@@ -0,0 +1,67 @@
+package soundboard
+
+import (
+	"time"
+
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/mediocregopher/radix/v3"
+)
+
+// cooldownLeft returns how long is left of the cooldown stored in key, 0 if there's none
+func cooldownLeft(key string) (time.Duration, error) {
+	var ms int64
+	err := common.RedisPool.Do(radix.Cmd(&ms, "PTTL", key))
+	if err != nil || ms < 0 {
+		return 0, err
+	}
+
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+// takeCooldown starts the cooldown stored in key if there's none running, checking and starting it in one go so
+// concurrent plays can't both get past it, returns how long is left of the running cooldown if there was one
+func takeCooldown(key string, seconds int) (time.Duration, error) {
+	if seconds < 1 {
+		return 0, nil
+	}
+
+	// the running cooldown could expire between the two commands, in which case we try again
+	for i := 0; i < 2; i++ {
+		var set string
+		err := common.RedisPool.Do(radix.FlatCmd(&set, "SET", key, "1", "NX", "PX", seconds*1000))
+		if err != nil {
+			return 0, err
+		}
+
+		if set == "OK" {
+			return 0, nil
+		}
+
+		left, err := cooldownLeft(key)
+		if err != nil || left > 0 {
+			return left, err
+		}
+	}
+
+	return time.Millisecond, nil
+}
+
+// releaseCooldowns removes cooldowns that were taken for a play that didn't happen
+func releaseCooldowns(keys ...string) {
+	if len(keys) < 1 {
+		return
+	}
+
+	err := common.RedisPool.Do(radix.Cmd(nil, "DEL", keys...))
+	if err != nil {
+		logger.WithError(err).Error("failed releasing soundboard cooldowns")
+	}
+}
+
+func humanizeCooldown(left time.Duration) string {
+	if left < time.Second {
+		left = time.Second
+	}
+
+	return common.HumanizeDuration(common.DurationPrecisionSeconds, left.Round(time.Second))
+}
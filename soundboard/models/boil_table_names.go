@@ -4,7 +4,11 @@
 package models
 
 var TableNames = struct {
-	SoundboardSounds string
+	SoundboardConfigs   string
+	SoundboardPlaylists string
+	SoundboardSounds    string
 }{
-	SoundboardSounds: "soundboard_sounds",
+	SoundboardConfigs:   "soundboard_configs",
+	SoundboardPlaylists: "soundboard_playlists",
+	SoundboardSounds:    "soundboard_sounds",
 }
@@ -0,0 +1,902 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// SoundboardConfig is an object representing the database table.
+type SoundboardConfig struct {
+	GuildID             int64            `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	CreatedAt           time.Time        `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt           time.Time        `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	UserCooldownSeconds int              `boil:"user_cooldown_seconds" json:"user_cooldown_seconds" toml:"user_cooldown_seconds" yaml:"user_cooldown_seconds"`
+	UploadRoles         types.Int64Array `boil:"upload_roles" json:"upload_roles,omitempty" toml:"upload_roles" yaml:"upload_roles,omitempty"`
+
+	R *soundboardConfigR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L soundboardConfigL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SoundboardConfigColumns = struct {
+	GuildID             string
+	CreatedAt           string
+	UpdatedAt           string
+	UserCooldownSeconds string
+	UploadRoles         string
+}{
+	GuildID:             "guild_id",
+	CreatedAt:           "created_at",
+	UpdatedAt:           "updated_at",
+	UserCooldownSeconds: "user_cooldown_seconds",
+	UploadRoles:         "upload_roles",
+}
+
+var SoundboardConfigTableColumns = struct {
+	GuildID             string
+	CreatedAt           string
+	UpdatedAt           string
+	UserCooldownSeconds string
+	UploadRoles         string
+}{
+	GuildID:             "soundboard_configs.guild_id",
+	CreatedAt:           "soundboard_configs.created_at",
+	UpdatedAt:           "soundboard_configs.updated_at",
+	UserCooldownSeconds: "soundboard_configs.user_cooldown_seconds",
+	UploadRoles:         "soundboard_configs.upload_roles",
+}
+
+// Generated where
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertypes_Int64Array struct{ field string }
+
+func (w whereHelpertypes_Int64Array) EQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpertypes_Int64Array) NEQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpertypes_Int64Array) LT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_Int64Array) LTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_Int64Array) GT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_Int64Array) GTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpertypes_Int64Array) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpertypes_Int64Array) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var SoundboardConfigWhere = struct {
+	GuildID             whereHelperint64
+	CreatedAt           whereHelpertime_Time
+	UpdatedAt           whereHelpertime_Time
+	UserCooldownSeconds whereHelperint
+	UploadRoles         whereHelpertypes_Int64Array
+}{
+	GuildID:             whereHelperint64{field: "\"soundboard_configs\".\"guild_id\""},
+	CreatedAt:           whereHelpertime_Time{field: "\"soundboard_configs\".\"created_at\""},
+	UpdatedAt:           whereHelpertime_Time{field: "\"soundboard_configs\".\"updated_at\""},
+	UserCooldownSeconds: whereHelperint{field: "\"soundboard_configs\".\"user_cooldown_seconds\""},
+	UploadRoles:         whereHelpertypes_Int64Array{field: "\"soundboard_configs\".\"upload_roles\""},
+}
+
+// SoundboardConfigRels is where relationship names are stored.
+var SoundboardConfigRels = struct {
+}{}
+
+// soundboardConfigR is where relationships are stored.
+type soundboardConfigR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*soundboardConfigR) NewStruct() *soundboardConfigR {
+	return &soundboardConfigR{}
+}
+
+// soundboardConfigL is where Load methods for each relationship are stored.
+type soundboardConfigL struct{}
+
+var (
+	soundboardConfigAllColumns            = []string{"guild_id", "created_at", "updated_at", "user_cooldown_seconds", "upload_roles"}
+	soundboardConfigColumnsWithoutDefault = []string{"guild_id", "created_at", "updated_at"}
+	soundboardConfigColumnsWithDefault    = []string{"user_cooldown_seconds", "upload_roles"}
+	soundboardConfigPrimaryKeyColumns     = []string{"guild_id"}
+	soundboardConfigGeneratedColumns      = []string{}
+)
+
+type (
+	// SoundboardConfigSlice is an alias for a slice of pointers to SoundboardConfig.
+	// This should almost always be used instead of []SoundboardConfig.
+	SoundboardConfigSlice []*SoundboardConfig
+
+	soundboardConfigQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	soundboardConfigType                 = reflect.TypeOf(&SoundboardConfig{})
+	soundboardConfigMapping              = queries.MakeStructMapping(soundboardConfigType)
+	soundboardConfigPrimaryKeyMapping, _ = queries.BindMapping(soundboardConfigType, soundboardConfigMapping, soundboardConfigPrimaryKeyColumns)
+	soundboardConfigInsertCacheMut       sync.RWMutex
+	soundboardConfigInsertCache          = make(map[string]insertCache)
+	soundboardConfigUpdateCacheMut       sync.RWMutex
+	soundboardConfigUpdateCache          = make(map[string]updateCache)
+	soundboardConfigUpsertCacheMut       sync.RWMutex
+	soundboardConfigUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single soundboardConfig record from the query using the global executor.
+func (q soundboardConfigQuery) OneG(ctx context.Context) (*SoundboardConfig, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single soundboardConfig record from the query.
+func (q soundboardConfigQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SoundboardConfig, error) {
+	o := &SoundboardConfig{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for soundboard_configs")
+	}
+
+	return o, nil
+}
+
+// AllG returns all SoundboardConfig records from the query using the global executor.
+func (q soundboardConfigQuery) AllG(ctx context.Context) (SoundboardConfigSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all SoundboardConfig records from the query.
+func (q soundboardConfigQuery) All(ctx context.Context, exec boil.ContextExecutor) (SoundboardConfigSlice, error) {
+	var o []*SoundboardConfig
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SoundboardConfig slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all SoundboardConfig records in the query using the global executor
+func (q soundboardConfigQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all SoundboardConfig records in the query.
+func (q soundboardConfigQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count soundboard_configs rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q soundboardConfigQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q soundboardConfigQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if soundboard_configs exists")
+	}
+
+	return count > 0, nil
+}
+
+// SoundboardConfigs retrieves all the records using an executor.
+func SoundboardConfigs(mods ...qm.QueryMod) soundboardConfigQuery {
+	mods = append(mods, qm.From("\"soundboard_configs\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"soundboard_configs\".*"})
+	}
+
+	return soundboardConfigQuery{q}
+}
+
+// FindSoundboardConfigG retrieves a single record by ID.
+func FindSoundboardConfigG(ctx context.Context, guildID int64, selectCols ...string) (*SoundboardConfig, error) {
+	return FindSoundboardConfig(ctx, boil.GetContextDB(), guildID, selectCols...)
+}
+
+// FindSoundboardConfig retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSoundboardConfig(ctx context.Context, exec boil.ContextExecutor, guildID int64, selectCols ...string) (*SoundboardConfig, error) {
+	soundboardConfigObj := &SoundboardConfig{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"soundboard_configs\" where \"guild_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, guildID)
+
+	err := q.Bind(ctx, exec, soundboardConfigObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from soundboard_configs")
+	}
+
+	return soundboardConfigObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *SoundboardConfig) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SoundboardConfig) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no soundboard_configs provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(soundboardConfigColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	soundboardConfigInsertCacheMut.RLock()
+	cache, cached := soundboardConfigInsertCache[key]
+	soundboardConfigInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			soundboardConfigAllColumns,
+			soundboardConfigColumnsWithDefault,
+			soundboardConfigColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(soundboardConfigType, soundboardConfigMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(soundboardConfigType, soundboardConfigMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"soundboard_configs\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"soundboard_configs\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into soundboard_configs")
+	}
+
+	if !cached {
+		soundboardConfigInsertCacheMut.Lock()
+		soundboardConfigInsertCache[key] = cache
+		soundboardConfigInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single SoundboardConfig record using the global executor.
+// See Update for more documentation.
+func (o *SoundboardConfig) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the SoundboardConfig.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SoundboardConfig) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	soundboardConfigUpdateCacheMut.RLock()
+	cache, cached := soundboardConfigUpdateCache[key]
+	soundboardConfigUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			soundboardConfigAllColumns,
+			soundboardConfigPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update soundboard_configs, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"soundboard_configs\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, soundboardConfigPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(soundboardConfigType, soundboardConfigMapping, append(wl, soundboardConfigPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update soundboard_configs row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for soundboard_configs")
+	}
+
+	if !cached {
+		soundboardConfigUpdateCacheMut.Lock()
+		soundboardConfigUpdateCache[key] = cache
+		soundboardConfigUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q soundboardConfigQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q soundboardConfigQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for soundboard_configs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for soundboard_configs")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o SoundboardConfigSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SoundboardConfigSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), soundboardConfigPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"soundboard_configs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, soundboardConfigPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in soundboardConfig slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all soundboardConfig")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *SoundboardConfig) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SoundboardConfig) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no soundboard_configs provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(soundboardConfigColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	soundboardConfigUpsertCacheMut.RLock()
+	cache, cached := soundboardConfigUpsertCache[key]
+	soundboardConfigUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			soundboardConfigAllColumns,
+			soundboardConfigColumnsWithDefault,
+			soundboardConfigColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			soundboardConfigAllColumns,
+			soundboardConfigPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert soundboard_configs, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(soundboardConfigAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(soundboardConfigPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert soundboard_configs, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(soundboardConfigPrimaryKeyColumns))
+			copy(conflict, soundboardConfigPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"soundboard_configs\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(soundboardConfigType, soundboardConfigMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(soundboardConfigType, soundboardConfigMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert soundboard_configs")
+	}
+
+	if !cached {
+		soundboardConfigUpsertCacheMut.Lock()
+		soundboardConfigUpsertCache[key] = cache
+		soundboardConfigUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single SoundboardConfig record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *SoundboardConfig) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single SoundboardConfig record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SoundboardConfig) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SoundboardConfig provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), soundboardConfigPrimaryKeyMapping)
+	sql := "DELETE FROM \"soundboard_configs\" WHERE \"guild_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from soundboard_configs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for soundboard_configs")
+	}
+
+	return rowsAff, nil
+}
+
+func (q soundboardConfigQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q soundboardConfigQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no soundboardConfigQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from soundboard_configs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for soundboard_configs")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o SoundboardConfigSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SoundboardConfigSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), soundboardConfigPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"soundboard_configs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, soundboardConfigPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from soundboardConfig slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for soundboard_configs")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *SoundboardConfig) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no SoundboardConfig provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SoundboardConfig) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSoundboardConfig(ctx, exec, o.GuildID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SoundboardConfigSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty SoundboardConfigSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SoundboardConfigSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SoundboardConfigSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), soundboardConfigPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"soundboard_configs\".* FROM \"soundboard_configs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, soundboardConfigPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SoundboardConfigSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SoundboardConfigExistsG checks if the SoundboardConfig row exists.
+func SoundboardConfigExistsG(ctx context.Context, guildID int64) (bool, error) {
+	return SoundboardConfigExists(ctx, boil.GetContextDB(), guildID)
+}
+
+// SoundboardConfigExists checks if the SoundboardConfig row exists.
+func SoundboardConfigExists(ctx context.Context, exec boil.ContextExecutor, guildID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"soundboard_configs\" where \"guild_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, guildID)
+	}
+	row := exec.QueryRowContext(ctx, sql, guildID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if soundboard_configs exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SoundboardConfig row exists.
+func (o *SoundboardConfig) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SoundboardConfigExists(ctx, exec, o.GuildID)
+}
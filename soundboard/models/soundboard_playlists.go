@@ -0,0 +1,845 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// SoundboardPlaylist is an object representing the database table.
+type SoundboardPlaylist struct {
+	ID        int              `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt time.Time        `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time        `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	GuildID   int64            `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	Name      string           `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Sounds    types.Int64Array `boil:"sounds" json:"sounds" toml:"sounds" yaml:"sounds"`
+
+	R *soundboardPlaylistR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L soundboardPlaylistL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SoundboardPlaylistColumns = struct {
+	ID        string
+	CreatedAt string
+	UpdatedAt string
+	GuildID   string
+	Name      string
+	Sounds    string
+}{
+	ID:        "id",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+	GuildID:   "guild_id",
+	Name:      "name",
+	Sounds:    "sounds",
+}
+
+var SoundboardPlaylistTableColumns = struct {
+	ID        string
+	CreatedAt string
+	UpdatedAt string
+	GuildID   string
+	Name      string
+	Sounds    string
+}{
+	ID:        "soundboard_playlists.id",
+	CreatedAt: "soundboard_playlists.created_at",
+	UpdatedAt: "soundboard_playlists.updated_at",
+	GuildID:   "soundboard_playlists.guild_id",
+	Name:      "soundboard_playlists.name",
+	Sounds:    "soundboard_playlists.sounds",
+}
+
+// Generated where
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
+func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var SoundboardPlaylistWhere = struct {
+	ID        whereHelperint
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+	GuildID   whereHelperint64
+	Name      whereHelperstring
+	Sounds    whereHelpertypes_Int64Array
+}{
+	ID:        whereHelperint{field: "\"soundboard_playlists\".\"id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"soundboard_playlists\".\"created_at\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"soundboard_playlists\".\"updated_at\""},
+	GuildID:   whereHelperint64{field: "\"soundboard_playlists\".\"guild_id\""},
+	Name:      whereHelperstring{field: "\"soundboard_playlists\".\"name\""},
+	Sounds:    whereHelpertypes_Int64Array{field: "\"soundboard_playlists\".\"sounds\""},
+}
+
+// SoundboardPlaylistRels is where relationship names are stored.
+var SoundboardPlaylistRels = struct {
+}{}
+
+// soundboardPlaylistR is where relationships are stored.
+type soundboardPlaylistR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*soundboardPlaylistR) NewStruct() *soundboardPlaylistR {
+	return &soundboardPlaylistR{}
+}
+
+// soundboardPlaylistL is where Load methods for each relationship are stored.
+type soundboardPlaylistL struct{}
+
+var (
+	soundboardPlaylistAllColumns            = []string{"id", "created_at", "updated_at", "guild_id", "name", "sounds"}
+	soundboardPlaylistColumnsWithoutDefault = []string{"created_at", "updated_at", "guild_id", "name", "sounds"}
+	soundboardPlaylistColumnsWithDefault    = []string{"id"}
+	soundboardPlaylistPrimaryKeyColumns     = []string{"id"}
+	soundboardPlaylistGeneratedColumns      = []string{}
+)
+
+type (
+	// SoundboardPlaylistSlice is an alias for a slice of pointers to SoundboardPlaylist.
+	// This should almost always be used instead of []SoundboardPlaylist.
+	SoundboardPlaylistSlice []*SoundboardPlaylist
+
+	soundboardPlaylistQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	soundboardPlaylistType                 = reflect.TypeOf(&SoundboardPlaylist{})
+	soundboardPlaylistMapping              = queries.MakeStructMapping(soundboardPlaylistType)
+	soundboardPlaylistPrimaryKeyMapping, _ = queries.BindMapping(soundboardPlaylistType, soundboardPlaylistMapping, soundboardPlaylistPrimaryKeyColumns)
+	soundboardPlaylistInsertCacheMut       sync.RWMutex
+	soundboardPlaylistInsertCache          = make(map[string]insertCache)
+	soundboardPlaylistUpdateCacheMut       sync.RWMutex
+	soundboardPlaylistUpdateCache          = make(map[string]updateCache)
+	soundboardPlaylistUpsertCacheMut       sync.RWMutex
+	soundboardPlaylistUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single soundboardPlaylist record from the query using the global executor.
+func (q soundboardPlaylistQuery) OneG(ctx context.Context) (*SoundboardPlaylist, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single soundboardPlaylist record from the query.
+func (q soundboardPlaylistQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SoundboardPlaylist, error) {
+	o := &SoundboardPlaylist{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for soundboard_playlists")
+	}
+
+	return o, nil
+}
+
+// AllG returns all SoundboardPlaylist records from the query using the global executor.
+func (q soundboardPlaylistQuery) AllG(ctx context.Context) (SoundboardPlaylistSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all SoundboardPlaylist records from the query.
+func (q soundboardPlaylistQuery) All(ctx context.Context, exec boil.ContextExecutor) (SoundboardPlaylistSlice, error) {
+	var o []*SoundboardPlaylist
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SoundboardPlaylist slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all SoundboardPlaylist records in the query using the global executor
+func (q soundboardPlaylistQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all SoundboardPlaylist records in the query.
+func (q soundboardPlaylistQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count soundboard_playlists rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q soundboardPlaylistQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q soundboardPlaylistQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if soundboard_playlists exists")
+	}
+
+	return count > 0, nil
+}
+
+// SoundboardPlaylists retrieves all the records using an executor.
+func SoundboardPlaylists(mods ...qm.QueryMod) soundboardPlaylistQuery {
+	mods = append(mods, qm.From("\"soundboard_playlists\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"soundboard_playlists\".*"})
+	}
+
+	return soundboardPlaylistQuery{q}
+}
+
+// FindSoundboardPlaylistG retrieves a single record by ID.
+func FindSoundboardPlaylistG(ctx context.Context, iD int, selectCols ...string) (*SoundboardPlaylist, error) {
+	return FindSoundboardPlaylist(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindSoundboardPlaylist retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSoundboardPlaylist(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*SoundboardPlaylist, error) {
+	soundboardPlaylistObj := &SoundboardPlaylist{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"soundboard_playlists\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, soundboardPlaylistObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from soundboard_playlists")
+	}
+
+	return soundboardPlaylistObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *SoundboardPlaylist) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SoundboardPlaylist) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no soundboard_playlists provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(soundboardPlaylistColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	soundboardPlaylistInsertCacheMut.RLock()
+	cache, cached := soundboardPlaylistInsertCache[key]
+	soundboardPlaylistInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			soundboardPlaylistAllColumns,
+			soundboardPlaylistColumnsWithDefault,
+			soundboardPlaylistColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(soundboardPlaylistType, soundboardPlaylistMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(soundboardPlaylistType, soundboardPlaylistMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"soundboard_playlists\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"soundboard_playlists\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into soundboard_playlists")
+	}
+
+	if !cached {
+		soundboardPlaylistInsertCacheMut.Lock()
+		soundboardPlaylistInsertCache[key] = cache
+		soundboardPlaylistInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single SoundboardPlaylist record using the global executor.
+// See Update for more documentation.
+func (o *SoundboardPlaylist) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the SoundboardPlaylist.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SoundboardPlaylist) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	soundboardPlaylistUpdateCacheMut.RLock()
+	cache, cached := soundboardPlaylistUpdateCache[key]
+	soundboardPlaylistUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			soundboardPlaylistAllColumns,
+			soundboardPlaylistPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update soundboard_playlists, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"soundboard_playlists\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, soundboardPlaylistPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(soundboardPlaylistType, soundboardPlaylistMapping, append(wl, soundboardPlaylistPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update soundboard_playlists row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for soundboard_playlists")
+	}
+
+	if !cached {
+		soundboardPlaylistUpdateCacheMut.Lock()
+		soundboardPlaylistUpdateCache[key] = cache
+		soundboardPlaylistUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q soundboardPlaylistQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q soundboardPlaylistQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for soundboard_playlists")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for soundboard_playlists")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o SoundboardPlaylistSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SoundboardPlaylistSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), soundboardPlaylistPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"soundboard_playlists\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, soundboardPlaylistPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in soundboardPlaylist slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all soundboardPlaylist")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *SoundboardPlaylist) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SoundboardPlaylist) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no soundboard_playlists provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(soundboardPlaylistColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	soundboardPlaylistUpsertCacheMut.RLock()
+	cache, cached := soundboardPlaylistUpsertCache[key]
+	soundboardPlaylistUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			soundboardPlaylistAllColumns,
+			soundboardPlaylistColumnsWithDefault,
+			soundboardPlaylistColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			soundboardPlaylistAllColumns,
+			soundboardPlaylistPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert soundboard_playlists, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(soundboardPlaylistAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(soundboardPlaylistPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert soundboard_playlists, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(soundboardPlaylistPrimaryKeyColumns))
+			copy(conflict, soundboardPlaylistPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"soundboard_playlists\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(soundboardPlaylistType, soundboardPlaylistMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(soundboardPlaylistType, soundboardPlaylistMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert soundboard_playlists")
+	}
+
+	if !cached {
+		soundboardPlaylistUpsertCacheMut.Lock()
+		soundboardPlaylistUpsertCache[key] = cache
+		soundboardPlaylistUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single SoundboardPlaylist record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *SoundboardPlaylist) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single SoundboardPlaylist record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SoundboardPlaylist) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SoundboardPlaylist provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), soundboardPlaylistPrimaryKeyMapping)
+	sql := "DELETE FROM \"soundboard_playlists\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from soundboard_playlists")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for soundboard_playlists")
+	}
+
+	return rowsAff, nil
+}
+
+func (q soundboardPlaylistQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q soundboardPlaylistQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no soundboardPlaylistQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from soundboard_playlists")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for soundboard_playlists")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o SoundboardPlaylistSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SoundboardPlaylistSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), soundboardPlaylistPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"soundboard_playlists\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, soundboardPlaylistPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from soundboardPlaylist slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for soundboard_playlists")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *SoundboardPlaylist) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no SoundboardPlaylist provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SoundboardPlaylist) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSoundboardPlaylist(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SoundboardPlaylistSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty SoundboardPlaylistSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SoundboardPlaylistSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SoundboardPlaylistSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), soundboardPlaylistPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"soundboard_playlists\".* FROM \"soundboard_playlists\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, soundboardPlaylistPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SoundboardPlaylistSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SoundboardPlaylistExistsG checks if the SoundboardPlaylist row exists.
+func SoundboardPlaylistExistsG(ctx context.Context, iD int) (bool, error) {
+	return SoundboardPlaylistExists(ctx, boil.GetContextDB(), iD)
+}
+
+// SoundboardPlaylistExists checks if the SoundboardPlaylist row exists.
+func SoundboardPlaylistExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"soundboard_playlists\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if soundboard_playlists exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SoundboardPlaylist row exists.
+func (o *SoundboardPlaylist) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SoundboardPlaylistExists(ctx, exec, o.ID)
+}
@@ -33,6 +33,9 @@ type SoundboardSound struct {
 	Status           int              `boil:"status" json:"status" toml:"status" yaml:"status"`
 	RequiredRoles    types.Int64Array `boil:"required_roles" json:"required_roles,omitempty" toml:"required_roles" yaml:"required_roles,omitempty"`
 	BlacklistedRoles types.Int64Array `boil:"blacklisted_roles" json:"blacklisted_roles,omitempty" toml:"blacklisted_roles" yaml:"blacklisted_roles,omitempty"`
+	CooldownSeconds  int              `boil:"cooldown_seconds" json:"cooldown_seconds" toml:"cooldown_seconds" yaml:"cooldown_seconds"`
+	TrimStartMS      int              `boil:"trim_start_ms" json:"trim_start_ms" toml:"trim_start_ms" yaml:"trim_start_ms"`
+	TrimEndMS        int              `boil:"trim_end_ms" json:"trim_end_ms" toml:"trim_end_ms" yaml:"trim_end_ms"`
 
 	R *soundboardSoundR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L soundboardSoundL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -48,6 +51,9 @@ var SoundboardSoundColumns = struct {
 	Status           string
 	RequiredRoles    string
 	BlacklistedRoles string
+	CooldownSeconds  string
+	TrimStartMS      string
+	TrimEndMS        string
 }{
 	ID:               "id",
 	CreatedAt:        "created_at",
@@ -58,6 +64,9 @@ var SoundboardSoundColumns = struct {
 	Status:           "status",
 	RequiredRoles:    "required_roles",
 	BlacklistedRoles: "blacklisted_roles",
+	CooldownSeconds:  "cooldown_seconds",
+	TrimStartMS:      "trim_start_ms",
+	TrimEndMS:        "trim_end_ms",
 }
 
 var SoundboardSoundTableColumns = struct {
@@ -70,6 +79,9 @@ var SoundboardSoundTableColumns = struct {
 	Status           string
 	RequiredRoles    string
 	BlacklistedRoles string
+	CooldownSeconds  string
+	TrimStartMS      string
+	TrimEndMS        string
 }{
 	ID:               "soundboard_sounds.id",
 	CreatedAt:        "soundboard_sounds.created_at",
@@ -80,128 +92,13 @@ var SoundboardSoundTableColumns = struct {
 	Status:           "soundboard_sounds.status",
 	RequiredRoles:    "soundboard_sounds.required_roles",
 	BlacklistedRoles: "soundboard_sounds.blacklisted_roles",
+	CooldownSeconds:  "soundboard_sounds.cooldown_seconds",
+	TrimStartMS:      "soundboard_sounds.trim_start_ms",
+	TrimEndMS:        "soundboard_sounds.trim_end_ms",
 }
 
 // Generated where
 
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-type whereHelperint64 struct{ field string }
-
-func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelpertypes_Int64Array struct{ field string }
-
-func (w whereHelpertypes_Int64Array) EQ(x types.Int64Array) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpertypes_Int64Array) NEQ(x types.Int64Array) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpertypes_Int64Array) LT(x types.Int64Array) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertypes_Int64Array) LTE(x types.Int64Array) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertypes_Int64Array) GT(x types.Int64Array) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertypes_Int64Array) GTE(x types.Int64Array) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpertypes_Int64Array) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpertypes_Int64Array) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var SoundboardSoundWhere = struct {
 	ID               whereHelperint
 	CreatedAt        whereHelpertime_Time
@@ -212,6 +109,9 @@ var SoundboardSoundWhere = struct {
 	Status           whereHelperint
 	RequiredRoles    whereHelpertypes_Int64Array
 	BlacklistedRoles whereHelpertypes_Int64Array
+	CooldownSeconds  whereHelperint
+	TrimStartMS      whereHelperint
+	TrimEndMS        whereHelperint
 }{
 	ID:               whereHelperint{field: "\"soundboard_sounds\".\"id\""},
 	CreatedAt:        whereHelpertime_Time{field: "\"soundboard_sounds\".\"created_at\""},
@@ -222,6 +122,9 @@ var SoundboardSoundWhere = struct {
 	Status:           whereHelperint{field: "\"soundboard_sounds\".\"status\""},
 	RequiredRoles:    whereHelpertypes_Int64Array{field: "\"soundboard_sounds\".\"required_roles\""},
 	BlacklistedRoles: whereHelpertypes_Int64Array{field: "\"soundboard_sounds\".\"blacklisted_roles\""},
+	CooldownSeconds:  whereHelperint{field: "\"soundboard_sounds\".\"cooldown_seconds\""},
+	TrimStartMS:      whereHelperint{field: "\"soundboard_sounds\".\"trim_start_ms\""},
+	TrimEndMS:        whereHelperint{field: "\"soundboard_sounds\".\"trim_end_ms\""},
 }
 
 // SoundboardSoundRels is where relationship names are stored.
@@ -241,9 +144,9 @@ func (*soundboardSoundR) NewStruct() *soundboardSoundR {
 type soundboardSoundL struct{}
 
 var (
-	soundboardSoundAllColumns            = []string{"id", "created_at", "updated_at", "guild_id", "required_role", "name", "status", "required_roles", "blacklisted_roles"}
+	soundboardSoundAllColumns            = []string{"id", "created_at", "updated_at", "guild_id", "required_role", "name", "status", "required_roles", "blacklisted_roles", "cooldown_seconds", "trim_start_ms", "trim_end_ms"}
 	soundboardSoundColumnsWithoutDefault = []string{"created_at", "updated_at", "guild_id", "required_role", "name", "status"}
-	soundboardSoundColumnsWithDefault    = []string{"id", "required_roles", "blacklisted_roles"}
+	soundboardSoundColumnsWithDefault    = []string{"id", "required_roles", "blacklisted_roles", "cooldown_seconds", "trim_start_ms", "trim_end_ms"}
 	soundboardSoundPrimaryKeyColumns     = []string{"id"}
 	soundboardSoundGeneratedColumns      = []string{}
 )
@@ -10,6 +10,7 @@ import (
 	"github.com/ThatBathroom/yagpdb/v2/common"
 	"github.com/ThatBathroom/yagpdb/v2/lib/dca"
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/soundboard/models"
 )
 
 type PlayRequest struct {
@@ -17,6 +18,11 @@ type PlayRequest struct {
 	GuildID        int64
 	CommandRanFrom int64
 	Sound          int
+
+	// shown in the queue
+	SoundName       string
+	RequestedBy     int64
+	RequestedByName string
 }
 
 var (
@@ -28,26 +34,40 @@ var (
 	playersmu = sync.NewCond(&sync.Mutex{})
 )
 
-// RequestPlaySound either queues up a sound to be played in an existing player or creates a new one
-func RequestPlaySound(guildID int64, channelID, channelRanFrom int64, soundID int) (queued bool) {
-	item := &PlayRequest{
-		ChannelID:      channelID,
-		GuildID:        guildID,
-		Sound:          soundID,
-		CommandRanFrom: channelRanFrom,
+var ErrQueueFull = errors.New("Soundboard queue is full")
+
+// RequestPlaySound either queues up the sounds to be played in order in an existing player or creates a new one
+func RequestPlaySound(guildID int64, channelID, channelRanFrom int64, requestedBy *discordgo.User, sounds ...*models.SoundboardSound) (queued bool, err error) {
+	items := make([]*PlayRequest, 0, len(sounds))
+	for _, v := range sounds {
+		items = append(items, &PlayRequest{
+			ChannelID:      channelID,
+			GuildID:        guildID,
+			Sound:          v.ID,
+			CommandRanFrom: channelRanFrom,
+			SoundName:      v.Name,
+
+			RequestedBy:     requestedBy.ID,
+			RequestedByName: requestedBy.Username,
+		})
 	}
 
 	playersmu.L.Lock()
 	if p, ok := players[guildID]; ok {
+		if len(p.queue)+len(items) > MaxQueueLength {
+			playersmu.L.Unlock()
+			return false, ErrQueueFull
+		}
+
 		// add to existing player queue
-		p.queue = append(p.queue, item)
+		p.queue = append(p.queue, items...)
 		queued = true
 	} else {
 		// create new player
 		p = &Player{
 			ChannelID: channelID,
 			GuildID:   guildID,
-			queue:     []*PlayRequest{item},
+			queue:     items,
 		}
 		players[guildID] = p
 		go p.Run()
@@ -61,6 +81,48 @@ func RequestPlaySound(guildID int64, channelID, channelRanFrom int64, soundID in
 	return
 }
 
+// GetQueue returns the currently playing sound and the queued up ones of the guild's player
+func GetQueue(guildID int64) (current *PlayRequest, queue []*PlayRequest) {
+	playersmu.L.Lock()
+	defer playersmu.L.Unlock()
+
+	p, ok := players[guildID]
+	if !ok || p.stop {
+		return nil, nil
+	}
+
+	return p.current, append([]*PlayRequest(nil), p.queue...)
+}
+
+// skipSound stops the currently playing sound, moving on to the next one in the queue
+func skipSound(guildID int64) (skipped *PlayRequest) {
+	playersmu.L.Lock()
+	defer playersmu.L.Unlock()
+
+	p, ok := players[guildID]
+	if !ok || p.current == nil {
+		return nil
+	}
+
+	p.skip = true
+	return p.current
+}
+
+// clearQueue removes all the queued up sounds, leaving the currently playing one alone
+func clearQueue(guildID int64) (cleared int) {
+	playersmu.L.Lock()
+	defer playersmu.L.Unlock()
+
+	p, ok := players[guildID]
+	if !ok {
+		return 0
+	}
+
+	cleared = len(p.queue)
+	p.queue = nil
+	return cleared
+}
+
 func resetPlayerServer(guildID int64) string {
 	playersmu.L.Lock()
 
@@ -82,9 +144,11 @@ type Player struct {
 	// below fields are safe to access with playersmu
 	ChannelID    int64
 	queue        []*PlayRequest
+	current      *PlayRequest
 	timeLastPlay time.Time
 	playing      bool
 	stop         bool
+	skip         bool
 
 	// below fields are only safe to deal with in the main run goroutine
 	vc *discordgo.VoiceConnection
@@ -112,6 +176,8 @@ func (p *Player) Run() {
 		}
 
 		p.playing = true
+		p.current = item
+		p.skip = false
 		p.timeLastPlay = time.Now()
 		playersmu.L.Unlock()
 
@@ -130,6 +196,7 @@ func (p *Player) Run() {
 func (p *Player) waitForNextElement() {
 	playersmu.L.Lock()
 	p.playing = false
+	p.current = nil
 	for {
 		if p.stop {
 			p.exit()
@@ -215,8 +282,13 @@ func playSound(p *Player, vc *discordgo.VoiceConnection, session *discordgo.Sess
 			playersmu.L.Unlock()
 			return vc, nil
 		}
+		skip := p.skip
 		playersmu.L.Unlock()
 
+		if skip {
+			break
+		}
+
 		frame, err := decoder.OpusFrame()
 		if err != nil {
 			if err != io.EOF {
@@ -49,4 +49,37 @@ BEGIN
 		UPDATE soundboard_sounds SET required_roles=ARRAY[required_role]::BIGINT[] WHERE required_role IS NOT NULL AND required_role != '';
 	END IF;
 END $$;
+`, `
+ALTER TABLE soundboard_sounds ADD COLUMN IF NOT EXISTS cooldown_seconds INT NOT NULL DEFAULT 0;
+`, `
+CREATE TABLE IF NOT EXISTS soundboard_configs(
+	guild_id BIGINT PRIMARY KEY,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+	-- how long a member has to wait between playing sounds
+	user_cooldown_seconds INT NOT NULL DEFAULT 0
+);
+`, `
+CREATE TABLE IF NOT EXISTS soundboard_playlists(
+	id SERIAL PRIMARY KEY,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+	guild_id BIGINT NOT NULL,
+	name TEXT NOT NULL,
+
+	-- sound ids in the order they're played, the same sound can appear more than once
+	sounds BIGINT[] NOT NULL
+);
+`, `
+CREATE INDEX IF NOT EXISTS soundboard_playlists_guild_idx ON soundboard_playlists(guild_id);
+`, `
+-- the part of the sound that is kept when transcoding, end 0 means until the end of the sound
+ALTER TABLE soundboard_sounds ADD COLUMN IF NOT EXISTS trim_start_ms INT NOT NULL DEFAULT 0;
+`, `
+ALTER TABLE soundboard_sounds ADD COLUMN IF NOT EXISTS trim_end_ms INT NOT NULL DEFAULT 0;
+`, `
+-- roles that can add sounds with the SoundboardAdd command
+ALTER TABLE soundboard_configs ADD COLUMN IF NOT EXISTS upload_roles BIGINT[];
 `}
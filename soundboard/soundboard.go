@@ -3,6 +3,7 @@ package soundboard
 //go:generate sqlboiler --no-hooks psql
 
 import (
+	"database/sql"
 	"fmt"
 	"os"
 
@@ -86,6 +87,14 @@ func KeySoundLock(id int) string {
 	return fmt.Sprintf("soundboard_soundlock:%d", id)
 }
 
+func KeySoundCooldown(id int) string {
+	return fmt.Sprintf("soundboard_sound_cooldown:%d", id)
+}
+
+func KeyUserCooldown(guildID, userID int64) string {
+	return fmt.Sprintf("soundboard_user_cooldown:%d:%d", guildID, userID)
+}
+
 func SoundFilePath(id int, status TranscodingStatus) string {
 	if status == TranscodingStatusReady {
 		return fmt.Sprintf("soundboard/ready/%d.dca", id)
@@ -97,6 +106,15 @@ func SoundFilePath(id int, status TranscodingStatus) string {
 const (
 	MaxGuildSounds        = 50
 	MaxGuildSoundsPremium = 250
+
+	MaxGuildPlaylists = 10
+	MaxPlaylistSounds = 10
+
+	// Max sounds waiting in the queue of a player
+	MaxQueueLength = 25
+
+	// Max cooldown of sounds and members, in seconds
+	MaxCooldown = 60 * 60
 )
 
 func MaxSoundsForContext(ctx context.Context) int {
@@ -111,3 +129,31 @@ func GetSoundboardSounds(guildID int64, ctx context.Context) ([]*models.Soundboa
 	result, err := models.SoundboardSounds(qm.Where("guild_id=?", guildID)).AllG(ctx)
 	return result, err
 }
+
+// MaxSoundsForGuild is MaxSoundsForContext for when there's no web request context
+func MaxSoundsForGuild(guildID int64) (int, error) {
+	isPremium, err := premium.IsGuildPremium(guildID)
+	if err != nil {
+		return 0, err
+	}
+
+	if isPremium {
+		return MaxGuildSoundsPremium, nil
+	}
+
+	return MaxGuildSounds, nil
+}
+
+// GetConfig returns the soundboard config of the guild, or the default one if it has none
+func GetConfig(guildID int64, ctx context.Context) (*models.SoundboardConfig, error) {
+	conf, err := models.FindSoundboardConfigG(ctx, guildID)
+	if err == sql.ErrNoRows {
+		return &models.SoundboardConfig{GuildID: guildID}, nil
+	}
+
+	return conf, err
+}
+
+func GetPlaylists(guildID int64, ctx context.Context) ([]*models.SoundboardPlaylist, error) {
+	return models.SoundboardPlaylists(qm.Where("guild_id=?", guildID), qm.OrderBy("id asc")).AllG(ctx)
+}
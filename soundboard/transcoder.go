@@ -30,8 +30,14 @@ func init() {
 	cp := *dca.StdEncodeOptions
 	transcoderOptions = &cp
 	transcoderOptions.Bitrate = 100
+
+	// Normalize the loudness so sounds are roughly equally loud, and people can't blow out their friends ears
+	transcoderOptions.AudioFilter = loudnessFilter
 }
 
+// single pass EBU R128 loudness normalization, the volume filter of dca is applied after it
+const loudnessFilter = "loudnorm=I=-16:TP=-1.5:LRA=11"
+
 var _ commands.CommandProvider = (*Plugin)(nil)
 var _ backgroundworkers.BackgroundWorkerPlugin = (*Plugin)(nil)
 
@@ -145,7 +151,13 @@ func transcodeSound(sound *models.SoundboardSound) error {
 	}
 	defer destFile.Close()
 
-	session, err := dca.EncodeFile(SoundFilePath(sound.ID, TranscodingStatus(sound.Status)), transcoderOptions)
+	options := *transcoderOptions
+	if trim := trimFilter(sound.TrimStartMS, sound.TrimEndMS); trim != "" {
+		// trim first so only the kept part is taken into account when normalizing
+		options.AudioFilter = trim + "," + options.AudioFilter
+	}
+
+	session, err := dca.EncodeFile(SoundFilePath(sound.ID, TranscodingStatus(sound.Status)), &options)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,141 @@
+package soundboard
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/soundboard/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	// Max size of uploaded sound files, in bytes
+	MaxSoundFileSize = 10000000
+
+	// Trim points past this are rejected, in milliseconds
+	MaxTrimMS = 10 * 60 * 1000
+)
+
+// CheckNewSound returns why a sound with the name can't be added to the guild, or an empty string if it can
+func CheckNewSound(ctx context.Context, guildID int64, name string, maxSounds int) (string, error) {
+	nameConflict, err := models.SoundboardSounds(qm.Where("guild_id=? AND name=?", guildID, name)).ExistsG(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if nameConflict {
+		return "Name already used", nil
+	}
+
+	count, err := models.SoundboardSounds(qm.Where("guild_id=?", guildID)).CountG(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if count >= int64(maxSounds) {
+		return fmt.Sprintf("Max %d sounds allowed (%d for premium servers)", MaxGuildSounds, MaxGuildSoundsPremium), nil
+	}
+
+	return "", nil
+}
+
+// ErrTrimDCA is returned when trying to trim an already transcoded dca file, only sounds going through the transcoder
+// can be trimmed
+var ErrTrimDCA = errors.New("Already transcoded .dca files can't be trimmed")
+
+// QueueNewSound inserts the sound and stores the file read from r in the transcoding queue, already transcoded dca
+// files skip the transcoding. The sound is removed again if the file is too big or fails downloading.
+func QueueNewSound(ctx context.Context, sound *models.SoundboardSound, r io.Reader, isDCA bool) (tooBig bool, err error) {
+	if isDCA && (sound.TrimStartMS != 0 || sound.TrimEndMS != 0) {
+		return false, ErrTrimDCA
+	}
+
+	sound.Status = int(TranscodingStatusQueued)
+	if isDCA {
+		sound.Status = int(TranscodingStatusReady)
+	}
+
+	err = sound.InsertG(ctx, boil.Infer())
+	if err != nil {
+		return false, err
+	}
+
+	// Lock it so the transcoder doesn't pick up the file while it's still being written
+	locked, err := common.TryLockRedisKey(KeySoundLock(sound.ID), 60)
+	if err == nil && !locked {
+		err = errors.New("failed locking sound")
+	}
+	if err != nil {
+		sound.DeleteG(ctx)
+		return false, err
+	}
+	defer common.UnlockRedisKey(KeySoundLock(sound.ID))
+
+	fname := SoundFilePath(sound.ID, TranscodingStatusQueued)
+	if isDCA {
+		fname += ".dca"
+	}
+
+	destFile, err := os.Create(fname)
+	if err != nil {
+		sound.DeleteG(ctx)
+		return false, err
+	}
+
+	tooBig, err = DownloadNewSoundFile(r, destFile, MaxSoundFileSize)
+	destFile.Close()
+
+	if tooBig || err != nil {
+		os.Remove(fname)
+		sound.DeleteG(ctx)
+	}
+
+	return tooBig, err
+}
+
+// ValidateTrim checks the trim points of a sound, end 0 meaning the sound isn't cut at the end
+func ValidateTrim(startMS, endMS int) error {
+	if startMS < 0 || endMS < 0 {
+		return errors.New("Trim points can't be negative")
+	}
+
+	if startMS > MaxTrimMS || endMS > MaxTrimMS {
+		return fmt.Errorf("Trim points can be at most %d seconds into the sound", MaxTrimMS/1000)
+	}
+
+	if endMS != 0 && endMS <= startMS {
+		return errors.New("The end of the sound has to be after the start")
+	}
+
+	return nil
+}
+
+// trimFilter returns the ffmpeg filter that cuts the sound down to the trim points, or an empty string if
+// it's not trimmed
+func trimFilter(startMS, endMS int) string {
+	parts := make([]string, 0, 2)
+	if startMS > 0 {
+		parts = append(parts, "start="+formatSeconds(startMS))
+	}
+	if endMS > 0 {
+		parts = append(parts, "end="+formatSeconds(endMS))
+	}
+
+	if len(parts) == 0 {
+		return ""
+	}
+
+	// reset the timestamps so the trimmed sound starts at 0
+	return "atrim=" + strings.Join(parts, ":") + ",asetpts=PTS-STARTPTS"
+}
+
+func formatSeconds(ms int) string {
+	return strconv.FormatFloat(float64(ms)/1000, 'f', -1, 64)
+}
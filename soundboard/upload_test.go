@@ -0,0 +1,37 @@
+package soundboard
+
+import "testing"
+
+func TestTrimFilter(t *testing.T) {
+	cases := []struct {
+		start, end int
+		want       string
+	}{
+		{0, 0, ""},
+		{1500, 0, "atrim=start=1.5,asetpts=PTS-STARTPTS"},
+		{0, 4000, "atrim=end=4,asetpts=PTS-STARTPTS"},
+		{250, 3125, "atrim=start=0.25:end=3.125,asetpts=PTS-STARTPTS"},
+	}
+
+	for _, c := range cases {
+		if got := trimFilter(c.start, c.end); got != c.want {
+			t.Errorf("trimFilter(%d, %d) = %q, want %q", c.start, c.end, got, c.want)
+		}
+	}
+}
+
+func TestValidateTrim(t *testing.T) {
+	valid := [][2]int{{0, 0}, {1000, 0}, {0, 500}, {1000, 1001}}
+	for _, v := range valid {
+		if err := ValidateTrim(v[0], v[1]); err != nil {
+			t.Errorf("ValidateTrim(%d, %d): unexpected error %v", v[0], v[1], err)
+		}
+	}
+
+	invalid := [][2]int{{-1, 0}, {0, -1}, {2000, 1000}, {1000, 1000}, {0, MaxTrimMS + 1}}
+	for _, v := range invalid {
+		if err := ValidateTrim(v[0], v[1]); err == nil {
+			t.Errorf("ValidateTrim(%d, %d): expected error", v[0], v[1])
+		}
+	}
+}
@@ -5,10 +5,8 @@ import (
 	"fmt"
 	"html/template"
 	"io"
-	"mime/multipart"
 	"net/http"
 	"os"
-	"strconv"
 	"strings"
 
 	"emperror.dev/errors"
@@ -18,6 +16,7 @@ import (
 	"github.com/ThatBathroom/yagpdb/v2/web"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
 	"goji.io"
 	"goji.io/pat"
 )
@@ -31,6 +30,23 @@ type PostForm struct {
 
 	RequiredRoles    []int64 `valid:"role"`
 	BlacklistedRoles []int64 `valid:"role"`
+
+	// Seconds before the sound can be played again
+	CooldownSeconds int `valid:"0,3600"`
+
+	// Part of the sound to keep in seconds, only used when uploading
+	TrimStart float64 `valid:"0,600"`
+	TrimEnd   float64 `valid:"0,600"`
+}
+
+func (pf *PostForm) Validate(tmpl web.TemplateData, _ int64) bool {
+	err := ValidateTrim(int(pf.TrimStart*1000), int(pf.TrimEnd*1000))
+	if err != nil {
+		tmpl.AddAlerts(web.ErrorAlert(err.Error()))
+		return false
+	}
+
+	return true
 }
 
 func (pf *PostForm) ToDBModel() *models.SoundboardSound {
@@ -40,13 +56,38 @@ func (pf *PostForm) ToDBModel() *models.SoundboardSound {
 		Name:             pf.Name,
 		RequiredRoles:    pf.RequiredRoles,
 		BlacklistedRoles: pf.BlacklistedRoles,
+		CooldownSeconds:  pf.CooldownSeconds,
+		TrimStartMS:      int(pf.TrimStart * 1000),
+		TrimEndMS:        int(pf.TrimEnd * 1000),
 	}
 }
 
+type ConfigForm struct {
+	// Seconds a member has to wait between playing sounds
+	UserCooldownSeconds int `valid:"0,3600"`
+
+	// Roles that can add sounds with the SoundboardAdd command
+	UploadRoles []int64 `valid:"role"`
+}
+
+type PlaylistForm struct {
+	ID   int
+	Name string `valid:",1,100,trimspace"`
+
+	// Comma separated sound names, in the order they're played
+	Sounds string `valid:",2000"`
+}
+
 var (
 	panelLogKeyAddedSound   = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "soundboard_added_sound", FormatString: "Added soundboard sound %s"})
 	panelLogKeyUpdatedSound = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "soundboard_updated_sound", FormatString: "Updated soundboard sound %s"})
 	panelLogKeyRemovedSound = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "soundboard_removed_sound", FormatString: "Removed soundboard sound %s"})
+
+	panelLogKeyUpdatedSettings = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "soundboard_updated_settings", FormatString: "Updated soundboard settings"})
+
+	panelLogKeyAddedPlaylist   = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "soundboard_added_playlist", FormatString: "Added soundboard playlist %s"})
+	panelLogKeyUpdatedPlaylist = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "soundboard_updated_playlist", FormatString: "Updated soundboard playlist %s"})
+	panelLogKeyRemovedPlaylist = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "soundboard_removed_playlist", FormatString: "Removed soundboard playlist %s"})
 )
 
 func (p *Plugin) InitWeb() {
@@ -71,6 +112,11 @@ func (p *Plugin) InitWeb() {
 	cpMux.Handle(pat.Post("/new"), web.ControllerPostHandler(HandleNew, getHandler, PostForm{}))
 	cpMux.Handle(pat.Post("/update"), web.ControllerPostHandler(HandleUpdate, getHandler, PostForm{}))
 	cpMux.Handle(pat.Post("/delete"), web.ControllerPostHandler(HandleDelete, getHandler, PostForm{}))
+	cpMux.Handle(pat.Post("/settings"), web.ControllerPostHandler(HandleSaveSettings, getHandler, ConfigForm{}))
+
+	cpMux.Handle(pat.Post("/playlists/new"), web.ControllerPostHandler(HandleNewPlaylist, getHandler, PlaylistForm{}))
+	cpMux.Handle(pat.Post("/playlists/update"), web.ControllerPostHandler(HandleUpdatePlaylist, getHandler, PlaylistForm{}))
+	cpMux.Handle(pat.Post("/playlists/delete"), web.ControllerPostHandler(HandleDeletePlaylist, getHandler, PlaylistForm{}))
 }
 
 func HandleGetCP(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
@@ -82,108 +128,81 @@ func HandleGetCP(w http.ResponseWriter, r *http.Request) (web.TemplateData, erro
 		return tmpl, err
 	}
 
+	conf, err := GetConfig(g.ID, ctx)
+	if err != nil {
+		return tmpl, err
+	}
+
+	playlists, err := GetPlaylists(g.ID, ctx)
+	if err != nil {
+		return tmpl, err
+	}
+
+	// the sounds of each playlist as they're entered in the form
+	playlistSounds := make(map[int]string)
+	for _, v := range playlists {
+		playlistSounds[v.ID] = joinSoundNames(PlaylistSounds(v, sounds))
+	}
+
 	tmpl["SoundboardSounds"] = sounds
+	tmpl["SoundboardConfig"] = conf
+	tmpl["Playlists"] = playlists
+	tmpl["PlaylistSounds"] = playlistSounds
+	tmpl["MaxPlaylistSounds"] = MaxPlaylistSounds
+	tmpl["MaxGuildPlaylists"] = MaxGuildPlaylists
 	return tmpl, nil
 }
 
 func HandleNew(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
 	ctx := r.Context()
 	g, tmpl := web.GetBaseCPContextData(ctx)
+	data := ctx.Value(common.ContextKeyParsedForm).(*PostForm)
+
+	problem, err := CheckNewSound(ctx, g.ID, data.Name, MaxSoundsForContext(ctx))
+	if err != nil {
+		return tmpl, err
+	}
+
+	if problem != "" {
+		tmpl.AddAlerts(web.ErrorAlert(problem))
+		return tmpl, nil
+	}
 
 	isDCA := false
-	var file multipart.File
+	var file io.Reader
 	if r.FormValue("SoundURL") == "" {
 		f, header, err := r.FormFile("Sound")
 		if err != nil {
 			return tmpl, err
 		}
+		defer f.Close()
 		file = f
 
 		if strings.HasSuffix(header.Filename, ".dca") {
 			isDCA = true
 		}
+	} else {
+		resp, err := http.Get(r.FormValue("SoundURL"))
+		if err != nil {
+			tmpl.AddAlerts(web.ErrorAlert("Failed downloading sound: " + err.Error()))
+			return tmpl, err
+		}
+		defer resp.Body.Close()
+		file = resp.Body
 	}
 
-	data := ctx.Value(common.ContextKeyParsedForm).(*PostForm)
-
 	dbModel := data.ToDBModel()
-	dbModel.Status = int(TranscodingStatusQueued)
-	if isDCA {
-		dbModel.Status = int(TranscodingStatusReady)
-	}
 	dbModel.GuildID = g.ID
 
-	// check for name conflict
-	nameConflict, err := models.SoundboardSounds(qm.Where("guild_id=? AND name=?", g.ID, data.Name)).ExistsG(r.Context())
-	if err != nil {
-		return tmpl, err
-	}
-
-	if nameConflict {
-		tmpl.AddAlerts(web.ErrorAlert("Name already used"))
-		return tmpl, nil
-	}
-
-	// check sound limit
-	count, err := models.SoundboardSounds(qm.Where("guild_id=?", g.ID)).CountG(r.Context())
-	if err != nil {
-		return tmpl, err
-	}
-	if count >= int64(MaxSoundsForContext(ctx)) {
-		tmpl.AddAlerts(web.ErrorAlert(fmt.Sprintf("Max %d sounds allowed (%d for premium servers)", MaxGuildSounds, MaxGuildSoundsPremium)))
+	tooBig, err := QueueNewSound(ctx, dbModel, file, isDCA)
+	if err == ErrTrimDCA {
+		tmpl.AddAlerts(web.ErrorAlert(err.Error()))
 		return tmpl, nil
 	}
-
-	err = dbModel.InsertG(r.Context(), boil.Infer())
-	if err != nil {
-		return tmpl, err
+	if tooBig {
+		tmpl.AddAlerts(web.ErrorAlert("Max 10MB files allowed"))
 	}
-
-	// Lock it
-	locked, err := common.TryLockRedisKey(KeySoundLock(dbModel.ID), 60)
-	if err != nil || !locked {
-		if !locked {
-			tmpl.AddAlerts(web.ErrorAlert("Uh oh failed locking"))
-		}
-		return tmpl, err
-	}
-	defer common.UnlockRedisKey(KeySoundLock(dbModel.ID))
-
-	//logrus.Error("CREAte errror:", err)
-	fname := "soundboard/queue/" + strconv.Itoa(int(dbModel.ID))
-	if isDCA {
-		fname += ".dca"
-	}
-	destFile, err := os.Create(fname)
-	if err != nil {
-		return tmpl, err
-	}
-
-	tooBig := false
-	if file != nil {
-		tooBig, err = DownloadNewSoundFile(file, destFile, 10000000)
-	} else if r.FormValue("SoundURL") != "" {
-		var resp *http.Response
-		resp, err = http.Get(r.FormValue("SoundURL"))
-		if err != nil {
-			tmpl.AddAlerts(web.ErrorAlert("Failed downloading sound: " + err.Error()))
-			destFile.Close()
-		} else {
-			defer resp.Body.Close()
-			tooBig, err = DownloadNewSoundFile(resp.Body, destFile, 10000000)
-		}
-	} else {
-		err = errors.New("No sound!?")
-	}
-
-	destFile.Close()
-
 	if tooBig || err != nil {
-		os.Remove(fname)
-		if tooBig {
-			tmpl.AddAlerts(web.ErrorAlert("Max 10MB files allowed"))
-		}
-		dbModel.DeleteG(ctx)
 		return tmpl, err
 	}
 
@@ -248,8 +267,9 @@ func HandleUpdate(w http.ResponseWriter, r *http.Request) (web.TemplateData, err
 	dbModel.Name = data.Name
 	dbModel.RequiredRoles = data.RequiredRoles
 	dbModel.BlacklistedRoles = data.BlacklistedRoles
+	dbModel.CooldownSeconds = data.CooldownSeconds
 
-	_, err = dbModel.UpdateG(ctx, boil.Whitelist("name", "required_roles", "blacklisted_roles", "updated_at"))
+	_, err = dbModel.UpdateG(ctx, boil.Whitelist("name", "required_roles", "blacklisted_roles", "cooldown_seconds", "updated_at"))
 	if err == nil {
 		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeyUpdatedSound, &cplogs.Param{Type: cplogs.ParamTypeString, Value: data.Name}))
 	}
@@ -297,6 +317,174 @@ func HandleDelete(w http.ResponseWriter, r *http.Request) (web.TemplateData, err
 	return tmpl, err
 }
 
+func HandleSaveSettings(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+	ctx := r.Context()
+	g, tmpl := web.GetBaseCPContextData(ctx)
+	data := ctx.Value(common.ContextKeyParsedForm).(*ConfigForm)
+
+	conf := &models.SoundboardConfig{
+		GuildID:             g.ID,
+		UserCooldownSeconds: data.UserCooldownSeconds,
+		UploadRoles:         data.UploadRoles,
+	}
+
+	err := conf.UpsertG(ctx, true, []string{"guild_id"}, boil.Whitelist("user_cooldown_seconds", "upload_roles", "updated_at"), boil.Infer())
+	if err == nil {
+		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeyUpdatedSettings))
+	}
+	return tmpl, err
+}
+
+// parsePlaylistSounds looks up the comma separated sound names, returning their ids in order
+func parsePlaylistSounds(input string, sounds []*models.SoundboardSound) (types.Int64Array, error) {
+	result := make(types.Int64Array, 0)
+	for _, name := range strings.Split(input, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		var sound *models.SoundboardSound
+		for _, v := range sounds {
+			if strings.EqualFold(v.Name, name) {
+				sound = v
+				break
+			}
+		}
+
+		if sound == nil {
+			return nil, fmt.Errorf("Unknown sound: %s", name)
+		}
+
+		result = append(result, int64(sound.ID))
+	}
+
+	if len(result) < 1 {
+		return nil, errors.New("A playlist needs at least one sound")
+	}
+
+	if len(result) > MaxPlaylistSounds {
+		return nil, fmt.Errorf("Max %d sounds in a playlist", MaxPlaylistSounds)
+	}
+
+	return result, nil
+}
+
+func joinSoundNames(sounds []*models.SoundboardSound) string {
+	names := make([]string, 0, len(sounds))
+	for _, v := range sounds {
+		names = append(names, v.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func HandleNewPlaylist(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+	ctx := r.Context()
+	g, tmpl := web.GetBaseCPContextData(ctx)
+	data := ctx.Value(common.ContextKeyParsedForm).(*PlaylistForm)
+
+	count, err := models.SoundboardPlaylists(qm.Where("guild_id=?", g.ID)).CountG(ctx)
+	if err != nil {
+		return tmpl, err
+	}
+	if count >= MaxGuildPlaylists {
+		tmpl.AddAlerts(web.ErrorAlert(fmt.Sprintf("Max %d playlists allowed", MaxGuildPlaylists)))
+		return tmpl, nil
+	}
+
+	nameConflict, err := models.SoundboardPlaylists(qm.Where("guild_id=? AND name=?", g.ID, data.Name)).ExistsG(ctx)
+	if err != nil {
+		return tmpl, err
+	}
+
+	if nameConflict {
+		tmpl.AddAlerts(web.ErrorAlert("Name already used"))
+		return tmpl, nil
+	}
+
+	sounds, err := GetSoundboardSounds(g.ID, ctx)
+	if err != nil {
+		return tmpl, err
+	}
+
+	soundIDs, err := parsePlaylistSounds(data.Sounds, sounds)
+	if err != nil {
+		tmpl.AddAlerts(web.ErrorAlert(err.Error()))
+		return tmpl, nil
+	}
+
+	playlist := &models.SoundboardPlaylist{
+		GuildID: g.ID,
+		Name:    data.Name,
+		Sounds:  soundIDs,
+	}
+
+	err = playlist.InsertG(ctx, boil.Infer())
+	if err == nil {
+		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeyAddedPlaylist, &cplogs.Param{Type: cplogs.ParamTypeString, Value: data.Name}))
+	}
+	return tmpl, err
+}
+
+func HandleUpdatePlaylist(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+	ctx := r.Context()
+	g, tmpl := web.GetBaseCPContextData(ctx)
+	data := ctx.Value(common.ContextKeyParsedForm).(*PlaylistForm)
+
+	playlist, err := models.SoundboardPlaylists(qm.Where("guild_id = ? AND id = ?", g.ID, data.ID)).OneG(ctx)
+	if err != nil {
+		return tmpl.AddAlerts(web.ErrorAlert("Error retrieving playlist")), errors.WrapIf(err, "unknown playlist")
+	}
+
+	nameConflict, err := models.SoundboardPlaylists(qm.Where("guild_id = ? AND name = ? AND id != ?", g.ID, data.Name, data.ID)).ExistsG(ctx)
+	if err != nil {
+		return tmpl, err
+	}
+
+	if nameConflict {
+		tmpl.AddAlerts(web.ErrorAlert("Name already used"))
+		return tmpl, nil
+	}
+
+	sounds, err := GetSoundboardSounds(g.ID, ctx)
+	if err != nil {
+		return tmpl, err
+	}
+
+	soundIDs, err := parsePlaylistSounds(data.Sounds, sounds)
+	if err != nil {
+		tmpl.AddAlerts(web.ErrorAlert(err.Error()))
+		return tmpl, nil
+	}
+
+	playlist.Name = data.Name
+	playlist.Sounds = soundIDs
+
+	_, err = playlist.UpdateG(ctx, boil.Whitelist("name", "sounds", "updated_at"))
+	if err == nil {
+		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeyUpdatedPlaylist, &cplogs.Param{Type: cplogs.ParamTypeString, Value: data.Name}))
+	}
+	return tmpl, err
+}
+
+func HandleDeletePlaylist(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
+	ctx := r.Context()
+	g, tmpl := web.GetBaseCPContextData(ctx)
+	data := ctx.Value(common.ContextKeyParsedForm).(*PlaylistForm)
+
+	playlist, err := models.SoundboardPlaylists(qm.Where("guild_id = ? AND id = ?", g.ID, data.ID)).OneG(ctx)
+	if err != nil {
+		return tmpl, err
+	}
+
+	_, err = playlist.DeleteG(ctx)
+	if err == nil {
+		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeyRemovedPlaylist, &cplogs.Param{Type: cplogs.ParamTypeString, Value: playlist.Name}))
+	}
+	return tmpl, err
+}
+
 var _ web.PluginWithServerHomeWidget = (*Plugin)(nil)
 
 func (p *Plugin) LoadServerHomeWidget(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
@@ -1,6 +1,7 @@
 package streaming
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -18,6 +19,7 @@ import (
 	"github.com/ThatBathroom/yagpdb/v2/common/templates"
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
 	"github.com/ThatBathroom/yagpdb/v2/lib/dstate"
+	"github.com/ThatBathroom/yagpdb/v2/streaming/models"
 	"github.com/mediocregopher/radix/v3"
 )
 
@@ -42,6 +44,7 @@ func HandleUpdateStreaming(event *pubsub.Event) {
 	}
 
 	cachedConfig.Delete(event.TargetGuildInt)
+	cachedRules.Delete(event.TargetGuildInt)
 	CheckGuildFull(gs, true)
 }
 
@@ -57,6 +60,12 @@ func CheckGuildFull(gs *dstate.GuildSet, fetchMembers bool) {
 		return
 	}
 
+	rules, err := GetRules(context.Background(), gs.ID, true)
+	if err != nil {
+		logger.WithError(err).WithField("guild", gs.ID).Error("Failed retrieving streaming rules")
+		return
+	}
+
 	var wg sync.WaitGroup
 
 	slowCheck := make([]*dstate.MemberState, 0)
@@ -82,7 +91,7 @@ func CheckGuildFull(gs *dstate.GuildSet, fetchMembers bool) {
 					continue
 				}
 
-				err = CheckPresence(conn, config, ms, gs)
+				err = CheckPresence(conn, config, rules, ms, gs)
 
 				if err != nil {
 					logger.WithError(err).Error("Error checking presence")
@@ -111,7 +120,7 @@ func CheckGuildFull(gs *dstate.GuildSet, fetchMembers bool) {
 				continue
 			}
 
-			err = CheckPresence(conn, config, ms, gs)
+			err = CheckPresence(conn, config, rules, ms, gs)
 			if err != nil {
 				logger.WithError(err).Error("Error checking presence")
 				continue
@@ -140,6 +149,11 @@ func HandleGuildMemberUpdate(evt *eventsystem.EventData) (retry bool, err error)
 		return false, nil
 	}
 
+	rules, err := BotCachedGetRules(evt.GS.ID)
+	if err != nil {
+		return true, errors.WithStackIf(err)
+	}
+
 	ms := bot.State.GetMember(m.GuildID, m.User.ID)
 	if ms == nil {
 		logger.WithField("guild", m.GuildID).Error("Member not found in state")
@@ -154,7 +168,7 @@ func HandleGuildMemberUpdate(evt *eventsystem.EventData) (retry bool, err error)
 		return // no presence tracked, no poing in continuing
 	}
 
-	err = CheckPresence(common.RedisPool, config, ms, evt.GS)
+	err = CheckPresence(common.RedisPool, config, rules, ms, evt.GS)
 	if err != nil {
 		return bot.CheckDiscordErrRetry(err), errors.WithStackIf(err)
 	}
@@ -179,6 +193,13 @@ func HandleGuildCreate(evt *eventsystem.EventData) {
 	if !config.Enabled {
 		return
 	}
+
+	rules, err := GetRules(context.Background(), g.ID, true)
+	if err != nil {
+		logger.WithError(err).Error("Failed retrieving streaming rules")
+		return
+	}
+
 	gs := bot.State.GetGuild(g.ID)
 	if gs == nil {
 		logger.WithField("guild", g.ID).Error("Guild not found in state")
@@ -194,7 +215,7 @@ func HandleGuildCreate(evt *eventsystem.EventData) {
 					continue
 				}
 
-				err = CheckPresence(conn, config, ms, gs)
+				err = CheckPresence(conn, config, rules, ms, gs)
 				if err != nil {
 					logger.WithError(err).Error("Failed checking presence")
 				}
@@ -222,12 +243,17 @@ func HandlePresenceUpdate(evt *eventsystem.EventData) (retry bool, err error) {
 		return true, errors.WithStackIf(err)
 	}
 
-	if !config.Enabled || (config.GiveRole == 0 && (config.AnnounceMessage == "" || gs.GetChannel(config.AnnounceChannel) == nil)) {
+	rules, err := BotCachedGetRules(gs.ID)
+	if err != nil {
+		return true, errors.WithStackIf(err)
+	}
+
+	if !config.Enabled || (config.GiveRole == 0 && (config.AnnounceMessage == "" || gs.GetChannel(config.AnnounceChannel) == nil) && len(rules) == 0) {
 		// Don't bother trying to send anything, its not "fully" enabled
 		return
 	}
 
-	err = CheckPresenceSparse(common.RedisPool, config, &p.Presence, gs)
+	err = CheckPresenceSparse(common.RedisPool, config, rules, &p.Presence, gs)
 	if err != nil {
 		return bot.CheckDiscordErrRetry(err), errors.WrapIff(err, "failed checking presence for %d", p.User.ID)
 	}
@@ -235,7 +261,7 @@ func HandlePresenceUpdate(evt *eventsystem.EventData) (retry bool, err error) {
 	return false, nil
 }
 
-func CheckPresenceSparse(client radix.Client, config *Config, p *discordgo.Presence, gs *dstate.GuildSet) error {
+func CheckPresenceSparse(client radix.Client, config *Config, rules models.StreamingAnnouncementRuleSlice, p *discordgo.Presence, gs *dstate.GuildSet) error {
 	if !config.Enabled {
 		return nil
 	}
@@ -249,35 +275,18 @@ func CheckPresenceSparse(client radix.Client, config *Config, p *discordgo.Prese
 	// Now the real fun starts
 	// Either add or remove the stream
 	if p.Status != discordgo.StatusOffline && mainActivity != nil && mainActivity.URL != "" && mainActivity.Type == 1 && !ms.User.Bot {
-
 		// Streaming and not a bot
-		if !config.MeetsRequirements(ms.Member.Roles, mainActivity.State, mainActivity.Details) {
-			RemoveStreaming(client, config, gs.ID, p.User.ID, ms.Member.Roles)
-			return nil
-		}
-
-		if config.GiveRole != 0 {
-			go GiveStreamingRole(gs.ID, p.User.ID, config.GiveRole, ms.Member.Roles)
-		}
-
-		// if true, then we were marked now, and not before
-		var markedNow bool
-		client.Do(radix.FlatCmd(&markedNow, "SADD", KeyCurrentlyStreaming(gs.ID), p.User.ID))
-		if !markedNow {
-			// Already marked
-			return nil
+		stream := &StreamInfo{
+			URL:      mainActivity.URL,
+			Game:     mainActivity.State,
+			Title:    mainActivity.Details,
+			Platform: mainActivity.Name,
 		}
 
-		// Send the streaming announcement if enabled
-		if config.AnnounceChannel != 0 && config.AnnounceMessage != "" {
-			if err != nil {
-				return errors.WithStackIf(err)
-			}
-			go SendStreamingAnnouncement(config, gs, ms, mainActivity.URL, mainActivity.State, mainActivity.Details, mainActivity.Name)
-		}
+		handleStreaming(client, config, rules, ms, gs, stream)
 	} else {
 		// Not streaming
-		RemoveStreamingSparse(client, config, gs.ID, p.User.ID)
+		RemoveStreamingSparse(client, config, rules, gs.ID, p.User.ID, ms.Member.Roles)
 	}
 
 	return nil
@@ -297,7 +306,7 @@ func retrieveMainActivity(p *discordgo.Presence) *discordgo.Activity {
 	return nil
 }
 
-func CheckPresence(client radix.Client, config *Config, ms *dstate.MemberState, gs *dstate.GuildSet) error {
+func CheckPresence(client radix.Client, config *Config, rules models.StreamingAnnouncementRuleSlice, ms *dstate.MemberState, gs *dstate.GuildSet) error {
 	if !config.Enabled {
 		return nil
 	}
@@ -306,57 +315,115 @@ func CheckPresence(client radix.Client, config *Config, ms *dstate.MemberState,
 	// Either add or remove the stream
 	if ms.Presence != nil && ms.Presence.Status != dstate.StatusOffline && ms.Presence.Game != nil && ms.Presence.Game.URL != "" && ms.Presence.Game.Type == 1 && !ms.User.Bot {
 		// Streaming and not a bot
-
-		if !config.MeetsRequirements(ms.Member.Roles, ms.Presence.Game.State, ms.Presence.Game.Details) {
-			RemoveStreaming(client, config, gs.ID, ms.User.ID, ms.Member.Roles)
-			return nil
+		stream := &StreamInfo{
+			URL:      ms.Presence.Game.URL,
+			Game:     ms.Presence.Game.State,
+			Title:    ms.Presence.Game.Details,
+			Platform: ms.Presence.Game.Name,
 		}
 
-		if config.GiveRole != 0 {
-			go GiveStreamingRole(gs.ID, ms.User.ID, config.GiveRole, ms.Member.Roles)
-		}
+		handleStreaming(client, config, rules, ms, gs, stream)
+	} else {
+		// Not streaming
+		RemoveStreaming(client, config, rules, gs.ID, ms.User.ID, ms.Member.Roles)
+	}
+
+	return nil
+}
+
+// handleStreaming gives the roles and sends the announcements of the main config and the rules the streaming member matches
+func handleStreaming(client radix.Client, config *Config, rules models.StreamingAnnouncementRuleSlice, ms *dstate.MemberState, gs *dstate.GuildSet, stream *StreamInfo) {
+	mainMatches := config.MeetsRequirements(ms.Member.Roles, stream.Game, stream.Title)
+	matchingRules := MatchingRules(rules, ms.Member.Roles, stream.Game, stream.Title)
+
+	if !mainMatches && len(matchingRules) == 0 {
+		RemoveStreaming(client, config, rules, gs.ID, ms.User.ID, ms.Member.Roles)
+		return
+	}
 
-		// if true, then we were marked now, and not before
-		var markedNow bool
-		client.Do(radix.FlatCmd(&markedNow, "SADD", KeyCurrentlyStreaming(gs.ID), ms.User.ID))
-		if !markedNow {
-			// Already marked
-			return nil
+	// give the roles of what they match, and take away the ones of what they no longer match
+	var giveRoles []int64
+	if mainMatches && config.GiveRole != 0 {
+		giveRoles = append(giveRoles, config.GiveRole)
+		go GiveStreamingRole(gs.ID, ms.User.ID, config.GiveRole, ms.Member.Roles, 0)
+	}
+
+	for _, rule := range matchingRules {
+		if rule.GiveRole != 0 && !common.ContainsInt64Slice(giveRoles, rule.GiveRole) {
+			giveRoles = append(giveRoles, rule.GiveRole)
+			go GiveStreamingRole(gs.ID, ms.User.ID, rule.GiveRole, ms.Member.Roles, rule.ID)
 		}
+	}
 
-		// Send the streaming announcement if enabled
-		if config.AnnounceChannel != 0 && config.AnnounceMessage != "" {
-			go SendStreamingAnnouncement(config, gs, ms, ms.Presence.Game.URL, ms.Presence.Game.State, ms.Presence.Game.Details, ms.Presence.Game.Name)
+	if !mainMatches && !common.ContainsInt64Slice(giveRoles, config.GiveRole) {
+		go RemoveStreamingRole(gs.ID, ms.User.ID, config.GiveRole, ms.Member.Roles, 0)
+	}
+
+	for _, rule := range rules {
+		if !common.ContainsInt64Slice(giveRoles, rule.GiveRole) {
+			go RemoveStreamingRole(gs.ID, ms.User.ID, rule.GiveRole, ms.Member.Roles, rule.ID)
 		}
+	}
 
-	} else {
-		// Not streaming
-		RemoveStreaming(client, config, gs.ID, ms.User.ID, ms.Member.Roles)
+	// if true, then we were marked now, and not before
+	var markedNow bool
+	client.Do(radix.FlatCmd(&markedNow, "SADD", KeyCurrentlyStreaming(gs.ID), ms.User.ID))
+	if !markedNow {
+		// Already marked
+		return
 	}
 
-	return nil
+	stream.StartedAt = time.Now()
+	startStreamSession(gs.ID, ms.User.ID, stream)
+
+	// Send the streaming announcements if enabled
+	if mainMatches && config.AnnounceChannel != 0 && config.AnnounceMessage != "" {
+		go SendStreamingAnnouncement(config, gs, ms, stream)
+	}
+
+	for _, rule := range matchingRules {
+		if rule.ChannelID != 0 && rule.Message != "" {
+			go SendRuleAnnouncement(rule, gs, ms, stream)
+		}
+	}
 }
 
 func (config *Config) MeetsRequirements(roles []int64, activityState, activityDetails string) bool {
+	return meetsRequirements(config.RequireRole, config.IgnoreRole, config.GameRegex, config.TitleRegex, roles, activityState, activityDetails)
+}
+
+// MatchingRules returns the rules the streaming member matches
+func MatchingRules(rules models.StreamingAnnouncementRuleSlice, roles []int64, activityState, activityDetails string) []*models.StreamingAnnouncementRule {
+	var matching []*models.StreamingAnnouncementRule
+	for _, rule := range rules {
+		if meetsRequirements(rule.RequireRole, rule.IgnoreRole, rule.GameRegex, rule.TitleRegex, roles, activityState, activityDetails) {
+			matching = append(matching, rule)
+		}
+	}
+
+	return matching
+}
+
+func meetsRequirements(requireRole, ignoreRole int64, gameRegex, titleRegex string, roles []int64, activityState, activityDetails string) bool {
 	// Check if they have the required role
-	if config.RequireRole != 0 {
-		if !common.ContainsInt64Slice(roles, config.RequireRole) {
+	if requireRole != 0 {
+		if !common.ContainsInt64Slice(roles, requireRole) {
 			// Dosen't have required role
 			return false
 		}
 	}
 
 	// Check if they have a ignored role
-	if config.IgnoreRole != 0 {
-		if common.ContainsInt64Slice(roles, config.IgnoreRole) {
+	if ignoreRole != 0 {
+		if common.ContainsInt64Slice(roles, ignoreRole) {
 			// We ignore people with this role.. :'(
 			return false
 		}
 	}
 
-	if strings.TrimSpace(config.GameRegex) != "" {
+	if strings.TrimSpace(gameRegex) != "" {
 		gameName := activityState
-		compiledRegex, err := regexp.Compile(strings.TrimSpace(config.GameRegex))
+		compiledRegex, err := regexp.Compile(strings.TrimSpace(gameRegex))
 		if err == nil {
 			// It should be verified before this that its valid
 			if !compiledRegex.MatchString(gameName) {
@@ -365,9 +432,9 @@ func (config *Config) MeetsRequirements(roles []int64, activityState, activityDe
 		}
 	}
 
-	if strings.TrimSpace(config.TitleRegex) != "" {
+	if strings.TrimSpace(titleRegex) != "" {
 		streamTitle := activityDetails
-		compiledRegex, err := regexp.Compile(strings.TrimSpace(config.TitleRegex))
+		compiledRegex, err := regexp.Compile(strings.TrimSpace(titleRegex))
 		if err == nil {
 			// It should be verified before this that its valid
 			if !compiledRegex.MatchString(streamTitle) {
@@ -379,90 +446,126 @@ func (config *Config) MeetsRequirements(roles []int64, activityState, activityDe
 	return true
 }
 
-func RemoveStreamingSparse(client radix.Client, config *Config, guildID int64, memberID int64) {
+// RemoveStreamingSparse is like RemoveStreaming but does nothing if the member wasn't streaming, as presence updates
+// are received for everyone
+func RemoveStreamingSparse(client radix.Client, config *Config, rules models.StreamingAnnouncementRuleSlice, guildID int64, memberID int64, currentRoles []int64) {
 	var removed bool
 	client.Do(radix.FlatCmd(&removed, "SREM", KeyCurrentlyStreaming(guildID), memberID))
+	if !removed {
+		return
+	}
 
-	if removed && config.GiveRole != 0 {
-		common.BotSession.GuildMemberRoleRemove(guildID, memberID, config.GiveRole)
+	// only the roles the member has are removed, off the event goroutine
+	go RemoveStreamingRole(guildID, memberID, config.GiveRole, currentRoles, 0)
+	for _, rule := range rules {
+		go RemoveStreamingRole(guildID, memberID, rule.GiveRole, currentRoles, rule.ID)
 	}
+
+	go EndStream(config, rules, guildID, memberID)
 }
 
-func RemoveStreaming(client radix.Client, config *Config, guildID int64, memberID int64, currentRoles []int64) {
-	client.Do(radix.FlatCmd(nil, "SREM", KeyCurrentlyStreaming(guildID), memberID))
-	go RemoveStreamingRole(guildID, memberID, config.GiveRole, currentRoles)
+func RemoveStreaming(client radix.Client, config *Config, rules models.StreamingAnnouncementRuleSlice, guildID int64, memberID int64, currentRoles []int64) {
+	var removed bool
+	client.Do(radix.FlatCmd(&removed, "SREM", KeyCurrentlyStreaming(guildID), memberID))
+	go RemoveStreamingRole(guildID, memberID, config.GiveRole, currentRoles, 0)
+
+	for _, rule := range rules {
+		go RemoveStreamingRole(guildID, memberID, rule.GiveRole, currentRoles, rule.ID)
+	}
 
 	// Was not streaming before if we removed 0 elements
-	// var removed bool
-	// client.Do(radix.FlatCmd(&removed, "SREM", KeyCurrentlyStreaming(guildID), memberID))
-	// if removed && config.GiveRole != 0 {
-	// 	go common.BotSession.GuildMemberRoleRemove(guildID, memberID, config.GiveRole)
-	// }
+	if removed {
+		go EndStream(config, rules, guildID, memberID)
+	}
 }
 
-func SendStreamingAnnouncement(config *Config, guild *dstate.GuildSet, ms *dstate.MemberState, url string, gameName string, streamTitle string, streamPlatform string) {
+func SendStreamingAnnouncement(config *Config, guild *dstate.GuildSet, ms *dstate.MemberState, stream *StreamInfo) {
+	if sendAnnouncement(guild, ms, 0, config.AnnounceChannel, config.AnnounceMessage, stream) {
+		return
+	}
+
+	// unknown channel, disable announcements
+	logger.WithField("guild", guild.ID).WithField("channel", config.AnnounceChannel).Warn("Channel not found in state, not sending streaming announcement")
+
+	config.AnnounceChannel = 0
+	config.Save(guild.ID)
+}
+
+func SendRuleAnnouncement(rule *models.StreamingAnnouncementRule, guild *dstate.GuildSet, ms *dstate.MemberState, stream *StreamInfo) {
+	if sendAnnouncement(guild, ms, rule.ID, rule.ChannelID, rule.Message, stream) {
+		return
+	}
+
+	// unknown channel, disable announcements for this rule
+	logger.WithField("guild", guild.ID).WithField("rule", rule.ID).WithField("channel", rule.ChannelID).Warn("Channel not found in state, not sending streaming rule announcement")
+
+	_, err := models.StreamingAnnouncementRules(
+		models.StreamingAnnouncementRuleWhere.ID.EQ(rule.ID),
+	).UpdateAllG(context.Background(), models.M{"channel_id": 0})
+	if err != nil {
+		logger.WithError(err).WithField("guild", guild.ID).Error("Failed disabling streaming rule announcements")
+	}
+	cachedRules.Delete(guild.ID)
+}
+
+// sendAnnouncement sends the announcement of the rule (0 for the main config) and tracks it so it can be edited or
+// deleted when the stream ends, returns false if the channel was not found
+func sendAnnouncement(guild *dstate.GuildSet, ms *dstate.MemberState, ruleID int64, channelID int64, message string, stream *StreamInfo) (channelFound bool) {
 	// Only send one announcment every 1 hour
 	var resp string
 	key := fmt.Sprintf("streaming_announcement_sent:%d:%d", guild.ID, ms.User.ID)
+	if ruleID != 0 {
+		key += fmt.Sprintf(":%d", ruleID)
+	}
+
 	err := common.RedisPool.Do(radix.Cmd(&resp, "SET", key, "1", "EX", "3600", "NX"))
 	if err != nil {
 		logger.WithError(err).Error("failed setting streaming announcment cooldown")
-		return
+		return true
 	}
 
 	if resp != "OK" {
 		logger.Info("streaming announcment cooldown: ", ms.User.ID)
-		return
+		return true
 	}
 
 	// make sure the channel exists
-	foundChannel := false
-	var channel *dstate.ChannelState
-	for _, v := range guild.Channels {
-		if v.ID == config.AnnounceChannel {
-			foundChannel = true
-			channel = guild.GetChannel(config.AnnounceChannel)
-		}
-	}
-
-	// unknown channel, disable announcements
-	if !foundChannel {
-		config.AnnounceChannel = 0
-		config.Save(guild.ID)
-
-		logger.WithField("guild", guild.ID).WithField("channel", config.AnnounceChannel).Warn("Channel not found in state, not sending streaming announcement")
-		return
+	channel := guild.GetChannel(channelID)
+	if channel == nil {
+		return false
 	}
 
 	go analytics.RecordActiveUnit(guild.ID, &Plugin{}, "sent_streaming_announcement")
 
 	ctx := templates.NewContext(guild, channel, ms)
-	ctx.Data["URL"] = url
-	ctx.Data["url"] = url
-	ctx.Data["Game"] = gameName
-	ctx.Data["StreamTitle"] = streamTitle
-	ctx.Data["StreamPlatform"] = streamPlatform
+	stream.setTemplateData(ctx)
 
-	out, err := ctx.Execute(config.AnnounceMessage)
+	out, err := ctx.Execute(message)
 	if err != nil {
 		logger.WithError(err).WithField("guild", guild.ID).Warn("Failed executing template")
-		return
+		return true
 	}
 
-	m, err := common.BotSession.ChannelMessageSendComplex(config.AnnounceChannel, ctx.MessageSend(out))
+	m, err := common.BotSession.ChannelMessageSendComplex(channelID, ctx.MessageSend(out))
 	if err != nil {
-		return
+		return true
 	}
+
+	trackAnnouncement(guild.ID, ms.User.ID, ruleID, channelID, m.ID)
+
 	if ctx.CurrentFrame.DelResponse {
-		templates.MaybeScheduledDeleteMessage(guild.ID, config.AnnounceChannel, m.ID, ctx.CurrentFrame.DelResponseDelay, "")
+		templates.MaybeScheduledDeleteMessage(guild.ID, channelID, m.ID, ctx.CurrentFrame.DelResponseDelay, "")
 	}
 
 	if ctx.CurrentFrame.PublishResponse {
-		common.BotSession.ChannelMessageCrosspost(config.AnnounceChannel, m.ID)
+		common.BotSession.ChannelMessageCrosspost(channelID, m.ID)
 	}
+
+	return true
 }
 
-func GiveStreamingRole(guildID, memberID, streamingRole int64, currentUserRoles []int64) {
+// GiveStreamingRole gives the streaming role of the rule (0 for the main config) to the member
+func GiveStreamingRole(guildID, memberID, streamingRole int64, currentUserRoles []int64, ruleID int64) {
 	if streamingRole == 0 {
 		return
 	}
@@ -477,7 +580,7 @@ func GiveStreamingRole(guildID, memberID, streamingRole int64, currentUserRoles
 
 	if err != nil {
 		if common.IsDiscordErr(err, discordgo.ErrCodeMissingPermissions, discordgo.ErrCodeUnknownRole, discordgo.ErrCodeMissingAccess) {
-			DisableStreamingRole(guildID)
+			disableStreamingRole(guildID, ruleID)
 		}
 
 		logger.WithError(err).WithField("guild", guildID).WithField("user", memberID).Error("Failed adding streaming role")
@@ -485,7 +588,8 @@ func GiveStreamingRole(guildID, memberID, streamingRole int64, currentUserRoles
 	}
 }
 
-func RemoveStreamingRole(guildID, memberID int64, streamingRole int64, currentRoles []int64) {
+// RemoveStreamingRole removes the streaming role of the rule (0 for the main config) from the member
+func RemoveStreamingRole(guildID, memberID int64, streamingRole int64, currentRoles []int64, ruleID int64) {
 	if streamingRole == 0 {
 		return
 	}
@@ -500,11 +604,28 @@ func RemoveStreamingRole(guildID, memberID int64, streamingRole int64, currentRo
 	if err != nil {
 		logger.WithError(err).WithField("guild", guildID).WithField("user", memberID).WithField("role", streamingRole).Error("Failed removing streaming role")
 		if common.IsDiscordErr(err, discordgo.ErrCodeMissingPermissions, discordgo.ErrCodeUnknownRole, discordgo.ErrCodeMissingAccess) {
-			DisableStreamingRole(guildID)
+			disableStreamingRole(guildID, ruleID)
 		}
 	}
 }
 
+func disableStreamingRole(guildID, ruleID int64) {
+	if ruleID == 0 {
+		DisableStreamingRole(guildID)
+		return
+	}
+
+	logger.WithField("guild", guildID).WithField("rule", ruleID).Warn("Disabling streaming role for rule because of misssing permissions or unknown role")
+
+	_, err := models.StreamingAnnouncementRules(
+		models.StreamingAnnouncementRuleWhere.ID.EQ(ruleID),
+	).UpdateAllG(context.Background(), models.M{"give_role": 0})
+	if err != nil {
+		logger.WithError(err).WithField("guild", guildID).Error("Failed disabling streaming rule role")
+	}
+	cachedRules.Delete(guildID)
+}
+
 func DisableStreamingRole(guildID int64) {
 	logger.WithField("guild", guildID).Warn("Disabling streaming role for server because of misssing permissions or unknown role")
 
@@ -532,3 +653,17 @@ func BotCachedGetConfig(guildID int64) (*Config, error) {
 
 	return v.(*Config), nil
 }
+
+var cachedRules = common.CacheSet.RegisterSlot("streaming_rules", nil, int64(0))
+
+func BotCachedGetRules(guildID int64) (models.StreamingAnnouncementRuleSlice, error) {
+	v, err := cachedRules.GetCustomFetch(guildID, func(key interface{}) (interface{}, error) {
+		return GetRules(context.Background(), guildID, true)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(models.StreamingAnnouncementRuleSlice), nil
+}
@@ -0,0 +1,84 @@
+package streaming
+
+import (
+	"testing"
+
+	"github.com/ThatBathroom/yagpdb/v2/streaming/models"
+)
+
+func TestMeetsRequirements(t *testing.T) {
+	cases := []struct {
+		name                    string
+		requireRole, ignoreRole int64
+		gameRegex, titleRegex   string
+		roles                   []int64
+		game, title             string
+		want                    bool
+	}{
+		{"no requirements", 0, 0, "", "", nil, "Minecraft", "hello", true},
+		{"has required role", 1, 0, "", "", []int64{1, 2}, "", "", true},
+		{"missing required role", 1, 0, "", "", []int64{2}, "", "", false},
+		{"has ignored role", 0, 2, "", "", []int64{1, 2}, "", "", false},
+		{"required and ignored role", 1, 2, "", "", []int64{1, 2}, "", "", false},
+		{"game matches", 0, 0, "(?i)^minecraft$", "", nil, "MineCraft", "", true},
+		{"game doesn't match", 0, 0, "^minecraft$", "", nil, "Terraria", "", false},
+		{"title matches", 0, 0, "", "speedrun", nil, "", "any% speedrun", true},
+		{"title doesn't match", 0, 0, "", "speedrun", nil, "", "just chatting", false},
+		{"regexes are trimmed", 0, 0, "  ^a$  ", "", nil, "a", "", true},
+		{"invalid regex is ignored", 0, 0, "(", "", nil, "anything", "", true},
+		{"game and title have to match", 0, 0, "a", "b", nil, "a", "c", false},
+	}
+
+	for _, c := range cases {
+		got := meetsRequirements(c.requireRole, c.ignoreRole, c.gameRegex, c.titleRegex, c.roles, c.game, c.title)
+		if got != c.want {
+			t.Errorf("%s: got %t, want %t", c.name, got, c.want)
+		}
+	}
+}
+
+func TestMatchingRules(t *testing.T) {
+	rules := models.StreamingAnnouncementRuleSlice{
+		{ID: 1},
+		{ID: 2, RequireRole: 10},
+		{ID: 3, IgnoreRole: 20},
+		{ID: 4, GameRegex: "^Minecraft$"},
+	}
+
+	cases := []struct {
+		name  string
+		roles []int64
+		game  string
+		want  []int64
+	}{
+		{"no roles", nil, "Terraria", []int64{1, 3}},
+		{"required role", []int64{10}, "Terraria", []int64{1, 2, 3}},
+		{"ignored role", []int64{20}, "Minecraft", []int64{1, 4}},
+		{"everything", []int64{10}, "Minecraft", []int64{1, 2, 3, 4}},
+	}
+
+	for _, c := range cases {
+		matching := MatchingRules(rules, c.roles, c.game, "")
+
+		got := make([]int64, 0, len(matching))
+		for _, v := range matching {
+			got = append(got, v.ID)
+		}
+
+		if len(got) != len(c.want) {
+			t.Errorf("%s: got rules %v, want %v", c.name, got, c.want)
+			continue
+		}
+
+		for i := range got {
+			if got[i] != c.want[i] {
+				t.Errorf("%s: got rules %v, want %v", c.name, got, c.want)
+				break
+			}
+		}
+	}
+
+	if MatchingRules(nil, nil, "", "") != nil {
+		t.Error("expected no matches without rules")
+	}
+}
@@ -0,0 +1,12 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+var TableNames = struct {
+	StreamingAnnouncementRules string
+	StreamingSessions          string
+}{
+	StreamingAnnouncementRules: "streaming_announcement_rules",
+	StreamingSessions:          "streaming_sessions",
+}
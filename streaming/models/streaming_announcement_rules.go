@@ -0,0 +1,960 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// StreamingAnnouncementRule is an object representing the database table.
+type StreamingAnnouncementRule struct {
+	ID          int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	GuildID     int64     `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	Name        string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Enabled     bool      `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	ChannelID   int64     `boil:"channel_id" json:"channel_id" toml:"channel_id" yaml:"channel_id"`
+	Message     string    `boil:"message" json:"message" toml:"message" yaml:"message"`
+	GiveRole    int64     `boil:"give_role" json:"give_role" toml:"give_role" yaml:"give_role"`
+	RequireRole int64     `boil:"require_role" json:"require_role" toml:"require_role" yaml:"require_role"`
+	IgnoreRole  int64     `boil:"ignore_role" json:"ignore_role" toml:"ignore_role" yaml:"ignore_role"`
+	GameRegex   string    `boil:"game_regex" json:"game_regex" toml:"game_regex" yaml:"game_regex"`
+	TitleRegex  string    `boil:"title_regex" json:"title_regex" toml:"title_regex" yaml:"title_regex"`
+	EndAction   string    `boil:"end_action" json:"end_action" toml:"end_action" yaml:"end_action"`
+	EndMessage  string    `boil:"end_message" json:"end_message" toml:"end_message" yaml:"end_message"`
+
+	R *streamingAnnouncementRuleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L streamingAnnouncementRuleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var StreamingAnnouncementRuleColumns = struct {
+	ID          string
+	CreatedAt   string
+	UpdatedAt   string
+	GuildID     string
+	Name        string
+	Enabled     string
+	ChannelID   string
+	Message     string
+	GiveRole    string
+	RequireRole string
+	IgnoreRole  string
+	GameRegex   string
+	TitleRegex  string
+	EndAction   string
+	EndMessage  string
+}{
+	ID:          "id",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+	GuildID:     "guild_id",
+	Name:        "name",
+	Enabled:     "enabled",
+	ChannelID:   "channel_id",
+	Message:     "message",
+	GiveRole:    "give_role",
+	RequireRole: "require_role",
+	IgnoreRole:  "ignore_role",
+	GameRegex:   "game_regex",
+	TitleRegex:  "title_regex",
+	EndAction:   "end_action",
+	EndMessage:  "end_message",
+}
+
+var StreamingAnnouncementRuleTableColumns = struct {
+	ID          string
+	CreatedAt   string
+	UpdatedAt   string
+	GuildID     string
+	Name        string
+	Enabled     string
+	ChannelID   string
+	Message     string
+	GiveRole    string
+	RequireRole string
+	IgnoreRole  string
+	GameRegex   string
+	TitleRegex  string
+	EndAction   string
+	EndMessage  string
+}{
+	ID:          "streaming_announcement_rules.id",
+	CreatedAt:   "streaming_announcement_rules.created_at",
+	UpdatedAt:   "streaming_announcement_rules.updated_at",
+	GuildID:     "streaming_announcement_rules.guild_id",
+	Name:        "streaming_announcement_rules.name",
+	Enabled:     "streaming_announcement_rules.enabled",
+	ChannelID:   "streaming_announcement_rules.channel_id",
+	Message:     "streaming_announcement_rules.message",
+	GiveRole:    "streaming_announcement_rules.give_role",
+	RequireRole: "streaming_announcement_rules.require_role",
+	IgnoreRole:  "streaming_announcement_rules.ignore_role",
+	GameRegex:   "streaming_announcement_rules.game_regex",
+	TitleRegex:  "streaming_announcement_rules.title_regex",
+	EndAction:   "streaming_announcement_rules.end_action",
+	EndMessage:  "streaming_announcement_rules.end_message",
+}
+
+// Generated where
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
+func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var StreamingAnnouncementRuleWhere = struct {
+	ID          whereHelperint64
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+	GuildID     whereHelperint64
+	Name        whereHelperstring
+	Enabled     whereHelperbool
+	ChannelID   whereHelperint64
+	Message     whereHelperstring
+	GiveRole    whereHelperint64
+	RequireRole whereHelperint64
+	IgnoreRole  whereHelperint64
+	GameRegex   whereHelperstring
+	TitleRegex  whereHelperstring
+	EndAction   whereHelperstring
+	EndMessage  whereHelperstring
+}{
+	ID:          whereHelperint64{field: "\"streaming_announcement_rules\".\"id\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"streaming_announcement_rules\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"streaming_announcement_rules\".\"updated_at\""},
+	GuildID:     whereHelperint64{field: "\"streaming_announcement_rules\".\"guild_id\""},
+	Name:        whereHelperstring{field: "\"streaming_announcement_rules\".\"name\""},
+	Enabled:     whereHelperbool{field: "\"streaming_announcement_rules\".\"enabled\""},
+	ChannelID:   whereHelperint64{field: "\"streaming_announcement_rules\".\"channel_id\""},
+	Message:     whereHelperstring{field: "\"streaming_announcement_rules\".\"message\""},
+	GiveRole:    whereHelperint64{field: "\"streaming_announcement_rules\".\"give_role\""},
+	RequireRole: whereHelperint64{field: "\"streaming_announcement_rules\".\"require_role\""},
+	IgnoreRole:  whereHelperint64{field: "\"streaming_announcement_rules\".\"ignore_role\""},
+	GameRegex:   whereHelperstring{field: "\"streaming_announcement_rules\".\"game_regex\""},
+	TitleRegex:  whereHelperstring{field: "\"streaming_announcement_rules\".\"title_regex\""},
+	EndAction:   whereHelperstring{field: "\"streaming_announcement_rules\".\"end_action\""},
+	EndMessage:  whereHelperstring{field: "\"streaming_announcement_rules\".\"end_message\""},
+}
+
+// StreamingAnnouncementRuleRels is where relationship names are stored.
+var StreamingAnnouncementRuleRels = struct {
+}{}
+
+// streamingAnnouncementRuleR is where relationships are stored.
+type streamingAnnouncementRuleR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*streamingAnnouncementRuleR) NewStruct() *streamingAnnouncementRuleR {
+	return &streamingAnnouncementRuleR{}
+}
+
+// streamingAnnouncementRuleL is where Load methods for each relationship are stored.
+type streamingAnnouncementRuleL struct{}
+
+var (
+	streamingAnnouncementRuleAllColumns            = []string{"id", "created_at", "updated_at", "guild_id", "name", "enabled", "channel_id", "message", "give_role", "require_role", "ignore_role", "game_regex", "title_regex", "end_action", "end_message"}
+	streamingAnnouncementRuleColumnsWithoutDefault = []string{"created_at", "updated_at", "guild_id", "name"}
+	streamingAnnouncementRuleColumnsWithDefault    = []string{"id", "enabled", "channel_id", "message", "give_role", "require_role", "ignore_role", "game_regex", "title_regex", "end_action", "end_message"}
+	streamingAnnouncementRulePrimaryKeyColumns     = []string{"id"}
+	streamingAnnouncementRuleGeneratedColumns      = []string{}
+)
+
+type (
+	// StreamingAnnouncementRuleSlice is an alias for a slice of pointers to StreamingAnnouncementRule.
+	// This should almost always be used instead of []StreamingAnnouncementRule.
+	StreamingAnnouncementRuleSlice []*StreamingAnnouncementRule
+
+	streamingAnnouncementRuleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	streamingAnnouncementRuleType                 = reflect.TypeOf(&StreamingAnnouncementRule{})
+	streamingAnnouncementRuleMapping              = queries.MakeStructMapping(streamingAnnouncementRuleType)
+	streamingAnnouncementRulePrimaryKeyMapping, _ = queries.BindMapping(streamingAnnouncementRuleType, streamingAnnouncementRuleMapping, streamingAnnouncementRulePrimaryKeyColumns)
+	streamingAnnouncementRuleInsertCacheMut       sync.RWMutex
+	streamingAnnouncementRuleInsertCache          = make(map[string]insertCache)
+	streamingAnnouncementRuleUpdateCacheMut       sync.RWMutex
+	streamingAnnouncementRuleUpdateCache          = make(map[string]updateCache)
+	streamingAnnouncementRuleUpsertCacheMut       sync.RWMutex
+	streamingAnnouncementRuleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single streamingAnnouncementRule record from the query using the global executor.
+func (q streamingAnnouncementRuleQuery) OneG(ctx context.Context) (*StreamingAnnouncementRule, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single streamingAnnouncementRule record from the query.
+func (q streamingAnnouncementRuleQuery) One(ctx context.Context, exec boil.ContextExecutor) (*StreamingAnnouncementRule, error) {
+	o := &StreamingAnnouncementRule{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for streaming_announcement_rules")
+	}
+
+	return o, nil
+}
+
+// AllG returns all StreamingAnnouncementRule records from the query using the global executor.
+func (q streamingAnnouncementRuleQuery) AllG(ctx context.Context) (StreamingAnnouncementRuleSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all StreamingAnnouncementRule records from the query.
+func (q streamingAnnouncementRuleQuery) All(ctx context.Context, exec boil.ContextExecutor) (StreamingAnnouncementRuleSlice, error) {
+	var o []*StreamingAnnouncementRule
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to StreamingAnnouncementRule slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all StreamingAnnouncementRule records in the query using the global executor
+func (q streamingAnnouncementRuleQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all StreamingAnnouncementRule records in the query.
+func (q streamingAnnouncementRuleQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count streaming_announcement_rules rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q streamingAnnouncementRuleQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q streamingAnnouncementRuleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if streaming_announcement_rules exists")
+	}
+
+	return count > 0, nil
+}
+
+// StreamingAnnouncementRules retrieves all the records using an executor.
+func StreamingAnnouncementRules(mods ...qm.QueryMod) streamingAnnouncementRuleQuery {
+	mods = append(mods, qm.From("\"streaming_announcement_rules\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"streaming_announcement_rules\".*"})
+	}
+
+	return streamingAnnouncementRuleQuery{q}
+}
+
+// FindStreamingAnnouncementRuleG retrieves a single record by ID.
+func FindStreamingAnnouncementRuleG(ctx context.Context, iD int64, selectCols ...string) (*StreamingAnnouncementRule, error) {
+	return FindStreamingAnnouncementRule(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindStreamingAnnouncementRule retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindStreamingAnnouncementRule(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*StreamingAnnouncementRule, error) {
+	streamingAnnouncementRuleObj := &StreamingAnnouncementRule{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"streaming_announcement_rules\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, streamingAnnouncementRuleObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from streaming_announcement_rules")
+	}
+
+	return streamingAnnouncementRuleObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *StreamingAnnouncementRule) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *StreamingAnnouncementRule) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no streaming_announcement_rules provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(streamingAnnouncementRuleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	streamingAnnouncementRuleInsertCacheMut.RLock()
+	cache, cached := streamingAnnouncementRuleInsertCache[key]
+	streamingAnnouncementRuleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			streamingAnnouncementRuleAllColumns,
+			streamingAnnouncementRuleColumnsWithDefault,
+			streamingAnnouncementRuleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(streamingAnnouncementRuleType, streamingAnnouncementRuleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(streamingAnnouncementRuleType, streamingAnnouncementRuleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"streaming_announcement_rules\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"streaming_announcement_rules\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into streaming_announcement_rules")
+	}
+
+	if !cached {
+		streamingAnnouncementRuleInsertCacheMut.Lock()
+		streamingAnnouncementRuleInsertCache[key] = cache
+		streamingAnnouncementRuleInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single StreamingAnnouncementRule record using the global executor.
+// See Update for more documentation.
+func (o *StreamingAnnouncementRule) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the StreamingAnnouncementRule.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *StreamingAnnouncementRule) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	streamingAnnouncementRuleUpdateCacheMut.RLock()
+	cache, cached := streamingAnnouncementRuleUpdateCache[key]
+	streamingAnnouncementRuleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			streamingAnnouncementRuleAllColumns,
+			streamingAnnouncementRulePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update streaming_announcement_rules, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"streaming_announcement_rules\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, streamingAnnouncementRulePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(streamingAnnouncementRuleType, streamingAnnouncementRuleMapping, append(wl, streamingAnnouncementRulePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update streaming_announcement_rules row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for streaming_announcement_rules")
+	}
+
+	if !cached {
+		streamingAnnouncementRuleUpdateCacheMut.Lock()
+		streamingAnnouncementRuleUpdateCache[key] = cache
+		streamingAnnouncementRuleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q streamingAnnouncementRuleQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q streamingAnnouncementRuleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for streaming_announcement_rules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for streaming_announcement_rules")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o StreamingAnnouncementRuleSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o StreamingAnnouncementRuleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), streamingAnnouncementRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"streaming_announcement_rules\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, streamingAnnouncementRulePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in streamingAnnouncementRule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all streamingAnnouncementRule")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *StreamingAnnouncementRule) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *StreamingAnnouncementRule) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no streaming_announcement_rules provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(streamingAnnouncementRuleColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	streamingAnnouncementRuleUpsertCacheMut.RLock()
+	cache, cached := streamingAnnouncementRuleUpsertCache[key]
+	streamingAnnouncementRuleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			streamingAnnouncementRuleAllColumns,
+			streamingAnnouncementRuleColumnsWithDefault,
+			streamingAnnouncementRuleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			streamingAnnouncementRuleAllColumns,
+			streamingAnnouncementRulePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert streaming_announcement_rules, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(streamingAnnouncementRuleAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(streamingAnnouncementRulePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert streaming_announcement_rules, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(streamingAnnouncementRulePrimaryKeyColumns))
+			copy(conflict, streamingAnnouncementRulePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"streaming_announcement_rules\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(streamingAnnouncementRuleType, streamingAnnouncementRuleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(streamingAnnouncementRuleType, streamingAnnouncementRuleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert streaming_announcement_rules")
+	}
+
+	if !cached {
+		streamingAnnouncementRuleUpsertCacheMut.Lock()
+		streamingAnnouncementRuleUpsertCache[key] = cache
+		streamingAnnouncementRuleUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single StreamingAnnouncementRule record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *StreamingAnnouncementRule) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single StreamingAnnouncementRule record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *StreamingAnnouncementRule) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no StreamingAnnouncementRule provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), streamingAnnouncementRulePrimaryKeyMapping)
+	sql := "DELETE FROM \"streaming_announcement_rules\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from streaming_announcement_rules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for streaming_announcement_rules")
+	}
+
+	return rowsAff, nil
+}
+
+func (q streamingAnnouncementRuleQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q streamingAnnouncementRuleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no streamingAnnouncementRuleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from streaming_announcement_rules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for streaming_announcement_rules")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o StreamingAnnouncementRuleSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o StreamingAnnouncementRuleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), streamingAnnouncementRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"streaming_announcement_rules\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, streamingAnnouncementRulePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from streamingAnnouncementRule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for streaming_announcement_rules")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *StreamingAnnouncementRule) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no StreamingAnnouncementRule provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *StreamingAnnouncementRule) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindStreamingAnnouncementRule(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *StreamingAnnouncementRuleSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty StreamingAnnouncementRuleSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *StreamingAnnouncementRuleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := StreamingAnnouncementRuleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), streamingAnnouncementRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"streaming_announcement_rules\".* FROM \"streaming_announcement_rules\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, streamingAnnouncementRulePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in StreamingAnnouncementRuleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// StreamingAnnouncementRuleExistsG checks if the StreamingAnnouncementRule row exists.
+func StreamingAnnouncementRuleExistsG(ctx context.Context, iD int64) (bool, error) {
+	return StreamingAnnouncementRuleExists(ctx, boil.GetContextDB(), iD)
+}
+
+// StreamingAnnouncementRuleExists checks if the StreamingAnnouncementRule row exists.
+func StreamingAnnouncementRuleExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"streaming_announcement_rules\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if streaming_announcement_rules exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the StreamingAnnouncementRule row exists.
+func (o *StreamingAnnouncementRule) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return StreamingAnnouncementRuleExists(ctx, exec, o.ID)
+}
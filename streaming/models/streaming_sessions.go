@@ -0,0 +1,814 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// StreamingSession is an object representing the database table.
+type StreamingSession struct {
+	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	GuildID   int64     `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	UserID    int64     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	StartedAt time.Time `boil:"started_at" json:"started_at" toml:"started_at" yaml:"started_at"`
+	EndedAt   time.Time `boil:"ended_at" json:"ended_at" toml:"ended_at" yaml:"ended_at"`
+	URL       string    `boil:"url" json:"url" toml:"url" yaml:"url"`
+	Game      string    `boil:"game" json:"game" toml:"game" yaml:"game"`
+	Title     string    `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Platform  string    `boil:"platform" json:"platform" toml:"platform" yaml:"platform"`
+
+	R *streamingSessionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L streamingSessionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var StreamingSessionColumns = struct {
+	ID        string
+	GuildID   string
+	UserID    string
+	StartedAt string
+	EndedAt   string
+	URL       string
+	Game      string
+	Title     string
+	Platform  string
+}{
+	ID:        "id",
+	GuildID:   "guild_id",
+	UserID:    "user_id",
+	StartedAt: "started_at",
+	EndedAt:   "ended_at",
+	URL:       "url",
+	Game:      "game",
+	Title:     "title",
+	Platform:  "platform",
+}
+
+var StreamingSessionTableColumns = struct {
+	ID        string
+	GuildID   string
+	UserID    string
+	StartedAt string
+	EndedAt   string
+	URL       string
+	Game      string
+	Title     string
+	Platform  string
+}{
+	ID:        "streaming_sessions.id",
+	GuildID:   "streaming_sessions.guild_id",
+	UserID:    "streaming_sessions.user_id",
+	StartedAt: "streaming_sessions.started_at",
+	EndedAt:   "streaming_sessions.ended_at",
+	URL:       "streaming_sessions.url",
+	Game:      "streaming_sessions.game",
+	Title:     "streaming_sessions.title",
+	Platform:  "streaming_sessions.platform",
+}
+
+// Generated where
+
+var StreamingSessionWhere = struct {
+	ID        whereHelperint64
+	GuildID   whereHelperint64
+	UserID    whereHelperint64
+	StartedAt whereHelpertime_Time
+	EndedAt   whereHelpertime_Time
+	URL       whereHelperstring
+	Game      whereHelperstring
+	Title     whereHelperstring
+	Platform  whereHelperstring
+}{
+	ID:        whereHelperint64{field: "\"streaming_sessions\".\"id\""},
+	GuildID:   whereHelperint64{field: "\"streaming_sessions\".\"guild_id\""},
+	UserID:    whereHelperint64{field: "\"streaming_sessions\".\"user_id\""},
+	StartedAt: whereHelpertime_Time{field: "\"streaming_sessions\".\"started_at\""},
+	EndedAt:   whereHelpertime_Time{field: "\"streaming_sessions\".\"ended_at\""},
+	URL:       whereHelperstring{field: "\"streaming_sessions\".\"url\""},
+	Game:      whereHelperstring{field: "\"streaming_sessions\".\"game\""},
+	Title:     whereHelperstring{field: "\"streaming_sessions\".\"title\""},
+	Platform:  whereHelperstring{field: "\"streaming_sessions\".\"platform\""},
+}
+
+// StreamingSessionRels is where relationship names are stored.
+var StreamingSessionRels = struct {
+}{}
+
+// streamingSessionR is where relationships are stored.
+type streamingSessionR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*streamingSessionR) NewStruct() *streamingSessionR {
+	return &streamingSessionR{}
+}
+
+// streamingSessionL is where Load methods for each relationship are stored.
+type streamingSessionL struct{}
+
+var (
+	streamingSessionAllColumns            = []string{"id", "guild_id", "user_id", "started_at", "ended_at", "url", "game", "title", "platform"}
+	streamingSessionColumnsWithoutDefault = []string{"guild_id", "user_id", "started_at", "ended_at", "url", "game", "title", "platform"}
+	streamingSessionColumnsWithDefault    = []string{"id"}
+	streamingSessionPrimaryKeyColumns     = []string{"id"}
+	streamingSessionGeneratedColumns      = []string{}
+)
+
+type (
+	// StreamingSessionSlice is an alias for a slice of pointers to StreamingSession.
+	// This should almost always be used instead of []StreamingSession.
+	StreamingSessionSlice []*StreamingSession
+
+	streamingSessionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	streamingSessionType                 = reflect.TypeOf(&StreamingSession{})
+	streamingSessionMapping              = queries.MakeStructMapping(streamingSessionType)
+	streamingSessionPrimaryKeyMapping, _ = queries.BindMapping(streamingSessionType, streamingSessionMapping, streamingSessionPrimaryKeyColumns)
+	streamingSessionInsertCacheMut       sync.RWMutex
+	streamingSessionInsertCache          = make(map[string]insertCache)
+	streamingSessionUpdateCacheMut       sync.RWMutex
+	streamingSessionUpdateCache          = make(map[string]updateCache)
+	streamingSessionUpsertCacheMut       sync.RWMutex
+	streamingSessionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single streamingSession record from the query using the global executor.
+func (q streamingSessionQuery) OneG(ctx context.Context) (*StreamingSession, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single streamingSession record from the query.
+func (q streamingSessionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*StreamingSession, error) {
+	o := &StreamingSession{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for streaming_sessions")
+	}
+
+	return o, nil
+}
+
+// AllG returns all StreamingSession records from the query using the global executor.
+func (q streamingSessionQuery) AllG(ctx context.Context) (StreamingSessionSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all StreamingSession records from the query.
+func (q streamingSessionQuery) All(ctx context.Context, exec boil.ContextExecutor) (StreamingSessionSlice, error) {
+	var o []*StreamingSession
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to StreamingSession slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all StreamingSession records in the query using the global executor
+func (q streamingSessionQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all StreamingSession records in the query.
+func (q streamingSessionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count streaming_sessions rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q streamingSessionQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q streamingSessionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if streaming_sessions exists")
+	}
+
+	return count > 0, nil
+}
+
+// StreamingSessions retrieves all the records using an executor.
+func StreamingSessions(mods ...qm.QueryMod) streamingSessionQuery {
+	mods = append(mods, qm.From("\"streaming_sessions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"streaming_sessions\".*"})
+	}
+
+	return streamingSessionQuery{q}
+}
+
+// FindStreamingSessionG retrieves a single record by ID.
+func FindStreamingSessionG(ctx context.Context, iD int64, selectCols ...string) (*StreamingSession, error) {
+	return FindStreamingSession(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindStreamingSession retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindStreamingSession(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*StreamingSession, error) {
+	streamingSessionObj := &StreamingSession{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"streaming_sessions\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, streamingSessionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from streaming_sessions")
+	}
+
+	return streamingSessionObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *StreamingSession) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *StreamingSession) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no streaming_sessions provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(streamingSessionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	streamingSessionInsertCacheMut.RLock()
+	cache, cached := streamingSessionInsertCache[key]
+	streamingSessionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			streamingSessionAllColumns,
+			streamingSessionColumnsWithDefault,
+			streamingSessionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(streamingSessionType, streamingSessionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(streamingSessionType, streamingSessionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"streaming_sessions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"streaming_sessions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into streaming_sessions")
+	}
+
+	if !cached {
+		streamingSessionInsertCacheMut.Lock()
+		streamingSessionInsertCache[key] = cache
+		streamingSessionInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single StreamingSession record using the global executor.
+// See Update for more documentation.
+func (o *StreamingSession) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the StreamingSession.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *StreamingSession) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	streamingSessionUpdateCacheMut.RLock()
+	cache, cached := streamingSessionUpdateCache[key]
+	streamingSessionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			streamingSessionAllColumns,
+			streamingSessionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update streaming_sessions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"streaming_sessions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, streamingSessionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(streamingSessionType, streamingSessionMapping, append(wl, streamingSessionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update streaming_sessions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for streaming_sessions")
+	}
+
+	if !cached {
+		streamingSessionUpdateCacheMut.Lock()
+		streamingSessionUpdateCache[key] = cache
+		streamingSessionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q streamingSessionQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q streamingSessionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for streaming_sessions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for streaming_sessions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o StreamingSessionSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o StreamingSessionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), streamingSessionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"streaming_sessions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, streamingSessionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in streamingSession slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all streamingSession")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *StreamingSession) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *StreamingSession) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no streaming_sessions provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(streamingSessionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	streamingSessionUpsertCacheMut.RLock()
+	cache, cached := streamingSessionUpsertCache[key]
+	streamingSessionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			streamingSessionAllColumns,
+			streamingSessionColumnsWithDefault,
+			streamingSessionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			streamingSessionAllColumns,
+			streamingSessionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert streaming_sessions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(streamingSessionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(streamingSessionPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert streaming_sessions, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(streamingSessionPrimaryKeyColumns))
+			copy(conflict, streamingSessionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"streaming_sessions\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(streamingSessionType, streamingSessionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(streamingSessionType, streamingSessionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert streaming_sessions")
+	}
+
+	if !cached {
+		streamingSessionUpsertCacheMut.Lock()
+		streamingSessionUpsertCache[key] = cache
+		streamingSessionUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single StreamingSession record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *StreamingSession) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single StreamingSession record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *StreamingSession) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no StreamingSession provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), streamingSessionPrimaryKeyMapping)
+	sql := "DELETE FROM \"streaming_sessions\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from streaming_sessions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for streaming_sessions")
+	}
+
+	return rowsAff, nil
+}
+
+func (q streamingSessionQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q streamingSessionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no streamingSessionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from streaming_sessions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for streaming_sessions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o StreamingSessionSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o StreamingSessionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), streamingSessionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"streaming_sessions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, streamingSessionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from streamingSession slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for streaming_sessions")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *StreamingSession) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no StreamingSession provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *StreamingSession) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindStreamingSession(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *StreamingSessionSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty StreamingSessionSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *StreamingSessionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := StreamingSessionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), streamingSessionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"streaming_sessions\".* FROM \"streaming_sessions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, streamingSessionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in StreamingSessionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// StreamingSessionExistsG checks if the StreamingSession row exists.
+func StreamingSessionExistsG(ctx context.Context, iD int64) (bool, error) {
+	return StreamingSessionExists(ctx, boil.GetContextDB(), iD)
+}
+
+// StreamingSessionExists checks if the StreamingSession row exists.
+func StreamingSessionExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"streaming_sessions\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if streaming_sessions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the StreamingSession row exists.
+func (o *StreamingSession) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return StreamingSessionExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,46 @@
+package streaming
+
+var DBSchemas = []string{`
+CREATE TABLE IF NOT EXISTS streaming_announcement_rules (
+	id BIGSERIAL PRIMARY KEY,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+	guild_id BIGINT NOT NULL,
+	name TEXT NOT NULL,
+	enabled BOOLEAN NOT NULL DEFAULT TRUE,
+
+	channel_id BIGINT NOT NULL DEFAULT 0,
+	message TEXT NOT NULL DEFAULT '',
+	give_role BIGINT NOT NULL DEFAULT 0,
+
+	require_role BIGINT NOT NULL DEFAULT 0,
+	ignore_role BIGINT NOT NULL DEFAULT 0,
+	game_regex TEXT NOT NULL DEFAULT '',
+	title_regex TEXT NOT NULL DEFAULT '',
+
+	end_action TEXT NOT NULL DEFAULT '',
+	end_message TEXT NOT NULL DEFAULT ''
+);
+`, `
+CREATE INDEX IF NOT EXISTS streaming_announcement_rules_guild_idx ON streaming_announcement_rules(guild_id);
+`, `
+CREATE TABLE IF NOT EXISTS streaming_sessions (
+	id BIGSERIAL PRIMARY KEY,
+
+	guild_id BIGINT NOT NULL,
+	user_id BIGINT NOT NULL,
+
+	started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	ended_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+	url TEXT NOT NULL,
+	game TEXT NOT NULL,
+	title TEXT NOT NULL,
+	platform TEXT NOT NULL
+);
+`, `
+CREATE INDEX IF NOT EXISTS streaming_sessions_guild_user_idx ON streaming_sessions(guild_id, user_id);
+`, `
+CREATE INDEX IF NOT EXISTS streaming_sessions_guild_ended_at_idx ON streaming_sessions(guild_id, ended_at);
+`}
@@ -0,0 +1,270 @@
+package streaming
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ThatBathroom/yagpdb/v2/bot"
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/common/templates"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/streaming/models"
+	"github.com/mediocregopher/radix/v3"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// how long an untouched stream session is kept in redis
+const streamSessionTTL = 60 * 60 * 24 * 7
+
+func KeyStreamSession(guildID, userID int64) string {
+	return fmt.Sprintf("streaming_session:%d:%d", guildID, userID)
+}
+
+func KeyStreamAnnouncements(guildID, userID int64) string {
+	return fmt.Sprintf("streaming_announcements:%d:%d", guildID, userID)
+}
+
+// StreamInfo is the stream a member is currently live with
+type StreamInfo struct {
+	URL       string
+	Game      string
+	Title     string
+	Platform  string
+	StartedAt time.Time
+}
+
+func (s *StreamInfo) setTemplateData(ctx *templates.Context) {
+	ctx.Data["URL"] = s.URL
+	ctx.Data["url"] = s.URL
+	ctx.Data["Game"] = s.Game
+	ctx.Data["StreamTitle"] = s.Title
+	ctx.Data["StreamPlatform"] = s.Platform
+}
+
+func startStreamSession(guildID, userID int64, stream *StreamInfo) {
+	serialized, err := json.Marshal(stream)
+	if err != nil {
+		logger.WithError(err).Error("failed serializing stream session")
+		return
+	}
+
+	err = common.RedisPool.Do(radix.FlatCmd(nil, "SET", KeyStreamSession(guildID, userID), serialized, "EX", streamSessionTTL))
+	if err != nil {
+		logger.WithError(err).WithField("guild", guildID).Error("failed storing stream session")
+	}
+}
+
+// trackAnnouncement remembers the announcement so it can be edited or deleted when the stream ends
+func trackAnnouncement(guildID, userID, ruleID, channelID, messageID int64) {
+	key := KeyStreamAnnouncements(guildID, userID)
+	err := common.RedisPool.Do(radix.Pipeline(
+		radix.Cmd(nil, "RPUSH", key, fmt.Sprintf("%d:%d:%d", ruleID, channelID, messageID)),
+		radix.FlatCmd(nil, "EXPIRE", key, streamSessionTTL),
+	))
+	if err != nil {
+		logger.WithError(err).WithField("guild", guildID).Error("failed tracking streaming announcement")
+	}
+}
+
+type trackedAnnouncement struct {
+	RuleID    int64
+	ChannelID int64
+	MessageID int64
+}
+
+func parseTrackedAnnouncement(s string) (*trackedAnnouncement, bool) {
+	split := strings.Split(s, ":")
+	if len(split) != 3 {
+		return nil, false
+	}
+
+	var ids [3]int64
+	for i, v := range split {
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		ids[i] = parsed
+	}
+
+	return &trackedAnnouncement{RuleID: ids[0], ChannelID: ids[1], MessageID: ids[2]}, true
+}
+
+// EndStream is called when a member stops streaming, it stores the stream in the history and
+// deletes or edits the announcements depending on the end action of their rule
+func EndStream(config *Config, rules models.StreamingAnnouncementRuleSlice, guildID, userID int64) {
+	var serializedSession []byte
+	var announcements []string
+	err := common.RedisPool.Do(radix.Pipeline(
+		radix.Cmd(&serializedSession, "GET", KeyStreamSession(guildID, userID)),
+		radix.Cmd(nil, "DEL", KeyStreamSession(guildID, userID)),
+		radix.Cmd(&announcements, "LRANGE", KeyStreamAnnouncements(guildID, userID), "0", "-1"),
+		radix.Cmd(nil, "DEL", KeyStreamAnnouncements(guildID, userID)),
+	))
+	if err != nil {
+		logger.WithError(err).WithField("guild", guildID).Error("failed retrieving stream session")
+		return
+	}
+
+	if len(serializedSession) == 0 {
+		// started streaming before sessions were tracked, or it expired
+		return
+	}
+
+	var stream StreamInfo
+	err = json.Unmarshal(serializedSession, &stream)
+	if err != nil {
+		logger.WithError(err).WithField("guild", guildID).Error("failed decoding stream session")
+		return
+	}
+
+	endedAt := time.Now()
+	recordStreamSession(guildID, userID, &stream, endedAt)
+
+	for _, v := range announcements {
+		tracked, ok := parseTrackedAnnouncement(v)
+		if !ok {
+			continue
+		}
+
+		endAction, endMessage := config.EndAction, config.EndMessage
+		if tracked.RuleID != 0 {
+			endAction = EndActionNone
+			for _, rule := range rules {
+				if rule.ID == tracked.RuleID {
+					endAction, endMessage = rule.EndAction, rule.EndMessage
+					break
+				}
+			}
+		}
+
+		switch endAction {
+		case EndActionDelete:
+			err = common.BotSession.ChannelMessageDelete(tracked.ChannelID, tracked.MessageID)
+		case EndActionEdit:
+			err = editEndedAnnouncement(guildID, userID, tracked, endMessage, &stream, endedAt)
+		default:
+			continue
+		}
+
+		if err != nil && !common.IsDiscordErr(err, discordgo.ErrCodeUnknownMessage, discordgo.ErrCodeUnknownChannel, discordgo.ErrCodeMissingAccess) {
+			logger.WithError(err).WithField("guild", guildID).Error("failed updating ended streaming announcement")
+		}
+	}
+}
+
+func editEndedAnnouncement(guildID, userID int64, tracked *trackedAnnouncement, message string, stream *StreamInfo, endedAt time.Time) error {
+	if message == "" {
+		return nil
+	}
+
+	gs := bot.State.GetGuild(guildID)
+	if gs == nil {
+		return nil
+	}
+
+	cs := gs.GetChannel(tracked.ChannelID)
+	if cs == nil {
+		return nil
+	}
+
+	ms, err := bot.GetMember(guildID, userID)
+	if err != nil {
+		return err
+	}
+
+	duration := endedAt.Sub(stream.StartedAt)
+
+	ctx := templates.NewContext(gs, cs, ms)
+	stream.setTemplateData(ctx)
+	ctx.Data["StreamEnded"] = true
+	ctx.Data["StreamStartedAt"] = stream.StartedAt
+	ctx.Data["StreamDuration"] = common.HumanizeDuration(common.DurationPrecisionMinutes, duration)
+	ctx.Data["StreamDurationSeconds"] = int(math.Round(duration.Seconds()))
+
+	out, err := ctx.Execute(message)
+	if err != nil {
+		logger.WithError(err).WithField("guild", guildID).Warn("Failed executing template")
+		return nil
+	}
+
+	if strings.TrimSpace(out) == "" {
+		return nil
+	}
+
+	msgSend := ctx.MessageSend(out)
+	_, err = common.BotSession.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		ID:              tracked.MessageID,
+		Channel:         tracked.ChannelID,
+		Content:         &msgSend.Content,
+		AllowedMentions: msgSend.AllowedMentions,
+	})
+	return err
+}
+
+func recordStreamSession(guildID, userID int64, stream *StreamInfo, endedAt time.Time) {
+	session := &models.StreamingSession{
+		GuildID:   guildID,
+		UserID:    userID,
+		StartedAt: stream.StartedAt,
+		EndedAt:   endedAt,
+		URL:       common.CutStringShort(stream.URL, 500),
+		Game:      common.CutStringShort(stream.Game, 200),
+		Title:     common.CutStringShort(stream.Title, 200),
+		Platform:  common.CutStringShort(stream.Platform, 100),
+	}
+
+	ctx := context.Background()
+	err := session.InsertG(ctx, boil.Infer())
+	if err != nil {
+		logger.WithError(err).WithField("guild", guildID).Error("failed storing stream session")
+		return
+	}
+
+	_, err = models.StreamingSessions(
+		models.StreamingSessionWhere.GuildID.EQ(guildID),
+		models.StreamingSessionWhere.EndedAt.LT(time.Now().AddDate(0, 0, -StreamHistoryMaxDays)),
+	).DeleteAllG(ctx)
+	if err != nil {
+		logger.WithError(err).WithField("guild", guildID).Error("failed removing old stream sessions")
+	}
+}
+
+// StreamerStats is the summary of a member's stream history
+type StreamerStats struct {
+	UserID       int64     `db:"user_id"`
+	Sessions     int       `db:"sessions"`
+	TotalSeconds int64     `db:"total_seconds"`
+	LastStreamAt time.Time `db:"last_stream_at"`
+
+	Username string `db:"-"`
+}
+
+func (s *StreamerStats) TotalStreamed() string {
+	return common.HumanizeDuration(common.DurationPrecisionMinutes, time.Duration(s.TotalSeconds)*time.Second)
+}
+
+const streamerStatsQuery = "SELECT user_id, count(*) AS sessions, COALESCE(SUM(EXTRACT(EPOCH FROM ended_at - started_at)), 0)::BIGINT AS total_seconds, MAX(ended_at) AS last_stream_at FROM streaming_sessions "
+
+// GetTopStreamers returns the members that streamed the longest in the history of the guild
+func GetTopStreamers(ctx context.Context, guildID int64, limit int) ([]*StreamerStats, error) {
+	var result []*StreamerStats
+	err := common.SQLX.SelectContext(ctx, &result, streamerStatsQuery+"WHERE guild_id = $1 GROUP BY user_id ORDER BY total_seconds DESC LIMIT $2", guildID, limit)
+	return result, err
+}
+
+// GetStreamerStats returns the stream history summary of the member, nil if they have no recorded streams
+func GetStreamerStats(ctx context.Context, guildID, userID int64) (*StreamerStats, error) {
+	var result []*StreamerStats
+	err := common.SQLX.SelectContext(ctx, &result, streamerStatsQuery+"WHERE guild_id = $1 AND user_id = $2 GROUP BY user_id", guildID, userID)
+	if err != nil || len(result) < 1 {
+		return nil, err
+	}
+
+	return result[0], nil
+}
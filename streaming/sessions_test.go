@@ -0,0 +1,32 @@
+package streaming
+
+import "testing"
+
+func TestParseTrackedAnnouncement(t *testing.T) {
+	cases := []struct {
+		in   string
+		want *trackedAnnouncement
+	}{
+		{"0:123:456", &trackedAnnouncement{RuleID: 0, ChannelID: 123, MessageID: 456}},
+		{"7:1:2", &trackedAnnouncement{RuleID: 7, ChannelID: 1, MessageID: 2}},
+		{"", nil},
+		{"1:2", nil},
+		{"1:2:3:4", nil},
+		{"a:2:3", nil},
+		{"1::3", nil},
+	}
+
+	for _, c := range cases {
+		got, ok := parseTrackedAnnouncement(c.in)
+		if c.want == nil {
+			if ok {
+				t.Errorf("parseTrackedAnnouncement(%q): expected it to fail, got %+v", c.in, got)
+			}
+			continue
+		}
+
+		if !ok || *got != *c.want {
+			t.Errorf("parseTrackedAnnouncement(%q) = %+v, %t, want %+v", c.in, got, ok, c.want)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package streaming
 
+//go:generate sqlboiler --no-hooks psql
+
 import (
+	"context"
 	"encoding/json"
 	"strconv"
 
@@ -8,6 +11,8 @@ import (
 	"github.com/ThatBathroom/yagpdb/v2/common"
 	"github.com/ThatBathroom/yagpdb/v2/common/featureflags"
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/streaming/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type Plugin struct{}
@@ -25,6 +30,27 @@ var logger = common.GetPluginLogger(&Plugin{})
 func RegisterPlugin() {
 	plugin := &Plugin{}
 	common.RegisterPlugin(plugin)
+
+	common.InitSchemas("streaming", DBSchemas...)
+}
+
+const (
+	// Max announcement rules per server, on top of the main config
+	MaxRules = 10
+
+	// How long stream history is kept for
+	StreamHistoryMaxDays = 90
+)
+
+// What to do with an announcement when the stream ends
+const (
+	EndActionNone   = ""
+	EndActionDelete = "delete"
+	EndActionEdit   = "edit"
+)
+
+func validEndAction(action string) bool {
+	return action == EndActionNone || action == EndActionDelete || action == EndActionEdit
 }
 
 type Config struct {
@@ -45,6 +71,10 @@ type Config struct {
 	// Match the game name or title against these to filter users out
 	GameRegex  string `json:"game_regex" schema:"game_regex" valid:"regex,2000"`
 	TitleRegex string `json:"title_regex" schema:"title_regex" valid:"regex,2000"`
+
+	// What to do with the announcement when the stream ends, and the message it's edited to
+	EndAction  string `json:"end_action" schema:"end_action"`
+	EndMessage string `json:"end_message" schema:"end_message" valid:"template,2000"`
 }
 
 type LegacyConfig struct {
@@ -65,6 +95,10 @@ type LegacyConfig struct {
 	// Match the game name or title against these to filter users out
 	GameRegex  string `json:"game_regex" schema:"game_regex" valid:"regex,2000"`
 	TitleRegex string `json:"title_regex" schema:"title_regex" valid:"regex,2000"`
+
+	// What to do with the announcement when the stream ends, and the message it's edited to
+	EndAction  string `json:"end_action" schema:"end_action"`
+	EndMessage string `json:"end_message" schema:"end_message" valid:"template,2000"`
 }
 
 func (c *Config) UnmarshalJSON(b []byte) error {
@@ -83,6 +117,8 @@ func (c *Config) UnmarshalJSON(b []byte) error {
 	c.TitleRegex = tmp.TitleRegex
 	c.Enabled = tmp.Enabled
 	c.AnnounceMessage = tmp.AnnounceMessage
+	c.EndAction = tmp.EndAction
+	c.EndMessage = tmp.EndMessage
 
 	return nil
 }
@@ -107,6 +143,19 @@ func GetConfig(guildID int64) (*Config, error) {
 	return config, err
 }
 
+// GetRules returns the announcement rules of the guild, optionally only the enabled ones
+func GetRules(ctx context.Context, guildID int64, onlyEnabled bool) (models.StreamingAnnouncementRuleSlice, error) {
+	qms := []qm.QueryMod{
+		models.StreamingAnnouncementRuleWhere.GuildID.EQ(guildID),
+		qm.OrderBy("id ASC"),
+	}
+	if onlyEnabled {
+		qms = append(qms, models.StreamingAnnouncementRuleWhere.Enabled.EQ(true))
+	}
+
+	return models.StreamingAnnouncementRules(qms...).AllG(ctx)
+}
+
 var _ featureflags.PluginWithFeatureFlags = (*Plugin)(nil)
 
 const (
@@ -119,8 +168,13 @@ func (p *Plugin) UpdateFeatureFlags(guildID int64) ([]string, error) {
 		return nil, errors.WithStackIf(err)
 	}
 
+	rules, err := GetRules(context.Background(), guildID, true)
+	if err != nil {
+		return nil, errors.WithStackIf(err)
+	}
+
 	var flags []string
-	if config.Enabled && (config.GiveRole != 0 || config.AnnounceChannel != 0) {
+	if config.Enabled && (config.GiveRole != 0 || config.AnnounceChannel != 0 || len(rules) > 0) {
 		flags = append(flags, featureFlagEnabled)
 	}
 
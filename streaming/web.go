@@ -2,18 +2,24 @@ package streaming
 
 import (
 	"context"
+	"database/sql"
 	_ "embed"
 	"fmt"
 	"html"
 	"html/template"
 	"net/http"
+	"strconv"
 
+	"github.com/ThatBathroom/yagpdb/v2/bot/botrest"
 	"github.com/ThatBathroom/yagpdb/v2/common"
 	"github.com/ThatBathroom/yagpdb/v2/common/cplogs"
 	"github.com/ThatBathroom/yagpdb/v2/common/featureflags"
 	"github.com/ThatBathroom/yagpdb/v2/common/pubsub"
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/streaming/models"
 	"github.com/ThatBathroom/yagpdb/v2/web"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"goji.io"
 	"goji.io/pat"
 )
@@ -27,7 +33,55 @@ const (
 	ConextKeyConfig ConextKey = iota
 )
 
-var panelLogKey = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "streaming_settings_updated", FormatString: "Updated streaming settings"})
+var (
+	panelLogKey            = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "streaming_settings_updated", FormatString: "Updated streaming settings"})
+	panelLogKeyAddedRule   = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "streaming_added_rule", FormatString: "Added streaming rule: %s"})
+	panelLogKeyUpdatedRule = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "streaming_updated_rule", FormatString: "Updated streaming rule: %s"})
+	panelLogKeyRemovedRule = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "streaming_removed_rule", FormatString: "Removed streaming rule: %s"})
+)
+
+// How many members are shown in the stream history
+const historyTopStreamers = 25
+
+type RuleForm struct {
+	Name    string `schema:"name" valid:",1,100,trimspace"`
+	Enabled bool   `schema:"enabled"`
+
+	AnnounceChannel int64  `schema:"announce_channel" valid:"channel,true"`
+	AnnounceMessage string `schema:"announce_message" valid:"template,2000"`
+	GiveRole        int64  `schema:"give_role" valid:"role,true"`
+
+	RequireRole int64  `schema:"require_role" valid:"role,true"`
+	IgnoreRole  int64  `schema:"ignore_role" valid:"role,true"`
+	GameRegex   string `schema:"game_regex" valid:"regex,2000"`
+	TitleRegex  string `schema:"title_regex" valid:"regex,2000"`
+
+	EndAction  string `schema:"end_action"`
+	EndMessage string `schema:"end_message" valid:"template,2000"`
+}
+
+func (f *RuleForm) Validate(tmpl web.TemplateData, guildID int64) bool {
+	if !validEndAction(f.EndAction) {
+		tmpl.AddAlerts(web.ErrorAlert("Invalid stream end action"))
+		return false
+	}
+
+	if f.AnnounceChannel == 0 && f.GiveRole == 0 {
+		tmpl.AddAlerts(web.ErrorAlert("A rule needs an announcement channel or a role to give"))
+		return false
+	}
+
+	return true
+}
+
+func (c *Config) Validate(tmpl web.TemplateData, guildID int64) bool {
+	if !validEndAction(c.EndAction) {
+		tmpl.AddAlerts(web.ErrorAlert("Invalid stream end action"))
+		return false
+	}
+
+	return true
+}
 
 func (p *Plugin) InitWeb() {
 	web.AddHTMLTemplate("streaming/assets/streaming.html", PageHTML)
@@ -46,12 +100,15 @@ func (p *Plugin) InitWeb() {
 	streamingMux.Use(web.RequirePermMW(discordgo.PermissionManageRoles))
 	streamingMux.Use(baseData)
 
-	// Get just renders the template, so let the renderhandler do all the work
-	streamingMux.Handle(pat.Get(""), web.RenderHandler(nil, "cp_streaming"))
-	streamingMux.Handle(pat.Get("/"), web.RenderHandler(nil, "cp_streaming"))
+	streamingMux.Handle(pat.Get(""), web.RenderHandler(HandleGetStreaming, "cp_streaming"))
+	streamingMux.Handle(pat.Get("/"), web.RenderHandler(HandleGetStreaming, "cp_streaming"))
 
 	streamingMux.Handle(pat.Post(""), web.FormParserMW(web.RenderHandler(HandlePostStreaming, "cp_streaming"), Config{}))
 	streamingMux.Handle(pat.Post("/"), web.FormParserMW(web.RenderHandler(HandlePostStreaming, "cp_streaming"), Config{}))
+
+	streamingMux.Handle(pat.Post("/rules/new"), web.FormParserMW(web.RenderHandler(HandleNewRule, "cp_streaming"), RuleForm{}))
+	streamingMux.Handle(pat.Post("/rules/:rule/update"), web.FormParserMW(web.RenderHandler(HandleUpdateRule, "cp_streaming"), RuleForm{}))
+	streamingMux.Handle(pat.Post("/rules/:rule/delete"), web.RenderHandler(HandleDeleteRule, "cp_streaming"))
 }
 
 // Adds the current config to the context
@@ -64,12 +121,93 @@ func baseData(inner http.Handler) http.Handler {
 			return
 		}
 		tmpl["StreamingConfig"] = config
+
+		rules, err := GetRules(r.Context(), guild.ID, false)
+		if web.CheckErr(tmpl, err, "Failed retrieving streaming rules :'(", web.CtxLogger(r.Context()).Error) {
+			web.LogIgnoreErr(web.Templates.ExecuteTemplate(w, "cp_streaming", tmpl))
+			return
+		}
+		tmpl["StreamingRules"] = rules
+		tmpl["MaxRules"] = MaxRules
+		tmpl["HistoryDays"] = StreamHistoryMaxDays
+
 		inner.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), ConextKeyConfig, config)))
 	}
 
 	return http.HandlerFunc(mw)
 }
 
+// HandleGetStreaming renders the page, with the stream history of the member in the member query parameter if set
+func HandleGetStreaming(w http.ResponseWriter, r *http.Request) interface{} {
+	ctx := r.Context()
+	guild, tmpl := web.GetBaseCPContextData(ctx)
+
+	top, err := GetTopStreamers(ctx, guild.ID, historyTopStreamers)
+	if web.CheckErr(tmpl, err, "Failed retrieving stream history", web.CtxLogger(ctx).Error) {
+		return tmpl
+	}
+	addUsernames(guild.ID, top)
+	tmpl["TopStreamers"] = top
+
+	memberID, _ := strconv.ParseInt(r.URL.Query().Get("member"), 10, 64)
+	if memberID == 0 {
+		return tmpl
+	}
+
+	tmpl["HistoryMemberID"] = memberID
+	stats, err := GetStreamerStats(ctx, guild.ID, memberID)
+	if web.CheckErr(tmpl, err, "Failed retrieving stream history", web.CtxLogger(ctx).Error) {
+		return tmpl
+	}
+
+	if stats == nil {
+		return tmpl.AddAlerts(web.WarningAlert("No recorded streams for that member"))
+	}
+	addUsernames(guild.ID, []*StreamerStats{stats})
+	tmpl["HistoryMember"] = stats
+
+	sessions, err := models.StreamingSessions(
+		models.StreamingSessionWhere.GuildID.EQ(guild.ID),
+		models.StreamingSessionWhere.UserID.EQ(memberID),
+		qm.OrderBy("ended_at DESC"),
+		qm.Limit(historyTopStreamers),
+	).AllG(ctx)
+	if web.CheckErr(tmpl, err, "Failed retrieving stream history", web.CtxLogger(ctx).Error) {
+		return tmpl
+	}
+	tmpl["HistorySessions"] = sessions
+
+	return tmpl
+}
+
+// addUsernames fills in the usernames of the streamers, falling back to their id if they couldn't be fetched
+func addUsernames(guildID int64, stats []*StreamerStats) {
+	if len(stats) < 1 {
+		return
+	}
+
+	userIDs := make([]int64, len(stats))
+	for i, v := range stats {
+		userIDs[i] = v.UserID
+		v.Username = discordgo.StrID(v.UserID)
+	}
+
+	members, err := botrest.GetMembers(guildID, userIDs...)
+	if err != nil {
+		logger.WithError(err).WithField("guild", guildID).Error("failed retrieving streamers")
+		return
+	}
+
+	for _, v := range stats {
+		for _, m := range members {
+			if m != nil && m.User.ID == v.UserID {
+				v.Username = m.User.String()
+				break
+			}
+		}
+	}
+}
+
 func HandlePostStreaming(w http.ResponseWriter, r *http.Request) interface{} {
 	ctx := r.Context()
 	guild, tmpl := web.GetBaseCPContextData(ctx)
@@ -104,6 +242,145 @@ func HandlePostStreaming(w http.ResponseWriter, r *http.Request) interface{} {
 	return tmpl.AddAlerts(web.SucessAlert("Saved settings"))
 }
 
+func (f *RuleForm) apply(rule *models.StreamingAnnouncementRule) {
+	rule.Name = f.Name
+	rule.Enabled = f.Enabled
+	rule.ChannelID = f.AnnounceChannel
+	rule.Message = f.AnnounceMessage
+	rule.GiveRole = f.GiveRole
+	rule.RequireRole = f.RequireRole
+	rule.IgnoreRole = f.IgnoreRole
+	rule.GameRegex = f.GameRegex
+	rule.TitleRegex = f.TitleRegex
+	rule.EndAction = f.EndAction
+	rule.EndMessage = f.EndMessage
+}
+
+func HandleNewRule(w http.ResponseWriter, r *http.Request) interface{} {
+	ctx := r.Context()
+	guild, tmpl := web.GetBaseCPContextData(ctx)
+	tmpl["VisibleURL"] = "/manage/" + discordgo.StrID(guild.ID) + "/streaming/"
+
+	ok := ctx.Value(common.ContextKeyFormOk).(bool)
+	form := ctx.Value(common.ContextKeyParsedForm).(*RuleForm)
+	if !ok {
+		return tmpl
+	}
+
+	count, err := models.StreamingAnnouncementRules(models.StreamingAnnouncementRuleWhere.GuildID.EQ(guild.ID)).CountG(ctx)
+	if web.CheckErr(tmpl, err, "Failed retrieving streaming rules", web.CtxLogger(ctx).Error) {
+		return tmpl
+	}
+
+	if count >= MaxRules {
+		return tmpl.AddAlerts(web.ErrorAlert(fmt.Sprintf("Max %d streaming rules allowed", MaxRules)))
+	}
+
+	rule := &models.StreamingAnnouncementRule{GuildID: guild.ID}
+	form.apply(rule)
+
+	err = rule.InsertG(ctx, boil.Infer())
+	if web.CheckErr(tmpl, err, "Failed saving streaming rule", web.CtxLogger(ctx).Error) {
+		return tmpl
+	}
+
+	rulesUpdated(r, tmpl, guild.ID)
+	go cplogs.RetryAddEntry(web.NewLogEntryFromContext(ctx, panelLogKeyAddedRule, &cplogs.Param{Type: cplogs.ParamTypeString, Value: rule.Name}))
+
+	return tmpl.AddAlerts(web.SucessAlert("Added streaming rule"))
+}
+
+func HandleUpdateRule(w http.ResponseWriter, r *http.Request) interface{} {
+	ctx := r.Context()
+	guild, tmpl := web.GetBaseCPContextData(ctx)
+	tmpl["VisibleURL"] = "/manage/" + discordgo.StrID(guild.ID) + "/streaming/"
+
+	ok := ctx.Value(common.ContextKeyFormOk).(bool)
+	form := ctx.Value(common.ContextKeyParsedForm).(*RuleForm)
+	if !ok {
+		return tmpl
+	}
+
+	rule, err := ruleFromRequest(r, guild.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return tmpl.AddAlerts(web.ErrorAlert("Unknown streaming rule"))
+		}
+		web.CheckErr(tmpl, err, "Failed retrieving streaming rule", web.CtxLogger(ctx).Error)
+		return tmpl
+	}
+
+	form.apply(rule)
+	_, err = rule.UpdateG(ctx, boil.Infer())
+	if web.CheckErr(tmpl, err, "Failed saving streaming rule", web.CtxLogger(ctx).Error) {
+		return tmpl
+	}
+
+	rulesUpdated(r, tmpl, guild.ID)
+	go cplogs.RetryAddEntry(web.NewLogEntryFromContext(ctx, panelLogKeyUpdatedRule, &cplogs.Param{Type: cplogs.ParamTypeString, Value: rule.Name}))
+
+	return tmpl.AddAlerts(web.SucessAlert("Saved streaming rule"))
+}
+
+func HandleDeleteRule(w http.ResponseWriter, r *http.Request) interface{} {
+	ctx := r.Context()
+	guild, tmpl := web.GetBaseCPContextData(ctx)
+	tmpl["VisibleURL"] = "/manage/" + discordgo.StrID(guild.ID) + "/streaming/"
+
+	rule, err := ruleFromRequest(r, guild.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return tmpl.AddAlerts(web.ErrorAlert("Unknown streaming rule"))
+		}
+		web.CheckErr(tmpl, err, "Failed retrieving streaming rule", web.CtxLogger(ctx).Error)
+		return tmpl
+	}
+
+	_, err = rule.DeleteG(ctx)
+	if web.CheckErr(tmpl, err, "Failed removing streaming rule", web.CtxLogger(ctx).Error) {
+		return tmpl
+	}
+
+	rulesUpdated(r, tmpl, guild.ID)
+	go cplogs.RetryAddEntry(web.NewLogEntryFromContext(ctx, panelLogKeyRemovedRule, &cplogs.Param{Type: cplogs.ParamTypeString, Value: rule.Name}))
+
+	return tmpl.AddAlerts(web.SucessAlert("Removed streaming rule"))
+}
+
+func ruleFromRequest(r *http.Request, guildID int64) (*models.StreamingAnnouncementRule, error) {
+	id, err := strconv.ParseInt(pat.Param(r, "rule"), 10, 64)
+	if err != nil {
+		return nil, sql.ErrNoRows
+	}
+
+	return models.StreamingAnnouncementRules(
+		models.StreamingAnnouncementRuleWhere.ID.EQ(id),
+		models.StreamingAnnouncementRuleWhere.GuildID.EQ(guildID),
+	).OneG(r.Context())
+}
+
+// rulesUpdated reloads the rules shown on the page and lets the bot know they changed
+func rulesUpdated(r *http.Request, tmpl web.TemplateData, guildID int64) {
+	ctx := r.Context()
+
+	rules, err := GetRules(ctx, guildID, false)
+	if err != nil {
+		web.CtxLogger(ctx).WithError(err).Error("failed retrieving streaming rules")
+	} else {
+		tmpl["StreamingRules"] = rules
+	}
+
+	err = featureflags.UpdatePluginFeatureFlags(guildID, &Plugin{})
+	if err != nil {
+		web.CtxLogger(ctx).WithError(err).Error("failed updating feature flags")
+	}
+
+	err = pubsub.Publish("update_streaming", guildID, nil)
+	if err != nil {
+		web.CtxLogger(ctx).WithError(err).Error("Failed sending update streaming event")
+	}
+}
+
 var _ web.PluginWithServerHomeWidget = (*Plugin)(nil)
 
 func (p *Plugin) LoadServerHomeWidget(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
@@ -121,6 +398,7 @@ func (p *Plugin) LoadServerHomeWidget(w http.ResponseWriter, r *http.Request) (w
 	<li>Streaming status: %s</li>
 	<li>Streaming role: <code>%s</code>%s</li>
 	<li>Streaming message: <code>#%s</code>%s</li>
+	<li>Announcement rules: <code>%d</code></li>
 </ul>`
 
 	status := web.EnabledDisabledSpanStatus(config.Enabled)
@@ -150,7 +428,12 @@ func (p *Plugin) LoadServerHomeWidget(w http.ResponseWriter, r *http.Request) (w
 		indicatorMessage = web.Indicator(false)
 	}
 
-	templateData["WidgetBody"] = template.HTML(fmt.Sprintf(format, status, roleStr, indicatorRole, channelStr, indicatorMessage))
+	numRules, err := models.StreamingAnnouncementRules(models.StreamingAnnouncementRuleWhere.GuildID.EQ(ag.ID)).CountG(r.Context())
+	if err != nil {
+		return templateData, err
+	}
+
+	templateData["WidgetBody"] = template.HTML(fmt.Sprintf(format, status, roleStr, indicatorRole, channelStr, indicatorMessage, numRules))
 
 	return templateData, nil
 }
@@ -4,9 +4,11 @@
 package models
 
 var TableNames = struct {
-	TimezoneGuildConfigs string
-	UserTimezones        string
+	TimezoneChannelOverrides string
+	TimezoneGuildConfigs     string
+	UserTimezones            string
 }{
-	TimezoneGuildConfigs: "timezone_guild_configs",
-	UserTimezones:        "user_timezones",
+	TimezoneChannelOverrides: "timezone_channel_overrides",
+	TimezoneGuildConfigs:     "timezone_guild_configs",
+	UserTimezones:            "user_timezones",
 }
@@ -0,0 +1,822 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// TimezoneChannelOverride is an object representing the database table.
+type TimezoneChannelOverride struct {
+	ChannelID    int64  `boil:"channel_id" json:"channel_id" toml:"channel_id" yaml:"channel_id"`
+	GuildID      int64  `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	TimezoneName string `boil:"timezone_name" json:"timezone_name" toml:"timezone_name" yaml:"timezone_name"`
+
+	R *timezoneChannelOverrideR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L timezoneChannelOverrideL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TimezoneChannelOverrideColumns = struct {
+	ChannelID    string
+	GuildID      string
+	TimezoneName string
+}{
+	ChannelID:    "channel_id",
+	GuildID:      "guild_id",
+	TimezoneName: "timezone_name",
+}
+
+var TimezoneChannelOverrideTableColumns = struct {
+	ChannelID    string
+	GuildID      string
+	TimezoneName string
+}{
+	ChannelID:    "timezone_channel_overrides.channel_id",
+	GuildID:      "timezone_channel_overrides.guild_id",
+	TimezoneName: "timezone_channel_overrides.timezone_name",
+}
+
+// Generated where
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
+func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var TimezoneChannelOverrideWhere = struct {
+	ChannelID    whereHelperint64
+	GuildID      whereHelperint64
+	TimezoneName whereHelperstring
+}{
+	ChannelID:    whereHelperint64{field: "\"timezone_channel_overrides\".\"channel_id\""},
+	GuildID:      whereHelperint64{field: "\"timezone_channel_overrides\".\"guild_id\""},
+	TimezoneName: whereHelperstring{field: "\"timezone_channel_overrides\".\"timezone_name\""},
+}
+
+// TimezoneChannelOverrideRels is where relationship names are stored.
+var TimezoneChannelOverrideRels = struct {
+}{}
+
+// timezoneChannelOverrideR is where relationships are stored.
+type timezoneChannelOverrideR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*timezoneChannelOverrideR) NewStruct() *timezoneChannelOverrideR {
+	return &timezoneChannelOverrideR{}
+}
+
+// timezoneChannelOverrideL is where Load methods for each relationship are stored.
+type timezoneChannelOverrideL struct{}
+
+var (
+	timezoneChannelOverrideAllColumns            = []string{"channel_id", "guild_id", "timezone_name"}
+	timezoneChannelOverrideColumnsWithoutDefault = []string{"channel_id", "guild_id", "timezone_name"}
+	timezoneChannelOverrideColumnsWithDefault    = []string{}
+	timezoneChannelOverridePrimaryKeyColumns     = []string{"channel_id"}
+	timezoneChannelOverrideGeneratedColumns      = []string{}
+)
+
+type (
+	// TimezoneChannelOverrideSlice is an alias for a slice of pointers to TimezoneChannelOverride.
+	// This should almost always be used instead of []TimezoneChannelOverride.
+	TimezoneChannelOverrideSlice []*TimezoneChannelOverride
+
+	timezoneChannelOverrideQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	timezoneChannelOverrideType                 = reflect.TypeOf(&TimezoneChannelOverride{})
+	timezoneChannelOverrideMapping              = queries.MakeStructMapping(timezoneChannelOverrideType)
+	timezoneChannelOverridePrimaryKeyMapping, _ = queries.BindMapping(timezoneChannelOverrideType, timezoneChannelOverrideMapping, timezoneChannelOverridePrimaryKeyColumns)
+	timezoneChannelOverrideInsertCacheMut       sync.RWMutex
+	timezoneChannelOverrideInsertCache          = make(map[string]insertCache)
+	timezoneChannelOverrideUpdateCacheMut       sync.RWMutex
+	timezoneChannelOverrideUpdateCache          = make(map[string]updateCache)
+	timezoneChannelOverrideUpsertCacheMut       sync.RWMutex
+	timezoneChannelOverrideUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single timezoneChannelOverride record from the query using the global executor.
+func (q timezoneChannelOverrideQuery) OneG(ctx context.Context) (*TimezoneChannelOverride, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single timezoneChannelOverride record from the query.
+func (q timezoneChannelOverrideQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TimezoneChannelOverride, error) {
+	o := &TimezoneChannelOverride{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for timezone_channel_overrides")
+	}
+
+	return o, nil
+}
+
+// AllG returns all TimezoneChannelOverride records from the query using the global executor.
+func (q timezoneChannelOverrideQuery) AllG(ctx context.Context) (TimezoneChannelOverrideSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all TimezoneChannelOverride records from the query.
+func (q timezoneChannelOverrideQuery) All(ctx context.Context, exec boil.ContextExecutor) (TimezoneChannelOverrideSlice, error) {
+	var o []*TimezoneChannelOverride
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TimezoneChannelOverride slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all TimezoneChannelOverride records in the query using the global executor
+func (q timezoneChannelOverrideQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all TimezoneChannelOverride records in the query.
+func (q timezoneChannelOverrideQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count timezone_channel_overrides rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q timezoneChannelOverrideQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q timezoneChannelOverrideQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if timezone_channel_overrides exists")
+	}
+
+	return count > 0, nil
+}
+
+// TimezoneChannelOverrides retrieves all the records using an executor.
+func TimezoneChannelOverrides(mods ...qm.QueryMod) timezoneChannelOverrideQuery {
+	mods = append(mods, qm.From("\"timezone_channel_overrides\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"timezone_channel_overrides\".*"})
+	}
+
+	return timezoneChannelOverrideQuery{q}
+}
+
+// FindTimezoneChannelOverrideG retrieves a single record by ID.
+func FindTimezoneChannelOverrideG(ctx context.Context, channelID int64, selectCols ...string) (*TimezoneChannelOverride, error) {
+	return FindTimezoneChannelOverride(ctx, boil.GetContextDB(), channelID, selectCols...)
+}
+
+// FindTimezoneChannelOverride retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTimezoneChannelOverride(ctx context.Context, exec boil.ContextExecutor, channelID int64, selectCols ...string) (*TimezoneChannelOverride, error) {
+	timezoneChannelOverrideObj := &TimezoneChannelOverride{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"timezone_channel_overrides\" where \"channel_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, channelID)
+
+	err := q.Bind(ctx, exec, timezoneChannelOverrideObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from timezone_channel_overrides")
+	}
+
+	return timezoneChannelOverrideObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *TimezoneChannelOverride) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TimezoneChannelOverride) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no timezone_channel_overrides provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(timezoneChannelOverrideColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	timezoneChannelOverrideInsertCacheMut.RLock()
+	cache, cached := timezoneChannelOverrideInsertCache[key]
+	timezoneChannelOverrideInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			timezoneChannelOverrideAllColumns,
+			timezoneChannelOverrideColumnsWithDefault,
+			timezoneChannelOverrideColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(timezoneChannelOverrideType, timezoneChannelOverrideMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(timezoneChannelOverrideType, timezoneChannelOverrideMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"timezone_channel_overrides\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"timezone_channel_overrides\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into timezone_channel_overrides")
+	}
+
+	if !cached {
+		timezoneChannelOverrideInsertCacheMut.Lock()
+		timezoneChannelOverrideInsertCache[key] = cache
+		timezoneChannelOverrideInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single TimezoneChannelOverride record using the global executor.
+// See Update for more documentation.
+func (o *TimezoneChannelOverride) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the TimezoneChannelOverride.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TimezoneChannelOverride) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	timezoneChannelOverrideUpdateCacheMut.RLock()
+	cache, cached := timezoneChannelOverrideUpdateCache[key]
+	timezoneChannelOverrideUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			timezoneChannelOverrideAllColumns,
+			timezoneChannelOverridePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update timezone_channel_overrides, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"timezone_channel_overrides\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, timezoneChannelOverridePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(timezoneChannelOverrideType, timezoneChannelOverrideMapping, append(wl, timezoneChannelOverridePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update timezone_channel_overrides row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for timezone_channel_overrides")
+	}
+
+	if !cached {
+		timezoneChannelOverrideUpdateCacheMut.Lock()
+		timezoneChannelOverrideUpdateCache[key] = cache
+		timezoneChannelOverrideUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q timezoneChannelOverrideQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q timezoneChannelOverrideQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for timezone_channel_overrides")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for timezone_channel_overrides")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o TimezoneChannelOverrideSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TimezoneChannelOverrideSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), timezoneChannelOverridePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"timezone_channel_overrides\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, timezoneChannelOverridePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in timezoneChannelOverride slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all timezoneChannelOverride")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *TimezoneChannelOverride) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TimezoneChannelOverride) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no timezone_channel_overrides provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(timezoneChannelOverrideColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	timezoneChannelOverrideUpsertCacheMut.RLock()
+	cache, cached := timezoneChannelOverrideUpsertCache[key]
+	timezoneChannelOverrideUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			timezoneChannelOverrideAllColumns,
+			timezoneChannelOverrideColumnsWithDefault,
+			timezoneChannelOverrideColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			timezoneChannelOverrideAllColumns,
+			timezoneChannelOverridePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert timezone_channel_overrides, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(timezoneChannelOverrideAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(timezoneChannelOverridePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert timezone_channel_overrides, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(timezoneChannelOverridePrimaryKeyColumns))
+			copy(conflict, timezoneChannelOverridePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"timezone_channel_overrides\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(timezoneChannelOverrideType, timezoneChannelOverrideMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(timezoneChannelOverrideType, timezoneChannelOverrideMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert timezone_channel_overrides")
+	}
+
+	if !cached {
+		timezoneChannelOverrideUpsertCacheMut.Lock()
+		timezoneChannelOverrideUpsertCache[key] = cache
+		timezoneChannelOverrideUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single TimezoneChannelOverride record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *TimezoneChannelOverride) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single TimezoneChannelOverride record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TimezoneChannelOverride) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TimezoneChannelOverride provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), timezoneChannelOverridePrimaryKeyMapping)
+	sql := "DELETE FROM \"timezone_channel_overrides\" WHERE \"channel_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from timezone_channel_overrides")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for timezone_channel_overrides")
+	}
+
+	return rowsAff, nil
+}
+
+func (q timezoneChannelOverrideQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q timezoneChannelOverrideQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no timezoneChannelOverrideQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from timezone_channel_overrides")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for timezone_channel_overrides")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o TimezoneChannelOverrideSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TimezoneChannelOverrideSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), timezoneChannelOverridePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"timezone_channel_overrides\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, timezoneChannelOverridePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from timezoneChannelOverride slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for timezone_channel_overrides")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *TimezoneChannelOverride) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no TimezoneChannelOverride provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TimezoneChannelOverride) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTimezoneChannelOverride(ctx, exec, o.ChannelID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TimezoneChannelOverrideSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty TimezoneChannelOverrideSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TimezoneChannelOverrideSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TimezoneChannelOverrideSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), timezoneChannelOverridePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"timezone_channel_overrides\".* FROM \"timezone_channel_overrides\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, timezoneChannelOverridePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TimezoneChannelOverrideSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TimezoneChannelOverrideExistsG checks if the TimezoneChannelOverride row exists.
+func TimezoneChannelOverrideExistsG(ctx context.Context, channelID int64) (bool, error) {
+	return TimezoneChannelOverrideExists(ctx, boil.GetContextDB(), channelID)
+}
+
+// TimezoneChannelOverrideExists checks if the TimezoneChannelOverride row exists.
+func TimezoneChannelOverrideExists(ctx context.Context, exec boil.ContextExecutor, channelID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"timezone_channel_overrides\" where \"channel_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, channelID)
+	}
+	row := exec.QueryRowContext(ctx, sql, channelID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if timezone_channel_overrides exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TimezoneChannelOverride row exists.
+func (o *TimezoneChannelOverride) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TimezoneChannelOverrideExists(ctx, exec, o.ChannelID)
+}
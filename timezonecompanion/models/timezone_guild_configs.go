@@ -28,6 +28,7 @@ type TimezoneGuildConfig struct {
 	DisabledInChannels  types.Int64Array `boil:"disabled_in_channels" json:"disabled_in_channels,omitempty" toml:"disabled_in_channels" yaml:"disabled_in_channels,omitempty"`
 	EnabledInChannels   types.Int64Array `boil:"enabled_in_channels" json:"enabled_in_channels,omitempty" toml:"enabled_in_channels" yaml:"enabled_in_channels,omitempty"`
 	NewChannelsDisabled bool             `boil:"new_channels_disabled" json:"new_channels_disabled" toml:"new_channels_disabled" yaml:"new_channels_disabled"`
+	DefaultTimezone     string           `boil:"default_timezone" json:"default_timezone" toml:"default_timezone" yaml:"default_timezone"`
 
 	R *timezoneGuildConfigR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L timezoneGuildConfigL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -38,11 +39,13 @@ var TimezoneGuildConfigColumns = struct {
 	DisabledInChannels  string
 	EnabledInChannels   string
 	NewChannelsDisabled string
+	DefaultTimezone     string
 }{
 	GuildID:             "guild_id",
 	DisabledInChannels:  "disabled_in_channels",
 	EnabledInChannels:   "enabled_in_channels",
 	NewChannelsDisabled: "new_channels_disabled",
+	DefaultTimezone:     "default_timezone",
 }
 
 var TimezoneGuildConfigTableColumns = struct {
@@ -50,38 +53,17 @@ var TimezoneGuildConfigTableColumns = struct {
 	DisabledInChannels  string
 	EnabledInChannels   string
 	NewChannelsDisabled string
+	DefaultTimezone     string
 }{
 	GuildID:             "timezone_guild_configs.guild_id",
 	DisabledInChannels:  "timezone_guild_configs.disabled_in_channels",
 	EnabledInChannels:   "timezone_guild_configs.enabled_in_channels",
 	NewChannelsDisabled: "timezone_guild_configs.new_channels_disabled",
+	DefaultTimezone:     "timezone_guild_configs.default_timezone",
 }
 
 // Generated where
 
-type whereHelperint64 struct{ field string }
-
-func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelpertypes_Int64Array struct{ field string }
 
 func (w whereHelpertypes_Int64Array) EQ(x types.Int64Array) qm.QueryMod {
@@ -120,11 +102,13 @@ var TimezoneGuildConfigWhere = struct {
 	DisabledInChannels  whereHelpertypes_Int64Array
 	EnabledInChannels   whereHelpertypes_Int64Array
 	NewChannelsDisabled whereHelperbool
+	DefaultTimezone     whereHelperstring
 }{
 	GuildID:             whereHelperint64{field: "\"timezone_guild_configs\".\"guild_id\""},
 	DisabledInChannels:  whereHelpertypes_Int64Array{field: "\"timezone_guild_configs\".\"disabled_in_channels\""},
 	EnabledInChannels:   whereHelpertypes_Int64Array{field: "\"timezone_guild_configs\".\"enabled_in_channels\""},
 	NewChannelsDisabled: whereHelperbool{field: "\"timezone_guild_configs\".\"new_channels_disabled\""},
+	DefaultTimezone:     whereHelperstring{field: "\"timezone_guild_configs\".\"default_timezone\""},
 }
 
 // TimezoneGuildConfigRels is where relationship names are stored.
@@ -144,9 +128,9 @@ func (*timezoneGuildConfigR) NewStruct() *timezoneGuildConfigR {
 type timezoneGuildConfigL struct{}
 
 var (
-	timezoneGuildConfigAllColumns            = []string{"guild_id", "disabled_in_channels", "enabled_in_channels", "new_channels_disabled"}
-	timezoneGuildConfigColumnsWithoutDefault = []string{"guild_id", "new_channels_disabled"}
-	timezoneGuildConfigColumnsWithDefault    = []string{"disabled_in_channels", "enabled_in_channels"}
+	timezoneGuildConfigAllColumns            = []string{"guild_id", "disabled_in_channels", "enabled_in_channels", "new_channels_disabled", "default_timezone"}
+	timezoneGuildConfigColumnsWithoutDefault = []string{"guild_id"}
+	timezoneGuildConfigColumnsWithDefault    = []string{"disabled_in_channels", "enabled_in_channels", "new_channels_disabled", "default_timezone"}
 	timezoneGuildConfigPrimaryKeyColumns     = []string{"guild_id"}
 	timezoneGuildConfigGeneratedColumns      = []string{}
 )
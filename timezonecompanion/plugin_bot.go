@@ -230,6 +230,111 @@ func (p *Plugin) AddCommands() {
 
 			return resp, nil
 		},
+	}, &commands.YAGCommand{
+		CmdCategory:         commands.CategoryTool,
+		Name:                "ServerTimezone",
+		Aliases:             []string{"servertz", "setservertz"},
+		Description:         "Sets the server's default timezone, used for members that haven't registered their own timezone (setz). Use -channel to override it in the current channel only.",
+		RequireDiscordPerms: []int64{discordgo.PermissionManageGuild},
+		Arguments: []*dcmd.ArgDef{
+			{Name: "Timezone", Type: dcmd.String},
+		},
+		ArgSwitches: []*dcmd.ArgDef{
+			{Name: "channel", Help: "Only set it for the current channel"},
+			{Name: "d", Help: "Delete the server default, or the channel override with -channel"},
+		},
+		RunFunc: func(parsed *dcmd.Data) (interface{}, error) {
+			guildID := parsed.GuildData.GS.ID
+			channelOnly := parsed.Switch("channel").Bool()
+
+			conf, err := models.FindTimezoneGuildConfigG(parsed.Context(), guildID)
+			if err != nil {
+				if err != sql.ErrNoRows {
+					return nil, err
+				}
+
+				conf = &models.TimezoneGuildConfig{GuildID: guildID}
+			}
+
+			if parsed.Switch("d").Bool() {
+				if channelOnly {
+					_, err = models.TimezoneChannelOverrides(models.TimezoneChannelOverrideWhere.ChannelID.EQ(parsed.ChannelID)).DeleteAllG(parsed.Context())
+					if err != nil {
+						return nil, err
+					}
+
+					return "Removed the timezone override for this channel", nil
+				}
+
+				conf.DefaultTimezone = ""
+				err = conf.UpsertG(parsed.Context(), true, []string{"guild_id"}, boil.Whitelist("default_timezone"), boil.Infer())
+				if err != nil {
+					return nil, err
+				}
+
+				return "Removed the server's default timezone", nil
+			}
+
+			if parsed.Args[0].Str() == "" {
+				out := "The server has no default timezone"
+				if conf.DefaultTimezone != "" {
+					out = "The server's default timezone is " + StrZone(conf.DefaultTimezone)
+				}
+
+				override, err := models.FindTimezoneChannelOverrideG(parsed.Context(), parsed.ChannelID)
+				if err != nil && err != sql.ErrNoRows {
+					return nil, err
+				}
+
+				if override != nil {
+					out += "\nThis channel uses " + StrZone(override.TimezoneName)
+				}
+
+				return out, nil
+			}
+
+			zone, candidates := matchZone(parsed.Args[0].Str())
+			if zone == "" {
+				if len(candidates) < 1 {
+					return "Unknown timezone, enter a country or timezone (not abbreviation like CET). there's a timezone picker here: <https://kevinnovak.github.io/Time-Zone-Picker/> you can use, enter the `Area/City` result", nil
+				}
+
+				if len(candidates) > 10 {
+					candidates = candidates[:10]
+				}
+
+				out := "More than 1 result, reuse the command with one of the following:\n"
+				for _, v := range candidates {
+					if s := StrZone(v); s != "" {
+						out += s + "\n"
+					}
+				}
+				return out, nil
+			}
+
+			if channelOnly {
+				override := &models.TimezoneChannelOverride{
+					ChannelID:    parsed.ChannelID,
+					GuildID:      guildID,
+					TimezoneName: zone,
+				}
+
+				err = override.UpsertG(parsed.Context(), true, []string{"channel_id"}, boil.Whitelist("timezone_name"), boil.Infer())
+				if err != nil {
+					return nil, err
+				}
+
+				return fmt.Sprintf("Set the timezone of this channel to %s", StrZone(zone)), nil
+			}
+
+			conf.DefaultTimezone = zone
+			err = conf.UpsertG(parsed.Context(), true, []string{"guild_id"}, boil.Whitelist("default_timezone"), boil.Infer())
+			if err != nil {
+				return nil, err
+			}
+
+			return fmt.Sprintf("Set the server's default timezone to %s", StrZone(zone)), nil
+		},
 	})
 }
 
@@ -281,6 +386,73 @@ func GetUserTimezone(userID int64) *time.Location {
 	return loc
 }
 
+// GetGuildTimezone returns the timezone set for the channel, falling back to the server's default timezone.
+// Returns nil if neither is set.
+func GetGuildTimezone(guildID, channelID int64) *time.Location {
+	name := ""
+
+	override, err := models.FindTimezoneChannelOverrideG(context.Background(), channelID)
+	if err == nil {
+		name = override.TimezoneName
+	} else if err != sql.ErrNoRows {
+		logger.WithError(err).WithField("guild", guildID).Error("failed retrieving channel timezone")
+	}
+
+	if name == "" {
+		conf, err := models.FindTimezoneGuildConfigG(context.Background(), guildID)
+		if err != nil {
+			if err != sql.ErrNoRows {
+				logger.WithError(err).WithField("guild", guildID).Error("failed retrieving guild config")
+			}
+			return nil
+		}
+
+		name = conf.DefaultTimezone
+	}
+
+	if name == "" {
+		return nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		logger.WithError(err).Error("failed loading location")
+		return nil
+	}
+
+	return loc
+}
+
+// GetTimezone returns the user's registered timezone, falling back to the channel's and then the server's default timezone.
+// Returns nil if none of them are set.
+func GetTimezone(userID, guildID, channelID int64) *time.Location {
+	if loc := GetUserTimezone(userID); loc != nil {
+		return loc
+	}
+
+	if guildID == 0 {
+		return nil
+	}
+
+	return GetGuildTimezone(guildID, channelID)
+}
+
+// matchZone returns the zone matching the input, if there's no single match the candidates are returned instead
+func matchZone(in string) (zone string, candidates []string) {
+	zones := FindZone(in)
+	if len(zones) == 1 {
+		return zones[0], nil
+	}
+
+	for _, v := range zones {
+		if strings.EqualFold(v, in) {
+			return v, nil
+		}
+	}
+
+	return "", zones
+}
+
 func FindZone(in string) []string {
 	lowerIn := strings.ToLower(in)
 	inSpaceReplaced := strings.ReplaceAll(lowerIn, " ", "_")
@@ -351,9 +523,15 @@ func (p *Plugin) handleMessageCreate(evt *eventsystem.EventData) {
 		return
 	}
 
+	footer := "in your local time"
 	zone := GetUserTimezone(m.Author.ID)
 	if zone == nil {
-		return
+		zone = GetGuildTimezone(m.GuildID, m.ChannelID)
+		if zone == nil {
+			return
+		}
+
+		footer = "in the server's timezone"
 	}
 
 	// re-parse it with proper context
@@ -365,7 +543,7 @@ func (p *Plugin) handleMessageCreate(evt *eventsystem.EventData) {
 	common.BotSession.ChannelMessageSendEmbed(m.ChannelID, &discordgo.MessageEmbed{
 		Timestamp: result.Time.Format(time.RFC3339),
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "Above time (" + result.Time.Format("15:04 MST") + ") in your local time",
+			Text: "Above time (" + result.Time.Format("15:04 MST") + ") " + footer,
 		},
 	})
 
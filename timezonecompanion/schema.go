@@ -17,4 +17,15 @@ ALTER TABLE timezone_guild_configs ADD COLUMN IF NOT EXISTS new_channels_disable
 CREATE TABLE IF NOT EXISTS user_timezones(
 	user_id BIGINT PRIMARY KEY,
 	timezone_name TEXT NOT NULL
-);`}
+);`, `
+ALTER TABLE timezone_guild_configs ADD COLUMN IF NOT EXISTS default_timezone TEXT NOT NULL DEFAULT '';
+`, `
+CREATE TABLE IF NOT EXISTS timezone_channel_overrides (
+	channel_id BIGINT PRIMARY KEY,
+	guild_id BIGINT NOT NULL,
+
+	timezone_name TEXT NOT NULL
+);
+`, `
+CREATE INDEX IF NOT EXISTS timezone_channel_overrides_guild_id_idx ON timezone_channel_overrides(guild_id);
+`}
@@ -0,0 +1,45 @@
+package timezonecompanion
+
+import (
+	"time"
+
+	"github.com/ThatBathroom/yagpdb/v2/common/templates"
+)
+
+func init() {
+	templates.RegisterSetupFunc(func(ctx *templates.Context) {
+		ctx.ContextFuncs["formatTime"] = tmplFormatTime(ctx)
+	})
+}
+
+// tmplFormatTime formats UTC times in the channel's or server's timezone if one is set,
+// times that were explicitly put in another location are left as is
+func tmplFormatTime(ctx *templates.Context) interface{} {
+	var loc *time.Location
+	looked := false
+
+	return func(t time.Time, args ...string) string {
+		layout := time.RFC822
+		if len(args) > 0 {
+			layout = args[0]
+		}
+
+		if t.Location() == time.UTC && ctx.GS != nil {
+			if !looked {
+				channelID := int64(0)
+				if ctx.CurrentFrame.CS != nil {
+					channelID = ctx.CurrentFrame.CS.ID
+				}
+
+				loc = GetGuildTimezone(ctx.GS.ID, channelID)
+				looked = true
+			}
+
+			if loc != nil {
+				t = t.In(loc)
+			}
+		}
+
+		return t.Format(layout)
+	}
+}
@@ -0,0 +1,64 @@
+package twitter
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/ThatBathroom/yagpdb/v2/feeds"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/twitter/models"
+	twitterscraper "github.com/n0madic/twitter-scraper"
+)
+
+var _ feeds.DigestPlugin = (*Plugin)(nil)
+
+func digestSettings(feed *models.TwitterFeed) feeds.DigestSettings {
+	return feeds.DigestSettings{
+		Mode:    feed.DigestMode,
+		DailyAt: feed.DigestDailyAt,
+	}
+}
+
+func queueDigestTweet(feed *models.TwitterFeed, t *twitterscraper.Tweet) error {
+	title, _, _ := strings.Cut(t.Text, "\n")
+	if title == "" {
+		title = "Tweet"
+	}
+
+	return feeds.QueueDigestItem("twitter", feed.ID, feed.GuildID, feed.ChannelID, digestSettings(feed), &feeds.DigestItem{
+		Title:  title,
+		URL:    t.PermanentURL,
+		Author: "@" + t.Username,
+	})
+}
+
+// DigestTarget implements feeds.DigestPlugin
+func (p *Plugin) DigestTarget(subscriptionID int64) (*feeds.DigestTarget, error) {
+	feed, err := models.FindTwitterFeedG(context.Background(), subscriptionID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	settings := digestSettings(feed)
+	if !feed.Enabled || settings.Immediate() {
+		return nil, nil
+	}
+
+	return &feeds.DigestTarget{
+		GuildID:   feed.GuildID,
+		ChannelID: feed.ChannelID,
+		Settings:  settings,
+		Name:      "@" + feed.TwitterUsername,
+		URL:       "https://twitter.com/" + feed.TwitterUsername,
+		Color:     0x38A1F3,
+		AllowedMentions: discordgo.AllowedMentions{
+			Parse: []discordgo.AllowedMentionType{},
+		},
+		UseWebhook:      true,
+		WebhookUsername: "Twitter • YAGPDB",
+	}, nil
+}
@@ -232,6 +232,14 @@ OUTER:
 	for _, v := range relevantFeeds {
 		go analytics.RecordActiveUnit(v.GuildID, p, "posted_twitter_message")
 
+		if !digestSettings(v).Immediate() {
+			err := queueDigestTweet(v, t)
+			if err != nil {
+				logger.WithError(err).WithField("feed_id", v.ID).Error("Failed queueing tweet for digest")
+			}
+			continue
+		}
+
 		mqueue.QueueMessage(&mqueue.QueuedElement{
 			Source:       "twitter",
 			SourceItemID: strconv.FormatInt(v.ID, 10),
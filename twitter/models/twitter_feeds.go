@@ -32,6 +32,8 @@ type TwitterFeed struct {
 	Enabled         bool      `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
 	IncludeReplies  bool      `boil:"include_replies" json:"include_replies" toml:"include_replies" yaml:"include_replies"`
 	IncludeRT       bool      `boil:"include_rt" json:"include_rt" toml:"include_rt" yaml:"include_rt"`
+	DigestMode      string    `boil:"digest_mode" json:"digest_mode" toml:"digest_mode" yaml:"digest_mode"`
+	DigestDailyAt   int       `boil:"digest_daily_at" json:"digest_daily_at" toml:"digest_daily_at" yaml:"digest_daily_at"`
 
 	R *twitterFeedR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L twitterFeedL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -47,6 +49,8 @@ var TwitterFeedColumns = struct {
 	Enabled         string
 	IncludeReplies  string
 	IncludeRT       string
+	DigestMode      string
+	DigestDailyAt   string
 }{
 	ID:              "id",
 	GuildID:         "guild_id",
@@ -57,6 +61,8 @@ var TwitterFeedColumns = struct {
 	Enabled:         "enabled",
 	IncludeReplies:  "include_replies",
 	IncludeRT:       "include_rt",
+	DigestMode:      "digest_mode",
+	DigestDailyAt:   "digest_daily_at",
 }
 
 var TwitterFeedTableColumns = struct {
@@ -69,6 +75,8 @@ var TwitterFeedTableColumns = struct {
 	Enabled         string
 	IncludeReplies  string
 	IncludeRT       string
+	DigestMode      string
+	DigestDailyAt   string
 }{
 	ID:              "twitter_feeds.id",
 	GuildID:         "twitter_feeds.guild_id",
@@ -79,6 +87,8 @@ var TwitterFeedTableColumns = struct {
 	Enabled:         "twitter_feeds.enabled",
 	IncludeReplies:  "twitter_feeds.include_replies",
 	IncludeRT:       "twitter_feeds.include_rt",
+	DigestMode:      "twitter_feeds.digest_mode",
+	DigestDailyAt:   "twitter_feeds.digest_daily_at",
 }
 
 // Generated where
@@ -163,6 +173,29 @@ func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field
 func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
 func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var TwitterFeedWhere = struct {
 	ID              whereHelperint64
 	GuildID         whereHelperint64
@@ -173,6 +206,8 @@ var TwitterFeedWhere = struct {
 	Enabled         whereHelperbool
 	IncludeReplies  whereHelperbool
 	IncludeRT       whereHelperbool
+	DigestMode      whereHelperstring
+	DigestDailyAt   whereHelperint
 }{
 	ID:              whereHelperint64{field: "\"twitter_feeds\".\"id\""},
 	GuildID:         whereHelperint64{field: "\"twitter_feeds\".\"guild_id\""},
@@ -183,6 +218,8 @@ var TwitterFeedWhere = struct {
 	Enabled:         whereHelperbool{field: "\"twitter_feeds\".\"enabled\""},
 	IncludeReplies:  whereHelperbool{field: "\"twitter_feeds\".\"include_replies\""},
 	IncludeRT:       whereHelperbool{field: "\"twitter_feeds\".\"include_rt\""},
+	DigestMode:      whereHelperstring{field: "\"twitter_feeds\".\"digest_mode\""},
+	DigestDailyAt:   whereHelperint{field: "\"twitter_feeds\".\"digest_daily_at\""},
 }
 
 // TwitterFeedRels is where relationship names are stored.
@@ -202,9 +239,9 @@ func (*twitterFeedR) NewStruct() *twitterFeedR {
 type twitterFeedL struct{}
 
 var (
-	twitterFeedAllColumns            = []string{"id", "guild_id", "created_at", "twitter_username", "twitter_user_id", "channel_id", "enabled", "include_replies", "include_rt"}
+	twitterFeedAllColumns            = []string{"id", "guild_id", "created_at", "twitter_username", "twitter_user_id", "channel_id", "enabled", "include_replies", "include_rt", "digest_mode", "digest_daily_at"}
 	twitterFeedColumnsWithoutDefault = []string{"guild_id", "created_at", "twitter_username", "twitter_user_id", "channel_id", "enabled"}
-	twitterFeedColumnsWithDefault    = []string{"id", "include_replies", "include_rt"}
+	twitterFeedColumnsWithDefault    = []string{"id", "include_replies", "include_rt", "digest_mode", "digest_daily_at"}
 	twitterFeedPrimaryKeyColumns     = []string{"id"}
 	twitterFeedGeneratedColumns      = []string{}
 )
@@ -17,5 +17,9 @@ CREATE INDEX IF NOT EXISTS twitter_user_id_idx ON twitter_feeds(twitter_user_id)
 ALTER TABLE twitter_feeds ADD COLUMN IF NOT EXISTS include_replies BOOLEAN NOT NULL DEFAULT false;
 `, `
 ALTER TABLE twitter_feeds ADD COLUMN IF NOT EXISTS include_rt BOOLEAN NOT NULL DEFAULT true;
+`, `
+ALTER TABLE twitter_feeds ADD COLUMN IF NOT EXISTS digest_mode TEXT NOT NULL DEFAULT '';
+`, `
+ALTER TABLE twitter_feeds ADD COLUMN IF NOT EXISTS digest_daily_at INT NOT NULL DEFAULT 0;
 `,
 }
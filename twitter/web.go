@@ -10,6 +10,7 @@ import (
 
 	"github.com/ThatBathroom/yagpdb/v2/common"
 	"github.com/ThatBathroom/yagpdb/v2/common/cplogs"
+	"github.com/ThatBathroom/yagpdb/v2/feeds"
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
 	"github.com/ThatBathroom/yagpdb/v2/premium"
 	"github.com/ThatBathroom/yagpdb/v2/twitter/models"
@@ -27,6 +28,19 @@ type Form struct {
 	TwitterUser    string `valid:",1,256"`
 	DiscordChannel int64  `valid:"channel,false"`
 	ID             int64
+	DigestMode     string
+	DigestDailyAt  string
+}
+
+var _ web.CustomValidator = (*Form)(nil)
+
+func (f *Form) Validate(tmpl web.TemplateData, _ int64) bool {
+	if _, err := feeds.ParseDigestForm(f.DigestMode, f.DigestDailyAt); err != nil {
+		tmpl.AddAlerts(web.ErrorAlert(err.Error()))
+		return false
+	}
+
+	return true
 }
 
 type EditForm struct {
@@ -34,6 +48,19 @@ type EditForm struct {
 	IncludeReplies  bool
 	IncludeRetweets bool
 	Enabled         bool
+	DigestMode      string
+	DigestDailyAt   string
+}
+
+var _ web.CustomValidator = (*EditForm)(nil)
+
+func (f *EditForm) Validate(tmpl web.TemplateData, _ int64) bool {
+	if _, err := feeds.ParseDigestForm(f.DigestMode, f.DigestDailyAt); err != nil {
+		tmpl.AddAlerts(web.ErrorAlert(err.Error()))
+		return false
+	}
+
+	return true
 }
 
 var (
@@ -115,12 +142,16 @@ func (p *Plugin) HandleNew(w http.ResponseWriter, r *http.Request) (web.Template
 	if err != nil {
 		return templateData.AddAlerts(web.ErrorAlert("Failed getting user id")), nil
 	}
+
+	digestDailyAt, _ := feeds.ParseDigestForm(form.DigestMode, form.DigestDailyAt)
 	m := &models.TwitterFeed{
 		GuildID:         activeGuild.ID,
 		TwitterUsername: user.Username,
 		TwitterUserID:   userId,
 		ChannelID:       form.DiscordChannel,
 		Enabled:         true,
+		DigestMode:      form.DigestMode,
+		DigestDailyAt:   digestDailyAt,
 	}
 
 	err = m.InsertG(ctx, boil.Infer())
@@ -174,8 +205,10 @@ func (p *Plugin) HandleEdit(w http.ResponseWriter, r *http.Request) (templateDat
 	sub.Enabled = data.Enabled
 	sub.IncludeRT = data.IncludeRetweets
 	sub.IncludeReplies = data.IncludeReplies
+	sub.DigestMode = data.DigestMode
+	sub.DigestDailyAt, _ = feeds.ParseDigestForm(data.DigestMode, data.DigestDailyAt)
 
-	_, err = sub.UpdateG(ctx, boil.Whitelist("channel_id", "enabled", "include_replies", "include_rt"))
+	_, err = sub.UpdateG(ctx, boil.Whitelist("channel_id", "enabled", "include_replies", "include_rt", "digest_mode", "digest_daily_at"))
 	if err == nil {
 		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKeyUpdatedFeed, &cplogs.Param{Type: cplogs.ParamTypeString, Value: sub.TwitterUsername}))
 	}
@@ -0,0 +1,177 @@
+package verification
+
+import (
+	"bytes"
+	"encoding/base64"
+	"html/template"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"emperror.dev/errors"
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/mediocregopher/radix/v3"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	captchaWidth  = 260
+	captchaHeight = 80
+
+	// how long a built in captcha can be solved for, a new one is made each time the verify page is loaded
+	captchaTTL = 60 * 15
+)
+
+func keyCaptchaAnswer(token string) string {
+	return "verification_captcha:" + token
+}
+
+// builtinProvider is a math problem rendered as an image by the web server, needing no third party service
+type builtinProvider struct{}
+
+func (p *builtinProvider) ID() string      { return ProviderBuiltin }
+func (p *builtinProvider) Name() string    { return "Built in math captcha" }
+func (p *builtinProvider) Available() bool { return true }
+
+func (p *builtinProvider) NewChallenge(token string) (*Challenge, error) {
+	question, answer := newMathProblem()
+
+	img, err := renderCaptcha(question)
+	if err != nil {
+		return nil, err
+	}
+
+	err = common.RedisPool.Do(radix.FlatCmd(nil, "SET", keyCaptchaAnswer(token), answer, "EX", captchaTTL))
+	if err != nil {
+		return nil, err
+	}
+
+	form := `<img class="mb-2" alt="Math problem" src="data:image/png;base64,` + base64.StdEncoding.EncodeToString(img) + `"><br>
+<input type="text" class="form-control" name="captcha_answer" autocomplete="off" inputmode="numeric" placeholder="Answer" required>`
+
+	return &Challenge{Form: template.HTML(form)}, nil
+}
+
+func (p *builtinProvider) Check(r *http.Request, token string) (bool, error) {
+	// every captcha can only be tried once, GETDEL makes sure concurrent submissions can't all read the answer
+	var answer string
+	err := common.RedisPool.Do(radix.Cmd(&answer, "GETDEL", keyCaptchaAnswer(token)))
+	if err != nil || answer == "" {
+		return false, err
+	}
+
+	return strings.TrimSpace(r.FormValue("captcha_answer")) == answer, nil
+}
+
+// newMathProblem returns an addition or subtraction of two digit numbers and its answer, never negative
+func newMathProblem() (question, answer string) {
+	a := rand.Intn(90) + 10
+	b := rand.Intn(90) + 10
+
+	if rand.Intn(2) == 0 {
+		return strconv.Itoa(a) + " + " + strconv.Itoa(b) + " = ?", strconv.Itoa(a + b)
+	}
+
+	if a < b {
+		a, b = b, a
+	}
+
+	return strconv.Itoa(a) + " - " + strconv.Itoa(b) + " = ?", strconv.Itoa(a - b)
+}
+
+var (
+	captchaFontOnce sync.Once
+	captchaFont     *opentype.Font
+	captchaFontErr  error
+)
+
+// renderCaptcha draws the text with every character slightly moved and colored differently, over some noise to make
+// it a bit harder to read for bots, and returns it PNG encoded
+func renderCaptcha(text string) ([]byte, error) {
+	captchaFontOnce.Do(func() {
+		captchaFont, captchaFontErr = opentype.Parse(gobold.TTF)
+	})
+	if captchaFontErr != nil {
+		return nil, errors.WithMessage(captchaFontErr, "opentype.Parse")
+	}
+
+	face, err := opentype.NewFace(captchaFont, &opentype.FaceOptions{Size: 30, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		return nil, errors.WithMessage(err, "opentype.NewFace")
+	}
+	defer face.Close()
+
+	canvas := image.NewRGBA(image.Rect(0, 0, captchaWidth, captchaHeight))
+	draw.Draw(canvas, canvas.Bounds(), image.NewUniform(color.RGBA{0xf2, 0xf2, 0xf2, 0xff}), image.Point{}, draw.Src)
+
+	for i := 0; i < 200; i++ {
+		canvas.Set(rand.Intn(captchaWidth), rand.Intn(captchaHeight), randomCaptchaColor())
+	}
+
+	x := 12 + rand.Intn(10)
+	for _, r := range text {
+		d := &font.Drawer{
+			Dst:  canvas,
+			Src:  image.NewUniform(randomCaptchaColor()),
+			Face: face,
+			Dot:  fixed.P(x, captchaHeight/2+12+rand.Intn(13)-6),
+		}
+		d.DrawString(string(r))
+
+		x = d.Dot.X.Ceil() + rand.Intn(3)
+	}
+
+	// lines over the text
+	for i := 0; i < 4; i++ {
+		drawLine(canvas, rand.Intn(captchaWidth/3), rand.Intn(captchaHeight), captchaWidth-rand.Intn(captchaWidth/3), rand.Intn(captchaHeight), randomCaptchaColor())
+	}
+
+	var buf bytes.Buffer
+	err = png.Encode(&buf, canvas)
+	return buf.Bytes(), err
+}
+
+// randomCaptchaColor returns a dark color that's readable on the light background
+func randomCaptchaColor() color.Color {
+	return color.RGBA{uint8(rand.Intn(120)), uint8(rand.Intn(120)), uint8(rand.Intn(120)), 0xff}
+}
+
+// drawLine draws a line between the points using Bresenham's algorithm
+func drawLine(dst draw.Image, x0, y0, x1, y1 int, c color.Color) {
+	dx, sx := x1-x0, 1
+	if dx < 0 {
+		dx, sx = -dx, -1
+	}
+
+	dy, sy := y1-y0, 1
+	if dy < 0 {
+		dy, sy = -dy, -1
+	}
+
+	err := dx - dy
+	for {
+		dst.Set(x0, y0, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+
+		e2 := 2 * err
+		if e2 > -dy {
+			err -= dy
+			x0 += sx
+		}
+		if e2 < dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package verification
+
+import (
+	"bytes"
+	"image/png"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestNewMathProblem(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		question, answer := newMathProblem()
+
+		var a, b int
+		var op string
+		fields := strings.Fields(question)
+		if len(fields) != 5 {
+			t.Fatalf("unexpected question %q", question)
+		}
+		a, _ = strconv.Atoi(fields[0])
+		op = fields[1]
+		b, _ = strconv.Atoi(fields[2])
+
+		if a < 10 || a > 99 || b < 10 || b > 99 {
+			t.Errorf("%q: expected two digit numbers", question)
+		}
+
+		want := a + b
+		if op == "-" {
+			want = a - b
+		}
+
+		if want < 0 || answer != strconv.Itoa(want) {
+			t.Errorf("%q: got answer %q, want %d", question, answer, want)
+		}
+	}
+}
+
+func TestRenderCaptcha(t *testing.T) {
+	encoded, err := renderCaptcha("99 + 99 = ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(encoded))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b := img.Bounds(); b.Dx() != captchaWidth || b.Dy() != captchaHeight {
+		t.Errorf("got size %dx%d, want %dx%d", b.Dx(), b.Dy(), captchaWidth, captchaHeight)
+	}
+}
@@ -32,6 +32,7 @@ type VerificationConfig struct {
 	WarnMessage         string `boil:"warn_message" json:"warn_message" toml:"warn_message" yaml:"warn_message"`
 	LogChannel          int64  `boil:"log_channel" json:"log_channel" toml:"log_channel" yaml:"log_channel"`
 	DMMessage           string `boil:"dm_message" json:"dm_message" toml:"dm_message" yaml:"dm_message"`
+	ChallengeProvider   string `boil:"challenge_provider" json:"challenge_provider" toml:"challenge_provider" yaml:"challenge_provider"`
 
 	R *verificationConfigR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L verificationConfigL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -47,6 +48,7 @@ var VerificationConfigColumns = struct {
 	WarnMessage         string
 	LogChannel          string
 	DMMessage           string
+	ChallengeProvider   string
 }{
 	GuildID:             "guild_id",
 	Enabled:             "enabled",
@@ -57,6 +59,7 @@ var VerificationConfigColumns = struct {
 	WarnMessage:         "warn_message",
 	LogChannel:          "log_channel",
 	DMMessage:           "dm_message",
+	ChallengeProvider:   "challenge_provider",
 }
 
 var VerificationConfigTableColumns = struct {
@@ -69,6 +72,7 @@ var VerificationConfigTableColumns = struct {
 	WarnMessage         string
 	LogChannel          string
 	DMMessage           string
+	ChallengeProvider   string
 }{
 	GuildID:             "verification_configs.guild_id",
 	Enabled:             "verification_configs.enabled",
@@ -79,6 +83,7 @@ var VerificationConfigTableColumns = struct {
 	WarnMessage:         "verification_configs.warn_message",
 	LogChannel:          "verification_configs.log_channel",
 	DMMessage:           "verification_configs.dm_message",
+	ChallengeProvider:   "verification_configs.challenge_provider",
 }
 
 // Generated where
@@ -175,6 +180,7 @@ var VerificationConfigWhere = struct {
 	WarnMessage         whereHelperstring
 	LogChannel          whereHelperint64
 	DMMessage           whereHelperstring
+	ChallengeProvider   whereHelperstring
 }{
 	GuildID:             whereHelperint64{field: "\"verification_configs\".\"guild_id\""},
 	Enabled:             whereHelperbool{field: "\"verification_configs\".\"enabled\""},
@@ -185,6 +191,7 @@ var VerificationConfigWhere = struct {
 	WarnMessage:         whereHelperstring{field: "\"verification_configs\".\"warn_message\""},
 	LogChannel:          whereHelperint64{field: "\"verification_configs\".\"log_channel\""},
 	DMMessage:           whereHelperstring{field: "\"verification_configs\".\"dm_message\""},
+	ChallengeProvider:   whereHelperstring{field: "\"verification_configs\".\"challenge_provider\""},
 }
 
 // VerificationConfigRels is where relationship names are stored.
@@ -204,9 +211,9 @@ func (*verificationConfigR) NewStruct() *verificationConfigR {
 type verificationConfigL struct{}
 
 var (
-	verificationConfigAllColumns            = []string{"guild_id", "enabled", "verified_role", "page_content", "kick_unverified_after", "warn_unverified_after", "warn_message", "log_channel", "dm_message"}
+	verificationConfigAllColumns            = []string{"guild_id", "enabled", "verified_role", "page_content", "kick_unverified_after", "warn_unverified_after", "warn_message", "log_channel", "dm_message", "challenge_provider"}
 	verificationConfigColumnsWithoutDefault = []string{"guild_id", "enabled", "verified_role", "page_content", "kick_unverified_after", "warn_unverified_after", "warn_message", "log_channel"}
-	verificationConfigColumnsWithDefault    = []string{"dm_message"}
+	verificationConfigColumnsWithDefault    = []string{"dm_message", "challenge_provider"}
 	verificationConfigPrimaryKeyColumns     = []string{"guild_id"}
 	verificationConfigGeneratedColumns      = []string{}
 )
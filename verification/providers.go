@@ -0,0 +1,273 @@
+package verification
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"html/template"
+	"net/http"
+	"net/url"
+
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/common/config"
+	"github.com/ThatBathroom/yagpdb/v2/web"
+	"github.com/mediocregopher/radix/v3"
+)
+
+var (
+	confHCaptchaSiteKey = config.RegisterOption("yagpdb.hcaptcha.site_key", "hCaptcha site key", "")
+	confHCaptchaSecret  = config.RegisterOption("yagpdb.hcaptcha.secret", "hCaptcha secret", "")
+
+	confTurnstileSiteKey = config.RegisterOption("yagpdb.turnstile.site_key", "Cloudflare Turnstile site key", "")
+	confTurnstileSecret  = config.RegisterOption("yagpdb.turnstile.secret", "Cloudflare Turnstile secret", "")
+)
+
+// Challenge providers that can be selected in the control panel
+const (
+	ProviderReCAPTCHA = "recaptcha"
+	ProviderHCaptcha  = "hcaptcha"
+	ProviderTurnstile = "turnstile"
+	ProviderBuiltin   = "builtin"
+)
+
+// Challenge is what's added to the verify page for the user to solve
+type Challenge struct {
+	// Added to the head of the page, e.g the script of a third party captcha
+	Head template.HTML
+
+	// Added to the verify form
+	Form template.HTML
+}
+
+// ChallengeProvider checks that the user visiting the verify page is a human
+type ChallengeProvider interface {
+	// ID is what's stored in the guild's config
+	ID() string
+	Name() string
+
+	// Available returns false if the provider isn't set up on this instance
+	Available() bool
+
+	// NewChallenge creates the challenge for the verification session with the token
+	NewChallenge(token string) (*Challenge, error)
+
+	// Check returns true if the submitted verify form solves the challenge of the session
+	Check(r *http.Request, token string) (bool, error)
+}
+
+var challengeProviders = []ChallengeProvider{
+	&siteVerifyProvider{
+		id:            ProviderReCAPTCHA,
+		name:          "Google reCAPTCHA",
+		scriptURL:     "https://www.google.com/recaptcha/api.js",
+		widgetClass:   "g-recaptcha",
+		responseField: "g-recaptcha-response",
+		verifyURL:     "https://www.google.com/recaptcha/api/siteverify",
+		siteKey:       confGoogleReCAPTCHASiteKey,
+		secret:        confGoogleReCAPTCHASecret,
+	},
+	&siteVerifyProvider{
+		id:            ProviderHCaptcha,
+		name:          "hCaptcha",
+		scriptURL:     "https://js.hcaptcha.com/1/api.js",
+		widgetClass:   "h-captcha",
+		responseField: "h-captcha-response",
+		verifyURL:     "https://api.hcaptcha.com/siteverify",
+		siteKey:       confHCaptchaSiteKey,
+		secret:        confHCaptchaSecret,
+	},
+	&siteVerifyProvider{
+		id:            ProviderTurnstile,
+		name:          "Cloudflare Turnstile",
+		scriptURL:     "https://challenges.cloudflare.com/turnstile/v0/api.js",
+		widgetClass:   "cf-turnstile",
+		responseField: "cf-turnstile-response",
+		verifyURL:     "https://challenges.cloudflare.com/turnstile/v0/siteverify",
+		siteKey:       confTurnstileSiteKey,
+		secret:        confTurnstileSecret,
+	},
+	&builtinProvider{},
+}
+
+// FindProvider returns the provider with the id, or nil if there's none
+func FindProvider(id string) ChallengeProvider {
+	for _, v := range challengeProviders {
+		if v.ID() == id {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// AvailableProviders returns the providers set up on this instance
+func AvailableProviders() []ChallengeProvider {
+	result := make([]ChallengeProvider, 0, len(challengeProviders))
+	for _, v := range challengeProviders {
+		if v.Available() {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// DefaultProvider is used by guilds that haven't selected one, reCAPTCHA if it's set up as that was the only
+// option before, the built in captcha otherwise
+func DefaultProvider() ChallengeProvider {
+	if p := FindProvider(ProviderReCAPTCHA); p.Available() {
+		return p
+	}
+
+	return FindProvider(ProviderBuiltin)
+}
+
+// providerForGuild returns the provider selected by the guild, or the default one if it's not available anymore
+func providerForGuild(selected string) ChallengeProvider {
+	if p := FindProvider(selected); p != nil && p.Available() {
+		return p
+	}
+
+	return DefaultProvider()
+}
+
+const (
+	// challenge attempts allowed per verification session, after that the session is locked out until it expires
+	maxChallengeAttempts = 5
+
+	challengeAttemptsTTL = 60 * 60 * 24
+)
+
+func keyChallengeAttempts(token string) string {
+	return "verification_challenge_attempts:" + token
+}
+
+// challengeLockedOut returns true if the verification session used up its challenge attempts
+func challengeLockedOut(token string) (bool, error) {
+	var attempts int
+	err := common.RedisPool.Do(radix.Cmd(&attempts, "GET", keyChallengeAttempts(token)))
+	return attempts >= maxChallengeAttempts, err
+}
+
+// reserveChallengeAttempt counts an attempt of the verification session before its answer is checked, so parallel
+// submissions can't all get past the limit, returns false if the session has no attempts left
+func reserveChallengeAttempt(token string) (bool, error) {
+	key := keyChallengeAttempts(token)
+
+	var attempts int
+	err := common.RedisPool.Do(radix.Cmd(&attempts, "INCR", key))
+	if err != nil {
+		return false, err
+	}
+
+	if attempts == 1 {
+		err = common.RedisPool.Do(radix.FlatCmd(nil, "EXPIRE", key, challengeAttemptsTTL))
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return attempts <= maxChallengeAttempts, nil
+}
+
+// releaseChallengeAttempt gives back an attempt whose answer couldn't be checked, e.g because the captcha provider is down
+func releaseChallengeAttempt(token string) error {
+	return common.RedisPool.Do(radix.Cmd(nil, "DECR", keyChallengeAttempts(token)))
+}
+
+// CheckCAPTCHAResponse is the response of the siteverify endpoints
+type CheckCAPTCHAResponse struct {
+	Success     bool     `json:"success"`
+	ChallengeTS string   `json:"challenge_ts"`
+	Hostname    string   `json:"hostname"`
+	ErrorCodes  []string `json:"error-codes"`
+}
+
+// siteVerifyProvider is a third party captcha with a javascript widget and a siteverify endpoint,
+// which reCAPTCHA, hCaptcha and Turnstile all share
+type siteVerifyProvider struct {
+	id   string
+	name string
+
+	scriptURL     string
+	widgetClass   string
+	responseField string
+	verifyURL     string
+
+	siteKey *config.ConfigOption
+	secret  *config.ConfigOption
+}
+
+func (p *siteVerifyProvider) ID() string   { return p.id }
+func (p *siteVerifyProvider) Name() string { return p.name }
+
+func (p *siteVerifyProvider) Available() bool {
+	return p.siteKey.GetString() != "" && p.secret.GetString() != ""
+}
+
+func (p *siteVerifyProvider) NewChallenge(token string) (*Challenge, error) {
+	return &Challenge{
+		Head: template.HTML(`<script src="` + p.scriptURL + `" async defer></script>`),
+		Form: template.HTML(fmt.Sprintf(`<div class="%s" data-sitekey="%s"></div>`, p.widgetClass, html.EscapeString(p.siteKey.GetString()))),
+	}, nil
+}
+
+func (p *siteVerifyProvider) Check(r *http.Request, token string) (bool, error) {
+	v := url.Values{
+		"response": {r.FormValue(p.responseField)},
+		"secret":   {p.secret.GetString()},
+	}
+
+	if confVerificationTrackIPs.GetBool() {
+		v.Set("remoteip", web.GetRequestIP(r))
+	}
+
+	resp, err := http.PostForm(p.verifyURL, v)
+	if err != nil {
+		return false, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("%s siteverify returned status %d", p.name, resp.StatusCode)
+	}
+
+	var dst CheckCAPTCHAResponse
+	decoder := json.NewDecoder(resp.Body)
+	err = decoder.Decode(&dst)
+	if err != nil {
+		return false, err
+	}
+
+	if !dst.Success {
+		if providerSideError(dst.ErrorCodes) {
+			return false, fmt.Errorf("%s couldn't check the response: %v", p.name, dst.ErrorCodes)
+		}
+
+		logger.Warnf("%s failed: %#v", p.name, dst)
+	}
+
+	return dst.Success, nil
+}
+
+// siteVerifyProviderErrors are siteverify error codes caused by our setup or the provider, not by a wrong answer
+var siteVerifyProviderErrors = []string{
+	"missing-input-secret",
+	"invalid-input-secret",
+	"sitekey-secret-mismatch",
+	"internal-error",
+}
+
+// providerSideError returns true if the siteverify error codes mean that the response couldn't be checked
+func providerSideError(codes []string) bool {
+	for _, code := range codes {
+		for _, v := range siteVerifyProviderErrors {
+			if code == v {
+				return true
+			}
+		}
+	}
+
+	return false
+}
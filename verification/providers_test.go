@@ -0,0 +1,22 @@
+package verification
+
+import "testing"
+
+func TestProviderSideError(t *testing.T) {
+	cases := []struct {
+		codes []string
+		want  bool
+	}{
+		{nil, false},
+		{[]string{"invalid-input-response"}, false},
+		{[]string{"timeout-or-duplicate"}, false},
+		{[]string{"internal-error"}, true},
+		{[]string{"invalid-input-response", "invalid-input-secret"}, true},
+	}
+
+	for _, c := range cases {
+		if got := providerSideError(c.codes); got != c.want {
+			t.Errorf("providerSideError(%v) = %v, want %v", c.codes, got, c.want)
+		}
+	}
+}
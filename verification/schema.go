@@ -38,4 +38,7 @@ CREATE TABLE IF NOT EXISTS verified_users (
 
 	PRIMARY KEY(guild_id, user_id)
 );
+`, `
+-- empty uses the default provider of the instance
+ALTER TABLE verification_configs ADD COLUMN IF NOT EXISTS challenge_provider TEXT NOT NULL DEFAULT '';
 `}
@@ -24,10 +24,8 @@ func (p *Plugin) PluginInfo() *common.PluginInfo {
 var logger = common.GetPluginLogger(&Plugin{})
 
 func RegisterPlugin() {
-
-	if confGoogleReCAPTCHASecret.GetString() == "" || confGoogleReCAPTCHASiteKey.GetString() == "" {
-		logger.Warn("no YAGPDB_GOOGLE_RECAPTCHA_SECRET and/or YAGPDB_GOOGLE_RECAPTCHA_SITE_KEY provided, not enabling verification plugin")
-		return
+	if !FindProvider(ProviderReCAPTCHA).Available() {
+		logger.Info("no YAGPDB_GOOGLE_RECAPTCHA_SECRET and/or YAGPDB_GOOGLE_RECAPTCHA_SITE_KEY provided, using the built in captcha by default")
 	}
 
 	common.InitSchemas("verification", DBSchemas...)
@@ -38,7 +36,7 @@ func RegisterPlugin() {
 const (
 	DefaultPageContent = `## Verification
 
-Please solve the following reCAPTCHA to make sure you're not a robot`
+Please solve the following CAPTCHA to make sure you're not a robot`
 )
 
 const DefaultDMMessage = `{{sendMessage nil (cembed
@@ -3,12 +3,10 @@ package verification
 import (
 	"database/sql"
 	_ "embed"
-	"encoding/json"
 	"fmt"
 	"html"
 	"html/template"
 	"net/http"
-	"net/url"
 	"strconv"
 	"time"
 
@@ -40,6 +38,20 @@ type FormData struct {
 	WarnMessage         string `valid:"template,10000"`
 	DMMessage           string `valid:"template,10000"`
 	LogChannel          int64  `valid:"channel,true"`
+	ChallengeProvider   string
+}
+
+func (f *FormData) Validate(tmpl web.TemplateData, _ int64) bool {
+	if f.ChallengeProvider == "" {
+		return true
+	}
+
+	if p := FindProvider(f.ChallengeProvider); p == nil || !p.Available() {
+		tmpl.AddAlerts(web.ErrorAlert("Unknown or unavailable CAPTCHA provider"))
+		return false
+	}
+
+	return true
 }
 
 var panelLogKey = cplogs.RegisterActionFormat(&cplogs.ActionFormat{Key: "verification_updated_settings", FormatString: "Updated verification settings"})
@@ -93,6 +105,8 @@ func (p *Plugin) handleGetSettings(w http.ResponseWriter, r *http.Request) (web.
 	}
 
 	templateData["DefaultPageContent"] = DefaultPageContent
+	templateData["ChallengeProviders"] = AvailableProviders()
+	templateData["DefaultProvider"] = DefaultProvider()
 	templateData["PluginSettings"] = settings
 	templateData["RoleInvalid"] = roleInvalid
 
@@ -115,10 +129,11 @@ func (p *Plugin) handlePostSettings(w http.ResponseWriter, r *http.Request) (web
 		WarnMessage:         formConfig.WarnMessage,
 		LogChannel:          formConfig.LogChannel,
 		DMMessage:           formConfig.DMMessage,
+		ChallengeProvider:   formConfig.ChallengeProvider,
 	}
 
-	columns := boil.Whitelist("enabled", "verified_role", "page_content", "kick_unverified_after", "warn_unverified_after", "warn_message", "log_channel", "dm_message")
-	columnsCreate := boil.Whitelist("guild_id", "enabled", "verified_role", "page_content", "kick_unverified_after", "warn_unverified_after", "warn_message", "log_channel", "dm_message")
+	columns := boil.Whitelist("enabled", "verified_role", "page_content", "kick_unverified_after", "warn_unverified_after", "warn_message", "log_channel", "dm_message", "challenge_provider")
+	columnsCreate := boil.Whitelist("guild_id", "enabled", "verified_role", "page_content", "kick_unverified_after", "warn_unverified_after", "warn_message", "log_channel", "dm_message", "challenge_provider")
 	err := model.UpsertG(ctx, true, []string{"guild_id"}, columns, columnsCreate)
 	if err == nil {
 		go cplogs.RetryAddEntry(web.NewLogEntryFromContext(r.Context(), panelLogKey))
@@ -148,10 +163,10 @@ func (p *Plugin) handleGetVerifyPage(w http.ResponseWriter, r *http.Request) (we
 		return templateData, nil
 	}
 
+	token := pat.Param(r, "token")
 	if _, ok := templateData["REValid"]; !ok {
 		// check if there's a valid session if we didn't just finish verifying
 		userID, _ := strconv.ParseInt(pat.Param(r, "user_id"), 10, 64)
-		token := pat.Param(r, "token")
 		_, err = models.VerificationSessions(
 			models.VerificationSessionWhere.UserID.EQ(userID),
 			models.VerificationSessionWhere.Token.EQ(token),
@@ -168,8 +183,25 @@ func (p *Plugin) handleGetVerifyPage(w http.ResponseWriter, r *http.Request) (we
 		}
 	}
 
-	templateData["ExtraHead"] = template.HTML(`<script src="https://www.google.com/recaptcha/api.js" async defer></script>`)
-	templateData["GoogleReCaptchaSiteKey"] = confGoogleReCAPTCHASiteKey.GetString()
+	if valid, _ := templateData["REValid"].(bool); !valid {
+		locked, err := challengeLockedOut(token)
+		if err != nil {
+			return templateData, err
+		}
+
+		if locked {
+			templateData.AddAlerts(web.ErrorAlert("Too many failed attempts, try rejoining the server or contact an admin if the problem persist"))
+			return templateData, nil
+		}
+
+		challenge, err := providerForGuild(settings.ChallengeProvider).NewChallenge(token)
+		if err != nil {
+			return templateData, err
+		}
+
+		templateData["ExtraHead"] = challenge.Head
+		templateData["ChallengeForm"] = challenge.Form
+	}
 
 	msg := settings.PageContent
 	if msg == "" {
@@ -204,12 +236,24 @@ func (p *Plugin) handlePostVerifyPage(w http.ResponseWriter, r *http.Request) (w
 		return templateData, nil
 	}
 
-	valid, err := p.checkCAPTCHAResponse(r.FormValue("g-recaptcha-response"))
-	if err != nil {
-		logrus.WithError(err).Error("Failed recaptcha response")
+	token := pat.Param(r, "token")
+
+	// the verify page shows why if there's no attempts left
+	hasAttempts, err := reserveChallengeAttempt(token)
+	if err != nil || !hasAttempts {
+		return templateData, err
+	}
+
+	valid, checkErr := providerForGuild(settings.ChallengeProvider).Check(r, token)
+	if checkErr != nil {
+		logrus.WithError(checkErr).Error("Failed checking captcha response")
+
+		// not the user's fault, don't count it against them
+		if err := releaseChallengeAttempt(token); err != nil {
+			web.CtxLogger(ctx).WithError(err).Error("failed releasing captcha attempt")
+		}
 	}
 
-	token := pat.Param(r, "token")
 	userID, _ := strconv.ParseInt(pat.Param(r, "user_id"), 10, 64)
 
 	verSession, err := models.VerificationSessions(
@@ -252,8 +296,10 @@ func (p *Plugin) handlePostVerifyPage(w http.ResponseWriter, r *http.Request) (w
 
 		go analytics.RecordActiveUnit(g.ID, p, "completed")
 
+	} else if checkErr != nil {
+		templateData.AddAlerts(web.ErrorAlert("Failed checking the CAPTCHA, please try again in a bit."))
 	} else {
-		templateData.AddAlerts(web.ErrorAlert("Invalid reCAPTCHA submission."))
+		templateData.AddAlerts(web.ErrorAlert("Invalid CAPTCHA submission."))
 	}
 
 	templateData["REValid"] = valid
@@ -261,53 +307,13 @@ func (p *Plugin) handlePostVerifyPage(w http.ResponseWriter, r *http.Request) (w
 	return templateData, err
 }
 
-type CheckCAPTCHAResponse struct {
-	Success     bool     `json:"success"`
-	ChallengeTS string   `json:"challenge_ts"`
-	Hostname    string   `json:"hostname"`
-	ErrorCodes  []string `json:"error-codes"`
-}
-
-type CheckCAPTCHARequest struct {
-	Secret   string `json:"secret"`
-	Response string `json:"response"`
-}
-
-func (p *Plugin) checkCAPTCHAResponse(response string) (valid bool, err error) {
-
-	v := url.Values{
-		"response": {response},
-		"secret":   {confGoogleReCAPTCHASecret.GetString()},
-	}
-
-	resp, err := http.PostForm("https://www.google.com/recaptcha/api/siteverify", v)
-	if err != nil {
-		return false, err
-	}
-
-	defer resp.Body.Close()
-
-	var dst CheckCAPTCHAResponse
-	decoder := json.NewDecoder(resp.Body)
-	err = decoder.Decode(&dst)
-	if err != nil {
-		return false, err
-	}
-
-	if !dst.Success {
-		logger.Warnf("reCAPTCHA failed: %#v", dst)
-	}
-
-	return dst.Success, nil
-}
-
 var _ web.PluginWithServerHomeWidget = (*Plugin)(nil)
 
 func (p *Plugin) LoadServerHomeWidget(w http.ResponseWriter, r *http.Request) (web.TemplateData, error) {
 	ag, templateData := web.GetBaseCPContextData(r.Context())
 	ctx := r.Context()
 
-	templateData["WidgetTitle"] = "Google reCAPTCHA Verification"
+	templateData["WidgetTitle"] = "CAPTCHA Verification"
 	templateData["SettingsPath"] = "/verification"
 
 	settings, err := models.FindVerificationConfigG(ctx, ag.ID)
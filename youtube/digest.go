@@ -0,0 +1,77 @@
+package youtube
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	"github.com/ThatBathroom/yagpdb/v2/feeds"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/youtube/models"
+	"google.golang.org/api/youtube/v3"
+)
+
+var _ feeds.DigestPlugin = (*Plugin)(nil)
+
+func digestSettings(sub *models.YoutubeChannelSubscription) feeds.DigestSettings {
+	return feeds.DigestSettings{
+		Mode:    sub.DigestMode,
+		DailyAt: sub.DigestDailyAt,
+	}
+}
+
+func queueDigestVideo(sub *models.YoutubeChannelSubscription, video *youtube.Video) error {
+	parsedChannel, _ := strconv.ParseInt(sub.ChannelID, 10, 64)
+	parsedGuild, _ := strconv.ParseInt(sub.GuildID, 10, 64)
+
+	return feeds.QueueDigestItem("youtube", int64(sub.ID), parsedGuild, parsedChannel, digestSettings(sub), &feeds.DigestItem{
+		Title: video.Snippet.Title,
+		URL:   "https://www.youtube.com/watch?v=" + video.Id,
+	})
+}
+
+// DigestTarget implements feeds.DigestPlugin
+func (p *Plugin) DigestTarget(subscriptionID int64) (*feeds.DigestTarget, error) {
+	sub, err := models.FindYoutubeChannelSubscriptionG(context.Background(), int(subscriptionID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	settings := digestSettings(sub)
+	if !sub.Enabled || settings.Immediate() {
+		return nil, nil
+	}
+
+	parsedChannel, _ := strconv.ParseInt(sub.ChannelID, 10, 64)
+	parsedGuild, _ := strconv.ParseInt(sub.GuildID, 10, 64)
+
+	target := &feeds.DigestTarget{
+		GuildID:   parsedGuild,
+		ChannelID: parsedChannel,
+		Settings:  settings,
+		Name:      sub.YoutubeChannelName,
+		URL:       "https://www.youtube.com/channel/" + sub.YoutubeChannelID,
+		Color:     0xff0000,
+		AllowedMentions: discordgo.AllowedMentions{
+			Parse: []discordgo.AllowedMentionType{},
+		},
+	}
+
+	if sub.MentionEveryone {
+		target.Content = "@everyone"
+		target.AllowedMentions.Parse = append(target.AllowedMentions.Parse, discordgo.AllowedMentionTypeEveryone)
+	} else if len(sub.MentionRoles) > 0 {
+		mentions := make([]string, 0, len(sub.MentionRoles))
+		for _, roleID := range sub.MentionRoles {
+			mentions = append(mentions, "<@&"+discordgo.StrID(roleID)+">")
+		}
+		target.Content = strings.Join(mentions, " ")
+		target.AllowedMentions.Parse = append(target.AllowedMentions.Parse, discordgo.AllowedMentionTypeRoles)
+	}
+
+	return target, nil
+}
@@ -37,6 +37,7 @@ func (p *Plugin) StartFeed() {
 	go p.runWebsubChecker()
 	go p.autoSyncWebsubs()
 	go p.deleteOldVideos()
+	go p.runLiveChecker()
 }
 
 func (p *Plugin) StopFeed(wg *sync.WaitGroup) {
@@ -203,35 +204,105 @@ func (p *Plugin) syncWebSubs() {
 func (p *Plugin) sendNewVidMessage(sub *models.YoutubeChannelSubscription, video *youtube.Video) {
 	parsedChannel, _ := strconv.ParseInt(sub.ChannelID, 10, 64)
 	parsedGuild, _ := strconv.ParseInt(sub.GuildID, 10, 64)
-	videoUrl := "https://www.youtube.com/watch?v=" + video.Id
 
-	var content string
-	switch video.Snippet.LiveBroadcastContent {
-	case "live":
-		content = fmt.Sprintf("**%s** started a livestream now!\n%s", video.Snippet.ChannelTitle, videoUrl)
-	case "upcoming":
-		content = fmt.Sprintf("**%s** is going to be live soon!\n%s", video.Snippet.ChannelTitle, videoUrl)
-	case "none":
-		content = fmt.Sprintf("**%s** uploaded a new youtube video!\n%s", video.Snippet.ChannelTitle, videoUrl)
-	default:
+	state := video.Snippet.LiveBroadcastContent
+	if state == VideoStateNone && !digestSettings(sub).Immediate() {
+		// livestreams are always announced right away, only uploads go into digests
+		err := queueDigestVideo(sub, video)
+		if err != nil {
+			logger.WithError(err).WithField("guild", parsedGuild).Error("Failed queueing youtube video for digest")
+		}
 		return
 	}
 
-	parseMentions := []discordgo.AllowedMentionType{}
-	announcement, err := models.FindYoutubeAnnouncementG(context.Background(), parsedGuild)
-	hasCustomAnnouncement := true
+	content, parseMentions, publishAnnouncement, ok := p.renderVideoMessage(sub, video, state, p.getAnnouncement(parsedGuild))
+	if !ok {
+		return
+	}
+
+	// livestream announcements are tracked once sent, so they can be edited when the stream goes live and ends
+	sourceItemID := ""
+	if state == VideoStateUpcoming || state == VideoStateLive {
+		sourceItemID = liveSourceItemID(sub.ID, video.Id, state)
+	}
+
+	go analytics.RecordActiveUnit(parsedGuild, p, "posted_youtube_message")
+	feeds.MetricPostedMessages.With(prometheus.Labels{"source": "youtube"}).Inc()
+	mqueue.QueueMessage(&mqueue.QueuedElement{
+		GuildID:             parsedGuild,
+		ChannelID:           parsedChannel,
+		Source:              "youtube",
+		SourceItemID:        sourceItemID,
+		MessageStr:          content,
+		PublishAnnouncement: publishAnnouncement,
+		Priority:            2,
+		AllowedMentions: discordgo.AllowedMentions{
+			Parse: parseMentions,
+		},
+	})
+}
+
+func (p *Plugin) getAnnouncement(guildID int64) *models.YoutubeAnnouncement {
+	announcement, err := models.FindYoutubeAnnouncementG(context.Background(), guildID)
 	if err != nil {
-		hasCustomAnnouncement = false
 		if err == sql.ErrNoRows {
-			logger.WithError(err).Debugf("Custom announcement doesn't exist for guild_id %d", parsedGuild)
+			logger.WithError(err).Debugf("Custom announcement doesn't exist for guild_id %d", guildID)
+		} else {
+			logger.WithError(err).Errorf("Failed fetching custom announcement for guild_id %d", guildID)
+		}
+		return nil
+	}
+
+	return announcement
+}
+
+// announcementTemplate returns the custom announcement template for the state, falling back to the main message
+func announcementTemplate(announcement *models.YoutubeAnnouncement, state string) string {
+	switch state {
+	case VideoStateUpcoming:
+		if announcement.UpcomingMessage != "" {
+			return announcement.UpcomingMessage
+		}
+	case VideoStateLive:
+		if announcement.LiveMessage != "" {
+			return announcement.LiveMessage
+		}
+	case VideoStateEnded:
+		if announcement.EndedMessage != "" {
+			return announcement.EndedMessage
+		}
+	}
+
+	return announcement.Message
+}
+
+// renderVideoMessage renders the announcement for the video in the given state, ok is false if nothing should be posted
+func (p *Plugin) renderVideoMessage(sub *models.YoutubeChannelSubscription, video *youtube.Video, state string, announcement *models.YoutubeAnnouncement) (content string, parseMentions []discordgo.AllowedMentionType, publishAnnouncement bool, ok bool) {
+	parsedChannel, _ := strconv.ParseInt(sub.ChannelID, 10, 64)
+	parsedGuild, _ := strconv.ParseInt(sub.GuildID, 10, 64)
+	videoUrl := "https://www.youtube.com/watch?v=" + video.Id
+	streamDuration := videoStreamDuration(video)
+
+	switch state {
+	case VideoStateLive:
+		content = fmt.Sprintf("**%s** started a livestream now!\n%s", video.Snippet.ChannelTitle, videoUrl)
+	case VideoStateUpcoming:
+		content = fmt.Sprintf("**%s** is going to be live soon!\n%s", video.Snippet.ChannelTitle, videoUrl)
+	case VideoStateEnded:
+		if streamDuration > 0 {
+			content = fmt.Sprintf("**%s** was live for %s, watch the VOD here!\n%s", video.Snippet.ChannelTitle, common.HumanizeDuration(common.DurationPrecisionMinutes, streamDuration), videoUrl)
 		} else {
-			logger.WithError(err).Errorf("Failed fetching custom announcement for guild_id %d", parsedGuild)
+			content = fmt.Sprintf("**%s** ended their livestream, watch the VOD here!\n%s", video.Snippet.ChannelTitle, videoUrl)
 		}
+	case VideoStateNone:
+		content = fmt.Sprintf("**%s** uploaded a new youtube video!\n%s", video.Snippet.ChannelTitle, videoUrl)
+	default:
+		return
 	}
 
-	var publishAnnouncement bool
+	parseMentions = []discordgo.AllowedMentionType{}
 
-	if hasCustomAnnouncement && announcement.Enabled && len(announcement.Message) > 0 {
+	if announcement != nil && announcement.Enabled && len(announcementTemplate(announcement, state)) > 0 {
 		guildState, err := discorddata.GetFullGuild(parsedGuild)
 		if err != nil {
 			logger.WithError(err).Errorf("Failed to get guild state for guild_id %d", parsedGuild)
@@ -252,10 +323,13 @@ func (p *Plugin) sendNewVidMessage(sub *models.YoutubeChannelSubscription, video
 		}
 
 		ctx := templates.NewContext(guildState, channelState, nil)
-		videoDurationString := strings.ToLower(strings.TrimPrefix(video.ContentDetails.Duration, "PT"))
-		videoDuration, err := common.ParseDuration(videoDurationString)
-		if err != nil {
-			videoDuration = time.Duration(0)
+		videoDuration := time.Duration(0)
+		if video.ContentDetails != nil {
+			videoDurationString := strings.ToLower(strings.TrimPrefix(video.ContentDetails.Duration, "PT"))
+			videoDuration, err = common.ParseDuration(videoDurationString)
+			if err != nil {
+				videoDuration = time.Duration(0)
+			}
 		}
 
 		ctx.Data["URL"] = videoUrl
@@ -264,17 +338,29 @@ func (p *Plugin) sendNewVidMessage(sub *models.YoutubeChannelSubscription, video
 		ctx.Data["YoutubeChannelID"] = sub.YoutubeChannelID
 		ctx.Data["ChannelID"] = sub.ChannelID
 		//should be true for upcoming too as upcoming is also technically a livestream
-		ctx.Data["IsLiveStream"] = (video.Snippet.LiveBroadcastContent == "live" || video.Snippet.LiveBroadcastContent == "upcoming")
-		ctx.Data["IsUpcoming"] = video.Snippet.LiveBroadcastContent == "upcoming"
+		ctx.Data["IsLiveStream"] = state == VideoStateLive || state == VideoStateUpcoming || state == VideoStateEnded
+		ctx.Data["IsUpcoming"] = state == VideoStateUpcoming
+		ctx.Data["IsLive"] = state == VideoStateLive
+		ctx.Data["IsEnded"] = state == VideoStateEnded
+		ctx.Data["State"] = state
 		ctx.Data["VideoID"] = video.Id
 		ctx.Data["VideoTitle"] = video.Snippet.Title
 		ctx.Data["VideoThumbnail"] = fmt.Sprintf("https://img.youtube.com/vi/%s/maxresdefault.jpg", video.Id)
 		ctx.Data["VideoDescription"] = video.Snippet.Description
 		ctx.Data["VideoDurationSeconds"] = int(math.Round(videoDuration.Seconds()))
+		if details := video.LiveStreamingDetails; details != nil {
+			ctx.Data["ScheduledStartTime"] = parseYoutubeTime(details.ScheduledStartTime)
+			ctx.Data["ActualStartTime"] = parseYoutubeTime(details.ActualStartTime)
+			ctx.Data["ActualEndTime"] = parseYoutubeTime(details.ActualEndTime)
+		}
+		if state == VideoStateEnded {
+			ctx.Data["VODURL"] = videoUrl
+			ctx.Data["StreamDurationSeconds"] = int(math.Round(streamDuration.Seconds()))
+		}
 		//full video object in case people want to do more advanced stuff
 		ctx.Data["Video"] = video
 
-		content, err = ctx.Execute(announcement.Message)
+		content, err = ctx.Execute(announcementTemplate(announcement, state))
 		//adding role and everyone ping here because most people are stupid and will complain about custom notification not pinging
 		parseMentions = []discordgo.AllowedMentionType{discordgo.AllowedMentionTypeRoles, discordgo.AllowedMentionTypeEveryone}
 		if err != nil {
@@ -297,20 +383,7 @@ func (p *Plugin) sendNewVidMessage(sub *models.YoutubeChannelSubscription, video
 		parseMentions = []discordgo.AllowedMentionType{discordgo.AllowedMentionTypeRoles}
 	}
 
-	go analytics.RecordActiveUnit(parsedGuild, p, "posted_youtube_message")
-	feeds.MetricPostedMessages.With(prometheus.Labels{"source": "youtube"}).Inc()
-	mqueue.QueueMessage(&mqueue.QueuedElement{
-		GuildID:             parsedGuild,
-		ChannelID:           parsedChannel,
-		Source:              "youtube",
-		SourceItemID:        "",
-		MessageStr:          content,
-		PublishAnnouncement: publishAnnouncement,
-		Priority:            2,
-		AllowedMentions: discordgo.AllowedMentions{
-			Parse: parseMentions,
-		},
-	})
+	return content, parseMentions, publishAnnouncement, true
 }
 
 var (
@@ -472,7 +545,7 @@ func (p *Plugin) parseYtVideoID(parse string) (id ytChannelID, err error) {
 	}
 }
 
-func (p *Plugin) AddFeed(guildID, discordChannelID int64, ytChannel *youtube.Channel, mentionEveryone bool, publishLivestream bool, publishShorts bool, mentionRoles []int64) (*models.YoutubeChannelSubscription, error) {
+func (p *Plugin) AddFeed(guildID, discordChannelID int64, ytChannel *youtube.Channel, mentionEveryone bool, publishLivestream bool, publishShorts bool, mentionRoles []int64, digest feeds.DigestSettings) (*models.YoutubeChannelSubscription, error) {
 	if mentionEveryone && len(mentionRoles) > 0 {
 		mentionRoles = make([]int64, 0)
 	}
@@ -485,6 +558,8 @@ func (p *Plugin) AddFeed(guildID, discordChannelID int64, ytChannel *youtube.Cha
 		PublishLivestream: publishLivestream,
 		PublishShorts:     publishShorts,
 		Enabled:           true,
+		DigestMode:        digest.Mode,
+		DigestDailyAt:     digest.DailyAt,
 	}
 
 	sub.YoutubeChannelName = ytChannel.Snippet.Title
@@ -618,7 +693,7 @@ func (p *Plugin) CheckVideo(parsedVideo XMLFeed) error {
 		return nil
 	}
 
-	resp, err := p.YTService.Videos.List([]string{"snippet", "contentDetails"}).Id(videoID).Do()
+	resp, err := p.YTService.Videos.List([]string{"snippet", "contentDetails", "liveStreamingDetails"}).Id(videoID).Do()
 	if err != nil || len(resp.Items) < 1 {
 		return err
 	}
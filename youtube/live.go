@@ -0,0 +1,249 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ThatBathroom/yagpdb/v2/common"
+	"github.com/ThatBathroom/yagpdb/v2/common/mqueue"
+	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
+	"github.com/ThatBathroom/yagpdb/v2/youtube/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"google.golang.org/api/youtube/v3"
+)
+
+// The states a video goes through, the first three match the snippet's liveBroadcastContent
+const (
+	VideoStateNone     = "none"
+	VideoStateUpcoming = "upcoming"
+	VideoStateLive     = "live"
+	VideoStateEnded    = "ended"
+)
+
+const (
+	// how often tracked livestream announcements are checked for state changes
+	LiveCheckInterval = time.Minute * 2
+
+	// announcements of streams that haven't ended by then are no longer tracked
+	LiveTrackMaxAge = time.Hour * 24 * 7
+
+	// max ids per videos.list request
+	videosListMaxIDs = 50
+)
+
+var _ mqueue.PluginWithMessageSentHandler = (*Plugin)(nil)
+
+func liveSourceItemID(subID int, videoID, state string) string {
+	return fmt.Sprintf("live:%d:%s:%s", subID, videoID, state)
+}
+
+func parseLiveSourceItemID(sourceItemID string) (subID int, videoID, state string, ok bool) {
+	split := strings.Split(sourceItemID, ":")
+	if len(split) != 4 || split[0] != "live" {
+		return 0, "", "", false
+	}
+
+	subID, err := strconv.Atoi(split[1])
+	if err != nil {
+		return 0, "", "", false
+	}
+
+	return subID, split[2], split[3], true
+}
+
+// videoLiveState returns the current state of a livestream or premiere
+func videoLiveState(video *youtube.Video) string {
+	details := video.LiveStreamingDetails
+	if details == nil {
+		if video.Snippet != nil && video.Snippet.LiveBroadcastContent != VideoStateNone {
+			return video.Snippet.LiveBroadcastContent
+		}
+
+		// no longer a livestream, the details are gone when a stream is turned into a regular video
+		return VideoStateEnded
+	}
+
+	if details.ActualEndTime != "" {
+		return VideoStateEnded
+	}
+
+	if details.ActualStartTime != "" {
+		return VideoStateLive
+	}
+
+	return VideoStateUpcoming
+}
+
+// MessageSent implements mqueue.PluginWithMessageSentHandler, it starts tracking livestream announcements so they can be
+// edited as the stream goes live and ends
+func (p *Plugin) MessageSent(elem *mqueue.QueuedElement, msg *discordgo.Message) {
+	subID, videoID, state, ok := parseLiveSourceItemID(elem.SourceItemID)
+	if !ok {
+		return
+	}
+
+	announcement := &models.YoutubeLiveAnnouncement{
+		SubscriptionID: subID,
+		GuildID:        elem.GuildID,
+		ChannelID:      elem.ChannelID,
+		MessageID:      msg.ID,
+		VideoID:        videoID,
+		State:          state,
+	}
+
+	err := announcement.InsertG(context.Background(), boil.Infer())
+	if err != nil {
+		logger.WithError(err).WithField("guild", elem.GuildID).Error("Failed tracking youtube livestream announcement")
+	}
+}
+
+func (p *Plugin) runLiveChecker() {
+	ticker := time.NewTicker(LiveCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case wg := <-p.Stop:
+			wg.Done()
+			return
+		case <-ticker.C:
+			p.checkLiveAnnouncements()
+		}
+	}
+}
+
+func (p *Plugin) checkLiveAnnouncements() {
+	ctx := context.Background()
+
+	_, err := models.YoutubeLiveAnnouncements(
+		models.YoutubeLiveAnnouncementWhere.CreatedAt.LT(time.Now().Add(-LiveTrackMaxAge)),
+	).DeleteAllG(ctx)
+	if err != nil {
+		logger.WithError(err).Error("Failed removing stale youtube livestream announcements")
+	}
+
+	tracked, err := models.YoutubeLiveAnnouncements(
+		qm.Load(models.YoutubeLiveAnnouncementRels.Subscription),
+	).AllG(ctx)
+	if err != nil {
+		logger.WithError(err).Error("Failed retrieving youtube livestream announcements")
+		return
+	}
+
+	byVideo := make(map[string][]*models.YoutubeLiveAnnouncement)
+	videoIDs := make([]string, 0, len(tracked))
+	for _, v := range tracked {
+		if _, ok := byVideo[v.VideoID]; !ok {
+			videoIDs = append(videoIDs, v.VideoID)
+		}
+		byVideo[v.VideoID] = append(byVideo[v.VideoID], v)
+	}
+
+	for i := 0; i < len(videoIDs); i += videosListMaxIDs {
+		end := i + videosListMaxIDs
+		if end > len(videoIDs) {
+			end = len(videoIDs)
+		}
+		chunk := videoIDs[i:end]
+
+		resp, err := p.YTService.Videos.List([]string{"snippet", "contentDetails", "liveStreamingDetails"}).Id(chunk...).Do()
+		if err != nil {
+			logger.WithError(err).Error("Failed retrieving youtube livestream states")
+			return
+		}
+
+		found := make(map[string]bool)
+		for _, video := range resp.Items {
+			found[video.Id] = true
+
+			state := videoLiveState(video)
+			for _, announcement := range byVideo[video.Id] {
+				if announcement.State != state {
+					p.updateLiveAnnouncement(announcement, video, state)
+				}
+			}
+		}
+
+		// deleted or privated, nothing more to announce
+		for _, id := range chunk {
+			if found[id] {
+				continue
+			}
+
+			for _, announcement := range byVideo[id] {
+				p.stopTrackingLiveAnnouncement(announcement)
+			}
+		}
+	}
+}
+
+// updateLiveAnnouncement edits the announcement to the template of the new state
+func (p *Plugin) updateLiveAnnouncement(la *models.YoutubeLiveAnnouncement, video *youtube.Video, state string) {
+	sub := la.R.Subscription
+	if sub == nil || !sub.Enabled {
+		p.stopTrackingLiveAnnouncement(la)
+		return
+	}
+
+	content, parseMentions, _, ok := p.renderVideoMessage(sub, video, state, p.getAnnouncement(la.GuildID))
+	if ok {
+		_, err := common.BotSession.ChannelMessageEditComplex(&discordgo.MessageEdit{
+			ID:      la.MessageID,
+			Channel: la.ChannelID,
+			Content: &content,
+			AllowedMentions: discordgo.AllowedMentions{
+				Parse: parseMentions,
+			},
+		})
+
+		if err != nil {
+			code, _ := common.DiscordError(err)
+			switch code {
+			case discordgo.ErrCodeUnknownMessage, discordgo.ErrCodeUnknownChannel, discordgo.ErrCodeMissingAccess, discordgo.ErrCodeMissingPermissions:
+				// message or channel is gone, or we can't see it anymore
+				p.stopTrackingLiveAnnouncement(la)
+			default:
+				// try again on the next check
+				logger.WithError(err).WithField("guild", la.GuildID).Error("Failed editing youtube livestream announcement")
+			}
+			return
+		}
+	}
+
+	if state == VideoStateEnded {
+		p.stopTrackingLiveAnnouncement(la)
+		return
+	}
+
+	la.State = state
+	_, err := la.UpdateG(context.Background(), boil.Whitelist("state", "updated_at"))
+	if err != nil {
+		logger.WithError(err).WithField("guild", la.GuildID).Error("Failed updating youtube livestream announcement state")
+	}
+}
+
+func (p *Plugin) stopTrackingLiveAnnouncement(la *models.YoutubeLiveAnnouncement) {
+	_, err := la.DeleteG(context.Background())
+	if err != nil {
+		logger.WithError(err).WithField("guild", la.GuildID).Error("Failed removing youtube livestream announcement")
+	}
+}
+
+func parseYoutubeTime(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339, s)
+	return t
+}
+
+// videoStreamDuration returns how long the stream was live for, or 0 if it hasn't ended
+func videoStreamDuration(video *youtube.Video) time.Duration {
+	details := video.LiveStreamingDetails
+	if details == nil || details.ActualStartTime == "" || details.ActualEndTime == "" {
+		return 0
+	}
+
+	return parseYoutubeTime(details.ActualEndTime).Sub(parseYoutubeTime(details.ActualStartTime))
+}
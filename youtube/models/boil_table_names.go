@@ -6,7 +6,9 @@ package models
 var TableNames = struct {
 	YoutubeAnnouncements        string
 	YoutubeChannelSubscriptions string
+	YoutubeLiveAnnouncements    string
 }{
 	YoutubeAnnouncements:        "youtube_announcements",
 	YoutubeChannelSubscriptions: "youtube_channel_subscriptions",
+	YoutubeLiveAnnouncements:    "youtube_live_announcements",
 }
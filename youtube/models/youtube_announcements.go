@@ -23,32 +23,47 @@ import (
 
 // YoutubeAnnouncement is an object representing the database table.
 type YoutubeAnnouncement struct {
-	GuildID int64  `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
-	Message string `boil:"message" json:"message" toml:"message" yaml:"message"`
-	Enabled bool   `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	GuildID         int64  `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	Message         string `boil:"message" json:"message" toml:"message" yaml:"message"`
+	Enabled         bool   `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	UpcomingMessage string `boil:"upcoming_message" json:"upcoming_message" toml:"upcoming_message" yaml:"upcoming_message"`
+	LiveMessage     string `boil:"live_message" json:"live_message" toml:"live_message" yaml:"live_message"`
+	EndedMessage    string `boil:"ended_message" json:"ended_message" toml:"ended_message" yaml:"ended_message"`
 
 	R *youtubeAnnouncementR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L youtubeAnnouncementL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var YoutubeAnnouncementColumns = struct {
-	GuildID string
-	Message string
-	Enabled string
+	GuildID         string
+	Message         string
+	Enabled         string
+	UpcomingMessage string
+	LiveMessage     string
+	EndedMessage    string
 }{
-	GuildID: "guild_id",
-	Message: "message",
-	Enabled: "enabled",
+	GuildID:         "guild_id",
+	Message:         "message",
+	Enabled:         "enabled",
+	UpcomingMessage: "upcoming_message",
+	LiveMessage:     "live_message",
+	EndedMessage:    "ended_message",
 }
 
 var YoutubeAnnouncementTableColumns = struct {
-	GuildID string
-	Message string
-	Enabled string
+	GuildID         string
+	Message         string
+	Enabled         string
+	UpcomingMessage string
+	LiveMessage     string
+	EndedMessage    string
 }{
-	GuildID: "youtube_announcements.guild_id",
-	Message: "youtube_announcements.message",
-	Enabled: "youtube_announcements.enabled",
+	GuildID:         "youtube_announcements.guild_id",
+	Message:         "youtube_announcements.message",
+	Enabled:         "youtube_announcements.enabled",
+	UpcomingMessage: "youtube_announcements.upcoming_message",
+	LiveMessage:     "youtube_announcements.live_message",
+	EndedMessage:    "youtube_announcements.ended_message",
 }
 
 // Generated where
@@ -113,13 +128,19 @@ func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field
 func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
 var YoutubeAnnouncementWhere = struct {
-	GuildID whereHelperint64
-	Message whereHelperstring
-	Enabled whereHelperbool
+	GuildID         whereHelperint64
+	Message         whereHelperstring
+	Enabled         whereHelperbool
+	UpcomingMessage whereHelperstring
+	LiveMessage     whereHelperstring
+	EndedMessage    whereHelperstring
 }{
-	GuildID: whereHelperint64{field: "\"youtube_announcements\".\"guild_id\""},
-	Message: whereHelperstring{field: "\"youtube_announcements\".\"message\""},
-	Enabled: whereHelperbool{field: "\"youtube_announcements\".\"enabled\""},
+	GuildID:         whereHelperint64{field: "\"youtube_announcements\".\"guild_id\""},
+	Message:         whereHelperstring{field: "\"youtube_announcements\".\"message\""},
+	Enabled:         whereHelperbool{field: "\"youtube_announcements\".\"enabled\""},
+	UpcomingMessage: whereHelperstring{field: "\"youtube_announcements\".\"upcoming_message\""},
+	LiveMessage:     whereHelperstring{field: "\"youtube_announcements\".\"live_message\""},
+	EndedMessage:    whereHelperstring{field: "\"youtube_announcements\".\"ended_message\""},
 }
 
 // YoutubeAnnouncementRels is where relationship names are stored.
@@ -139,9 +160,9 @@ func (*youtubeAnnouncementR) NewStruct() *youtubeAnnouncementR {
 type youtubeAnnouncementL struct{}
 
 var (
-	youtubeAnnouncementAllColumns            = []string{"guild_id", "message", "enabled"}
+	youtubeAnnouncementAllColumns            = []string{"guild_id", "message", "enabled", "upcoming_message", "live_message", "ended_message"}
 	youtubeAnnouncementColumnsWithoutDefault = []string{"guild_id", "message"}
-	youtubeAnnouncementColumnsWithDefault    = []string{"enabled"}
+	youtubeAnnouncementColumnsWithDefault    = []string{"enabled", "upcoming_message", "live_message", "ended_message"}
 	youtubeAnnouncementPrimaryKeyColumns     = []string{"guild_id"}
 	youtubeAnnouncementGeneratedColumns      = []string{}
 )
@@ -36,6 +36,8 @@ type YoutubeChannelSubscription struct {
 	PublishLivestream  bool             `boil:"publish_livestream" json:"publish_livestream" toml:"publish_livestream" yaml:"publish_livestream"`
 	PublishShorts      bool             `boil:"publish_shorts" json:"publish_shorts" toml:"publish_shorts" yaml:"publish_shorts"`
 	Enabled            bool             `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	DigestMode         string           `boil:"digest_mode" json:"digest_mode" toml:"digest_mode" yaml:"digest_mode"`
+	DigestDailyAt      int              `boil:"digest_daily_at" json:"digest_daily_at" toml:"digest_daily_at" yaml:"digest_daily_at"`
 
 	R *youtubeChannelSubscriptionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L youtubeChannelSubscriptionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -54,6 +56,8 @@ var YoutubeChannelSubscriptionColumns = struct {
 	PublishLivestream  string
 	PublishShorts      string
 	Enabled            string
+	DigestMode         string
+	DigestDailyAt      string
 }{
 	ID:                 "id",
 	CreatedAt:          "created_at",
@@ -67,6 +71,8 @@ var YoutubeChannelSubscriptionColumns = struct {
 	PublishLivestream:  "publish_livestream",
 	PublishShorts:      "publish_shorts",
 	Enabled:            "enabled",
+	DigestMode:         "digest_mode",
+	DigestDailyAt:      "digest_daily_at",
 }
 
 var YoutubeChannelSubscriptionTableColumns = struct {
@@ -82,6 +88,8 @@ var YoutubeChannelSubscriptionTableColumns = struct {
 	PublishLivestream  string
 	PublishShorts      string
 	Enabled            string
+	DigestMode         string
+	DigestDailyAt      string
 }{
 	ID:                 "youtube_channel_subscriptions.id",
 	CreatedAt:          "youtube_channel_subscriptions.created_at",
@@ -95,6 +103,8 @@ var YoutubeChannelSubscriptionTableColumns = struct {
 	PublishLivestream:  "youtube_channel_subscriptions.publish_livestream",
 	PublishShorts:      "youtube_channel_subscriptions.publish_shorts",
 	Enabled:            "youtube_channel_subscriptions.enabled",
+	DigestMode:         "youtube_channel_subscriptions.digest_mode",
+	DigestDailyAt:      "youtube_channel_subscriptions.digest_daily_at",
 }
 
 // Generated where
@@ -180,6 +190,8 @@ var YoutubeChannelSubscriptionWhere = struct {
 	PublishLivestream  whereHelperbool
 	PublishShorts      whereHelperbool
 	Enabled            whereHelperbool
+	DigestMode         whereHelperstring
+	DigestDailyAt      whereHelperint
 }{
 	ID:                 whereHelperint{field: "\"youtube_channel_subscriptions\".\"id\""},
 	CreatedAt:          whereHelpertime_Time{field: "\"youtube_channel_subscriptions\".\"created_at\""},
@@ -193,14 +205,20 @@ var YoutubeChannelSubscriptionWhere = struct {
 	PublishLivestream:  whereHelperbool{field: "\"youtube_channel_subscriptions\".\"publish_livestream\""},
 	PublishShorts:      whereHelperbool{field: "\"youtube_channel_subscriptions\".\"publish_shorts\""},
 	Enabled:            whereHelperbool{field: "\"youtube_channel_subscriptions\".\"enabled\""},
+	DigestMode:         whereHelperstring{field: "\"youtube_channel_subscriptions\".\"digest_mode\""},
+	DigestDailyAt:      whereHelperint{field: "\"youtube_channel_subscriptions\".\"digest_daily_at\""},
 }
 
 // YoutubeChannelSubscriptionRels is where relationship names are stored.
 var YoutubeChannelSubscriptionRels = struct {
-}{}
+	SubscriptionYoutubeLiveAnnouncements string
+}{
+	SubscriptionYoutubeLiveAnnouncements: "SubscriptionYoutubeLiveAnnouncements",
+}
 
 // youtubeChannelSubscriptionR is where relationships are stored.
 type youtubeChannelSubscriptionR struct {
+	SubscriptionYoutubeLiveAnnouncements YoutubeLiveAnnouncementSlice `boil:"SubscriptionYoutubeLiveAnnouncements" json:"SubscriptionYoutubeLiveAnnouncements" toml:"SubscriptionYoutubeLiveAnnouncements" yaml:"SubscriptionYoutubeLiveAnnouncements"`
 }
 
 // NewStruct creates a new relationship struct
@@ -208,13 +226,20 @@ func (*youtubeChannelSubscriptionR) NewStruct() *youtubeChannelSubscriptionR {
 	return &youtubeChannelSubscriptionR{}
 }
 
+func (r *youtubeChannelSubscriptionR) GetSubscriptionYoutubeLiveAnnouncements() YoutubeLiveAnnouncementSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SubscriptionYoutubeLiveAnnouncements
+}
+
 // youtubeChannelSubscriptionL is where Load methods for each relationship are stored.
 type youtubeChannelSubscriptionL struct{}
 
 var (
-	youtubeChannelSubscriptionAllColumns            = []string{"id", "created_at", "updated_at", "guild_id", "channel_id", "youtube_channel_id", "youtube_channel_name", "mention_everyone", "mention_roles", "publish_livestream", "publish_shorts", "enabled"}
+	youtubeChannelSubscriptionAllColumns            = []string{"id", "created_at", "updated_at", "guild_id", "channel_id", "youtube_channel_id", "youtube_channel_name", "mention_everyone", "mention_roles", "publish_livestream", "publish_shorts", "enabled", "digest_mode", "digest_daily_at"}
 	youtubeChannelSubscriptionColumnsWithoutDefault = []string{"created_at", "updated_at", "guild_id", "channel_id", "youtube_channel_id", "youtube_channel_name", "mention_everyone"}
-	youtubeChannelSubscriptionColumnsWithDefault    = []string{"id", "mention_roles", "publish_livestream", "publish_shorts", "enabled"}
+	youtubeChannelSubscriptionColumnsWithDefault    = []string{"id", "mention_roles", "publish_livestream", "publish_shorts", "enabled", "digest_mode", "digest_daily_at"}
 	youtubeChannelSubscriptionPrimaryKeyColumns     = []string{"id"}
 	youtubeChannelSubscriptionGeneratedColumns      = []string{}
 )
@@ -330,6 +355,188 @@ func (q youtubeChannelSubscriptionQuery) Exists(ctx context.Context, exec boil.C
 	return count > 0, nil
 }
 
+// SubscriptionYoutubeLiveAnnouncements retrieves all the youtube_live_announcement's YoutubeLiveAnnouncements with an executor via subscription_id column.
+func (o *YoutubeChannelSubscription) SubscriptionYoutubeLiveAnnouncements(mods ...qm.QueryMod) youtubeLiveAnnouncementQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"youtube_live_announcements\".\"subscription_id\"=?", o.ID),
+	)
+
+	return YoutubeLiveAnnouncements(queryMods...)
+}
+
+// LoadSubscriptionYoutubeLiveAnnouncements allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (youtubeChannelSubscriptionL) LoadSubscriptionYoutubeLiveAnnouncements(ctx context.Context, e boil.ContextExecutor, singular bool, maybeYoutubeChannelSubscription interface{}, mods queries.Applicator) error {
+	var slice []*YoutubeChannelSubscription
+	var object *YoutubeChannelSubscription
+
+	if singular {
+		var ok bool
+		object, ok = maybeYoutubeChannelSubscription.(*YoutubeChannelSubscription)
+		if !ok {
+			object = new(YoutubeChannelSubscription)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeYoutubeChannelSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeYoutubeChannelSubscription))
+			}
+		}
+	} else {
+		s, ok := maybeYoutubeChannelSubscription.(*[]*YoutubeChannelSubscription)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeYoutubeChannelSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeYoutubeChannelSubscription))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &youtubeChannelSubscriptionR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &youtubeChannelSubscriptionR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`youtube_live_announcements`),
+		qm.WhereIn(`youtube_live_announcements.subscription_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load youtube_live_announcements")
+	}
+
+	var resultSlice []*YoutubeLiveAnnouncement
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice youtube_live_announcements")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on youtube_live_announcements")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for youtube_live_announcements")
+	}
+
+	if singular {
+		object.R.SubscriptionYoutubeLiveAnnouncements = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &youtubeLiveAnnouncementR{}
+			}
+			foreign.R.Subscription = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SubscriptionID {
+				local.R.SubscriptionYoutubeLiveAnnouncements = append(local.R.SubscriptionYoutubeLiveAnnouncements, foreign)
+				if foreign.R == nil {
+					foreign.R = &youtubeLiveAnnouncementR{}
+				}
+				foreign.R.Subscription = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddSubscriptionYoutubeLiveAnnouncementsG adds the given related objects to the existing relationships
+// of the youtube_channel_subscription, optionally inserting them as new records.
+// Appends related to o.R.SubscriptionYoutubeLiveAnnouncements.
+// Sets related.R.Subscription appropriately.
+// Uses the global database handle.
+func (o *YoutubeChannelSubscription) AddSubscriptionYoutubeLiveAnnouncementsG(ctx context.Context, insert bool, related ...*YoutubeLiveAnnouncement) error {
+	return o.AddSubscriptionYoutubeLiveAnnouncements(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddSubscriptionYoutubeLiveAnnouncements adds the given related objects to the existing relationships
+// of the youtube_channel_subscription, optionally inserting them as new records.
+// Appends related to o.R.SubscriptionYoutubeLiveAnnouncements.
+// Sets related.R.Subscription appropriately.
+func (o *YoutubeChannelSubscription) AddSubscriptionYoutubeLiveAnnouncements(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*YoutubeLiveAnnouncement) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SubscriptionID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"youtube_live_announcements\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"subscription_id"}),
+				strmangle.WhereClause("\"", "\"", 2, youtubeLiveAnnouncementPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SubscriptionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &youtubeChannelSubscriptionR{
+			SubscriptionYoutubeLiveAnnouncements: related,
+		}
+	} else {
+		o.R.SubscriptionYoutubeLiveAnnouncements = append(o.R.SubscriptionYoutubeLiveAnnouncements, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &youtubeLiveAnnouncementR{
+				Subscription: o,
+			}
+		} else {
+			rel.R.Subscription = o
+		}
+	}
+	return nil
+}
+
 // YoutubeChannelSubscriptions retrieves all the records using an executor.
 func YoutubeChannelSubscriptions(mods ...qm.QueryMod) youtubeChannelSubscriptionQuery {
 	mods = append(mods, qm.From("\"youtube_channel_subscriptions\""))
@@ -0,0 +1,1027 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// YoutubeLiveAnnouncement is an object representing the database table.
+type YoutubeLiveAnnouncement struct {
+	ID             int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	SubscriptionID int       `boil:"subscription_id" json:"subscription_id" toml:"subscription_id" yaml:"subscription_id"`
+	GuildID        int64     `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	ChannelID      int64     `boil:"channel_id" json:"channel_id" toml:"channel_id" yaml:"channel_id"`
+	MessageID      int64     `boil:"message_id" json:"message_id" toml:"message_id" yaml:"message_id"`
+	VideoID        string    `boil:"video_id" json:"video_id" toml:"video_id" yaml:"video_id"`
+	State          string    `boil:"state" json:"state" toml:"state" yaml:"state"`
+
+	R *youtubeLiveAnnouncementR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L youtubeLiveAnnouncementL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var YoutubeLiveAnnouncementColumns = struct {
+	ID             string
+	CreatedAt      string
+	UpdatedAt      string
+	SubscriptionID string
+	GuildID        string
+	ChannelID      string
+	MessageID      string
+	VideoID        string
+	State          string
+}{
+	ID:             "id",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+	SubscriptionID: "subscription_id",
+	GuildID:        "guild_id",
+	ChannelID:      "channel_id",
+	MessageID:      "message_id",
+	VideoID:        "video_id",
+	State:          "state",
+}
+
+var YoutubeLiveAnnouncementTableColumns = struct {
+	ID             string
+	CreatedAt      string
+	UpdatedAt      string
+	SubscriptionID string
+	GuildID        string
+	ChannelID      string
+	MessageID      string
+	VideoID        string
+	State          string
+}{
+	ID:             "youtube_live_announcements.id",
+	CreatedAt:      "youtube_live_announcements.created_at",
+	UpdatedAt:      "youtube_live_announcements.updated_at",
+	SubscriptionID: "youtube_live_announcements.subscription_id",
+	GuildID:        "youtube_live_announcements.guild_id",
+	ChannelID:      "youtube_live_announcements.channel_id",
+	MessageID:      "youtube_live_announcements.message_id",
+	VideoID:        "youtube_live_announcements.video_id",
+	State:          "youtube_live_announcements.state",
+}
+
+// Generated where
+
+var YoutubeLiveAnnouncementWhere = struct {
+	ID             whereHelperint64
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+	SubscriptionID whereHelperint
+	GuildID        whereHelperint64
+	ChannelID      whereHelperint64
+	MessageID      whereHelperint64
+	VideoID        whereHelperstring
+	State          whereHelperstring
+}{
+	ID:             whereHelperint64{field: "\"youtube_live_announcements\".\"id\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"youtube_live_announcements\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"youtube_live_announcements\".\"updated_at\""},
+	SubscriptionID: whereHelperint{field: "\"youtube_live_announcements\".\"subscription_id\""},
+	GuildID:        whereHelperint64{field: "\"youtube_live_announcements\".\"guild_id\""},
+	ChannelID:      whereHelperint64{field: "\"youtube_live_announcements\".\"channel_id\""},
+	MessageID:      whereHelperint64{field: "\"youtube_live_announcements\".\"message_id\""},
+	VideoID:        whereHelperstring{field: "\"youtube_live_announcements\".\"video_id\""},
+	State:          whereHelperstring{field: "\"youtube_live_announcements\".\"state\""},
+}
+
+// YoutubeLiveAnnouncementRels is where relationship names are stored.
+var YoutubeLiveAnnouncementRels = struct {
+	Subscription string
+}{
+	Subscription: "Subscription",
+}
+
+// youtubeLiveAnnouncementR is where relationships are stored.
+type youtubeLiveAnnouncementR struct {
+	Subscription *YoutubeChannelSubscription `boil:"Subscription" json:"Subscription" toml:"Subscription" yaml:"Subscription"`
+}
+
+// NewStruct creates a new relationship struct
+func (*youtubeLiveAnnouncementR) NewStruct() *youtubeLiveAnnouncementR {
+	return &youtubeLiveAnnouncementR{}
+}
+
+func (r *youtubeLiveAnnouncementR) GetSubscription() *YoutubeChannelSubscription {
+	if r == nil {
+		return nil
+	}
+	return r.Subscription
+}
+
+// youtubeLiveAnnouncementL is where Load methods for each relationship are stored.
+type youtubeLiveAnnouncementL struct{}
+
+var (
+	youtubeLiveAnnouncementAllColumns            = []string{"id", "created_at", "updated_at", "subscription_id", "guild_id", "channel_id", "message_id", "video_id", "state"}
+	youtubeLiveAnnouncementColumnsWithoutDefault = []string{"created_at", "updated_at", "subscription_id", "guild_id", "channel_id", "message_id", "video_id", "state"}
+	youtubeLiveAnnouncementColumnsWithDefault    = []string{"id"}
+	youtubeLiveAnnouncementPrimaryKeyColumns     = []string{"id"}
+	youtubeLiveAnnouncementGeneratedColumns      = []string{}
+)
+
+type (
+	// YoutubeLiveAnnouncementSlice is an alias for a slice of pointers to YoutubeLiveAnnouncement.
+	// This should almost always be used instead of []YoutubeLiveAnnouncement.
+	YoutubeLiveAnnouncementSlice []*YoutubeLiveAnnouncement
+
+	youtubeLiveAnnouncementQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	youtubeLiveAnnouncementType                 = reflect.TypeOf(&YoutubeLiveAnnouncement{})
+	youtubeLiveAnnouncementMapping              = queries.MakeStructMapping(youtubeLiveAnnouncementType)
+	youtubeLiveAnnouncementPrimaryKeyMapping, _ = queries.BindMapping(youtubeLiveAnnouncementType, youtubeLiveAnnouncementMapping, youtubeLiveAnnouncementPrimaryKeyColumns)
+	youtubeLiveAnnouncementInsertCacheMut       sync.RWMutex
+	youtubeLiveAnnouncementInsertCache          = make(map[string]insertCache)
+	youtubeLiveAnnouncementUpdateCacheMut       sync.RWMutex
+	youtubeLiveAnnouncementUpdateCache          = make(map[string]updateCache)
+	youtubeLiveAnnouncementUpsertCacheMut       sync.RWMutex
+	youtubeLiveAnnouncementUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single youtubeLiveAnnouncement record from the query using the global executor.
+func (q youtubeLiveAnnouncementQuery) OneG(ctx context.Context) (*YoutubeLiveAnnouncement, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single youtubeLiveAnnouncement record from the query.
+func (q youtubeLiveAnnouncementQuery) One(ctx context.Context, exec boil.ContextExecutor) (*YoutubeLiveAnnouncement, error) {
+	o := &YoutubeLiveAnnouncement{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for youtube_live_announcements")
+	}
+
+	return o, nil
+}
+
+// AllG returns all YoutubeLiveAnnouncement records from the query using the global executor.
+func (q youtubeLiveAnnouncementQuery) AllG(ctx context.Context) (YoutubeLiveAnnouncementSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all YoutubeLiveAnnouncement records from the query.
+func (q youtubeLiveAnnouncementQuery) All(ctx context.Context, exec boil.ContextExecutor) (YoutubeLiveAnnouncementSlice, error) {
+	var o []*YoutubeLiveAnnouncement
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to YoutubeLiveAnnouncement slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all YoutubeLiveAnnouncement records in the query using the global executor
+func (q youtubeLiveAnnouncementQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all YoutubeLiveAnnouncement records in the query.
+func (q youtubeLiveAnnouncementQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count youtube_live_announcements rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q youtubeLiveAnnouncementQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q youtubeLiveAnnouncementQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if youtube_live_announcements exists")
+	}
+
+	return count > 0, nil
+}
+
+// Subscription pointed to by the foreign key.
+func (o *YoutubeLiveAnnouncement) Subscription(mods ...qm.QueryMod) youtubeChannelSubscriptionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SubscriptionID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return YoutubeChannelSubscriptions(queryMods...)
+}
+
+// LoadSubscription allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (youtubeLiveAnnouncementL) LoadSubscription(ctx context.Context, e boil.ContextExecutor, singular bool, maybeYoutubeLiveAnnouncement interface{}, mods queries.Applicator) error {
+	var slice []*YoutubeLiveAnnouncement
+	var object *YoutubeLiveAnnouncement
+
+	if singular {
+		var ok bool
+		object, ok = maybeYoutubeLiveAnnouncement.(*YoutubeLiveAnnouncement)
+		if !ok {
+			object = new(YoutubeLiveAnnouncement)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeYoutubeLiveAnnouncement)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeYoutubeLiveAnnouncement))
+			}
+		}
+	} else {
+		s, ok := maybeYoutubeLiveAnnouncement.(*[]*YoutubeLiveAnnouncement)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeYoutubeLiveAnnouncement)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeYoutubeLiveAnnouncement))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &youtubeLiveAnnouncementR{}
+		}
+		args[object.SubscriptionID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &youtubeLiveAnnouncementR{}
+			}
+
+			args[obj.SubscriptionID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`youtube_channel_subscriptions`),
+		qm.WhereIn(`youtube_channel_subscriptions.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load YoutubeChannelSubscription")
+	}
+
+	var resultSlice []*YoutubeChannelSubscription
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice YoutubeChannelSubscription")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for youtube_channel_subscriptions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for youtube_channel_subscriptions")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Subscription = foreign
+		if foreign.R == nil {
+			foreign.R = &youtubeChannelSubscriptionR{}
+		}
+		foreign.R.SubscriptionYoutubeLiveAnnouncements = append(foreign.R.SubscriptionYoutubeLiveAnnouncements, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SubscriptionID == foreign.ID {
+				local.R.Subscription = foreign
+				if foreign.R == nil {
+					foreign.R = &youtubeChannelSubscriptionR{}
+				}
+				foreign.R.SubscriptionYoutubeLiveAnnouncements = append(foreign.R.SubscriptionYoutubeLiveAnnouncements, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSubscriptionG of the youtubeLiveAnnouncement to the related item.
+// Sets o.R.Subscription to related.
+// Adds o to related.R.SubscriptionYoutubeLiveAnnouncements.
+// Uses the global database handle.
+func (o *YoutubeLiveAnnouncement) SetSubscriptionG(ctx context.Context, insert bool, related *YoutubeChannelSubscription) error {
+	return o.SetSubscription(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSubscription of the youtubeLiveAnnouncement to the related item.
+// Sets o.R.Subscription to related.
+// Adds o to related.R.SubscriptionYoutubeLiveAnnouncements.
+func (o *YoutubeLiveAnnouncement) SetSubscription(ctx context.Context, exec boil.ContextExecutor, insert bool, related *YoutubeChannelSubscription) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"youtube_live_announcements\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"subscription_id"}),
+		strmangle.WhereClause("\"", "\"", 2, youtubeLiveAnnouncementPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SubscriptionID = related.ID
+	if o.R == nil {
+		o.R = &youtubeLiveAnnouncementR{
+			Subscription: related,
+		}
+	} else {
+		o.R.Subscription = related
+	}
+
+	if related.R == nil {
+		related.R = &youtubeChannelSubscriptionR{
+			SubscriptionYoutubeLiveAnnouncements: YoutubeLiveAnnouncementSlice{o},
+		}
+	} else {
+		related.R.SubscriptionYoutubeLiveAnnouncements = append(related.R.SubscriptionYoutubeLiveAnnouncements, o)
+	}
+
+	return nil
+}
+
+// YoutubeLiveAnnouncements retrieves all the records using an executor.
+func YoutubeLiveAnnouncements(mods ...qm.QueryMod) youtubeLiveAnnouncementQuery {
+	mods = append(mods, qm.From("\"youtube_live_announcements\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"youtube_live_announcements\".*"})
+	}
+
+	return youtubeLiveAnnouncementQuery{q}
+}
+
+// FindYoutubeLiveAnnouncementG retrieves a single record by ID.
+func FindYoutubeLiveAnnouncementG(ctx context.Context, iD int64, selectCols ...string) (*YoutubeLiveAnnouncement, error) {
+	return FindYoutubeLiveAnnouncement(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindYoutubeLiveAnnouncement retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindYoutubeLiveAnnouncement(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*YoutubeLiveAnnouncement, error) {
+	youtubeLiveAnnouncementObj := &YoutubeLiveAnnouncement{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"youtube_live_announcements\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, youtubeLiveAnnouncementObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from youtube_live_announcements")
+	}
+
+	return youtubeLiveAnnouncementObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *YoutubeLiveAnnouncement) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *YoutubeLiveAnnouncement) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no youtube_live_announcements provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(youtubeLiveAnnouncementColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	youtubeLiveAnnouncementInsertCacheMut.RLock()
+	cache, cached := youtubeLiveAnnouncementInsertCache[key]
+	youtubeLiveAnnouncementInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			youtubeLiveAnnouncementAllColumns,
+			youtubeLiveAnnouncementColumnsWithDefault,
+			youtubeLiveAnnouncementColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(youtubeLiveAnnouncementType, youtubeLiveAnnouncementMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(youtubeLiveAnnouncementType, youtubeLiveAnnouncementMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"youtube_live_announcements\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"youtube_live_announcements\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into youtube_live_announcements")
+	}
+
+	if !cached {
+		youtubeLiveAnnouncementInsertCacheMut.Lock()
+		youtubeLiveAnnouncementInsertCache[key] = cache
+		youtubeLiveAnnouncementInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single YoutubeLiveAnnouncement record using the global executor.
+// See Update for more documentation.
+func (o *YoutubeLiveAnnouncement) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the YoutubeLiveAnnouncement.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *YoutubeLiveAnnouncement) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	youtubeLiveAnnouncementUpdateCacheMut.RLock()
+	cache, cached := youtubeLiveAnnouncementUpdateCache[key]
+	youtubeLiveAnnouncementUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			youtubeLiveAnnouncementAllColumns,
+			youtubeLiveAnnouncementPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update youtube_live_announcements, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"youtube_live_announcements\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, youtubeLiveAnnouncementPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(youtubeLiveAnnouncementType, youtubeLiveAnnouncementMapping, append(wl, youtubeLiveAnnouncementPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update youtube_live_announcements row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for youtube_live_announcements")
+	}
+
+	if !cached {
+		youtubeLiveAnnouncementUpdateCacheMut.Lock()
+		youtubeLiveAnnouncementUpdateCache[key] = cache
+		youtubeLiveAnnouncementUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q youtubeLiveAnnouncementQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q youtubeLiveAnnouncementQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for youtube_live_announcements")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for youtube_live_announcements")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o YoutubeLiveAnnouncementSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o YoutubeLiveAnnouncementSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), youtubeLiveAnnouncementPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"youtube_live_announcements\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, youtubeLiveAnnouncementPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in youtubeLiveAnnouncement slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all youtubeLiveAnnouncement")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *YoutubeLiveAnnouncement) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *YoutubeLiveAnnouncement) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no youtube_live_announcements provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(youtubeLiveAnnouncementColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	youtubeLiveAnnouncementUpsertCacheMut.RLock()
+	cache, cached := youtubeLiveAnnouncementUpsertCache[key]
+	youtubeLiveAnnouncementUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			youtubeLiveAnnouncementAllColumns,
+			youtubeLiveAnnouncementColumnsWithDefault,
+			youtubeLiveAnnouncementColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			youtubeLiveAnnouncementAllColumns,
+			youtubeLiveAnnouncementPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert youtube_live_announcements, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(youtubeLiveAnnouncementAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(youtubeLiveAnnouncementPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert youtube_live_announcements, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(youtubeLiveAnnouncementPrimaryKeyColumns))
+			copy(conflict, youtubeLiveAnnouncementPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"youtube_live_announcements\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(youtubeLiveAnnouncementType, youtubeLiveAnnouncementMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(youtubeLiveAnnouncementType, youtubeLiveAnnouncementMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert youtube_live_announcements")
+	}
+
+	if !cached {
+		youtubeLiveAnnouncementUpsertCacheMut.Lock()
+		youtubeLiveAnnouncementUpsertCache[key] = cache
+		youtubeLiveAnnouncementUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single YoutubeLiveAnnouncement record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *YoutubeLiveAnnouncement) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single YoutubeLiveAnnouncement record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *YoutubeLiveAnnouncement) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no YoutubeLiveAnnouncement provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), youtubeLiveAnnouncementPrimaryKeyMapping)
+	sql := "DELETE FROM \"youtube_live_announcements\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from youtube_live_announcements")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for youtube_live_announcements")
+	}
+
+	return rowsAff, nil
+}
+
+func (q youtubeLiveAnnouncementQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q youtubeLiveAnnouncementQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no youtubeLiveAnnouncementQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from youtube_live_announcements")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for youtube_live_announcements")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o YoutubeLiveAnnouncementSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o YoutubeLiveAnnouncementSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), youtubeLiveAnnouncementPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"youtube_live_announcements\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, youtubeLiveAnnouncementPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from youtubeLiveAnnouncement slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for youtube_live_announcements")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *YoutubeLiveAnnouncement) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no YoutubeLiveAnnouncement provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *YoutubeLiveAnnouncement) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindYoutubeLiveAnnouncement(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *YoutubeLiveAnnouncementSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty YoutubeLiveAnnouncementSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *YoutubeLiveAnnouncementSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := YoutubeLiveAnnouncementSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), youtubeLiveAnnouncementPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"youtube_live_announcements\".* FROM \"youtube_live_announcements\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, youtubeLiveAnnouncementPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in YoutubeLiveAnnouncementSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// YoutubeLiveAnnouncementExistsG checks if the YoutubeLiveAnnouncement row exists.
+func YoutubeLiveAnnouncementExistsG(ctx context.Context, iD int64) (bool, error) {
+	return YoutubeLiveAnnouncementExists(ctx, boil.GetContextDB(), iD)
+}
+
+// YoutubeLiveAnnouncementExists checks if the YoutubeLiveAnnouncement row exists.
+func YoutubeLiveAnnouncementExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"youtube_live_announcements\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if youtube_live_announcements exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the YoutubeLiveAnnouncement row exists.
+func (o *YoutubeLiveAnnouncement) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return YoutubeLiveAnnouncementExists(ctx, exec, o.ID)
+}
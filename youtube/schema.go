@@ -73,4 +73,36 @@ ALTER TABLE youtube_announcements ALTER COLUMN guild_id SET NOT NULL;
 ALTER TABLE youtube_announcements ALTER COLUMN message SET NOT NULL;
 `, `
 ALTER TABLE youtube_announcements ALTER COLUMN enabled SET NOT NULL;
+`, `
+
+-- Separate templates for the livestream states, empty falls back to the main message
+ALTER TABLE youtube_announcements ADD COLUMN IF NOT EXISTS upcoming_message TEXT NOT NULL DEFAULT '';
+`, `
+ALTER TABLE youtube_announcements ADD COLUMN IF NOT EXISTS live_message TEXT NOT NULL DEFAULT '';
+`, `
+ALTER TABLE youtube_announcements ADD COLUMN IF NOT EXISTS ended_message TEXT NOT NULL DEFAULT '';
+`, `
+
+-- Posted livestream announcements, edited as the stream goes from scheduled to live to ended
+CREATE TABLE IF NOT EXISTS youtube_live_announcements (
+	id BIGSERIAL PRIMARY KEY,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+	subscription_id INT NOT NULL REFERENCES youtube_channel_subscriptions(id) ON DELETE CASCADE,
+	guild_id BIGINT NOT NULL,
+	channel_id BIGINT NOT NULL,
+	message_id BIGINT NOT NULL,
+
+	video_id TEXT NOT NULL,
+	state TEXT NOT NULL,
+
+	UNIQUE(channel_id, message_id)
+);
+`, `
+
+-- Uploads can be collected into hourly or daily digests, livestreams are always announced right away
+ALTER TABLE youtube_channel_subscriptions ADD COLUMN IF NOT EXISTS digest_mode TEXT NOT NULL DEFAULT '';
+`, `
+ALTER TABLE youtube_channel_subscriptions ADD COLUMN IF NOT EXISTS digest_daily_at INT NOT NULL DEFAULT 0;
 `}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/ThatBathroom/yagpdb/v2/common"
 	"github.com/ThatBathroom/yagpdb/v2/common/cplogs"
+	"github.com/ThatBathroom/yagpdb/v2/feeds"
 	"github.com/ThatBathroom/yagpdb/v2/lib/discordgo"
 	"github.com/ThatBathroom/yagpdb/v2/web"
 	"github.com/ThatBathroom/yagpdb/v2/youtube/models"
@@ -47,11 +48,27 @@ type YoutubeFeedForm struct {
 	PublishLivestream bool
 	Enabled           bool
 	CustomMessage     string
+	DigestMode        string
+	DigestDailyAt     string
+}
+
+var _ web.CustomValidator = (*YoutubeFeedForm)(nil)
+
+func (f *YoutubeFeedForm) Validate(tmpl web.TemplateData, _ int64) bool {
+	if _, err := feeds.ParseDigestForm(f.DigestMode, f.DigestDailyAt); err != nil {
+		tmpl.AddAlerts(web.ErrorAlert(err.Error()))
+		return false
+	}
+
+	return true
 }
 
 type YoutubeAnnouncementForm struct {
-	Message string `json:"message" valid:"template,5000"`
-	Enabled bool
+	Message         string `json:"message" valid:"template,5000"`
+	UpcomingMessage string `json:"upcoming_message" valid:"template,5000"`
+	LiveMessage     string `json:"live_message" valid:"template,5000"`
+	EndedMessage    string `json:"ended_message" valid:"template,5000"`
+	Enabled         bool
 }
 
 var (
@@ -129,13 +146,16 @@ func (p *Plugin) HandleYoutubeAnnouncement(w http.ResponseWriter, r *http.Reques
 	form := ctx.Value(common.ContextKeyParsedForm).(*YoutubeAnnouncementForm)
 
 	announcement := &models.YoutubeAnnouncement{
-		GuildID: activeGuild.ID,
-		Message: form.Message,
-		Enabled: form.Enabled,
+		GuildID:         activeGuild.ID,
+		Message:         form.Message,
+		UpcomingMessage: form.UpcomingMessage,
+		LiveMessage:     form.LiveMessage,
+		EndedMessage:    form.EndedMessage,
+		Enabled:         form.Enabled,
 	}
 	err = announcement.UpsertG(ctx, true, []string{"guild_id"},
-		boil.Whitelist("message", "enabled"), /* updateColumns */
-		boil.Whitelist("guild_id", "message", "enabled") /* insertColumns */)
+		boil.Whitelist("message", "upcoming_message", "live_message", "ended_message", "enabled"), /* updateColumns */
+		boil.Whitelist("guild_id", "message", "upcoming_message", "live_message", "ended_message", "enabled") /* insertColumns */)
 	if err != nil {
 		return templateData, err
 	}
@@ -191,7 +211,9 @@ func (p *Plugin) HandleNew(w http.ResponseWriter, r *http.Request) (web.Template
 	}
 
 	ytChannel := cResp.Items[0]
-	sub, err := p.AddFeed(activeGuild.ID, data.DiscordChannel, ytChannel, data.MentionEveryone, data.PublishLivestream, data.PublishShorts, data.MentionRoles)
+	digestDailyAt, _ := feeds.ParseDigestForm(data.DigestMode, data.DigestDailyAt)
+	digest := feeds.DigestSettings{Mode: data.DigestMode, DailyAt: digestDailyAt}
+	sub, err := p.AddFeed(activeGuild.ID, data.DiscordChannel, ytChannel, data.MentionEveryone, data.PublishLivestream, data.PublishShorts, data.MentionRoles, digest)
 
 	if err != nil {
 		if err == ErrNoChannel {
@@ -248,6 +270,8 @@ func (p *Plugin) HandleEdit(w http.ResponseWriter, r *http.Request) (templateDat
 	updatedSub.PublishShorts = form.PublishShorts
 	updatedSub.ChannelID = discordgo.StrID(form.DiscordChannel)
 	updatedSub.Enabled = form.Enabled
+	updatedSub.DigestMode = form.DigestMode
+	updatedSub.DigestDailyAt, _ = feeds.ParseDigestForm(form.DigestMode, form.DigestDailyAt)
 
 	numEnabled, _ := models.YoutubeChannelSubscriptions(
 		models.YoutubeChannelSubscriptionWhere.GuildID.EQ(discordgo.StrID(activeGuild.ID)),